@@ -195,11 +195,67 @@ fn bench_check_with_threshold(c: &mut Criterion) {
     group.finish();
 }
 
+/// Generate a Go project with many small files, one unsafe.Pointer each.
+fn many_file_fixture(files: usize) -> tempfile::TempDir {
+    let dir = tempfile::tempdir().unwrap();
+    std::fs::write(
+        dir.path().join("go.mod"),
+        "module example.com/fixture\n\ngo 1.21\n",
+    )
+    .unwrap();
+    for i in 0..files {
+        let pkg = dir.path().join(format!("pkg{}", i / 50));
+        std::fs::create_dir_all(&pkg).unwrap();
+        std::fs::write(
+            pkg.join(format!("file{i}.go")),
+            format!(
+                "package pkg\n\nimport \"unsafe\"\n\n\
+                 func Addr{i}(x *int) uintptr {{\n\
+                 \t// SAFETY: x is pinned by the caller.\n\
+                 \treturn uintptr(unsafe.Pointer(x))\n}}\n"
+            ),
+        )
+        .unwrap();
+    }
+    dir
+}
+
+/// Compare a single worker against the default pool on 2,000 files.
+fn bench_check_jobs(c: &mut Criterion) {
+    let quench_bin = env!("CARGO_BIN_EXE_quench");
+
+    if !has_check_command() {
+        return;
+    }
+
+    let fixture = many_file_fixture(2000);
+    let mut group = c.benchmark_group("check_jobs");
+
+    for jobs in [Some("1"), None] {
+        let id = jobs.unwrap_or("default");
+        group.bench_with_input(BenchmarkId::new("many-files", id), &jobs, |b, jobs| {
+            b.iter(|| {
+                let mut cmd = Command::new(quench_bin);
+                cmd.args(["check", "--escapes", "--no-cache", "--no-limit"]);
+                if let Some(jobs) = jobs {
+                    cmd.args(["--jobs", jobs]);
+                }
+                cmd.current_dir(fixture.path())
+                    .output()
+                    .expect("quench check should run")
+            })
+        });
+    }
+
+    group.finish();
+}
+
 criterion_group!(
     benches,
     bench_check_cold,
     bench_check_deep,
     bench_check_large_files,
-    bench_check_with_threshold
+    bench_check_with_threshold,
+    bench_check_jobs
 );
 criterion_main!(benches);
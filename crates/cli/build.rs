@@ -1,16 +1,20 @@
 // SPDX-License-Identifier: MIT
 // Copyright (c) 2026 Alfred Jean LLC
 
-//! Build script that generates env var name constants for `env.rs`.
+//! Build script that generates env var name constants for `env.rs` and
+//! records the commit and compiler for `quench --version`.
 
 // Build scripts should panic on failure — there is no meaningful recovery.
 #![allow(clippy::unwrap_used, clippy::expect_used, clippy::panic)]
 
 use std::env;
 use std::fs;
-use std::path::Path;
+use std::path::{Path, PathBuf};
+use std::process::Command;
 
 fn main() {
+    build_info();
+
     let out_dir = env::var("OUT_DIR").expect("OUT_DIR not set");
     let dest = Path::new(&out_dir).join("env_names.rs");
 
@@ -27,6 +31,8 @@ pub const CODEX: &str = "CODEX";
 pub const CURSOR: &str = "CURSOR";
 /// Environment variable: indicates CI environment.
 pub const CI: &str = "CI";
+/// Environment variable: indicates GitHub Actions environment (`true`).
+pub const GITHUB_ACTIONS: &str = "GITHUB_ACTIONS";
 /// Environment variable: enables debug file listing.
 pub const QUENCH_DEBUG_FILES: &str = "QUENCH_DEBUG_FILES";
 /// Environment variable: enables debug/verbose output.
@@ -43,3 +49,67 @@ pub const XDG_CONFIG_HOME: &str = "XDG_CONFIG_HOME";
 
     fs::write(dest, contents).expect("failed to write env_names.rs");
 }
+
+/// Set `QUENCH_COMMIT` and `QUENCH_RUSTC` for `build_info.rs`.
+///
+/// The commit comes from the checkout being built, or from
+/// `QUENCH_BUILD_COMMIT` when building from a source archive without
+/// `.git` (e.g., a crates.io package); `unknown` otherwise.
+fn build_info() {
+    println!("cargo:rerun-if-changed=build.rs");
+    println!("cargo:rerun-if-env-changed=QUENCH_BUILD_COMMIT");
+
+    let commit = git(&["rev-parse", "--short=12", "HEAD"])
+        .or_else(|| env::var("QUENCH_BUILD_COMMIT").ok())
+        .filter(|commit| !commit.is_empty())
+        .unwrap_or_else(|| "unknown".to_string());
+    println!("cargo:rustc-env=QUENCH_COMMIT={}", commit);
+
+    // Rebuild when HEAD moves, so the commit stays current
+    if let Some(git_dir) = git(&["rev-parse", "--absolute-git-dir"]).map(PathBuf::from) {
+        for file in ["HEAD", "packed-refs"] {
+            let path = git_dir.join(file);
+            if path.exists() {
+                println!("cargo:rerun-if-changed={}", path.display());
+            }
+        }
+        if let Some(head) = git(&["symbolic-ref", "-q", "HEAD"]) {
+            let path = git_dir.join(head);
+            if path.exists() {
+                println!("cargo:rerun-if-changed={}", path.display());
+            }
+        }
+    }
+
+    // `rustc 1.85.0 (4d91de4e4 2025-02-17)` -> `rustc 1.85.0`
+    let rustc = env::var("RUSTC").unwrap_or_else(|_| "rustc".to_string());
+    let version = Command::new(rustc)
+        .arg("--version")
+        .output()
+        .ok()
+        .filter(|output| output.status.success())
+        .map(|output| {
+            String::from_utf8_lossy(&output.stdout)
+                .split_whitespace()
+                .take(2)
+                .collect::<Vec<_>>()
+                .join(" ")
+        })
+        .filter(|version| !version.is_empty())
+        .unwrap_or_else(|| "rustc unknown".to_string());
+    println!("cargo:rustc-env=QUENCH_RUSTC={}", version);
+}
+
+/// Output of a git command run in the crate directory, trimmed.
+fn git(args: &[&str]) -> Option<String> {
+    let dir = env::var("CARGO_MANIFEST_DIR").ok()?;
+    let output = Command::new("git")
+        .args(args)
+        .current_dir(dir)
+        .output()
+        .ok()?;
+    if !output.status.success() {
+        return None;
+    }
+    Some(String::from_utf8_lossy(&output.stdout).trim().to_string())
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! cgo preamble masking.
+//!
+//! The comment group directly above `import "C"` is the cgo preamble: C code
+//! handed to the C compiler, not a Go comment. A `CGO:` marker inside it
+//! (or a `#include` that looks like a comment to the generic search) must
+//! not count as the justification, so the preamble is masked before
+//! justification comments are searched.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+/// Replacement for masked lines: a comment with no content.
+const MASK: &str = "//";
+
+/// `import "C"` on its own, or `"C"` inside an import group.
+#[allow(clippy::expect_used)]
+static CGO_IMPORT: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r#"^\s*(?:import\s+)?"C"\s*(?://.*)?$"#).expect("valid regex pattern")
+});
+
+/// Mask the cgo preamble above each `import "C"`.
+///
+/// The preamble is the comment group ending on the line directly above the
+/// import: consecutive `//` lines and `/* ... */` blocks with no blank line
+/// between them, matching how cgo reads it. A `// CGO:` justification must
+/// be separated from the preamble by a blank line or trail the import.
+///
+/// Preamble lines and the blank lines above it become bare `//` comments,
+/// so the justification block above stays contiguous with the import.
+/// Returns None when the file has no preamble. Line numbers stay valid.
+pub fn mask_cgo_preambles(content: &str) -> Option<String> {
+    if !content.contains("\"C\"") {
+        return None;
+    }
+
+    let mut lines: Vec<&str> = content.split('\n').collect();
+    let imports: Vec<usize> = (0..lines.len())
+        .filter(|&i| CGO_IMPORT.is_match(lines[i]))
+        .collect();
+
+    let mut changed = false;
+    for import in imports {
+        let mut j = import;
+        while j > 0 {
+            let above = lines[j - 1].trim();
+            let start = if above.ends_with("*/") {
+                match (0..j).rev().find(|&k| lines[k].contains("/*")) {
+                    Some(start) => start,
+                    None => break,
+                }
+            } else if above.starts_with("//") {
+                j - 1
+            } else {
+                break;
+            };
+            for line in &mut lines[start..j] {
+                *line = MASK;
+            }
+            changed = true;
+            j = start;
+        }
+
+        // The blank lines separating the justification from the preamble
+        if j < import {
+            while j > 0 && lines[j - 1].trim().is_empty() {
+                j -= 1;
+                lines[j] = MASK;
+            }
+        }
+    }
+
+    changed.then(|| lines.join("\n"))
+}
+
+#[cfg(test)]
+#[path = "cgo_tests.rs"]
+mod tests;
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+
+#[test]
+fn no_cgo_import_returns_none() {
+    assert_eq!(
+        mask_cgo_preambles("package main\n\n// Doc\nfunc main() {}\n"),
+        None
+    );
+}
+
+#[test]
+fn import_without_preamble_returns_none() {
+    assert_eq!(mask_cgo_preambles("package main\n\nimport \"C\"\n"), None);
+}
+
+#[test]
+fn masks_block_preamble() {
+    let content = "package main\n\n// CGO: binds libm\n\n/*\n#include <math.h>\n*/\nimport \"C\"\n";
+    let masked = mask_cgo_preambles(content).unwrap();
+    assert_eq!(
+        masked,
+        "package main\n\n// CGO: binds libm\n//\n//\n//\n//\nimport \"C\"\n"
+    );
+}
+
+#[test]
+fn masks_line_comment_preamble() {
+    let content = "package main\n\n// #include <stdlib.h>\n// #cgo LDFLAGS: -lm\nimport \"C\"\n";
+    let masked = mask_cgo_preambles(content).unwrap();
+    assert_eq!(masked, "package main\n//\n//\n//\nimport \"C\"\n");
+}
+
+#[test]
+fn masks_cgo_marker_inside_preamble() {
+    let content = "package main\n\n/* CGO: not a Go comment */\nimport \"C\"\n";
+    let masked = mask_cgo_preambles(content).unwrap();
+    assert_eq!(masked, "package main\n//\n//\nimport \"C\"\n");
+}
+
+#[test]
+fn masks_adjacent_comment_group() {
+    // A comment touching the preamble is part of it, as cgo reads it
+    let content = "package main\n\n// CGO: binds libm\n/*\n#include <math.h>\n*/\nimport \"C\"\n";
+    let masked = mask_cgo_preambles(content).unwrap();
+    assert!(!masked.contains("CGO:"));
+    assert_eq!(masked.lines().count(), content.lines().count());
+}
+
+#[test]
+fn masks_preamble_in_import_group() {
+    let content = "package main\n\nimport (\n\t// #include <stdio.h>\n\t\"C\"\n\t\"fmt\"\n)\n";
+    let masked = mask_cgo_preambles(content).unwrap();
+    assert_eq!(
+        masked,
+        "package main\n\nimport (\n//\n\t\"C\"\n\t\"fmt\"\n)\n"
+    );
+}
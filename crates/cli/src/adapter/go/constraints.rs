@@ -0,0 +1,363 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Build constraint evaluation.
+//!
+//! A Go file is only part of a build when its constraints hold for the build
+//! tags: a `//go:build` expression (or legacy `// +build` lines) before the
+//! package clause, and a `_GOOS`, `_GOARCH` or `_GOOS_GOARCH` file name
+//! suffix. Follows `go build` semantics: `unix` holds for Unix systems,
+//! `linux` for android, `darwin` for ios, `solaris` for illumos, and the
+//! `gc` compiler and `go1.N` release tags always hold.
+
+use std::io::Read;
+use std::path::Path;
+
+/// Known operating systems (GOOS values).
+const KNOWN_OS: &[&str] = &[
+    "aix",
+    "android",
+    "darwin",
+    "dragonfly",
+    "freebsd",
+    "hurd",
+    "illumos",
+    "ios",
+    "js",
+    "linux",
+    "nacl",
+    "netbsd",
+    "openbsd",
+    "plan9",
+    "solaris",
+    "wasip1",
+    "windows",
+    "zos",
+];
+
+/// Known architectures (GOARCH values).
+const KNOWN_ARCH: &[&str] = &[
+    "386",
+    "amd64",
+    "amd64p32",
+    "arm",
+    "armbe",
+    "arm64",
+    "arm64be",
+    "loong64",
+    "mips",
+    "mipsle",
+    "mips64",
+    "mips64le",
+    "mips64p32",
+    "mips64p32le",
+    "ppc",
+    "ppc64",
+    "ppc64le",
+    "riscv",
+    "riscv64",
+    "s390",
+    "s390x",
+    "sparc",
+    "sparc64",
+    "wasm",
+];
+
+/// Operating systems satisfying the `unix` tag.
+const UNIX_OS: &[&str] = &[
+    "aix",
+    "android",
+    "darwin",
+    "dragonfly",
+    "freebsd",
+    "hurd",
+    "illumos",
+    "ios",
+    "linux",
+    "netbsd",
+    "openbsd",
+    "solaris",
+];
+
+/// Bytes read looking for constraints; they precede the package clause.
+const HEADER_LIMIT: u64 = 8 * 1024;
+
+/// A build constraint expression.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum BuildExpr {
+    Tag(String),
+    Not(Box<BuildExpr>),
+    And(Box<BuildExpr>, Box<BuildExpr>),
+    Or(Box<BuildExpr>, Box<BuildExpr>),
+}
+
+impl BuildExpr {
+    /// Evaluate with `satisfied` deciding each tag.
+    pub fn eval(&self, satisfied: &impl Fn(&str) -> bool) -> bool {
+        match self {
+            Self::Tag(tag) => satisfied(tag),
+            Self::Not(expr) => !expr.eval(satisfied),
+            Self::And(a, b) => a.eval(satisfied) && b.eval(satisfied),
+            Self::Or(a, b) => a.eval(satisfied) || b.eval(satisfied),
+        }
+    }
+}
+
+/// Parse a `//go:build` expression (`linux && (amd64 || arm64)`).
+///
+/// Returns None for malformed expressions.
+pub fn parse_build_expr(text: &str) -> Option<BuildExpr> {
+    let tokens = tokenize(text)?;
+    let mut parser = Parser { tokens, pos: 0 };
+    let expr = parser.or()?;
+    (parser.pos == parser.tokens.len()).then_some(expr)
+}
+
+/// Parse one legacy `// +build` line: spaces separate alternatives, commas
+/// join terms, and `!` negates a term.
+fn parse_plus_build_line(text: &str) -> Option<BuildExpr> {
+    text.split_whitespace()
+        .map(|option| {
+            option
+                .split(',')
+                .map(|term| match term.strip_prefix('!') {
+                    Some(tag) if is_tag(tag) => Some(BuildExpr::Not(Box::new(tag_expr(tag)))),
+                    None if is_tag(term) => Some(tag_expr(term)),
+                    _ => None,
+                })
+                .reduce(|a, b| Some(BuildExpr::And(Box::new(a?), Box::new(b?))))?
+        })
+        .reduce(|a, b| Some(BuildExpr::Or(Box::new(a?), Box::new(b?))))?
+}
+
+/// The constraint expression from a Go file's header.
+///
+/// A `//go:build` line wins; otherwise all `// +build` lines must hold.
+/// Constraints after the package clause don't count.
+pub fn build_constraint(content: &str) -> Option<BuildExpr> {
+    let mut plus_build: Option<BuildExpr> = None;
+    let mut in_block = false;
+    for line in content.lines() {
+        let trimmed = line.trim();
+        if in_block {
+            in_block = !trimmed.contains("*/");
+            continue;
+        }
+        if let Some(expr) = trimmed.strip_prefix("//go:build") {
+            if expr.is_empty() || expr.starts_with([' ', '\t']) {
+                return parse_build_expr(expr);
+            }
+            continue;
+        }
+        if let Some(rest) = trimmed.strip_prefix("//") {
+            if let Some(expr) = rest.trim_start().strip_prefix("+build")
+                && expr.starts_with([' ', '\t'])
+                && let Some(line_expr) = parse_plus_build_line(expr)
+            {
+                plus_build = Some(match plus_build {
+                    Some(prev) => BuildExpr::And(Box::new(prev), Box::new(line_expr)),
+                    None => line_expr,
+                });
+            }
+            continue;
+        }
+        if trimmed.is_empty() {
+            continue;
+        }
+        if let Some(rest) = trimmed.strip_prefix("/*") {
+            in_block = !rest.contains("*/");
+            continue;
+        }
+        // Package clause or code: constraints can no longer appear
+        break;
+    }
+    plus_build
+}
+
+/// Tags required by a file name suffix (`_windows.go`, `_linux_arm64.go`).
+///
+/// Everything before the first `_` is ignored, so `windows.go` is
+/// unconstrained.
+pub fn file_name_tags(path: &Path) -> Vec<&str> {
+    let Some(stem) = path
+        .file_name()
+        .and_then(|n| n.to_str())
+        .and_then(|n| n.strip_suffix(".go"))
+    else {
+        return Vec::new();
+    };
+    let stem = stem.strip_suffix("_test").unwrap_or(stem);
+    let Some(at) = stem.find('_') else {
+        return Vec::new();
+    };
+    let parts: Vec<&str> = stem[at + 1..].split('_').collect();
+    match parts.as_slice() {
+        [.., os, arch] if KNOWN_OS.contains(os) && KNOWN_ARCH.contains(arch) => vec![*os, *arch],
+        [.., last] if KNOWN_OS.contains(last) || KNOWN_ARCH.contains(last) => vec![*last],
+        _ => Vec::new(),
+    }
+}
+
+/// Whether `tag` holds for the build tags.
+pub fn tag_satisfied(tag: &str, tags: &[String]) -> bool {
+    let has = |t: &str| tags.iter().any(|given| given == t);
+    has(tag)
+        || tag == "gc"
+        || tag
+            .strip_prefix("go1.")
+            .is_some_and(|minor| !minor.is_empty() && minor.bytes().all(|b| b.is_ascii_digit()))
+        || (tag == "unix" && UNIX_OS.iter().any(|os| has(os)))
+        || (tag == "linux" && has("android"))
+        || (tag == "darwin" && has("ios"))
+        || (tag == "solaris" && has("illumos"))
+}
+
+/// Whether a file is part of the build for `tags`.
+///
+/// Files other than `.go` always are. Malformed constraints are ignored,
+/// so the file is kept.
+pub fn matches_build_tags(path: &Path, content: &str, tags: &[String]) -> bool {
+    if !is_go_file(path) {
+        return true;
+    }
+    let satisfied = |tag: &str| tag_satisfied(tag, tags);
+    file_name_tags(path).into_iter().all(satisfied)
+        && build_constraint(content).is_none_or(|expr| expr.eval(&satisfied))
+}
+
+/// Like [`matches_build_tags`], reading only the file's header.
+pub fn matches_build_tags_file(path: &Path, tags: &[String]) -> bool {
+    if !is_go_file(path) {
+        return true;
+    }
+    let Ok(file) = std::fs::File::open(path) else {
+        return true;
+    };
+    let mut header = Vec::new();
+    if file.take(HEADER_LIMIT).read_to_end(&mut header).is_err() {
+        return true;
+    }
+    matches_build_tags(path, &String::from_utf8_lossy(&header), tags)
+}
+
+#[derive(Debug, Clone, PartialEq, Eq)]
+enum Token {
+    Tag(String),
+    Not,
+    And,
+    Or,
+    Open,
+    Close,
+}
+
+fn tokenize(text: &str) -> Option<Vec<Token>> {
+    let bytes = text.as_bytes();
+    let mut tokens = Vec::new();
+    let mut i = 0;
+    while i < bytes.len() {
+        match bytes[i] {
+            b' ' | b'\t' | b'\r' => i += 1,
+            b'!' => {
+                tokens.push(Token::Not);
+                i += 1;
+            }
+            b'(' => {
+                tokens.push(Token::Open);
+                i += 1;
+            }
+            b')' => {
+                tokens.push(Token::Close);
+                i += 1;
+            }
+            b'&' if bytes.get(i + 1) == Some(&b'&') => {
+                tokens.push(Token::And);
+                i += 2;
+            }
+            b'|' if bytes.get(i + 1) == Some(&b'|') => {
+                tokens.push(Token::Or);
+                i += 2;
+            }
+            _ => {
+                let start = i;
+                while i < bytes.len() && is_tag_byte(bytes[i]) {
+                    i += 1;
+                }
+                if start == i {
+                    return None;
+                }
+                tokens.push(Token::Tag(text[start..i].to_string()));
+            }
+        }
+    }
+    Some(tokens)
+}
+
+/// Recursive descent over `||` (lowest), `&&`, then `!` and parentheses.
+struct Parser {
+    tokens: Vec<Token>,
+    pos: usize,
+}
+
+impl Parser {
+    fn next_is(&mut self, token: &Token) -> bool {
+        let matched = self.tokens.get(self.pos) == Some(token);
+        if matched {
+            self.pos += 1;
+        }
+        matched
+    }
+
+    fn or(&mut self) -> Option<BuildExpr> {
+        let mut expr = self.and()?;
+        while self.next_is(&Token::Or) {
+            expr = BuildExpr::Or(Box::new(expr), Box::new(self.and()?));
+        }
+        Some(expr)
+    }
+
+    fn and(&mut self) -> Option<BuildExpr> {
+        let mut expr = self.not()?;
+        while self.next_is(&Token::And) {
+            expr = BuildExpr::And(Box::new(expr), Box::new(self.not()?));
+        }
+        Some(expr)
+    }
+
+    fn not(&mut self) -> Option<BuildExpr> {
+        if self.next_is(&Token::Not) {
+            return Some(BuildExpr::Not(Box::new(self.not()?)));
+        }
+        if self.next_is(&Token::Open) {
+            let expr = self.or()?;
+            return self.next_is(&Token::Close).then_some(expr);
+        }
+        match self.tokens.get(self.pos) {
+            Some(Token::Tag(tag)) => {
+                let expr = tag_expr(tag);
+                self.pos += 1;
+                Some(expr)
+            }
+            _ => None,
+        }
+    }
+}
+
+fn is_go_file(path: &Path) -> bool {
+    path.extension().and_then(|e| e.to_str()) == Some("go")
+}
+
+fn tag_expr(tag: &str) -> BuildExpr {
+    BuildExpr::Tag(tag.to_string())
+}
+
+fn is_tag(text: &str) -> bool {
+    !text.is_empty() && text.bytes().all(is_tag_byte)
+}
+
+fn is_tag_byte(byte: u8) -> bool {
+    byte.is_ascii_alphanumeric() || byte == b'_' || byte == b'.'
+}
+
+#[cfg(test)]
+#[path = "constraints_tests.rs"]
+mod tests;
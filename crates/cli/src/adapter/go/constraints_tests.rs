@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use std::path::Path;
+
+use tempfile::TempDir;
+use yare::parameterized;
+
+use super::*;
+
+fn tags(list: &[&str]) -> Vec<String> {
+    list.iter().map(|t| t.to_string()).collect()
+}
+
+fn holds(expr: &str, given: &[&str]) -> bool {
+    let given = tags(given);
+    parse_build_expr(expr)
+        .unwrap()
+        .eval(&|tag: &str| tag_satisfied(tag, &given))
+}
+
+#[parameterized(
+    tag = { "linux", &["linux"], true },
+    missing_tag = { "windows", &["linux"], false },
+    not = { "!windows", &["linux"], true },
+    and = { "linux && amd64", &["linux", "amd64"], true },
+    and_missing = { "linux && arm64", &["linux", "amd64"], false },
+    or = { "darwin || linux", &["linux"], true },
+    precedence = { "windows || linux && amd64", &["linux", "amd64"], true },
+    parens = { "(windows || linux) && !cgo", &["linux"], true },
+    double_not = { "!!linux", &["linux"], true },
+)]
+fn evaluates_expressions(expr: &str, given: &[&str], expected: bool) {
+    assert_eq!(holds(expr, given), expected);
+}
+
+#[parameterized(
+    unix = { "unix", &["linux"], true },
+    unix_windows = { "unix", &["windows"], false },
+    android_is_linux = { "linux", &["android"], true },
+    ios_is_darwin = { "darwin", &["ios"], true },
+    illumos_is_solaris = { "solaris", &["illumos"], true },
+    release = { "go1.21", &[], true },
+    compiler = { "gc", &[], true },
+    custom = { "integration", &["linux"], false },
+)]
+fn implied_tags(tag: &str, given: &[&str], expected: bool) {
+    assert_eq!(tag_satisfied(tag, &tags(given)), expected);
+}
+
+#[parameterized(
+    empty = { "" },
+    dangling_and = { "linux &&" },
+    unclosed = { "(linux" },
+    single_ampersand = { "linux & amd64" },
+    stray_close = { "linux)" },
+)]
+fn rejects_malformed_expressions(expr: &str) {
+    assert!(parse_build_expr(expr).is_none());
+}
+
+#[test]
+fn reads_go_build_line_before_package() {
+    let content = "// Copyright 2026 Example\n\n//go:build windows && !arm64\n\npackage sys\n";
+    assert_eq!(
+        build_constraint(content),
+        parse_build_expr("windows && !arm64")
+    );
+}
+
+#[test]
+fn ignores_constraints_after_package() {
+    assert!(build_constraint("package sys\n\n//go:build windows\n").is_none());
+}
+
+#[test]
+fn legacy_plus_build_lines_are_anded() {
+    let content = "// +build linux darwin\n// +build amd64,!cgo\n\npackage sys\n";
+    assert_eq!(
+        build_constraint(content),
+        parse_build_expr("(linux || darwin) && (amd64 && !cgo)")
+    );
+}
+
+#[test]
+fn go_build_line_wins_over_plus_build() {
+    let content = "//go:build linux\n// +build windows\n\npackage sys\n";
+    assert_eq!(build_constraint(content), parse_build_expr("linux"));
+}
+
+#[parameterized(
+    os = { "pipe_windows.go", &["windows"] },
+    arch = { "asm_arm64.go", &["arm64"] },
+    os_arch = { "sys_linux_amd64.go", &["linux", "amd64"] },
+    test_file = { "pipe_windows_test.go", &["windows"] },
+    bare_name = { "windows.go", &[] },
+    unknown_suffix = { "pipe_helper.go", &[] },
+    not_go = { "notes_windows.txt", &[] },
+)]
+fn derives_tags_from_file_names(name: &str, expected: &[&str]) {
+    assert_eq!(file_name_tags(Path::new(name)), expected);
+}
+
+#[test]
+fn matches_file_name_and_constraint() {
+    let linux = tags(&["linux", "amd64"]);
+    assert!(matches_build_tags(
+        Path::new("main.go"),
+        "package main\n",
+        &linux
+    ));
+    assert!(!matches_build_tags(
+        Path::new("pipe_windows.go"),
+        "package p\n",
+        &linux
+    ));
+    assert!(!matches_build_tags(
+        Path::new("winapi.go"),
+        "//go:build windows\n\npackage p\n",
+        &linux
+    ));
+    // Malformed constraints keep the file
+    assert!(matches_build_tags(
+        Path::new("odd.go"),
+        "//go:build linux &&\n\npackage p\n",
+        &linux
+    ));
+}
+
+#[test]
+fn reads_header_from_go_files_only() {
+    let temp = TempDir::new().unwrap();
+    let windows = "//go:build windows\n\npackage p\n";
+    std::fs::write(temp.path().join("winapi.go"), windows).unwrap();
+    std::fs::write(temp.path().join("notes.md"), windows).unwrap();
+    let linux = tags(&["linux"]);
+
+    assert!(!matches_build_tags_file(
+        &temp.path().join("winapi.go"),
+        &linux
+    ));
+    assert!(matches_build_tags_file(
+        &temp.path().join("notes.md"),
+        &linux
+    ));
+    assert!(matches_build_tags_file(
+        &temp.path().join("missing.go"),
+        &linux
+    ));
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Generated file detection.
+//!
+//! Go marks generated files with a `// Code generated ... DO NOT EDIT.` line
+//! before the package clause (see https://go.dev/s/generatedcode). Protobuf
+//! bindings and mocks carry this header and legitimately use `unsafe`; nobody
+//! maintains them by hand, so they are skipped by default.
+
+use std::io::Read;
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+/// The canonical generated-file marker.
+#[allow(clippy::expect_used)]
+static GENERATED_HEADER: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^// Code generated .* DO NOT EDIT\.$").expect("valid regex pattern")
+});
+
+/// Bytes read looking for the header; it precedes the package clause.
+const HEADER_LIMIT: u64 = 8 * 1024;
+
+/// Whether Go source carries the generated-file header.
+///
+/// The marker must be a line comment of its own before the first
+/// non-comment, non-blank text, so a license block above it is fine but a
+/// marker after `package` doesn't count.
+pub fn is_generated(content: &str) -> bool {
+    let mut in_block = false;
+    for line in content.lines() {
+        let line = line.trim_end();
+        if in_block {
+            in_block = !line.contains("*/");
+            continue;
+        }
+        if GENERATED_HEADER.is_match(line) {
+            return true;
+        }
+
+        let trimmed = line.trim_start();
+        if trimmed.is_empty() || trimmed.starts_with("//") {
+            continue;
+        }
+        if let Some(rest) = trimmed.strip_prefix("/*") {
+            in_block = !rest.contains("*/");
+            continue;
+        }
+        // Package clause or code: the header can no longer appear
+        return false;
+    }
+    false
+}
+
+/// Whether a `.go` file is generated, reading only its header.
+pub fn is_generated_file(path: &Path) -> bool {
+    if path.extension().and_then(|e| e.to_str()) != Some("go") {
+        return false;
+    }
+    let Ok(file) = std::fs::File::open(path) else {
+        return false;
+    };
+    let mut header = Vec::new();
+    if file.take(HEADER_LIMIT).read_to_end(&mut header).is_err() {
+        return false;
+    }
+    is_generated(&String::from_utf8_lossy(&header))
+}
+
+#[cfg(test)]
+#[path = "generated_tests.rs"]
+mod tests;
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use tempfile::TempDir;
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    protoc = { "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n", true },
+    mockgen = { "// Code generated by MockGen. DO NOT EDIT.\n// Source: store.go\n\npackage mocks\n", true },
+    after_license = { "// Copyright 2026 Example\n// SPDX-License-Identifier: MIT\n\n// Code generated by stringer; DO NOT EDIT.\n\npackage main\n", true },
+    after_block_comment = { "/*\nLicensed under MIT.\n*/\n\n// Code generated by go generate. DO NOT EDIT.\npackage main\n", true },
+    crlf = { "// Code generated by protoc-gen-go. DO NOT EDIT.\r\npackage pb\r\n", true },
+    after_package = { "package main\n\n// Code generated by hand. DO NOT EDIT.\n", false },
+    missing_period = { "// Code generated by protoc-gen-go. DO NOT EDIT\npackage pb\n", false },
+    indented = { "  // Code generated by protoc-gen-go. DO NOT EDIT.\npackage pb\n", false },
+    trailing_text = { "// Code generated by protoc-gen-go. DO NOT EDIT. Really.\npackage pb\n", false },
+    inside_block_comment = { "/*\n// Code generated by protoc-gen-go. DO NOT EDIT.\n*/\npackage pb\n", false },
+    plain_source = { "package main\n\nfunc main() {}\n", false },
+)]
+fn detects_generated_header(content: &str, expected: bool) {
+    assert_eq!(is_generated(content), expected);
+}
+
+#[test]
+fn reads_header_from_go_files_only() {
+    let temp = TempDir::new().unwrap();
+    let header = "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n";
+    std::fs::write(temp.path().join("api.pb.go"), header).unwrap();
+    std::fs::write(temp.path().join("notes.md"), header).unwrap();
+    std::fs::write(temp.path().join("main.go"), "package main\n").unwrap();
+
+    assert!(is_generated_file(&temp.path().join("api.pb.go")));
+    assert!(!is_generated_file(&temp.path().join("notes.md")));
+    assert!(!is_generated_file(&temp.path().join("main.go")));
+    assert!(!is_generated_file(&temp.path().join("missing.go")));
+}
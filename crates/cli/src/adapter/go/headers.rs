@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go reflect header field normalization.
+//!
+//! Writing `hdr.Data` on a `reflect.SliceHeader`/`reflect.StringHeader` is
+//! the dangerous part of header manipulation, but the line names only the
+//! variable. Variables bound to a header type are tracked per file and their
+//! `.Data` assignments rewritten to `reflect.SliceHeader.Data = ...` so the
+//! `reflect_header` escape pattern matches them.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+/// Statements that bind a variable to a reflect header:
+/// `h := (*reflect.SliceHeader)(p)`, `h := &reflect.SliceHeader{...}`,
+/// and `var h reflect.SliceHeader`.
+#[allow(clippy::expect_used)]
+static HEADER_BINDINGS: LazyLock<[Regex; 3]> = LazyLock::new(|| {
+    [
+        Regex::new(r"(\w+)\s*:?=\s*\(\s*\*\s*reflect\.((?:Slice|String)Header)\s*\)")
+            .expect("valid regex pattern"),
+        Regex::new(r"(\w+)\s*:?=\s*&?\s*reflect\.((?:Slice|String)Header)\s*\{")
+            .expect("valid regex pattern"),
+        Regex::new(r"\bvar\s+(\w+)\s+\*?\s*reflect\.((?:Slice|String)Header)\b")
+            .expect("valid regex pattern"),
+    ]
+});
+
+/// A variable bound to a reflect header type.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct HeaderBinding {
+    /// Variable name (e.g., "hdr").
+    pub name: String,
+    /// Header type (e.g., "SliceHeader").
+    pub header: String,
+}
+
+/// Find variables bound to `reflect.SliceHeader` or `reflect.StringHeader`.
+///
+/// Expects canonical package names (see `normalize_import_aliases`).
+pub fn parse_header_bindings(content: &str) -> Vec<HeaderBinding> {
+    let mut bindings: Vec<HeaderBinding> = Vec::new();
+
+    for regex in HEADER_BINDINGS.iter() {
+        for caps in regex.captures_iter(content) {
+            let binding = HeaderBinding {
+                name: caps[1].to_string(),
+                header: caps[2].to_string(),
+            };
+            if binding.name != "_" && !bindings.contains(&binding) {
+                bindings.push(binding);
+            }
+        }
+    }
+
+    bindings
+}
+
+/// Rewrite `.Data` assignments on header variables to the header type.
+///
+/// Returns None when no header variable has its `.Data` field assigned.
+/// Newlines are never changed, so line numbers stay valid.
+pub fn normalize_header_fields(content: &str) -> Option<String> {
+    let bindings = parse_header_bindings(content);
+    if bindings.is_empty() {
+        return None;
+    }
+
+    let mut normalized = content.to_string();
+    let mut changed = false;
+    for binding in &bindings {
+        let pattern = format!(
+            r"(^|[^\w.]){}\.Data(\s*=[^=])",
+            regex::escape(&binding.name)
+        );
+        let Ok(assignment) = Regex::new(&pattern) else {
+            continue;
+        };
+        if !assignment.is_match(&normalized) {
+            continue;
+        }
+        let replacement = format!("${{1}}reflect.{}.Data${{2}}", binding.header);
+        normalized = assignment
+            .replace_all(&normalized, replacement.as_str())
+            .into_owned();
+        changed = true;
+    }
+
+    changed.then_some(normalized)
+}
+
+#[cfg(test)]
+#[path = "headers_tests.rs"]
+mod tests;
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use yare::parameterized;
+
+#[parameterized(
+    pointer_conversion = { "hdr := (*reflect.SliceHeader)(unsafe.Pointer(&b))", "hdr", "SliceHeader" },
+    reassignment = { "sh = (*reflect.StringHeader)(unsafe.Pointer(&s))", "sh", "StringHeader" },
+    literal = { "h := reflect.SliceHeader{Len: n, Cap: n}", "h", "SliceHeader" },
+    address_of_literal = { "h := &reflect.StringHeader{}", "h", "StringHeader" },
+    var_decl = { "var h reflect.SliceHeader", "h", "SliceHeader" },
+    var_pointer_decl = { "var h *reflect.StringHeader", "h", "StringHeader" },
+)]
+fn parses_header_binding(line: &str, name: &str, header: &str) {
+    let bindings = parse_header_bindings(line);
+    assert_eq!(
+        bindings,
+        vec![HeaderBinding {
+            name: name.to_string(),
+            header: header.to_string(),
+        }]
+    );
+}
+
+#[test]
+fn ignores_blank_binding() {
+    assert!(parse_header_bindings("_ = (*reflect.SliceHeader)(p)").is_empty());
+}
+
+#[test]
+fn normalize_rewrites_data_assignment() {
+    let content = "hdr := (*reflect.SliceHeader)(unsafe.Pointer(&b))\nhdr.Data = uintptr(p)\n";
+    let normalized = normalize_header_fields(content).unwrap();
+    assert!(normalized.contains("reflect.SliceHeader.Data = uintptr(p)"));
+    assert_eq!(normalized.lines().count(), content.lines().count());
+}
+
+#[parameterized(
+    comparison = { "if hdr.Data == 0 {" },
+    read = { "p := hdr.Data" },
+    other_field = { "hdr.Len = n" },
+    other_variable = { "other.Data = p" },
+)]
+fn normalize_ignores_non_data_assignments(line: &str) {
+    let content = format!(
+        "hdr := (*reflect.SliceHeader)(unsafe.Pointer(&b))\n{}\n",
+        line
+    );
+    assert!(normalize_header_fields(&content).is_none());
+}
+
+#[test]
+fn normalize_returns_none_without_bindings() {
+    assert!(normalize_header_fields("x.Data = p\n").is_none());
+}
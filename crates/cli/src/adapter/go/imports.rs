@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go import parsing and alias normalization.
+//!
+//! Escape patterns are written against canonical package names
+//! (`unsafe.Pointer`, `exec.Command`). Files that import a governed package
+//! under an alias (`u "unsafe"`, `osexec "os/exec"`) or as a dot-import
+//! (`. "reflect"`) are rewritten so the same patterns match.
+
+use regex::Regex;
+
+/// Packages (by import path) whose selectors are matched by default escape
+/// patterns, with the exported identifiers qualified under a dot-import.
+///
+/// `unsafe.Slice`, `unsafe.String`, and `unsafe.Add` are left out: bare
+/// `Slice`/`String`/`Add` collide with common method names
+/// (`func (t T) String() string`).
+const GOVERNED_PACKAGES: &[(&str, &[&str])] = &[
+    ("unsafe", &["Pointer", "SliceData", "StringData"]),
+    ("reflect", &["SliceHeader", "StringHeader"]),
+    ("os/exec", &["Command", "CommandContext"]),
+];
+
+/// An import spec.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct GoImport {
+    /// Import path (e.g., "golang.org/x/sys/unix").
+    pub path: String,
+    /// Explicit local name (`u`, `.`, or `_`), or None for the default.
+    pub name: Option<String>,
+    /// Line of the spec, 1-based.
+    pub line: u32,
+}
+
+impl GoImport {
+    /// Name that qualifies the package's identifiers in the file, or None
+    /// for blank and dot-imports.
+    ///
+    /// Without an explicit name this is the last path element, skipping a
+    /// major version suffix (`rand` for `math/rand/v2`).
+    pub fn local_name(&self) -> Option<&str> {
+        match self.name.as_deref() {
+            Some("_" | ".") => None,
+            Some(name) => Some(name),
+            None => {
+                let mut elements = self.path.rsplit('/');
+                let last = elements.next().unwrap_or(&self.path);
+                let is_major_version = last.len() > 1
+                    && last.starts_with('v')
+                    && last[1..].bytes().all(|b| b.is_ascii_digit());
+                match elements.next() {
+                    Some(parent) if is_major_version => Some(parent),
+                    _ => Some(last),
+                }
+            }
+        }
+    }
+}
+
+/// An import of a governed package under a non-default name.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct ImportAlias {
+    /// Import path (e.g., "unsafe").
+    pub package: &'static str,
+    /// Local name used in the file (e.g., "u"), or "." for a dot-import.
+    pub alias: String,
+}
+
+/// Find every import spec in a file.
+///
+/// Handles single-line imports and parenthesized import blocks.
+pub fn parse_imports(content: &str) -> Vec<GoImport> {
+    let mut imports = Vec::new();
+    let mut in_block = false;
+
+    for (idx, line) in content.lines().enumerate() {
+        let trimmed = line.trim();
+
+        let spec = if in_block {
+            if trimmed.starts_with(')') {
+                in_block = false;
+                continue;
+            }
+            trimmed
+        } else if let Some(rest) = trimmed.strip_prefix("import") {
+            let rest = rest.trim_start();
+            if rest.starts_with('(') {
+                in_block = true;
+                rest[1..].trim_start()
+            } else {
+                rest
+            }
+        } else {
+            continue;
+        };
+
+        if let Some((name, path)) = parse_import_spec(spec) {
+            imports.push(GoImport {
+                path: path.to_string(),
+                name: name.map(str::to_string),
+                line: idx as u32 + 1,
+            });
+        }
+    }
+
+    imports
+}
+
+/// Find imports of governed packages that use an alias.
+///
+/// Blank (`_`) and default-named imports are not aliases; dot-imports are
+/// returned with alias `.`.
+pub fn parse_import_aliases(content: &str) -> Vec<ImportAlias> {
+    parse_imports(content)
+        .into_iter()
+        .filter_map(|import| {
+            let name = import.name?;
+            let (package, _) = *GOVERNED_PACKAGES.iter().find(|(p, _)| *p == import.path)?;
+            if name == "_" || name == package_name(package) {
+                return None;
+            }
+            Some(ImportAlias {
+                package,
+                alias: name,
+            })
+        })
+        .collect()
+}
+
+/// Default local name of a package: the last element of its import path.
+fn package_name(path: &str) -> &str {
+    path.rsplit('/').next().unwrap_or(path)
+}
+
+/// Parse a single import spec like `u "unsafe"` into its name and path.
+fn parse_import_spec(spec: &str) -> Option<(Option<&str>, &str)> {
+    let (name, rest) = if spec.starts_with('"') {
+        (None, spec)
+    } else {
+        let (name, rest) = spec.split_once(char::is_whitespace)?;
+        if name.starts_with("//") {
+            return None;
+        }
+        (Some(name), rest.trim_start())
+    };
+    let path = rest.strip_prefix('"')?;
+    Some((name, &path[..path.find('"')?]))
+}
+
+/// Rewrite aliased selectors to their canonical package name.
+///
+/// Returns None when the file has no aliased governed imports, so callers
+/// can keep matching against the original content. Newlines are never
+/// changed, so line numbers stay valid.
+pub fn normalize_import_aliases(content: &str) -> Option<String> {
+    let aliases = parse_import_aliases(content);
+    if aliases.is_empty() {
+        return None;
+    }
+
+    let mut normalized = content.to_string();
+    for import in &aliases {
+        let name = package_name(import.package);
+        let (selector, replacement) = if import.alias == "." {
+            // Qualify bare identifiers: `SliceHeader` -> `reflect.SliceHeader`
+            let idents = GOVERNED_PACKAGES
+                .iter()
+                .find(|(p, _)| *p == import.package)
+                .map(|(_, idents)| idents.join("|"))
+                .unwrap_or_default();
+            (
+                format!(r"(^|[^\w.])({})\b", idents),
+                format!("${{1}}{}.${{2}}", name),
+            )
+        } else {
+            (
+                format!(r"(^|[^\w.]){}\.", regex::escape(&import.alias)),
+                format!("${{1}}{}.", name),
+            )
+        };
+        let Ok(selector) = Regex::new(&selector) else {
+            continue;
+        };
+        normalized = selector
+            .replace_all(&normalized, replacement.as_str())
+            .into_owned();
+    }
+
+    Some(normalized)
+}
+
+#[cfg(test)]
+#[path = "imports_tests.rs"]
+mod tests;
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use yare::parameterized;
+
+#[parameterized(
+    single_line = { "package p\n\nimport u \"unsafe\"\n", &["u"] },
+    block = { "package p\n\nimport (\n\t\"fmt\"\n\tu \"unsafe\"\n)\n", &["u"] },
+    block_same_line = { "package p\n\nimport (u \"unsafe\"\n)\n", &["u"] },
+    trailing_comment = { "import ptr \"unsafe\" // for Slice\n", &["ptr"] },
+    default_name = { "import \"unsafe\"\n", &[] },
+    explicit_default_name = { "import unsafe \"unsafe\"\n", &[] },
+    blank_import = { "import _ \"unsafe\"\n", &[] },
+    dot_import = { "import . \"reflect\"\n", &["."] },
+    reflect_alias = { "import (\n\tr \"reflect\"\n)\n", &["r"] },
+    other_package = { "import u \"net/url\"\n", &[] },
+    exec_alias = { "import osexec \"os/exec\"\n", &["osexec"] },
+    exec_default_name = { "import exec \"os/exec\"\n", &[] },
+)]
+fn parses_import_aliases(content: &str, expected: &[&str]) {
+    let aliases: Vec<_> = parse_import_aliases(content)
+        .into_iter()
+        .map(|a| a.alias)
+        .collect();
+    assert_eq!(aliases, expected);
+}
+
+#[test]
+fn parses_import_paths_names_and_lines() {
+    let content = "package p\n\nimport \"syscall\"\n\nimport (\n\t\"fmt\"\n\tunix \"golang.org/x/sys/unix\"\n\t. \"golang.org/x/sys/windows\" // for Handle\n\t_ \"embed\"\n)\n";
+    let imports: Vec<_> = parse_imports(content)
+        .into_iter()
+        .map(|i| (i.path, i.name, i.line))
+        .collect();
+    assert_eq!(
+        imports,
+        vec![
+            ("syscall".to_string(), None, 3),
+            ("fmt".to_string(), None, 6),
+            (
+                "golang.org/x/sys/unix".to_string(),
+                Some("unix".to_string()),
+                7
+            ),
+            (
+                "golang.org/x/sys/windows".to_string(),
+                Some(".".to_string()),
+                8
+            ),
+            ("embed".to_string(), Some("_".to_string()), 9),
+        ]
+    );
+}
+
+#[test]
+fn normalize_returns_none_without_aliases() {
+    let content = "package p\n\nimport \"unsafe\"\n\nvar _ = unsafe.Pointer(nil)\n";
+    assert!(normalize_import_aliases(content).is_none());
+}
+
+#[test]
+fn normalize_rewrites_aliased_selectors() {
+    let content = "import u \"unsafe\"\n\nfunc f(p *byte) []byte {\n\treturn u.Slice(p, 4)\n}\n";
+    let normalized = normalize_import_aliases(content).unwrap();
+    assert!(normalized.contains("return unsafe.Slice(p, 4)"));
+    assert_eq!(normalized.lines().count(), content.lines().count());
+}
+
+#[test]
+fn normalize_rewrites_aliased_exec_to_package_name() {
+    let content = "import (\n\tosexec \"os/exec\"\n)\n\nvar c = osexec.Command(\"ls\")\n";
+    let normalized = normalize_import_aliases(content).unwrap();
+    assert!(normalized.contains("var c = exec.Command(\"ls\")"));
+}
+
+#[test]
+fn normalize_qualifies_dot_imported_exec() {
+    let content = "import . \"os/exec\"\n\nvar c = CommandContext(ctx, \"ls\")\n";
+    let normalized = normalize_import_aliases(content).unwrap();
+    assert!(normalized.contains("var c = exec.CommandContext(ctx, \"ls\")"));
+}
+
+#[parameterized(
+    field_access = { "x.u.Slice(p, 4)" },
+    longer_identifier = { "menu.Slice(p, 4)" },
+)]
+fn normalize_ignores_non_alias_selectors(line: &str) {
+    let content = format!("import u \"unsafe\"\n\n{}\n", line);
+    let normalized = normalize_import_aliases(&content).unwrap();
+    assert!(normalized.contains(line), "{:?} should be unchanged", line);
+}
+
+#[test]
+fn normalize_qualifies_dot_imported_identifiers() {
+    let content = "import . \"reflect\"\n\nvar h *SliceHeader\nvar s StringHeader\n";
+    let normalized = normalize_import_aliases(content).unwrap();
+    assert!(normalized.contains("var h *reflect.SliceHeader"));
+    assert!(normalized.contains("var s reflect.StringHeader"));
+}
+
+#[parameterized(
+    other_selector = { "var h x.SliceHeader" },
+    longer_identifier = { "var h MySliceHeader" },
+    method_name = { "func (t T) String() string" },
+)]
+fn normalize_ignores_non_dot_import_identifiers(line: &str) {
+    let content = format!("import (\n\t. \"reflect\"\n\t. \"unsafe\"\n)\n\n{}\n", line);
+    let normalized = normalize_import_aliases(&content).unwrap();
+    assert!(normalized.contains(line), "{:?} should be unchanged", line);
+}
+
+#[parameterized(
+    default = { "os", None, Some("os") },
+    nested = { "net/http", None, Some("http") },
+    major_version = { "math/rand/v2", None, Some("rand") },
+    version_like_name = { "example.com/v", None, Some("v") },
+    alias = { "net/http", Some("nethttp"), Some("nethttp") },
+    blank = { "embed", Some("_"), None },
+    dot = { "strings", Some("."), None },
+)]
+fn local_name_qualifies_package(path: &str, name: Option<&str>, expected: Option<&str>) {
+    let import = GoImport {
+        path: path.to_string(),
+        name: name.map(String::from),
+        line: 1,
+    };
+    assert_eq!(import.local_name(), expected);
+}
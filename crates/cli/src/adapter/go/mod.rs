@@ -6,7 +6,13 @@
 //! Provides Go-specific behavior for checks:
 //! - File classification (source vs test)
 //! - Default patterns for Go projects
-//! - Go-specific escape patterns (unsafe.Pointer, go:linkname, go:noescape)
+//! - Module discovery for multi-module repositories
+//! - Generated file detection (`// Code generated ... DO NOT EDIT.`)
+//! - Build constraint evaluation (`//go:build`, `_GOOS_GOARCH.go` suffixes)
+//! - Lexical syntax checking (unterminated literals, unbalanced brackets)
+//! - Go-specific escape patterns (unsafe.Pointer, unsafe.Slice, unsafe.Add, reflect headers,
+//!   go:linkname, go:noescape, go:nosplit, go:uintptrescapes, go:nocheckptr, go:noinline,
+//!   go:inline, import "C", os/exec)
 //!
 //! See docs/specs/langs/golang.md for specification.
 
@@ -14,14 +20,33 @@ use std::path::Path;
 
 use globset::GlobSet;
 
+mod cgo;
+mod constraints;
+mod generated;
+mod headers;
+mod imports;
+mod modules;
 mod suppress;
+mod syntax;
 
 pub use crate::adapter::common::policy::PolicyCheckResult;
+pub use cgo::mask_cgo_preambles;
+pub use constraints::{
+    BuildExpr, build_constraint, file_name_tags, matches_build_tags, matches_build_tags_file,
+    parse_build_expr, tag_satisfied,
+};
+pub use generated::{is_generated, is_generated_file};
+pub use headers::{HeaderBinding, normalize_header_fields, parse_header_bindings};
+pub use imports::{
+    GoImport, ImportAlias, normalize_import_aliases, parse_import_aliases, parse_imports,
+};
+pub use modules::{GoModule, find_modules, module_for};
 pub use suppress::{NolintDirective, parse_nolint_directives};
+pub use syntax::{SyntaxError, find_syntax_error};
 
 use super::common;
 use super::glob::build_glob_set;
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 use crate::config::GoPolicyConfig;
 
 /// Default escape patterns for Go.
@@ -37,6 +62,38 @@ const GO_ESCAPE_PATTERNS: &[EscapePattern] = &[
         advice: "Add a // SAFETY: comment explaining pointer validity.",
         in_tests: None,
     },
+    EscapePattern {
+        name: "unsafe_slice",
+        pattern: r"unsafe\.Slice(Data)?\(",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining the pointer and length are valid.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "unsafe_string",
+        pattern: r"unsafe\.String(Data)?\(",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining the bytes are valid and never mutated.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "unsafe_add",
+        pattern: r"unsafe\.Add\(",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining why the offset stays within the same allocation.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "reflect_header",
+        pattern: r"\(\s*\*\s*reflect\.(Slice|String)Header\s*\)\s*\(|reflect\.(Slice|String)Header(\s*\{|\.Data\s*=[^=])",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining why the header matches live memory.",
+        in_tests: None,
+    },
     EscapePattern {
         name: "go_linkname",
         pattern: r"//go:linkname",
@@ -53,6 +110,78 @@ const GO_ESCAPE_PATTERNS: &[EscapePattern] = &[
         advice: "Add a // NOESCAPE: comment explaining why escape analysis should be bypassed.",
         in_tests: None,
     },
+    EscapePattern {
+        name: "go_nosplit",
+        pattern: r"//go:nosplit",
+        action: EscapeAction::Comment,
+        comment: Some("// NOSPLIT:"),
+        advice: "Add a // NOSPLIT: comment explaining why the stack check can be skipped.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "go_uintptrescapes",
+        pattern: r"//go:uintptrescapes",
+        action: EscapeAction::Comment,
+        comment: Some("// UINTPTRESCAPES:"),
+        advice: "Add a // UINTPTRESCAPES: comment explaining why uintptr arguments must stay live.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "go_nocheckptr",
+        pattern: r"//go:nocheckptr",
+        action: EscapeAction::Comment,
+        comment: Some("// NOCHECKPTR:"),
+        advice: "Add a // NOCHECKPTR: comment explaining why pointer checks can be skipped.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "go_noinline",
+        pattern: r"//go:noinline\b",
+        action: EscapeAction::Comment,
+        comment: Some("// NOINLINE:"),
+        advice: "Add a // NOINLINE: comment explaining why the function must not be inlined.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "go_inline",
+        pattern: r"//go:inline\b",
+        action: EscapeAction::Comment,
+        comment: Some("// INLINE:"),
+        advice: "Add an // INLINE: comment explaining why the function should be inlined.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "cgo_import",
+        pattern: r#"(?m)^[ \t]*(?:import[ \t]+)?"C"[ \t]*(?:$|//)"#,
+        action: EscapeAction::Comment,
+        comment: Some("// CGO:"),
+        advice: "Add a // CGO: comment explaining why C interop is needed.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "exec_import",
+        pattern: r#"(?m)^[ \t]*(?:import[ \t]+)?(?:[\w.]+[ \t]+)?"os/exec""#,
+        action: EscapeAction::Comment,
+        comment: Some("// EXEC:"),
+        advice: "Add a // EXEC: comment explaining why this package runs external commands.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "exec_command",
+        pattern: r"\bexec\.Command(Context)?\s*\(",
+        action: EscapeAction::Comment,
+        comment: Some("// EXEC:"),
+        advice: "Add a // EXEC: comment explaining which command runs and where its arguments come from.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "exec_sprintf",
+        pattern: r"\bexec\.Command(Context)?\s*\([^)]*\bfmt\.Sprintf\s*\(",
+        action: EscapeAction::Forbid,
+        comment: None,
+        advice: "Pass the program and each argument separately instead of formatting a command string; interpolated commands are a shell-injection risk.",
+        in_tests: None,
+    },
 ];
 
 /// Go language adapter.
@@ -99,7 +228,7 @@ impl Adapter for GoAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["go"]
+        ProjectLanguage::Go.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
@@ -152,8 +281,21 @@ pub fn parse_go_mod(content: &str) -> Option<String> {
     None
 }
 
+/// Rewrite Go source so default escape patterns match canonical names.
+///
+/// Applies import alias normalization, then reflect header `.Data`
+/// assignment normalization. Returns None when neither changes anything.
+pub fn normalize_escape_source(content: &str) -> Option<String> {
+    let aliased = normalize_import_aliases(content);
+    let base = aliased.as_deref().unwrap_or(content);
+    normalize_header_fields(base).or(aliased)
+}
+
 /// Enumerate packages from directory structure.
-/// Returns paths relative to the module root that contain .go files.
+/// Returns paths relative to the project root that contain .go files.
+///
+/// In a multi-module repository this spans every module; use
+/// [`module_for`] to find the module a package belongs to.
 pub fn enumerate_packages(root: &Path) -> Vec<String> {
     let mut packages = Vec::new();
     enumerate_packages_recursive(root, root, &mut packages);
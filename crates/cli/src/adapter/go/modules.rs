@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go module discovery for multi-module repositories.
+//!
+//! Each directory with a `go.mod` is a module root. A package belongs to
+//! the innermost module containing it, and each module has its own
+//! `vendor/` directory. Two modules can both have an `internal/config`
+//! package; only the module path tells them apart.
+
+use std::path::Path;
+
+use super::parse_go_mod;
+
+/// Directories the go tool never treats as part of a module tree.
+const SKIP_DIRS: &[&str] = &["vendor", "testdata", "node_modules"];
+
+/// A Go module rooted at a directory with a `go.mod`.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct GoModule {
+    /// Module root relative to the project root ("." for the root itself).
+    pub dir: String,
+    /// Module path from the `module` directive (falls back to `dir`).
+    pub path: String,
+}
+
+impl GoModule {
+    /// The module's `vendor/` directory, relative to the project root.
+    pub fn vendor_dir(&self) -> String {
+        if self.dir == "." {
+            "vendor".to_string()
+        } else {
+            format!("{}/vendor", self.dir)
+        }
+    }
+
+    /// Whether a package directory (relative to the project root) is inside this module.
+    pub fn contains(&self, package: &str) -> bool {
+        self.dir == "."
+            || package == self.dir
+            || package
+                .strip_prefix(&self.dir)
+                .is_some_and(|rest| rest.starts_with('/'))
+    }
+
+    /// Import path of a package directory inside this module.
+    pub fn import_path(&self, package: &str) -> String {
+        let rest = if self.dir == "." {
+            package
+        } else {
+            package
+                .strip_prefix(&self.dir)
+                .unwrap_or(package)
+                .trim_start_matches('/')
+        };
+        match rest {
+            "" | "." => self.path.clone(),
+            rest => format!("{}/{}", self.path, rest),
+        }
+    }
+}
+
+/// Find every module under `root`, sorted by directory.
+///
+/// Skips `vendor/`, `testdata/`, and directories starting with `.` or `_`,
+/// which the go tool ignores.
+pub fn find_modules(root: &Path) -> Vec<GoModule> {
+    let mut modules = Vec::new();
+    find_modules_recursive(root, root, &mut modules);
+    modules.sort_by(|a, b| a.dir.cmp(&b.dir));
+    modules
+}
+
+fn find_modules_recursive(root: &Path, current: &Path, modules: &mut Vec<GoModule>) {
+    let go_mod = current.join("go.mod");
+    if go_mod.is_file() {
+        let dir = match current.strip_prefix(root).ok().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let path = std::fs::read_to_string(&go_mod)
+            .ok()
+            .and_then(|content| parse_go_mod(&content))
+            .unwrap_or_else(|| dir.clone());
+        modules.push(GoModule { dir, path });
+    }
+
+    let Ok(entries) = std::fs::read_dir(current) else {
+        return;
+    };
+    for entry in entries.filter_map(|e| e.ok()) {
+        let name = entry.file_name();
+        let name = name.to_string_lossy();
+        if name.starts_with('.') || name.starts_with('_') || SKIP_DIRS.contains(&name.as_ref()) {
+            continue;
+        }
+        if entry.file_type().is_ok_and(|t| t.is_dir()) {
+            find_modules_recursive(root, &entry.path(), modules);
+        }
+    }
+}
+
+/// The innermost module containing a package directory.
+pub fn module_for<'a>(modules: &'a [GoModule], package: &str) -> Option<&'a GoModule> {
+    modules
+        .iter()
+        .filter(|m| m.contains(package))
+        .max_by_key(|m| if m.dir == "." { 0 } else { m.dir.len() })
+}
+
+#[cfg(test)]
+#[path = "modules_tests.rs"]
+mod tests;
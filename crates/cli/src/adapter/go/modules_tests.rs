@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use std::fs;
+
+use tempfile::TempDir;
+
+use super::*;
+
+fn module(dir: &str, path: &str) -> GoModule {
+    GoModule {
+        dir: dir.to_string(),
+        path: path.to_string(),
+    }
+}
+
+fn write_go_mod(root: &Path, dir: &str, module_path: &str) {
+    let dir = root.join(dir);
+    fs::create_dir_all(&dir).unwrap();
+    fs::write(
+        dir.join("go.mod"),
+        format!("module {}\n\ngo 1.21\n", module_path),
+    )
+    .unwrap();
+}
+
+#[test]
+fn finds_nested_modules() {
+    let temp = TempDir::new().unwrap();
+    write_go_mod(temp.path(), "services/worker", "example.com/worker");
+    write_go_mod(temp.path(), "services/api", "example.com/api");
+
+    assert_eq!(
+        find_modules(temp.path()),
+        vec![
+            module("services/api", "example.com/api"),
+            module("services/worker", "example.com/worker"),
+        ]
+    );
+}
+
+#[test]
+fn finds_root_and_nested_modules() {
+    let temp = TempDir::new().unwrap();
+    write_go_mod(temp.path(), ".", "example.com/repo");
+    write_go_mod(temp.path(), "tools", "example.com/repo/tools");
+
+    assert_eq!(
+        find_modules(temp.path()),
+        vec![
+            module(".", "example.com/repo"),
+            module("tools", "example.com/repo/tools"),
+        ]
+    );
+}
+
+#[test]
+fn skips_vendor_testdata_and_hidden_directories() {
+    let temp = TempDir::new().unwrap();
+    write_go_mod(temp.path(), ".", "example.com/repo");
+    write_go_mod(temp.path(), "vendor/example.com/dep", "example.com/dep");
+    write_go_mod(temp.path(), "pkg/testdata/mod", "example.com/fixture");
+    write_go_mod(temp.path(), ".cache/mod", "example.com/cached");
+    write_go_mod(temp.path(), "_scratch", "example.com/scratch");
+
+    assert_eq!(
+        find_modules(temp.path()),
+        vec![module(".", "example.com/repo")]
+    );
+}
+
+#[test]
+fn vendor_dir_is_relative_to_module() {
+    assert_eq!(module(".", "example.com/repo").vendor_dir(), "vendor");
+    assert_eq!(
+        module("services/api", "example.com/api").vendor_dir(),
+        "services/api/vendor"
+    );
+}
+
+#[test]
+fn import_path_joins_module_path() {
+    let api = module("services/api", "example.com/api");
+    assert_eq!(api.import_path("services/api"), "example.com/api");
+    assert_eq!(
+        api.import_path("services/api/internal/config"),
+        "example.com/api/internal/config"
+    );
+
+    let root = module(".", "example.com/repo");
+    assert_eq!(root.import_path("."), "example.com/repo");
+    assert_eq!(root.import_path("pkg/api"), "example.com/repo/pkg/api");
+}
+
+#[test]
+fn module_for_picks_innermost_module() {
+    let modules = vec![
+        module(".", "example.com/repo"),
+        module("tools", "example.com/repo/tools"),
+    ];
+    assert_eq!(
+        module_for(&modules, "tools/lint").map(|m| m.path.as_str()),
+        Some("example.com/repo/tools")
+    );
+    assert_eq!(
+        module_for(&modules, "toolsets").map(|m| m.path.as_str()),
+        Some("example.com/repo")
+    );
+}
+
+#[test]
+fn internal_packages_in_different_modules_stay_distinct() {
+    let modules = vec![
+        module("services/api", "example.com/api"),
+        module("services/worker", "example.com/worker"),
+    ];
+    let import_path = |package: &str| module_for(&modules, package).map(|m| m.import_path(package));
+
+    assert_eq!(
+        import_path("services/api/internal/config").as_deref(),
+        Some("example.com/api/internal/config")
+    );
+    assert_eq!(
+        import_path("services/worker/internal/config").as_deref(),
+        Some("example.com/worker/internal/config")
+    );
+    assert_eq!(import_path("scripts"), None);
+}
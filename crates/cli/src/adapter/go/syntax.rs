@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Lexical syntax checking for Go source.
+//!
+//! The escapes check matches Go with line-based lexers that assume comments,
+//! literals, and brackets are well formed. A file that breaks them (an
+//! unterminated string, a stray `}`) is reported with its first error rather
+//! than matched, so one broken file can't skew the results for the rest.
+
+/// The first syntax error in a Go file.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct SyntaxError {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column.
+    pub column: u32,
+    /// What is wrong (e.g., "unterminated string literal").
+    pub message: String,
+}
+
+/// Find the first lexical or bracket nesting error in Go source.
+///
+/// Only what the escapes lexers rely on is checked: comments, string, raw
+/// string and rune literals must be terminated, and `()`, `[]` and `{}`
+/// must nest. This is not a full parse.
+pub fn find_syntax_error(content: &str) -> Option<SyntaxError> {
+    let mut cursor = Cursor::new(content);
+    // Open brackets with their positions
+    let mut open: Vec<(u8, u32, u32)> = Vec::new();
+
+    while let Some(byte) = cursor.peek(0) {
+        let (line, column) = (cursor.line, cursor.column);
+        let error = |message: String| {
+            Some(SyntaxError {
+                line,
+                column,
+                message,
+            })
+        };
+        match byte {
+            b'/' if cursor.peek(1) == Some(b'/') => cursor.skip_line(),
+            b'/' if cursor.peek(1) == Some(b'*') => {
+                cursor.bump();
+                cursor.bump();
+                if !cursor.skip_past(b"*/") {
+                    return error("unterminated block comment".to_string());
+                }
+            }
+            b'"' | b'\'' => {
+                cursor.bump();
+                if !cursor.skip_quoted(byte) {
+                    let kind = if byte == b'"' { "string" } else { "rune" };
+                    return error(format!("unterminated {} literal", kind));
+                }
+            }
+            b'`' => {
+                cursor.bump();
+                if !cursor.skip_past(b"`") {
+                    return error("unterminated raw string literal".to_string());
+                }
+            }
+            b'(' | b'[' | b'{' => {
+                open.push((byte, line, column));
+                cursor.bump();
+            }
+            b')' | b']' | b'}' => {
+                match open.pop() {
+                    Some((opener, ..)) if closer(opener) == byte => {}
+                    Some((opener, open_line, _)) => {
+                        return error(format!(
+                            "unexpected `{}`, expected `{}` to close line {}",
+                            byte as char,
+                            closer(opener) as char,
+                            open_line
+                        ));
+                    }
+                    None => return error(format!("unexpected `{}`", byte as char)),
+                }
+                cursor.bump();
+            }
+            _ => cursor.bump(),
+        }
+    }
+
+    open.pop().map(|(opener, line, column)| SyntaxError {
+        line,
+        column,
+        message: format!("`{}` is never closed", opener as char),
+    })
+}
+
+fn closer(opener: u8) -> u8 {
+    match opener {
+        b'(' => b')',
+        b'[' => b']',
+        _ => b'}',
+    }
+}
+
+/// Byte cursor tracking the line and (character) column.
+struct Cursor<'a> {
+    bytes: &'a [u8],
+    pos: usize,
+    line: u32,
+    column: u32,
+}
+
+impl<'a> Cursor<'a> {
+    fn new(content: &'a str) -> Self {
+        Self {
+            bytes: content.as_bytes(),
+            pos: 0,
+            line: 1,
+            column: 1,
+        }
+    }
+
+    fn peek(&self, offset: usize) -> Option<u8> {
+        self.bytes.get(self.pos + offset).copied()
+    }
+
+    fn bump(&mut self) {
+        let Some(byte) = self.peek(0) else {
+            return;
+        };
+        self.pos += 1;
+        if byte == b'\n' {
+            self.line += 1;
+            self.column = 1;
+        } else if byte & 0xC0 != 0x80 {
+            // UTF-8 continuation bytes don't start a character
+            self.column += 1;
+        }
+    }
+
+    /// Skip to the end of the line (a line comment).
+    fn skip_line(&mut self) {
+        while self.peek(0).is_some_and(|b| b != b'\n') {
+            self.bump();
+        }
+    }
+
+    /// Skip past the next `terminator`; false if there is none.
+    fn skip_past(&mut self, terminator: &[u8]) -> bool {
+        while self.peek(0).is_some() {
+            if self.bytes[self.pos..].starts_with(terminator) {
+                for _ in 0..terminator.len() {
+                    self.bump();
+                }
+                return true;
+            }
+            self.bump();
+        }
+        false
+    }
+
+    /// Skip the rest of a quoted literal; false if the line or file ends first.
+    fn skip_quoted(&mut self, quote: u8) -> bool {
+        loop {
+            match self.peek(0) {
+                None | Some(b'\n') => return false,
+                Some(b'\\') => {
+                    self.bump();
+                    if matches!(self.peek(0), None | Some(b'\n')) {
+                        return false;
+                    }
+                    self.bump();
+                }
+                Some(byte) => {
+                    self.bump();
+                    if byte == quote {
+                        return true;
+                    }
+                }
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+#[path = "syntax_tests.rs"]
+mod tests;
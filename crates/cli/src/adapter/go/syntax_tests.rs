@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn error_at(content: &str) -> Option<(u32, u32, String)> {
+    find_syntax_error(content).map(|e| (e.line, e.column, e.message))
+}
+
+#[parameterized(
+    simple = { "package main\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n" },
+    brackets_in_strings = { "package p\n\nvar s = \"}{)(\"\nvar r = '}'\n" },
+    brackets_in_comments = { "package p\n\n// func f() {\n/* ( [ */\n" },
+    raw_string_across_lines = { "package p\n\nvar q = `\nSELECT \"x\" FROM t WHERE a = '}'\n`\n" },
+    escaped_quotes = { "package p\n\nvar s = \"say \\\"hi\\\"\"\nvar r = '\\''\n" },
+    comment_markers_in_strings = { "package p\n\nvar u = \"http://example.com/*\"\n" },
+    composite_literal = { "package p\n\nvar m = map[string][]int{\"a\": {1, 2}}\n" },
+)]
+fn accepts_well_formed_source(content: &str) {
+    assert_eq!(error_at(content), None);
+}
+
+#[parameterized(
+    string = { "package p\n\nvar s = \"open\nvar t = 1\n", 3, 9, "unterminated string literal" },
+    rune = { "package p\n\nvar r = 'x\n", 3, 9, "unterminated rune literal" },
+    raw_string = { "package p\n\nvar q = `open\n", 3, 9, "unterminated raw string literal" },
+    block_comment = { "package p\n\n/* never closed\nfunc f() {}\n", 3, 1, "unterminated block comment" },
+    escaped_newline = { "package p\n\nvar s = \"a\\\n\"\n", 3, 9, "unterminated string literal" },
+)]
+fn reports_unterminated_tokens(content: &str, line: u32, column: u32, message: &str) {
+    assert_eq!(error_at(content), Some((line, column, message.to_string())));
+}
+
+#[parameterized(
+    stray_close = { "package p\n\nfunc f() {\n}\n}\n", 5, 1, "unexpected `}`" },
+    mismatched = { "package p\n\nfunc f() {\n\tg(1]\n}\n", 4, 5, "unexpected `]`, expected `)` to close line 4" },
+    never_closed = { "package p\n\nfunc f() {\n\tg()\n", 3, 10, "`{` is never closed" },
+)]
+fn reports_unbalanced_brackets(content: &str, line: u32, column: u32, message: &str) {
+    assert_eq!(error_at(content), Some((line, column, message.to_string())));
+}
+
+#[test]
+fn columns_count_characters() {
+    assert_eq!(
+        error_at("package p\n\nvar s = \"é\" + \"open\n"),
+        Some((3, 15, "unterminated string literal".to_string()))
+    );
+}
@@ -52,15 +52,28 @@ fn has_correct_name_and_extensions() {
 }
 
 #[test]
-fn returns_three_default_escape_patterns() {
+fn returns_default_escape_patterns() {
     let adapter = GoAdapter::new();
-    assert_eq!(adapter.default_escapes().len(), 3);
+    assert_eq!(adapter.default_escapes().len(), 16);
 }
 
 #[parameterized(
     unsafe_pointer = { "unsafe_pointer", r"unsafe\.Pointer", Some("// SAFETY:") },
+    unsafe_slice = { "unsafe_slice", r"unsafe\.Slice(Data)?\(", Some("// SAFETY:") },
+    unsafe_string = { "unsafe_string", r"unsafe\.String(Data)?\(", Some("// SAFETY:") },
+    unsafe_add = { "unsafe_add", r"unsafe\.Add\(", Some("// SAFETY:") },
+    reflect_header = { "reflect_header", r"\(\s*\*\s*reflect\.(Slice|String)Header\s*\)\s*\(|reflect\.(Slice|String)Header(\s*\{|\.Data\s*=[^=])", Some("// SAFETY:") },
     go_linkname = { "go_linkname", r"//go:linkname", Some("// LINKNAME:") },
     go_noescape = { "go_noescape", r"//go:noescape", Some("// NOESCAPE:") },
+    go_nosplit = { "go_nosplit", r"//go:nosplit", Some("// NOSPLIT:") },
+    go_uintptrescapes = { "go_uintptrescapes", r"//go:uintptrescapes", Some("// UINTPTRESCAPES:") },
+    go_nocheckptr = { "go_nocheckptr", r"//go:nocheckptr", Some("// NOCHECKPTR:") },
+    go_noinline = { "go_noinline", r"//go:noinline\b", Some("// NOINLINE:") },
+    go_inline = { "go_inline", r"//go:inline\b", Some("// INLINE:") },
+    cgo_import = { "cgo_import", r#"(?m)^[ \t]*(?:import[ \t]+)?"C"[ \t]*(?:$|//)"#, Some("// CGO:") },
+    exec_import = { "exec_import", r#"(?m)^[ \t]*(?:import[ \t]+)?(?:[\w.]+[ \t]+)?"os/exec""#, Some("// EXEC:") },
+    exec_command = { "exec_command", r"\bexec\.Command(Context)?\s*\(", Some("// EXEC:") },
+    exec_sprintf = { "exec_sprintf", r"\bexec\.Command(Context)?\s*\([^)]*\bfmt\.Sprintf\s*\(", None },
 )]
 fn default_escape_pattern(name: &str, pattern: &str, expected_comment: Option<&str>) {
     let adapter = GoAdapter::new();
@@ -73,6 +86,35 @@ fn default_escape_pattern(name: &str, pattern: &str, expected_comment: Option<&s
     assert_eq!(found.comment, expected_comment, "comment for {:?}", name);
 }
 
+#[parameterized(
+    import_single = { "exec_import", "import \"os/exec\"", true },
+    import_block = { "exec_import", "\t\"os/exec\"", true },
+    import_alias = { "exec_import", "\tosexec \"os/exec\"", true },
+    import_other = { "exec_import", "\t\"github.com/x/os/exec\"", false },
+    command = { "exec_command", "cmd := exec.Command(\"git\", \"status\")", true },
+    command_context = { "exec_command", "exec.CommandContext(ctx, \"ls\")", true },
+    command_type = { "exec_command", "var c *exec.Cmd", false },
+    look_path = { "exec_command", "exec.LookPath(\"git\")", false },
+    sprintf_shell = { "exec_sprintf", "exec.Command(\"sh\", \"-c\", fmt.Sprintf(\"ls %s\", dir))", true },
+    sprintf_single = { "exec_sprintf", "exec.CommandContext(ctx, fmt.Sprintf(\"git %s\", arg))", true },
+    sprintf_multiline = { "exec_sprintf", "exec.Command(\n\t\"sh\", \"-c\",\n\tfmt.Sprintf(\"rm %s\", p),\n)", true },
+    separate_args = { "exec_sprintf", "exec.Command(\"ls\", dir)", false },
+    sprintf_after_call = { "exec_sprintf", "exec.Command(\"ls\", dir).Run(); log(fmt.Sprintf(\"x\"))", false },
+)]
+fn exec_patterns_match(name: &str, line: &str, expected: bool) {
+    use crate::pattern::CompiledPattern;
+
+    let adapter = GoAdapter::new();
+    let pattern = adapter
+        .default_escapes()
+        .iter()
+        .find(|p| p.name == name)
+        .unwrap();
+
+    let compiled = CompiledPattern::compile(pattern.pattern).unwrap();
+    assert_eq!(!compiled.find_all(line).is_empty(), expected, "{:?}", line);
+}
+
 #[test]
 fn parses_module_name_from_go_mod() {
     let content = r#"module github.com/example/project
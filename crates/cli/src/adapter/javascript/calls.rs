@@ -0,0 +1,336 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! JavaScript/TypeScript call-site normalization.
+//!
+//! Escape patterns are plain regexes (`\beval\s*\(`), so against raw source
+//! they also match inside string literals, block comments and on unrelated
+//! methods (`parser.eval()`). A lightweight tokenizer rewrites the text
+//! patterns are matched against:
+//!
+//! - string, template and regex literal contents are blanked (template
+//!   `${...}` expressions are code and kept)
+//! - block comment contents are blanked, unless they hold a TypeScript
+//!   directive (`/* @ts-ignore */`)
+//! - member calls and method definitions that share a governed name
+//!   (`parser.eval`, `vm.Function`, `eval(input) {`) are masked; the global
+//!   object forms (`window.eval`) are kept
+//!
+//! Line comments are left for comment detection. Only bytes are replaced
+//! with spaces, so line numbers and columns stay valid.
+
+/// Names matched by default escape patterns as calls.
+const GOVERNED_NAMES: &[&str] = &["eval", "Function"];
+
+/// Objects whose members are the real globals (`window.eval` is `eval`).
+const GLOBAL_OBJECTS: &[&str] = &["window", "globalThis", "self", "global"];
+
+/// Keywords that can precede a method or function name in a definition.
+const DEFINITION_KEYWORDS: &[&str] = &[
+    "function",
+    "static",
+    "async",
+    "public",
+    "private",
+    "protected",
+    "override",
+];
+
+/// Keywords after which `/` starts a regex literal rather than a division.
+const REGEX_KEYWORDS: &[&str] = &[
+    "return",
+    "typeof",
+    "instanceof",
+    "in",
+    "of",
+    "new",
+    "delete",
+    "void",
+    "throw",
+    "case",
+    "do",
+    "else",
+    "yield",
+    "await",
+];
+
+/// Rewrite JS/TS source so default escape patterns only match real code.
+///
+/// Returns None when nothing changes, so callers can keep matching
+/// against the original content.
+pub fn normalize_escape_source(content: &str) -> Option<String> {
+    let bytes = content.as_bytes();
+    let mut buf = bytes.to_vec();
+    // Brace depth at which each open template literal's `${` expression ends
+    let mut templates: Vec<usize> = Vec::new();
+    let mut depth = 0usize;
+    // Whether a `/` here would start a regex literal
+    let mut regex_allowed = true;
+    let mut i = 0;
+
+    while i < bytes.len() {
+        let byte = bytes[i];
+        match byte {
+            b'/' if bytes.get(i + 1) == Some(&b'/') => {
+                while i < bytes.len() && bytes[i] != b'\n' {
+                    i += 1;
+                }
+            }
+            b'/' if bytes.get(i + 1) == Some(&b'*') => {
+                let body_start = i + 2;
+                let body_end = content[body_start..]
+                    .find("*/")
+                    .map_or(bytes.len(), |at| body_start + at);
+                if !content[body_start..body_end].contains("@ts-") {
+                    mask(&mut buf, body_start, body_end);
+                }
+                i = (body_end + 2).min(bytes.len());
+            }
+            b'/' if regex_allowed => match regex_end(bytes, i) {
+                Some(end) => {
+                    mask(&mut buf, i + 1, end);
+                    i = end + 1;
+                    while i < bytes.len() && is_name_byte(bytes[i]) {
+                        i += 1;
+                    }
+                    regex_allowed = false;
+                }
+                None => {
+                    i += 1;
+                    regex_allowed = true;
+                }
+            },
+            b'"' | b'\'' => {
+                let (body_end, end) = string_end(bytes, i);
+                mask(&mut buf, i + 1, body_end);
+                i = end;
+                regex_allowed = false;
+            }
+            b'`' => {
+                i = template(bytes, &mut buf, i + 1, depth, &mut templates);
+                regex_allowed = false;
+            }
+            b'{' => {
+                depth += 1;
+                i += 1;
+                regex_allowed = true;
+            }
+            b'}' if templates.last() == Some(&depth) => {
+                templates.pop();
+                i = template(bytes, &mut buf, i + 1, depth, &mut templates);
+                regex_allowed = false;
+            }
+            b'}' => {
+                depth = depth.saturating_sub(1);
+                i += 1;
+                regex_allowed = true;
+            }
+            // `</` closes a JSX element
+            b')' | b']' | b'<' => {
+                i += 1;
+                regex_allowed = false;
+            }
+            b' ' | b'\t' | b'\r' | b'\n' => i += 1,
+            b'0'..=b'9' => {
+                while i < bytes.len() && (is_name_byte(bytes[i]) || bytes[i] == b'.') {
+                    i += 1;
+                }
+                regex_allowed = false;
+            }
+            _ if is_name_byte(byte) => {
+                let start = i;
+                while i < bytes.len() && is_name_byte(bytes[i]) {
+                    i += 1;
+                }
+                let name = &content[start..i];
+                if GOVERNED_NAMES.contains(&name)
+                    && (is_foreign_member(bytes, content, start)
+                        || is_definition(bytes, content, start, i))
+                {
+                    mask(&mut buf, start, i);
+                }
+                regex_allowed = REGEX_KEYWORDS.contains(&name);
+            }
+            _ => {
+                i += 1;
+                regex_allowed = true;
+            }
+        }
+    }
+
+    let normalized = String::from_utf8(buf).ok()?;
+    (normalized != content).then_some(normalized)
+}
+
+/// Check whether the name at `start` is a member of a non-global object
+/// (`parser.eval`, `a?.eval`), as opposed to `eval` or `window.eval`.
+fn is_foreign_member(bytes: &[u8], content: &str, start: usize) -> bool {
+    let mut dot = start;
+    while dot > 0 && matches!(bytes[dot - 1], b' ' | b'\t') {
+        dot -= 1;
+    }
+    if dot == 0 || bytes[dot - 1] != b'.' {
+        return false;
+    }
+    let mut object_end = dot - 1;
+    if object_end > 0 && bytes[object_end - 1] == b'?' {
+        object_end -= 1;
+    }
+    // Spread (`...eval`) isn't member access
+    if object_end > 0 && bytes[object_end - 1] == b'.' {
+        return false;
+    }
+    let mut object_start = object_end;
+    while object_start > 0 && is_name_byte(bytes[object_start - 1]) {
+        object_start -= 1;
+    }
+    let object = &content[object_start..object_end];
+    let is_global =
+        GLOBAL_OBJECTS.contains(&object) && (object_start == 0 || bytes[object_start - 1] != b'.');
+    !is_global
+}
+
+/// Check whether the name at `start..end` is being defined rather than
+/// called: `function eval(`, or a method (`eval(input) {`, `eval(): T {`)
+/// in a class body or object literal.
+fn is_definition(bytes: &[u8], content: &str, start: usize, end: usize) -> bool {
+    let mut prev = start;
+    while prev > 0 && bytes[prev - 1].is_ascii_whitespace() {
+        prev -= 1;
+    }
+    let mut word_start = prev;
+    while word_start > 0 && is_name_byte(bytes[word_start - 1]) {
+        word_start -= 1;
+    }
+    let prev_word = &content[word_start..prev];
+    if prev_word == "function" {
+        return true;
+    }
+    let member_position = DEFINITION_KEYWORDS.contains(&prev_word)
+        || prev == 0
+        || matches!(bytes[prev - 1], b'{' | b'}' | b';' | b',' | b'*');
+    if !member_position {
+        return false;
+    }
+
+    // A call in statement position is never followed by `{` or `:`
+    let mut i = skip_whitespace(bytes, end);
+    if bytes.get(i) != Some(&b'(') {
+        return false;
+    }
+    let mut depth = 0usize;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'(' => depth += 1,
+            b')' => {
+                depth -= 1;
+                if depth == 0 {
+                    break;
+                }
+            }
+            _ => {}
+        }
+        i += 1;
+    }
+    matches!(bytes.get(skip_whitespace(bytes, i + 1)), Some(b'{' | b':'))
+}
+
+fn skip_whitespace(bytes: &[u8], mut i: usize) -> usize {
+    while i < bytes.len() && bytes[i].is_ascii_whitespace() {
+        i += 1;
+    }
+    i
+}
+
+/// Find the end of a quoted string opening at `start`.
+///
+/// Returns (body end, position after the closing quote). Unterminated
+/// strings end at the newline.
+fn string_end(bytes: &[u8], start: usize) -> (usize, usize) {
+    let quote = bytes[start];
+    let mut i = start + 1;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'\\' => i += 2,
+            b'\n' => return (i, i),
+            b if b == quote => return (i, i + 1),
+            _ => i += 1,
+        }
+    }
+    (bytes.len(), bytes.len())
+}
+
+/// Blank template literal text from `i` up to the closing backtick or the
+/// next `${`, returning the position to resume scanning.
+///
+/// On `${`, `depth` is pushed so the matching `}` resumes the template.
+fn template(
+    bytes: &[u8],
+    buf: &mut [u8],
+    mut i: usize,
+    depth: usize,
+    templates: &mut Vec<usize>,
+) -> usize {
+    let body_start = i;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'\\' => i += 2,
+            b'`' => {
+                mask(buf, body_start, i);
+                return i + 1;
+            }
+            b'$' if bytes.get(i + 1) == Some(&b'{') => {
+                mask(buf, body_start, i);
+                templates.push(depth);
+                return i + 2;
+            }
+            _ => i += 1,
+        }
+    }
+    mask(buf, body_start, bytes.len());
+    bytes.len()
+}
+
+/// Find the closing `/` of a regex literal opening at `start`, or None
+/// if the line ends first (so the `/` is a division).
+fn regex_end(bytes: &[u8], start: usize) -> Option<usize> {
+    let mut in_class = false;
+    let mut i = start + 1;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'\\' => i += 2,
+            b'\n' | b'\r' => return None,
+            b'[' => {
+                in_class = true;
+                i += 1;
+            }
+            b']' => {
+                in_class = false;
+                i += 1;
+            }
+            b'/' if !in_class => return Some(i),
+            _ => i += 1,
+        }
+    }
+    None
+}
+
+/// Replace bytes with spaces, keeping newlines.
+fn mask(buf: &mut [u8], start: usize, end: usize) {
+    let end = end.min(buf.len());
+    for byte in &mut buf[start.min(end)..end] {
+        if !matches!(*byte, b'\n' | b'\r') {
+            *byte = b' ';
+        }
+    }
+}
+
+/// Identifier bytes; non-ASCII bytes are treated as identifier characters
+/// so multi-byte characters are never split.
+fn is_name_byte(byte: u8) -> bool {
+    byte.is_ascii_alphanumeric() || byte == b'_' || byte == b'$' || byte >= 0x80
+}
+
+#[cfg(test)]
+#[path = "calls_tests.rs"]
+mod tests;
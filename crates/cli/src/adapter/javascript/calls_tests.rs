@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use yare::parameterized;
+
+fn normalize(content: &str) -> String {
+    normalize_escape_source(content).unwrap_or_else(|| content.to_string())
+}
+
+#[test]
+fn returns_none_when_nothing_changes() {
+    assert!(normalize_escape_source("const f = new Function(src);\neval(code);\n").is_none());
+}
+
+#[parameterized(
+    double_quoted = { "x = \"eval(y)\";\n", "x = \"       \";\n" },
+    single_quoted = { "x = 'new Function(a)';\n", "x = '               ';\n" },
+    escaped_quote = { "x = 'a\\'eval(';\n", "x = '        ';\n" },
+    template = { "x = `eval(y)`;\n", "x = `       `;\n" },
+    regex = { "re = /eval\\(/g;\n", "re = /      /g;\n" },
+    block_comment = { "/* eval(x) */ y();\n", "/*         */ y();\n" },
+)]
+fn blanks_literals_and_block_comments(content: &str, expected: &str) {
+    assert_eq!(normalize(content), expected);
+}
+
+#[test]
+fn keeps_template_expressions() {
+    let content = "x = `run ${eval(code)} now`;\n";
+    assert_eq!(normalize(content), "x = `    ${eval(code)}    `;\n");
+}
+
+#[test]
+fn keeps_nested_braces_in_template_expressions() {
+    let content = "x = `a ${f({ k: 'eval(' })} eval(`;\n";
+    assert_eq!(normalize(content), "x = `  ${f({ k: '     ' })}      `;\n");
+}
+
+#[test]
+fn keeps_line_comments_and_directives() {
+    let content = "// eval(x) is unsafe\n/* @ts-ignore */\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[test]
+fn division_is_not_a_regex() {
+    let content = "const half = total / 2; eval(x) / 3;\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[test]
+fn jsx_closing_tag_is_not_a_regex() {
+    let content = "<p>{x}</p> <div dangerouslySetInnerHTML={h} />\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[parameterized(
+    method_call = { "parser.eval(x);\n", "parser.    (x);\n" },
+    optional_call = { "parser?.eval(x);\n", "parser?.    (x);\n" },
+    namespaced_constructor = { "new vm.Function(src);\n", "new vm.        (src);\n" },
+    method_definition = { "class P {\n  eval(input: string): number {\n", "class P {\n      (input: string): number {\n" },
+    static_method = { "  static eval(x) {\n", "  static     (x) {\n" },
+    object_method = { "const p = { eval(x) { return x; } };\n", "const p = {     (x) { return x; } };\n" },
+    function_declaration = { "function eval(x) {}\n", "function     (x) {}\n" },
+)]
+fn masks_lookalike_members(content: &str, expected: &str) {
+    assert_eq!(normalize(content), expected);
+}
+
+#[parameterized(
+    window = { "window.eval(x);\n" },
+    global_this = { "globalThis.eval(x);\n" },
+    global_constructor = { "new window.Function(src);\n" },
+)]
+fn keeps_global_members(content: &str) {
+    assert_eq!(normalize(content), content);
+}
+
+#[parameterized(
+    statement = { "eval(code);\n" },
+    block = { "{\n  eval(code)\n}\n" },
+    ternary = { "x = ok ? eval(a) : b;\n" },
+    argument = { "run(a, eval(b));\n" },
+)]
+fn keeps_calls(content: &str) {
+    assert_eq!(normalize(content), content);
+}
+
+#[test]
+fn preserves_length_and_lines() {
+    let content = "const s = 'café eval(x)';\nconst t = `ü\n${eval(y)}`;\n";
+    let normalized = normalize(content);
+    assert_eq!(normalized.len(), content.len());
+    assert_eq!(normalized.lines().count(), content.lines().count());
+    assert!(normalized.contains("${eval(y)}"));
+}
@@ -7,6 +7,8 @@
 //! - File classification (source vs test)
 //! - Default patterns for JS/TS projects
 //! - JS/TS-specific escape patterns (Phase 495)
+//! - Dynamic code and raw HTML escape patterns (`eval`, `new Function`,
+//!   `dangerouslySetInnerHTML`)
 //!
 //! See docs/specs/langs/javascript.md for specification.
 
@@ -15,12 +17,14 @@ use std::path::Path;
 use globset::GlobSet;
 
 mod bundler;
+mod calls;
 mod package_manager;
 mod suppress;
 mod workspace;
 
 pub use crate::adapter::common::policy::PolicyCheckResult;
 pub use bundler::{Bundler, detect_bundler};
+pub use calls::normalize_escape_source;
 pub use package_manager::PackageManager;
 pub use suppress::{JavaScriptSuppress, SuppressTool, parse_javascript_suppresses};
 pub use workspace::JsWorkspace;
@@ -29,7 +33,7 @@ use crate::config::JavaScriptPolicyConfig;
 
 use super::common;
 use super::glob::build_glob_set;
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 
 /// Common exclude directory prefixes to check before GlobSet.
 /// Order: most common first for early exit.
@@ -37,7 +41,11 @@ const EXCLUDE_PREFIXES: &[&str] = &["node_modules", "dist", "build", ".next", "c
 
 /// Default escape patterns for JavaScript/TypeScript.
 ///
-/// These patterns detect common type safety escapes that require justification.
+/// These patterns detect common type safety escapes, dynamic code execution
+/// and raw HTML injection that require justification.
+///
+/// Patterns are matched against normalized source (see [`normalize_escape_source`]),
+/// so string literals, block comments and `parser.eval()` don't match.
 const JS_ESCAPE_PATTERNS: &[EscapePattern] = &[
     EscapePattern {
         name: "as_unknown",
@@ -55,6 +63,30 @@ const JS_ESCAPE_PATTERNS: &[EscapePattern] = &[
         advice: "@ts-ignore is forbidden. Use @ts-expect-error instead, which fails if the error is resolved.",
         in_tests: None,
     },
+    EscapePattern {
+        name: "eval",
+        pattern: r"\beval\s*\(",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining why eval is necessary and where its input comes from.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "new_function",
+        pattern: r"\bnew\s+Function\s*\(",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining why new Function is necessary and where its source comes from.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "dangerously_set_inner_html",
+        pattern: r"\bdangerouslySetInnerHTML\b",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining how the HTML is sanitized.",
+        in_tests: None,
+    },
 ];
 
 /// JavaScript/TypeScript language adapter.
@@ -145,7 +177,7 @@ impl Adapter for JavaScriptAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["js", "jsx", "ts", "tsx", "mjs", "mts", "cjs", "cts"]
+        ProjectLanguage::JavaScript.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
@@ -96,7 +96,7 @@ fn default_escapes_has_js_patterns() {
     let adapter = JavaScriptAdapter::new();
     let escapes = adapter.default_escapes();
 
-    assert_eq!(escapes.len(), 2);
+    assert_eq!(escapes.len(), 5);
 
     // Verify as_unknown pattern
     let as_unknown = escapes.iter().find(|p| p.name == "as_unknown").unwrap();
@@ -107,6 +107,13 @@ fn default_escapes_has_js_patterns() {
     let ts_ignore = escapes.iter().find(|p| p.name == "ts_ignore").unwrap();
     assert_eq!(ts_ignore.action, EscapeAction::Forbid);
     assert!(ts_ignore.comment.is_none());
+
+    // Dynamic code and raw HTML require // SAFETY: comments
+    for name in ["eval", "new_function", "dangerously_set_inner_html"] {
+        let pattern = escapes.iter().find(|p| p.name == name).unwrap();
+        assert_eq!(pattern.action, EscapeAction::Comment);
+        assert_eq!(pattern.comment, Some("// SAFETY:"));
+    }
 }
 
 // =============================================================================
@@ -160,3 +167,29 @@ fn ts_ignore_pattern_matches() {
     assert!(compiled.find_all("// @ts-expect-error").is_empty()); // allowed alternative
     assert!(compiled.find_all("// ts-ignore").is_empty()); // missing @
 }
+
+#[parameterized(
+    eval_call = { "eval", "const r = eval(code);", true },
+    eval_spaced = { "eval", "eval (code)", true },
+    eval_suffix = { "eval", "retrieval(code)", false },
+    eval_prefix = { "eval", "evaluate(code)", false },
+    new_function = { "new_function", "const f = new Function('a', body);", true },
+    new_function_type = { "new_function", "const f: Function = g;", false },
+    subclass = { "new_function", "new FunctionBuilder()", false },
+    inner_html_jsx = { "dangerously_set_inner_html", "<div dangerouslySetInnerHTML={{ __html: h }} />", true },
+    inner_html_object = { "dangerously_set_inner_html", "{ dangerouslySetInnerHTML: { __html: h } }", true },
+    inner_html_dom = { "dangerously_set_inner_html", "el.innerHTML = h;", false },
+)]
+fn dynamic_code_patterns_match(name: &str, line: &str, expected: bool) {
+    use crate::pattern::CompiledPattern;
+
+    let adapter = JavaScriptAdapter::new();
+    let pattern = adapter
+        .default_escapes()
+        .iter()
+        .find(|p| p.name == name)
+        .unwrap();
+
+    let compiled = CompiledPattern::compile(pattern.pattern).unwrap();
+    assert_eq!(!compiled.find_all(line).is_empty(), expected, "{:?}", line);
+}
@@ -67,7 +67,7 @@
 
 use std::collections::HashMap;
 use std::path::Path;
-use std::sync::Arc;
+use std::sync::{Arc, LazyLock};
 
 pub mod common;
 pub mod generic;
@@ -82,7 +82,9 @@ pub mod rust;
 pub mod shell;
 
 pub use generic::GenericAdapter;
-pub use go::{enumerate_packages, parse_nolint_directives};
+pub use go::{
+    enumerate_packages, mask_cgo_preambles, normalize_escape_source, parse_nolint_directives,
+};
 pub use javascript::JsWorkspace;
 pub use rust::parse_suppress_attrs;
 
@@ -148,6 +150,19 @@ pub struct EscapePattern {
     pub in_tests: Option<&'static str>,
 }
 
+/// Default escape patterns of a language's adapter (none for generic).
+pub fn default_escapes(language: ProjectLanguage) -> &'static [EscapePattern] {
+    match language {
+        ProjectLanguage::Rust => RustAdapter::new().default_escapes(),
+        ProjectLanguage::Go => GoAdapter::new().default_escapes(),
+        ProjectLanguage::JavaScript => JavaScriptAdapter::new().default_escapes(),
+        ProjectLanguage::Python => PythonAdapter::new().default_escapes(),
+        ProjectLanguage::Ruby => RubyAdapter::new().default_escapes(),
+        ProjectLanguage::Shell => ShellAdapter::new().default_escapes(),
+        ProjectLanguage::Generic => &[],
+    }
+}
+
 /// Action required for an escape pattern match.
 #[derive(Debug, Clone, Copy, PartialEq, Eq)]
 pub enum EscapeAction {
@@ -210,7 +225,7 @@ impl Default for AdapterRegistry {
 // =============================================================================
 
 /// Detect project language from marker files.
-#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash)]
 pub enum ProjectLanguage {
     Rust,
     Go,
@@ -221,6 +236,57 @@ pub enum ProjectLanguage {
     Generic,
 }
 
+impl ProjectLanguage {
+    /// Languages with an adapter, in detection order.
+    pub const ADAPTERS: [ProjectLanguage; 6] = [
+        ProjectLanguage::Rust,
+        ProjectLanguage::Go,
+        ProjectLanguage::JavaScript,
+        ProjectLanguage::Python,
+        ProjectLanguage::Ruby,
+        ProjectLanguage::Shell,
+    ];
+
+    /// File extensions dispatched to this language's adapter.
+    pub fn extensions(self) -> &'static [&'static str] {
+        match self {
+            ProjectLanguage::Rust => &["rs"],
+            ProjectLanguage::Go => &["go"],
+            ProjectLanguage::JavaScript => &["js", "jsx", "ts", "tsx", "mjs", "mts", "cjs", "cts"],
+            ProjectLanguage::Python => &["py"],
+            ProjectLanguage::Ruby => &["rb", "rake"],
+            ProjectLanguage::Shell => &["sh", "bash", "bats"],
+            ProjectLanguage::Generic => &[],
+        }
+    }
+
+    /// Parse a language name (`go`, `golang`, `ts`, ...), case-insensitively.
+    pub fn from_name(name: &str) -> Option<Self> {
+        match name.to_ascii_lowercase().as_str() {
+            "rust" | "rs" => Some(ProjectLanguage::Rust),
+            "go" | "golang" => Some(ProjectLanguage::Go),
+            "javascript" | "js" | "typescript" | "ts" => Some(ProjectLanguage::JavaScript),
+            "python" | "py" => Some(ProjectLanguage::Python),
+            "ruby" | "rb" => Some(ProjectLanguage::Ruby),
+            "shell" | "sh" | "bash" => Some(ProjectLanguage::Shell),
+            "generic" => Some(ProjectLanguage::Generic),
+            _ => None,
+        }
+    }
+}
+
+impl<'de> serde::Deserialize<'de> for ProjectLanguage {
+    fn deserialize<D: serde::Deserializer<'de>>(deserializer: D) -> Result<Self, D::Error> {
+        let name = String::deserialize(deserializer)?;
+        Self::from_name(&name).ok_or_else(|| {
+            serde::de::Error::custom(format!(
+                "unknown language {:?}, expected one of: rust, go, javascript, python, ruby, shell, generic",
+                name
+            ))
+        })
+    }
+}
+
 impl std::fmt::Display for ProjectLanguage {
     fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
         match self {
@@ -241,7 +307,7 @@ pub fn detect_language(root: &Path) -> ProjectLanguage {
         return ProjectLanguage::Rust;
     }
 
-    if root.join("go.mod").exists() {
+    if has_go_markers(root) {
         return ProjectLanguage::Go;
     }
 
@@ -263,6 +329,11 @@ pub fn detect_language(root: &Path) -> ProjectLanguage {
         return ProjectLanguage::Ruby;
     }
 
+    // Multi-module Go repository without a root go.mod (before Shell check)
+    if has_nested_go_modules(root) {
+        return ProjectLanguage::Go;
+    }
+
     // Check for Shell project markers: *.sh in root, bin/, or scripts/
     if has_shell_markers(root) {
         return ProjectLanguage::Shell;
@@ -271,6 +342,71 @@ pub fn detect_language(root: &Path) -> ProjectLanguage {
     ProjectLanguage::Generic
 }
 
+/// Adapter languages by file extension, from [`ProjectLanguage::extensions`].
+static LANGUAGE_BY_EXTENSION: LazyLock<HashMap<&'static str, ProjectLanguage>> =
+    LazyLock::new(|| {
+        ProjectLanguage::ADAPTERS
+            .iter()
+            .flat_map(|&language| {
+                language
+                    .extensions()
+                    .iter()
+                    .map(move |&ext| (ext, language))
+            })
+            .collect()
+    });
+
+/// Language of a single file from its extension, if it has an adapter.
+///
+/// This is the one file-to-adapter mapping: checks dispatch each file to
+/// its adapter's rules through it, so a Go repository's `.ts` files get the
+/// JavaScript rules. Files with other extensions have no adapter and are
+/// skipped by language-specific rules.
+pub fn language_for_file(path: &Path) -> Option<ProjectLanguage> {
+    let ext = path.extension()?.to_str()?.to_ascii_lowercase();
+    LANGUAGE_BY_EXTENSION.get(ext.as_str()).copied()
+}
+
+/// The project language: `[project] language` (or `--lang`) if set,
+/// otherwise detected from marker files.
+pub fn project_language(root: &Path, config: &crate::config::Config) -> ProjectLanguage {
+    config
+        .project
+        .language
+        .unwrap_or_else(|| detect_language(root))
+}
+
+/// Check if project has Go markers.
+/// Detection: go.mod or go.work
+fn has_go_markers(root: &Path) -> bool {
+    root.join("go.mod").exists() || root.join("go.work").exists()
+}
+
+/// Check if subdirectories hold Go modules (multi-module repository).
+/// Detection: go.mod up to two levels below the root
+fn has_nested_go_modules(root: &Path) -> bool {
+    subdirs(root).any(|dir| {
+        dir.join("go.mod").is_file() || subdirs(&dir).any(|sub| sub.join("go.mod").is_file())
+    })
+}
+
+/// Subdirectories the go tool would consider (skips vendor, testdata, hidden).
+fn subdirs(dir: &Path) -> impl Iterator<Item = std::path::PathBuf> {
+    dir.read_dir()
+        .into_iter()
+        .flatten()
+        .filter_map(|e| e.ok())
+        .filter(|entry| {
+            let name = entry.file_name();
+            let name = name.to_string_lossy();
+            !name.starts_with('.')
+                && !name.starts_with('_')
+                && !matches!(name.as_ref(), "vendor" | "testdata" | "node_modules")
+                && entry.file_type().is_ok_and(|t| t.is_dir())
+        })
+        .map(|entry| entry.path())
+}
+
 /// Check if project has Python markers.
 /// Detection: pyproject.toml, setup.py, setup.cfg, or requirements.txt
 fn has_python_markers(root: &Path) -> bool {
@@ -331,7 +467,7 @@ pub fn detect_all_languages(root: &Path) -> Vec<ProjectLanguage> {
     if root.join("Cargo.toml").exists() {
         langs.push(ProjectLanguage::Rust);
     }
-    if root.join("go.mod").exists() {
+    if has_go_markers(root) || has_nested_go_modules(root) {
         langs.push(ProjectLanguage::Go);
     }
     if root.join("package.json").exists()
@@ -421,11 +557,16 @@ impl AdapterRegistry {
             &resolved.test,
         )));
 
-        match detect_language(root) {
+        match project_language(root, config) {
             ProjectLanguage::Rust => {
                 registry.register(Arc::new(RustAdapter::with_patterns(resolved)));
             }
             ProjectLanguage::Go => {
+                // Each nested module vendors its own dependencies
+                let mut resolved = resolved;
+                for module in go::find_modules(root).iter().filter(|m| m.dir != ".") {
+                    resolved.exclude.push(format!("{}/**", module.vendor_dir()));
+                }
                 registry.register(Arc::new(GoAdapter::with_patterns(resolved)));
             }
             ProjectLanguage::JavaScript => {
@@ -462,7 +603,7 @@ pub fn resolve_project_patterns(root: &Path, config: &crate::config::Config) ->
         GenericAdapter::default_test_patterns()
     };
 
-    match detect_language(root) {
+    match project_language(root, config) {
         ProjectLanguage::Rust => resolve_rust_patterns(config, &fallback_test_patterns),
         ProjectLanguage::Go => resolve_go_patterns(config, &fallback_test_patterns),
         ProjectLanguage::JavaScript => resolve_javascript_patterns(config, &fallback_test_patterns),
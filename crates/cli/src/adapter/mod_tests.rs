@@ -40,6 +40,36 @@ fn detect_language_generic_without_cargo_toml() {
     assert_eq!(detect_language(dir.path()), ProjectLanguage::Generic);
 }
 
+#[test]
+fn detect_language_go_with_nested_modules() {
+    let dir = TempDir::new().unwrap();
+    for module in ["services/api", "services/worker"] {
+        std::fs::create_dir_all(dir.path().join(module)).unwrap();
+        std::fs::write(
+            dir.path().join(module).join("go.mod"),
+            "module example.com/svc\n",
+        )
+        .unwrap();
+    }
+    // Shell scripts alongside don't win over the Go modules
+    std::fs::write(dir.path().join("build.sh"), "#!/bin/bash\necho hi\n").unwrap();
+
+    assert_eq!(detect_language(dir.path()), ProjectLanguage::Go);
+}
+
+#[test]
+fn detect_language_ignores_vendored_go_modules() {
+    let dir = TempDir::new().unwrap();
+    std::fs::create_dir_all(dir.path().join("vendor/dep")).unwrap();
+    std::fs::write(
+        dir.path().join("vendor/dep/go.mod"),
+        "module example.com/dep\n",
+    )
+    .unwrap();
+
+    assert_eq!(detect_language(dir.path()), ProjectLanguage::Generic);
+}
+
 #[test]
 fn for_project_registers_rust_adapter() {
     let dir = TempDir::new().unwrap();
@@ -54,6 +84,28 @@ fn for_project_registers_rust_adapter() {
     assert_eq!(registry.adapter_for(Path::new("src/lib.rs")).name(), "rust");
 }
 
+#[test]
+fn for_project_with_config_excludes_nested_module_vendor() {
+    let dir = TempDir::new().unwrap();
+    std::fs::create_dir_all(dir.path().join("services/api")).unwrap();
+    std::fs::write(
+        dir.path().join("services/api/go.mod"),
+        "module example.com/api\n",
+    )
+    .unwrap();
+
+    let registry =
+        AdapterRegistry::for_project_with_config(dir.path(), &crate::config::Config::default());
+    assert_eq!(
+        registry.classify(Path::new("services/api/main.go")),
+        FileKind::Source
+    );
+    assert_eq!(
+        registry.classify(Path::new("services/api/vendor/dep/dep.go")),
+        FileKind::Other
+    );
+}
+
 #[test]
 fn for_project_generic_fallback() {
     let dir = TempDir::new().unwrap();
@@ -112,3 +164,42 @@ fn project_language_display() {
     assert_eq!(ProjectLanguage::Shell.to_string(), "Shell");
     assert_eq!(ProjectLanguage::Generic.to_string(), "Generic");
 }
+
+#[test]
+fn language_for_file_uses_extension() {
+    assert_eq!(
+        language_for_file(Path::new("internal/store/ptr.go")),
+        Some(ProjectLanguage::Go)
+    );
+    assert_eq!(
+        language_for_file(Path::new("src/lib.RS")),
+        Some(ProjectLanguage::Rust)
+    );
+    assert_eq!(
+        language_for_file(Path::new("web/app.tsx")),
+        Some(ProjectLanguage::JavaScript)
+    );
+    assert_eq!(language_for_file(Path::new("README.md")), None);
+    assert_eq!(language_for_file(Path::new("Makefile")), None);
+}
+
+#[test]
+fn project_language_override_beats_markers() {
+    let dir = TempDir::new().unwrap();
+    std::fs::write(dir.path().join("Cargo.toml"), "[package]\nname = \"x\"\n").unwrap();
+    let mut config = crate::config::Config::default();
+    assert_eq!(project_language(dir.path(), &config), ProjectLanguage::Rust);
+
+    config.project.language = ProjectLanguage::from_name("Go");
+    assert_eq!(project_language(dir.path(), &config), ProjectLanguage::Go);
+}
+
+#[test]
+fn every_adapter_extension_maps_back_to_its_language() {
+    for language in ProjectLanguage::ADAPTERS {
+        for ext in language.extensions() {
+            let path = format!("file.{}", ext);
+            assert_eq!(language_for_file(Path::new(&path)), Some(language));
+        }
+    }
+}
@@ -6,19 +6,20 @@
 use std::path::Path;
 
 use super::{
-    JsWorkspace, ProjectLanguage, detect_language, python::detect_package as detect_python_package,
-    rust::CargoWorkspace,
+    JsWorkspace, ProjectLanguage, project_language,
+    python::detect_package as detect_python_package, rust::CargoWorkspace,
 };
 use crate::config::Config;
 
 /// Apply language-specific exclude patterns and auto-detect workspace packages.
 ///
-/// Returns the complete list of exclude patterns (user-configured + language defaults).
+/// Returns the complete list of exclude patterns: user-configured plus language
+/// defaults, or only the user's when an explicit list replaces the defaults.
 /// Mutates `config` to populate auto-detected `packages` and `package_names`.
 pub fn apply_language_defaults(root: &Path, config: &mut Config) -> Vec<String> {
     let mut exclude_patterns = config.project.exclude.patterns.clone();
 
-    match detect_language(root) {
+    match project_language(root, config) {
         ProjectLanguage::Rust => {
             // Exclude target/ directory for Rust projects
             if !exclude_patterns.iter().any(|p| p.contains("target")) {
@@ -111,10 +112,18 @@ pub fn apply_language_defaults(root: &Path, config: &mut Config) -> Vec<String>
             }
         }
         ProjectLanguage::Go => {
-            // Exclude vendor/ directory for Go projects
+            let modules = super::go::find_modules(root);
+
+            // Exclude vendor/ directory for Go projects, and each nested module's vendor/
             if !exclude_patterns.iter().any(|p| p.contains("vendor")) {
                 exclude_patterns.push("vendor".to_string());
             }
+            for module in modules.iter().filter(|m| m.dir != ".") {
+                let vendor = module.vendor_dir();
+                if !exclude_patterns.contains(&vendor) {
+                    exclude_patterns.push(vendor);
+                }
+            }
 
             // Auto-detect Go packages if not configured
             if config.project.packages.is_empty() {
@@ -123,16 +132,22 @@ pub fn apply_language_defaults(root: &Path, config: &mut Config) -> Vec<String>
                 // projects don't benefit from per-package breakdown)
                 if packages.len() > 1 {
                     for pkg_path in packages {
-                        // Use directory name as display name
-                        let name = Path::new(&pkg_path)
-                            .file_name()
-                            .and_then(|n| n.to_str())
-                            .unwrap_or(&pkg_path)
-                            .to_string();
+                        // Use directory name as display name; with several modules,
+                        // the import path keeps same-named packages apart
+                        let module = super::go::module_for(&modules, &pkg_path);
+                        let name = match module {
+                            Some(module) if modules.len() > 1 => module.import_path(&pkg_path),
+                            _ => Path::new(&pkg_path)
+                                .file_name()
+                                .and_then(|n| n.to_str())
+                                .unwrap_or(&pkg_path)
+                                .to_string(),
+                        };
                         config.project.package_names.insert(pkg_path.clone(), name);
                         config.project.packages.push(pkg_path);
                     }
                     config.project.packages.sort();
+                    tracing::debug!("auto-detected Go modules: {:?}", modules);
                     tracing::debug!("auto-detected Go packages: {:?}", config.project.packages);
                     tracing::debug!("package names: {:?}", config.project.package_names);
                 }
@@ -213,5 +228,9 @@ pub fn apply_language_defaults(root: &Path, config: &mut Config) -> Vec<String>
         ProjectLanguage::Generic => {}
     }
 
-    exclude_patterns
+    if config.project.exclude.uses_defaults() {
+        exclude_patterns
+    } else {
+        config.project.exclude.patterns.clone()
+    }
 }
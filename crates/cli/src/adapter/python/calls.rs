@@ -0,0 +1,464 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Python call-site normalization.
+//!
+//! Escape patterns are plain regexes (`\beval\s*\(`), so against raw source
+//! they also match inside string literals and on unrelated methods
+//! (`obj.eval()`). A lightweight tokenizer finds the real call sites and
+//! rewrites the text patterns are matched against:
+//!
+//! - string literal contents are blanked
+//! - governed calls reached through an import (`sp.run`, `from pickle import
+//!   loads`) are rewritten to their canonical name (`subprocess.run`,
+//!   `pickle.loads`)
+//! - method calls and definitions that share a governed name are masked
+//! - multi-line governed calls are joined onto their first line, so argument
+//!   patterns (`shell=True`) match on the line of the call
+//!
+//! The number of lines never changes, so line numbers stay valid.
+
+use std::collections::HashMap;
+
+/// Calls matched by default escape patterns, by canonical name.
+const GOVERNED_CALLS: &[&str] = &[
+    "eval",
+    "exec",
+    "os.system",
+    "pickle.loads",
+    "subprocess.run",
+    "subprocess.call",
+    "subprocess.check_call",
+    "subprocess.check_output",
+    "subprocess.Popen",
+];
+
+/// Modules that re-export a governed module's calls under another name.
+const MODULE_ALIASES: &[(&str, &str)] = &[
+    ("builtins.", ""),
+    ("cPickle.", "pickle."),
+    ("_pickle.", "pickle."),
+];
+
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+enum Kind {
+    Name,
+    /// String literal; the body excludes prefix and quotes.
+    Str {
+        body_start: usize,
+        body_end: usize,
+    },
+    Comment,
+    Open,
+    Close,
+    Dot,
+    /// End of a logical line (newline outside brackets, or `;`).
+    End,
+    Other,
+}
+
+#[derive(Debug, Clone, Copy)]
+struct Token {
+    kind: Kind,
+    start: usize,
+    end: usize,
+}
+
+/// Rewrite Python source so default escape patterns only match real calls.
+///
+/// Returns None when nothing changes, so callers can keep matching
+/// against the original content.
+pub fn normalize_escape_source(content: &str) -> Option<String> {
+    let tokens = tokenize(content);
+    let aliases = parse_imports(content, &tokens);
+
+    let mut buf = content.as_bytes().to_vec();
+    for token in &tokens {
+        if let Kind::Str {
+            body_start,
+            body_end,
+        } = token.kind
+        {
+            mask(&mut buf, body_start, body_end);
+        }
+    }
+
+    // (start, end, replacement), applied after masking
+    let mut edits: Vec<(usize, usize, String)> = Vec::new();
+    let mut collapsed_until = 0;
+    let code: Vec<Token> = tokens
+        .iter()
+        .copied()
+        .filter(|t| t.kind != Kind::Comment)
+        .collect();
+
+    let mut i = 0;
+    while i < code.len() {
+        let Some(chain) = call_chain(content, &code, i) else {
+            i += 1;
+            continue;
+        };
+        let chain_start = code[i].start;
+        let chain_end = code[chain.open - 1].end;
+        let canonical = chain.rooted.then(|| resolve(&chain.segments, &aliases));
+
+        match canonical.filter(|name| GOVERNED_CALLS.contains(&name.as_str())) {
+            Some(name) => {
+                if content[chain_start..chain_end] != name {
+                    edits.push((chain_start, chain_end, name));
+                }
+                if let Some(close) = matching_close(&code, chain.open)
+                    && chain_start >= collapsed_until
+                {
+                    let span_end = code[close].end;
+                    collapse(&mut buf, &tokens, chain_start, span_end, &mut edits);
+                    collapsed_until = span_end;
+                }
+            }
+            None if collides(&chain.segments) => mask(&mut buf, chain_start, chain_end),
+            None => {}
+        }
+        i = chain.open;
+    }
+
+    let mut normalized = String::from_utf8(buf).ok()?;
+    edits.sort_by_key(|(start, _, _)| std::cmp::Reverse(*start));
+    for (start, end, replacement) in edits {
+        normalized.replace_range(start..end, &replacement);
+    }
+
+    (normalized != content).then_some(normalized)
+}
+
+/// A dotted name followed by `(`.
+struct CallChain<'a> {
+    segments: Vec<&'a str>,
+    /// False for method calls on an expression (`f().eval()`) and for
+    /// definitions (`def eval(`), which never resolve to a governed call.
+    rooted: bool,
+    /// Index of the `(` token.
+    open: usize,
+}
+
+/// Read the call chain starting at token `i`, if any.
+fn call_chain<'a>(content: &'a str, tokens: &[Token], i: usize) -> Option<CallChain<'a>> {
+    if tokens[i].kind != Kind::Name {
+        return None;
+    }
+    let prev = i.checked_sub(1).map(|p| &tokens[p]);
+    // Continuation of a chain already read from an earlier name
+    if prev.is_some_and(|p| p.kind == Kind::Dot) && i >= 2 && tokens[i - 2].kind == Kind::Name {
+        return None;
+    }
+    let is_definition = prev.is_some_and(|p| {
+        p.kind == Kind::Name && matches!(&content[p.start..p.end], "def" | "class")
+    });
+    let rooted = !is_definition && prev.is_none_or(|p| p.kind != Kind::Dot);
+
+    let mut segments = vec![&content[tokens[i].start..tokens[i].end]];
+    let mut j = i + 1;
+    while j + 1 < tokens.len() && tokens[j].kind == Kind::Dot && tokens[j + 1].kind == Kind::Name {
+        segments.push(&content[tokens[j + 1].start..tokens[j + 1].end]);
+        j += 2;
+    }
+
+    let open = tokens.get(j)?;
+    if open.kind != Kind::Open || content.as_bytes()[open.start] != b'(' {
+        return None;
+    }
+    Some(CallChain {
+        segments,
+        rooted,
+        open: j,
+    })
+}
+
+/// Resolve a rooted chain to its canonical dotted name through imports.
+fn resolve(segments: &[&str], aliases: &HashMap<String, String>) -> String {
+    let mut name = match aliases.get(segments[0]) {
+        Some(qualified) => qualified.clone(),
+        None => segments[0].to_string(),
+    };
+    for segment in &segments[1..] {
+        name.push('.');
+        name.push_str(segment);
+    }
+    for (module, canonical) in MODULE_ALIASES {
+        if let Some(rest) = name.strip_prefix(module) {
+            return format!("{}{}", canonical, rest);
+        }
+    }
+    name
+}
+
+/// Check whether a non-governed chain ends like a governed call
+/// (`obj.eval`, `self.os.system`) and would be matched by its pattern.
+fn collides(segments: &[&str]) -> bool {
+    GOVERNED_CALLS.iter().any(|governed| {
+        let parts: Vec<&str> = governed.split('.').collect();
+        segments.ends_with(&parts)
+    })
+}
+
+/// Find the `)` matching the `(` at index `open`.
+fn matching_close(tokens: &[Token], open: usize) -> Option<usize> {
+    let mut depth = 0usize;
+    for (offset, token) in tokens[open..].iter().enumerate() {
+        match token.kind {
+            Kind::Open => depth += 1,
+            Kind::Close => {
+                depth = depth.saturating_sub(1);
+                if depth == 0 {
+                    return Some(open + offset);
+                }
+            }
+            _ => {}
+        }
+    }
+    None
+}
+
+/// Join a call spanning several lines onto its first line.
+///
+/// Comments inside the call are masked so they don't swallow the joined
+/// arguments; the removed newlines are re-inserted after the call.
+fn collapse(
+    buf: &mut [u8],
+    tokens: &[Token],
+    start: usize,
+    end: usize,
+    edits: &mut Vec<(usize, usize, String)>,
+) {
+    let newlines = buf[start..end].iter().filter(|&&b| b == b'\n').count();
+    if newlines == 0 {
+        return;
+    }
+    for token in tokens {
+        if token.kind == Kind::Comment && token.start >= start && token.end <= end {
+            mask(buf, token.start, token.end);
+        }
+    }
+    for byte in &mut buf[start..end] {
+        if matches!(*byte, b'\n' | b'\r') {
+            *byte = b' ';
+        }
+    }
+    edits.push((end, end, "\n".repeat(newlines)));
+}
+
+/// Replace bytes with spaces, keeping newlines.
+fn mask(buf: &mut [u8], start: usize, end: usize) {
+    for byte in &mut buf[start..end] {
+        if !matches!(*byte, b'\n' | b'\r') {
+            *byte = b' ';
+        }
+    }
+}
+
+/// Map local names bound by `import` and `from ... import` to qualified names.
+///
+/// `import subprocess as sp` binds `sp` to `subprocess`; `from os import
+/// system` binds `system` to `os.system`. Relative imports are ignored.
+fn parse_imports(content: &str, tokens: &[Token]) -> HashMap<String, String> {
+    let mut aliases = HashMap::new();
+    let code: Vec<&Token> = tokens.iter().filter(|t| t.kind != Kind::Comment).collect();
+    let text = |t: &Token| &content[t.start..t.end];
+
+    for statement in code.split(|t| t.kind == Kind::End) {
+        let words: Vec<&str> = statement
+            .iter()
+            .filter(|t| !matches!(t.kind, Kind::Open | Kind::Close))
+            .map(|t| text(t))
+            .collect();
+        match words.first() {
+            Some(&"import") => {
+                for clause in words[1..].split(|w| *w == ",") {
+                    let (module, alias) = split_alias(clause);
+                    if module.is_empty() {
+                        continue;
+                    }
+                    match alias {
+                        Some(alias) => aliases.insert(alias.to_string(), module),
+                        // `import os.path` binds `os`
+                        None => {
+                            let root = module.split('.').next().unwrap_or_default().to_string();
+                            aliases.insert(root.clone(), root)
+                        }
+                    };
+                }
+            }
+            Some(&"from") => {
+                let Some(import) = words.iter().position(|w| *w == "import") else {
+                    continue;
+                };
+                let module = words[1..import].concat();
+                if module.is_empty() || module.starts_with('.') {
+                    continue;
+                }
+                for clause in words[import + 1..].split(|w| *w == ",") {
+                    let (name, alias) = split_alias(clause);
+                    if name.is_empty() || name == "*" {
+                        continue;
+                    }
+                    let local = alias.unwrap_or(&name).to_string();
+                    aliases.insert(local, format!("{}.{}", module, name));
+                }
+            }
+            _ => {}
+        }
+    }
+    aliases
+}
+
+/// Split an import clause like `a . b as c` into (`a.b`, Some(`c`)).
+fn split_alias<'a>(clause: &[&'a str]) -> (String, Option<&'a str>) {
+    match clause.iter().position(|w| *w == "as") {
+        Some(at) => (clause[..at].concat(), clause.get(at + 1).copied()),
+        None => (clause.concat(), None),
+    }
+}
+
+/// Tokenize Python source into the coarse tokens needed for call detection.
+fn tokenize(content: &str) -> Vec<Token> {
+    let bytes = content.as_bytes();
+    let mut tokens = Vec::new();
+    let mut depth = 0usize;
+    let mut i = 0;
+
+    while i < bytes.len() {
+        let start = i;
+        let byte = bytes[i];
+        let kind = match byte {
+            b'\n' => {
+                i += 1;
+                if depth > 0 {
+                    continue;
+                }
+                Kind::End
+            }
+            b' ' | b'\t' | b'\r' | b'\x0c' => {
+                i += 1;
+                continue;
+            }
+            // Line continuation
+            b'\\' => {
+                i += 1;
+                if bytes.get(i) == Some(&b'\r') {
+                    i += 1;
+                }
+                if bytes.get(i) == Some(&b'\n') {
+                    i += 1;
+                }
+                continue;
+            }
+            b'#' => {
+                while i < bytes.len() && bytes[i] != b'\n' {
+                    i += 1;
+                }
+                Kind::Comment
+            }
+            b'"' | b'\'' => lex_string(bytes, &mut i),
+            b'(' | b'[' | b'{' => {
+                depth += 1;
+                i += 1;
+                Kind::Open
+            }
+            b')' | b']' | b'}' => {
+                depth = depth.saturating_sub(1);
+                i += 1;
+                Kind::Close
+            }
+            b'.' if !bytes.get(i + 1).is_some_and(u8::is_ascii_digit) => {
+                i += 1;
+                Kind::Dot
+            }
+            b';' if depth == 0 => {
+                i += 1;
+                Kind::End
+            }
+            b'0'..=b'9' | b'.' => {
+                while i < bytes.len() && (is_name_byte(bytes[i]) || bytes[i] == b'.') {
+                    i += 1;
+                }
+                Kind::Other
+            }
+            _ if is_name_byte(byte) => {
+                while i < bytes.len() && is_name_byte(bytes[i]) {
+                    i += 1;
+                }
+                if matches!(bytes.get(i), Some(b'"' | b'\'')) && is_string_prefix(&bytes[start..i])
+                {
+                    lex_string(bytes, &mut i)
+                } else {
+                    Kind::Name
+                }
+            }
+            _ => {
+                i += 1;
+                Kind::Other
+            }
+        };
+        tokens.push(Token {
+            kind,
+            start,
+            end: i,
+        });
+    }
+    tokens
+}
+
+/// Lex a string literal whose opening quote is at `*i`.
+///
+/// Unterminated single-quoted strings end at the newline; unterminated
+/// triple-quoted strings run to the end of the file.
+fn lex_string(bytes: &[u8], i: &mut usize) -> Kind {
+    let quote = bytes[*i];
+    let triple = bytes.get(*i + 1) == Some(&quote) && bytes.get(*i + 2) == Some(&quote);
+    let quote_len = if triple { 3 } else { 1 };
+    *i += quote_len;
+    let body_start = *i;
+
+    while *i < bytes.len() {
+        match bytes[*i] {
+            b'\\' => *i += 2,
+            b'\n' if !triple => break,
+            b if b == quote
+                && (!triple
+                    || (bytes.get(*i + 1) == Some(&quote)
+                        && bytes.get(*i + 2) == Some(&quote))) =>
+            {
+                let body_end = *i;
+                *i += quote_len;
+                return Kind::Str {
+                    body_start,
+                    body_end,
+                };
+            }
+            _ => *i += 1,
+        }
+    }
+    *i = (*i).min(bytes.len());
+    Kind::Str {
+        body_start,
+        body_end: *i,
+    }
+}
+
+/// Identifier bytes; non-ASCII bytes are treated as identifier characters
+/// so multi-byte characters are never split.
+fn is_name_byte(byte: u8) -> bool {
+    byte.is_ascii_alphanumeric() || byte == b'_' || byte >= 0x80
+}
+
+/// String prefixes: r, u, b, f and their two-letter combinations.
+fn is_string_prefix(prefix: &[u8]) -> bool {
+    let lower = prefix.to_ascii_lowercase();
+    matches!(
+        lower.as_slice(),
+        b"r" | b"u" | b"b" | b"f" | b"br" | b"rb" | b"fr" | b"rf"
+    )
+}
+
+#[cfg(test)]
+#[path = "calls_tests.rs"]
+mod tests;
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use yare::parameterized;
+
+fn normalize(content: &str) -> String {
+    normalize_escape_source(content).unwrap_or_else(|| content.to_string())
+}
+
+#[test]
+fn returns_none_when_nothing_changes() {
+    assert!(normalize_escape_source("import os\n\nos.system(cmd)\n").is_none());
+}
+
+#[parameterized(
+    double_quoted = { "x = \"eval(y)\"\n", "x = \"       \"\n" },
+    single_quoted = { "x = 'os.system(y)'\n", "x = '            '\n" },
+    prefixed = { "x = rb'eval(y)'\n", "x = rb'       '\n" },
+    escaped_quote = { "x = \"a\\\"eval(\"\n", "x = \"        \"\n" },
+    triple_quoted = { "\"\"\"\nexec(y)\n\"\"\"\n", "\"\"\"\n       \n\"\"\"\n" },
+)]
+fn blanks_string_contents(content: &str, expected: &str) {
+    assert_eq!(normalize(content), expected);
+}
+
+#[test]
+fn keeps_comments() {
+    let content = "# don't call eval(x)\nx = 1\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[parameterized(
+    method_call = { "obj.eval(x)\n", "        (x)\n" },
+    method_on_call = { "get().exec(x)\n", "get().    (x)\n" },
+    attribute_system = { "self.os.system(cmd)\n", "              (cmd)\n" },
+    definition = { "def eval(self):\n", "def     (self):\n" },
+)]
+fn masks_lookalike_calls(content: &str, expected: &str) {
+    assert_eq!(normalize(content), expected);
+}
+
+#[parameterized(
+    module_alias = { "import subprocess as sp\nsp.run(c)\n", "subprocess.run(c)" },
+    from_import = { "from pickle import loads\nloads(data)\n", "pickle.loads(data)" },
+    from_import_alias = { "from os import system as sh\nsh(cmd)\n", "os.system(cmd)" },
+    parenthesized = { "from subprocess import (\n    run,\n    Popen as P,\n)\nP(c)\n", "subprocess.Popen(c)" },
+    cpickle = { "import cPickle as pickle\npickle.loads(d)\n", "pickle.loads(d)" },
+    builtins = { "import builtins\nbuiltins.eval(x)\n", "eval(x)" },
+)]
+fn rewrites_imported_calls(content: &str, expected_line: &str) {
+    let normalized = normalize(content);
+    assert!(
+        normalized.lines().any(|l| l == expected_line),
+        "expected {:?} in:\n{}",
+        expected_line,
+        normalized
+    );
+}
+
+#[test]
+fn shadowed_builtin_is_masked() {
+    let content = "from sandbox import eval\neval(x)\n";
+    assert_eq!(normalize(content).lines().nth(1), Some("    (x)"));
+}
+
+#[test]
+fn relative_imports_are_ignored() {
+    let content = "from . import system\nsystem(cmd)\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[test]
+fn joins_multiline_governed_call() {
+    let content = "subprocess.run(\n    cmd,  # user input\n    shell=True,\n).check()\nnext()\n";
+    let normalized = normalize(content);
+    let lines: Vec<&str> = normalized.lines().collect();
+
+    assert_eq!(lines.len(), content.lines().count());
+    assert!(lines[0].starts_with("subprocess.run("), "{:?}", lines);
+    assert!(lines[0].contains("shell=True"), "{:?}", lines);
+    assert!(!lines[0].contains("user input"), "{:?}", lines);
+    assert_eq!(lines[3], ".check()");
+    assert_eq!(lines[4], "next()");
+}
+
+#[test]
+fn leaves_multiline_other_calls() {
+    let content = "print(\n    x,\n)\n";
+    assert_eq!(normalize(content), content);
+}
+
+#[test]
+fn rewrites_nested_governed_calls() {
+    let content = "import subprocess as sp\nsp.run(\n    sp.check_output(a),\n    shell=True,\n)\n";
+    let normalized = normalize(content);
+    let lines: Vec<&str> = normalized.lines().collect();
+
+    assert_eq!(lines.len(), content.lines().count());
+    assert!(
+        lines[1].starts_with("subprocess.run(") && lines[1].contains("subprocess.check_output(a)"),
+        "{:?}",
+        lines
+    );
+}
+
+#[test]
+fn preserves_multibyte_text() {
+    let content = "s = 'café eval(x)'\nobj.eval(\"ü\")\n";
+    let normalized = normalize(content);
+    assert_eq!(normalized.len(), content.len());
+    assert_eq!(normalized.lines().count(), 2);
+}
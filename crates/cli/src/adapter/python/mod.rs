@@ -8,7 +8,8 @@
 //! - Default patterns for Python files
 //! - Project layout detection (src-layout vs flat-layout)
 //! - Package name extraction from pyproject.toml and setup.py
-//! - Default escape patterns (debuggers, eval/exec)
+//! - Default escape patterns (debuggers, eval/exec, shell and pickle calls)
+//! - Call-site normalization so escape patterns skip strings and lookalike methods
 //! - Lint config policy checking
 //! - Suppress directive parsing (noqa, type: ignore, pylint)
 //! - Package manager detection (pip, poetry, uv, pipenv)
@@ -17,8 +18,11 @@
 
 use std::path::Path;
 
+mod calls;
 mod package_manager;
 
+pub use calls::normalize_escape_source;
+
 pub use package_manager::{PackageManager, PythonTooling};
 
 use globset::GlobSet;
@@ -31,7 +35,7 @@ pub use suppress::{PythonSuppress, PythonSuppressKind, parse_python_suppresses};
 use super::common;
 use super::common::patterns::normalize_exclude_patterns;
 use super::glob::build_glob_set;
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 use crate::config::PythonPolicyConfig;
 
 /// Default escape patterns for Python.
@@ -39,6 +43,11 @@ use crate::config::PythonPolicyConfig;
 /// These patterns detect potentially dangerous or debug-only code:
 /// - Debugger patterns (breakpoint, pdb) - forbidden even in tests
 /// - Dynamic execution patterns (eval, exec, __import__, compile) - require comments
+/// - Shell and deserialization calls (os.system, subprocess with shell=True,
+///   pickle.loads) - require `# SAFETY:` comments
+///
+/// Patterns are matched against normalized source (see [`normalize_escape_source`]),
+/// so `obj.eval()` and string literals don't match, and imports are resolved.
 const PYTHON_ESCAPE_PATTERNS: &[EscapePattern] = &[
     // Debugger patterns - forbidden even in tests
     EscapePattern {
@@ -78,16 +87,16 @@ const PYTHON_ESCAPE_PATTERNS: &[EscapePattern] = &[
         name: "eval",
         pattern: r"\beval\s*\(",
         action: EscapeAction::Comment,
-        comment: Some("# EVAL:"),
-        advice: "Add a # EVAL: comment explaining why eval is necessary.",
+        comment: Some("# SAFETY:|# EVAL:"),
+        advice: "Add a # SAFETY: or # EVAL: comment explaining why eval is necessary.",
         in_tests: None,
     },
     EscapePattern {
         name: "exec",
         pattern: r"\bexec\s*\(",
         action: EscapeAction::Comment,
-        comment: Some("# EXEC:"),
-        advice: "Add a # EXEC: comment explaining why exec is necessary.",
+        comment: Some("# SAFETY:|# EXEC:"),
+        advice: "Add a # SAFETY: or # EXEC: comment explaining why exec is necessary.",
         in_tests: None,
     },
     EscapePattern {
@@ -106,6 +115,31 @@ const PYTHON_ESCAPE_PATTERNS: &[EscapePattern] = &[
         advice: "Add a # DYNAMIC: comment explaining why compile is necessary for code execution.",
         in_tests: None,
     },
+    // Shell and deserialization patterns - allowed in tests by default
+    EscapePattern {
+        name: "os_system",
+        pattern: r"\bos\.system\s*\(",
+        action: EscapeAction::Comment,
+        comment: Some("# SAFETY:"),
+        advice: "Add a # SAFETY: comment explaining why the command can't be injected.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "subprocess_shell",
+        pattern: r"\bsubprocess\.(run|call|check_call|check_output|Popen)\s*\(.*\bshell\s*=\s*True\b",
+        action: EscapeAction::Comment,
+        comment: Some("# SAFETY:"),
+        advice: "Add a # SAFETY: comment explaining why the command can't be injected, or pass an argument list without shell=True.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "pickle_loads",
+        pattern: r"\bpickle\.loads\s*\(",
+        action: EscapeAction::Comment,
+        comment: Some("# SAFETY:"),
+        advice: "Add a # SAFETY: comment explaining why the data is trusted.",
+        in_tests: None,
+    },
 ];
 
 /// Python language adapter.
@@ -195,7 +229,7 @@ impl Adapter for PythonAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["py"]
+        ProjectLanguage::Python.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
@@ -360,21 +360,50 @@ fn dynamic_execution_escapes_allowed_in_tests() {
 }
 
 #[test]
-fn eval_requires_eval_comment() {
+fn eval_requires_safety_or_eval_comment() {
     let adapter = PythonAdapter::new();
     let escapes = adapter.default_escapes();
 
     let eval_escape = escapes.iter().find(|e| e.name == "eval").unwrap();
-    assert_eq!(eval_escape.comment, Some("# EVAL:"));
+    assert_eq!(eval_escape.comment, Some("# SAFETY:|# EVAL:"));
 }
 
 #[test]
-fn exec_requires_exec_comment() {
+fn exec_requires_safety_or_exec_comment() {
     let adapter = PythonAdapter::new();
     let escapes = adapter.default_escapes();
 
     let exec_escape = escapes.iter().find(|e| e.name == "exec").unwrap();
-    assert_eq!(exec_escape.comment, Some("# EXEC:"));
+    assert_eq!(exec_escape.comment, Some("# SAFETY:|# EXEC:"));
+}
+
+#[test]
+fn shell_and_pickle_calls_require_safety_comment() {
+    let adapter = PythonAdapter::new();
+    let escapes = adapter.default_escapes();
+
+    for name in ["os_system", "subprocess_shell", "pickle_loads"] {
+        let escape = escapes.iter().find(|e| e.name == name).unwrap();
+        assert_eq!(escape.action, EscapeAction::Comment, "{} action", name);
+        assert_eq!(escape.comment, Some("# SAFETY:"), "{} comment", name);
+        assert_eq!(escape.in_tests, None, "{} in_tests", name);
+    }
+}
+
+#[test]
+fn subprocess_shell_matches_only_shell_true() {
+    let adapter = PythonAdapter::new();
+    let escape = adapter
+        .default_escapes()
+        .iter()
+        .find(|e| e.name == "subprocess_shell")
+        .unwrap();
+    let regex = regex::Regex::new(escape.pattern).unwrap();
+
+    assert!(regex.is_match("subprocess.run(cmd, shell=True)"));
+    assert!(regex.is_match("subprocess.Popen(cmd, cwd=d, shell = True)"));
+    assert!(!regex.is_match("subprocess.run(cmd, shell=False)"));
+    assert!(!regex.is_match("subprocess.run([\"ls\", path])"));
 }
 
 #[test]
@@ -23,7 +23,7 @@ pub use suppress::{RubySuppress, RubySuppressKind, parse_ruby_suppresses};
 use super::common;
 use super::common::patterns::normalize_exclude_patterns;
 use super::glob::build_glob_set;
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 use crate::config::RubyPolicyConfig;
 
 /// Default escape patterns for Ruby.
@@ -146,7 +146,7 @@ impl Adapter for RubyAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["rb", "rake"]
+        ProjectLanguage::Ruby.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
@@ -26,7 +26,7 @@ pub use cfg_test::{CfgTestBlock, CfgTestInfo, CfgTestItemKind};
 pub use suppress::{SuppressAttr, parse_suppress_attrs};
 pub use workspace::CargoWorkspace;
 
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 use crate::config::RustPolicyConfig;
 
 /// Default escape patterns for Rust.
@@ -42,6 +42,31 @@ const RUST_ESCAPE_PATTERNS: &[EscapePattern] = &[
         advice: "Add a // SAFETY: comment explaining the invariants.",
         in_tests: None,
     },
+    EscapePattern {
+        name: "unsafe_fn",
+        pattern: r#"unsafe\s+(extern\s+"[^"]*"\s+)?fn\s+\w"#,
+        action: EscapeAction::Comment,
+        // Callers' obligations are idiomatically documented in a `# Safety` doc section
+        comment: Some("// SAFETY:|/// # Safety"),
+        advice: "Add a // SAFETY: comment or # Safety doc section stating the caller's obligations.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "unsafe_impl",
+        pattern: r"unsafe\s+impl\b",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:"),
+        advice: "Add a // SAFETY: comment explaining why the type upholds the trait's invariants.",
+        in_tests: None,
+    },
+    EscapePattern {
+        name: "unsafe_trait",
+        pattern: r"unsafe\s+trait\b",
+        action: EscapeAction::Comment,
+        comment: Some("// SAFETY:|/// # Safety"),
+        advice: "Add a // SAFETY: comment or # Safety doc section stating what implementors must uphold.",
+        in_tests: None,
+    },
     EscapePattern {
         name: "transmute",
         // SAFETY: This string literal defines the pattern; it's not actual transmute usage.
@@ -104,7 +129,7 @@ impl Adapter for RustAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["rs"]
+        ProjectLanguage::Rust.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
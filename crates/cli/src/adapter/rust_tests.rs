@@ -126,6 +126,9 @@ pub fn multiply(a: i32, b: i32) -> i32 {
 // Use Clippy's unwrap_used and expect_used lints for that.
 #[parameterized(
     unsafe_requires_comment = { "unsafe", EscapeAction::Comment, Some("// SAFETY:") },
+    unsafe_fn_requires_comment = { "unsafe_fn", EscapeAction::Comment, Some("// SAFETY:|/// # Safety") },
+    unsafe_impl_requires_comment = { "unsafe_impl", EscapeAction::Comment, Some("// SAFETY:") },
+    unsafe_trait_requires_comment = { "unsafe_trait", EscapeAction::Comment, Some("// SAFETY:|/// # Safety") },
     transmute_requires_comment = { "transmute", EscapeAction::Comment, Some("// SAFETY:") },
 )]
 fn default_escape_pattern(
@@ -149,9 +152,45 @@ fn default_escape_pattern(
 }
 
 #[test]
-fn returns_two_default_patterns() {
+fn returns_five_default_patterns() {
     let adapter = RustAdapter::new();
-    assert_eq!(adapter.default_escapes().len(), 2);
+    assert_eq!(adapter.default_escapes().len(), 5);
+}
+
+#[parameterized(
+    unsafe_fn = { "unsafe_fn", "pub unsafe fn deref<T>(p: *const T) -> T {" },
+    unsafe_extern_fn = { "unsafe_fn", "unsafe extern \"C\" fn callback(arg: *mut u8) {" },
+    unsafe_impl = { "unsafe_impl", "unsafe impl Send for Handle {}" },
+    unsafe_impl_generic = { "unsafe_impl", "unsafe impl<T: Send> Sync for Cell<T> {}" },
+    unsafe_trait = { "unsafe_trait", "pub unsafe trait RawBytes {" },
+)]
+fn unsafe_declaration_pattern_matches(name: &str, line: &str) {
+    let adapter = RustAdapter::new();
+    let patterns = adapter.default_escapes();
+    let pattern = patterns.iter().find(|p| p.name == name).unwrap();
+    let regex = regex::Regex::new(pattern.pattern).unwrap();
+    assert!(regex.is_match(line), "{:?} should match {:?}", name, line);
+}
+
+#[parameterized(
+    fn_pointer_type = { "let f: unsafe fn(*const u8) = g;" },
+    safe_fn = { "pub fn deref(p: &u8) -> u8 {" },
+    identifier = { "let unsafe_impl = true;" },
+)]
+fn unsafe_declaration_patterns_skip(line: &str) {
+    let adapter = RustAdapter::new();
+    for pattern in adapter.default_escapes() {
+        if !pattern.name.starts_with("unsafe_") {
+            continue;
+        }
+        let regex = regex::Regex::new(pattern.pattern).unwrap();
+        assert!(
+            !regex.is_match(line),
+            "{:?} should not match {:?}",
+            pattern.name,
+            line
+        );
+    }
 }
 
 #[test]
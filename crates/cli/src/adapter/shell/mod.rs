@@ -22,7 +22,7 @@ pub use suppress::{ShellcheckSuppress, parse_shellcheck_suppresses};
 
 use super::common;
 use super::glob::build_glob_set;
-use super::{Adapter, EscapeAction, EscapePattern, FileKind};
+use super::{Adapter, EscapeAction, EscapePattern, FileKind, ProjectLanguage};
 use crate::config::ShellPolicyConfig;
 
 /// Default escape patterns for Shell.
@@ -100,7 +100,7 @@ impl Adapter for ShellAdapter {
     }
 
     fn extensions(&self) -> &'static [&'static str] {
-        &["sh", "bash", "bats"]
+        ProjectLanguage::Shell.extensions()
     }
 
     fn classify(&self, path: &Path) -> FileKind {
@@ -9,6 +9,7 @@ use std::path::Path;
 use chrono::{DateTime, Utc};
 use serde::{Deserialize, Serialize};
 
+use crate::build_info;
 use crate::git::read_git_note;
 
 /// Current baseline format version.
@@ -27,6 +28,10 @@ pub struct Baseline {
     #[serde(skip_serializing_if = "Option::is_none")]
     pub commit: Option<String>,
 
+    /// Version of quench that last wrote the baseline.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub quench_version: Option<String>,
+
     /// Stored metrics.
     pub metrics: BaselineMetrics,
 }
@@ -101,6 +106,7 @@ impl Baseline {
             version: BASELINE_VERSION,
             updated: Utc::now(),
             commit: None,
+            quench_version: Some(build_info::VERSION.to_string()),
             metrics: BaselineMetrics::default(),
         }
     }
@@ -180,9 +186,10 @@ impl Baseline {
         self
     }
 
-    /// Update the timestamp to now.
+    /// Update the timestamp to now, recording the running quench version.
     pub fn touch(&mut self) {
         self.updated = Utc::now();
+        self.quench_version = Some(build_info::VERSION.to_string());
     }
 
     /// Get the age of this baseline in days.
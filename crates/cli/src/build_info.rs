@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Build information for `quench --version` and output metadata.
+//!
+//! The commit and compiler are recorded by `build.rs`, so CI can pin the
+//! exact build that produced a report or baseline.
+
+/// Release version, from the crate manifest.
+pub const VERSION: &str = env!("CARGO_PKG_VERSION");
+
+/// Short commit hash the binary was built from, or `unknown`.
+pub const COMMIT: &str = env!("QUENCH_COMMIT");
+
+/// Compiler that built the binary, e.g. `rustc 1.85.0`.
+pub const RUSTC: &str = env!("QUENCH_RUSTC");
+
+/// Version line printed by `quench --version`, after the binary name:
+/// `0.4.0 (commit 1a2b3c4d5e6f, rustc 1.85.0)`.
+pub const VERSION_LINE: &str = concat!(
+    env!("CARGO_PKG_VERSION"),
+    " (commit ",
+    env!("QUENCH_COMMIT"),
+    ", ",
+    env!("QUENCH_RUSTC"),
+    ")"
+);
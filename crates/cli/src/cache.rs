@@ -3,11 +3,16 @@
 
 //! File-level caching for check results.
 //!
-//! Caches check violations per file using mtime+size as cache key.
+//! Caches check violations per file, keyed on content hash and the set of
+//! checks that produced them. Unchanged mtime+size skips hashing, so warm
+//! runs don't read unchanged files; a touched file with the same content
+//! (e.g., after `git checkout`) is still a hit.
 //! Provides 10x speedup on iterative runs where few files change.
 
 use std::collections::HashMap;
+use std::collections::hash_map::DefaultHasher;
 use std::fs::Metadata;
+use std::hash::{Hash, Hasher};
 use std::path::{Path, PathBuf};
 use std::sync::Arc;
 use std::sync::atomic::{AtomicUsize, Ordering};
@@ -18,6 +23,7 @@ use dashmap::DashMap;
 use serde::{Deserialize, Serialize};
 
 use crate::check::Violation;
+use crate::checks::CHECK_NAMES;
 
 /// Cache version for invalidation on format changes.
 /// Incremented when check logic changes (e.g., counting nonblank vs all lines).
@@ -49,7 +55,60 @@ use crate::check::Violation;
 /// v36: Python suppress comments now detected above @decorator lines.
 /// v37: JavaScript suppress config no longer inherits Rust-specific lint patterns.
 /// v38: Only #[cfg(test)] mod blocks count as test LOC; non-module items stay as source.
-pub(crate) const CACHE_VERSION: u32 = 38;
+/// v39: Added go_nosplit escape pattern; combined justification markers.
+/// v40: Added unsafe_slice/unsafe_string escape patterns; Go import alias normalization.
+/// v41: Added reflect_header escape pattern; dot-imports and header `.Data` assignments.
+/// v42: Added column to cached violations.
+/// v43: Added per-pattern comment markers with `|` alternatives.
+/// v44: Added unsafe_fn/unsafe_impl/unsafe_trait Rust escape patterns.
+/// v45: Python escape patterns match tokenized call sites; added shell and pickle patterns.
+/// v46: Added cgo_import Go escape pattern.
+/// v47: Added go_uintptrescapes and go_nocheckptr Go escape patterns.
+/// v48: Entries keyed on content hash and check set; checksummed cache file.
+/// v49: Justification comments must be in the contiguous block above (blank lines end it).
+/// v50: Go syscall imports checked against [golang.syscall].allow.
+/// v51: Opt-in go_panic justification rule ([golang.panic]).
+/// v52: Columns of matches on normalized Go lines point into the original source.
+/// v53: JS/TS eval, new Function and dangerouslySetInnerHTML patterns; JS/TS normalization.
+/// v54: Adapter escape defaults dispatched per file by extension.
+/// v55: Go os/exec import, exec.Command and formatted command string patterns.
+/// v56: Opt-in go_embed directive rule ([golang.embed]).
+/// v57: go_recover rule for recover() outside deferred functions.
+/// v58: Escape violations per occurrence instead of per line.
+/// v59: parse_error violations for Go files that don't lex.
+/// v60: unsafe_add escape pattern; opt-in unsafe_introspection rule.
+/// v61: Opt-in go_linkname_push rule for pushes into other packages.
+/// v62: Opt-in weak_rand rule for math/rand in sensitive packages.
+/// v63: Opt-in unchecked_error rule for discarded errors of listed functions.
+/// v64: Opt-in unreferenced_todo rule for TODO/FIXME without an issue reference.
+/// v65: [golang.linkname].allow skips the comment for listed targets.
+/// v66: Opt-in go_sleep rule for time.Sleep without a // SLEEP: comment.
+/// v67: Opt-in go_init rule for init() functions without an // INIT: comment.
+/// v68: Opt-in context_background and context_todo rules for request-scoped packages.
+/// v69: Opt-in hardcoded_secret rule for credential-named string literals.
+/// v70: Opt-in deprecated_use rule for the module's deprecated symbols.
+/// v71: Opt-in builtin_print rule for print and println builtin calls.
+/// v72: Opt-in exported_any rule for any in exported signatures.
+/// v73: Opt-in go_goroutine rule for goroutines launched without recovery.
+/// v74: Opt-in large_struct_return rule for large structs returned by value.
+/// v75: Opt-in sprintf_concat rule for fmt.Sprintf used to concatenate.
+/// v76: Opt-in time_now rule for the wall clock in [golang.clock].packages.
+/// v77: Opt-in default_http_client rule for http.Get and http.DefaultClient.
+/// v78: Opt-in map_order rule for slices returned in map iteration order.
+/// v79: Opt-in go_exit rule for log.Fatal and os.Exit in library packages.
+/// v80: Added go_noinline and go_inline Go escape patterns.
+/// v81: Opt-in unlocked_map rule for map fields accessed without the struct's mutex.
+/// v82: Opt-in error_string rule for capitalized or punctuated Go error strings.
+/// v83: unspread_args rule for []any slices passed to fmt without `...`.
+/// v84: Opt-in aliased_append rule for appends that reuse a slice used later.
+/// v85: waitgroup_add rule for sync.WaitGroup Add calls inside their goroutine.
+/// v86: context_key rule for context.WithValue keys of built-in types.
+/// v87: defer_loop rule for defer statements in a loop body.
+/// v88: must_compile rule for regexp.MustCompile on run-time patterns.
+/// v89: Cached violations record whether they are warnings.
+/// v90: reflect_header matches header literals, conversions and `.Data`
+/// assignments, not bare type mentions.
+pub(crate) const CACHE_VERSION: u32 = 90;
 
 /// Cache file name within .quench directory.
 pub const CACHE_FILE_NAME: &str = "cache.bin";
@@ -76,6 +135,49 @@ pub enum CacheError {
     /// Config hash changed.
     #[error("config changed")]
     ConfigChanged,
+
+    /// Cache file is truncated or its checksum doesn't match.
+    #[error("cache file corrupted")]
+    Corrupted,
+}
+
+/// Set of checks whose violations a cache entry holds.
+///
+/// A bitmask over `CHECK_NAMES`. An entry written by `quench check --escapes`
+/// holds no cloc violations, so it can't serve a run that also enables cloc.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub struct CheckSet(u32);
+
+impl CheckSet {
+    /// Bit for checks not in `CHECK_NAMES`.
+    const UNKNOWN: u32 = 1 << 31;
+
+    /// Build the set from check names.
+    pub fn from_names<'a>(names: impl IntoIterator<Item = &'a str>) -> Self {
+        Self(names.into_iter().fold(0, |bits, name| {
+            bits | CHECK_NAMES
+                .iter()
+                .position(|n| *n == name)
+                .map_or(Self::UNKNOWN, |i| 1 << i)
+        }))
+    }
+
+    /// Every registered check.
+    pub fn all() -> Self {
+        Self::from_names(CHECK_NAMES.iter().copied())
+    }
+
+    /// Whether this set contains every check in `other`.
+    pub fn covers(self, other: Self) -> bool {
+        self.0 & other.0 == other.0
+    }
+}
+
+/// Hash file content for cache keys.
+pub fn hash_content(content: &[u8]) -> u64 {
+    let mut hasher = DefaultHasher::new();
+    content.hash(&mut hasher);
+    hasher.finish()
 }
 
 /// Metadata used as cache key for a single file.
@@ -118,6 +220,10 @@ impl FileCacheKey {
 pub struct CachedFileResult {
     /// Cache key when this result was computed.
     pub key: FileCacheKey,
+    /// Hash of the file content when this result was computed.
+    pub content_hash: u64,
+    /// Checks that ran on the file.
+    pub checks: CheckSet,
     /// Violations found in this file (across all checks).
     /// Uses Arc for O(1) clone on cache hits instead of O(n) deep clone.
     pub violations: Arc<Vec<CachedViolation>>,
@@ -127,6 +233,8 @@ pub struct CachedFileResult {
 #[derive(Debug, Serialize, Deserialize)]
 pub(crate) struct SerializedFileResult {
     pub(crate) key: FileCacheKey,
+    pub(crate) content_hash: u64,
+    pub(crate) checks: CheckSet,
     pub(crate) violations: Vec<CachedViolation>,
 }
 
@@ -137,6 +245,8 @@ pub struct CachedViolation {
     pub check: String,
     /// Line number (if applicable).
     pub line: Option<u32>,
+    /// Column number (if applicable).
+    pub column: Option<u32>,
     /// Violation type/category.
     pub violation_type: String,
     /// Advice message.
@@ -153,6 +263,8 @@ pub struct CachedViolation {
     pub nonblank: Option<i64>,
     /// Path in TOC/link that was broken (for docs violations).
     pub target_path: Option<String>,
+    /// Reported as a warning rather than an error.
+    pub warning: bool,
 }
 
 impl CachedViolation {
@@ -161,6 +273,7 @@ impl CachedViolation {
         Self {
             check: check.to_string(),
             line: v.line,
+            column: v.column,
             violation_type: v.violation_type.clone(),
             advice: v.advice.clone(),
             value: v.value,
@@ -169,6 +282,7 @@ impl CachedViolation {
             lines: v.lines,
             nonblank: v.nonblank,
             target_path: v.target.clone().or_else(|| v.path.clone()),
+            warning: v.warning,
         }
     }
 
@@ -177,6 +291,7 @@ impl CachedViolation {
         Violation {
             file: Some(file),
             line: self.line,
+            column: self.column,
             violation_type: self.violation_type.clone(),
             advice: self.advice.clone(),
             value: self.value,
@@ -198,6 +313,8 @@ impl CachedViolation {
             scope: None,
             expected: None,
             found: None,
+            count: None,
+            warning: self.warning,
         }
     }
 }
@@ -255,7 +372,7 @@ impl FileCache {
     /// Load cache from disk.
     pub fn from_persistent(path: &Path, config_hash: u64) -> Result<Self, CacheError> {
         let bytes = std::fs::read(path)?;
-        let cache: PersistentCache = postcard::from_bytes(&bytes)?;
+        let cache = decode(&bytes)?;
 
         // Validate version
         if cache.version != CACHE_VERSION {
@@ -277,6 +394,8 @@ impl FileCache {
                     path,
                     CachedFileResult {
                         key: result.key,
+                        content_hash: result.content_hash,
+                        checks: result.checks,
                         violations: Arc::new(result.violations),
                     },
                 )
@@ -294,27 +413,70 @@ impl FileCache {
 
     /// Look up cached violations for a file.
     ///
-    /// Returns Some if the file has a valid cache entry (matching mtime+size).
-    /// Returns None on cache miss.
+    /// Returns Some if the file has an entry covering `checks` whose
+    /// mtime+size match, or, failing that, whose content hash matches the
+    /// file on disk. Returns None on cache miss.
     ///
     /// The returned Arc allows O(1) clone instead of O(n) deep clone of violations.
-    pub fn lookup(&self, path: &Path, key: &FileCacheKey) -> Option<Arc<Vec<CachedViolation>>> {
-        if let Some(entry) = self.inner.get(path)
-            && entry.key == *key
-        {
-            self.hits.fetch_add(1, Ordering::Relaxed);
-            return Some(Arc::clone(&entry.violations));
+    pub fn lookup(
+        &self,
+        path: &Path,
+        key: &FileCacheKey,
+        checks: CheckSet,
+    ) -> Option<Arc<Vec<CachedViolation>>> {
+        let hit = self.lookup_entry(path, key, checks);
+        let counter = if hit.is_some() {
+            &self.hits
+        } else {
+            &self.misses
+        };
+        counter.fetch_add(1, Ordering::Relaxed);
+        hit
+    }
+
+    fn lookup_entry(
+        &self,
+        path: &Path,
+        key: &FileCacheKey,
+        checks: CheckSet,
+    ) -> Option<Arc<Vec<CachedViolation>>> {
+        let content_hash = {
+            let entry = self.inner.get(path)?;
+            if !entry.checks.covers(checks) {
+                return None;
+            }
+            if entry.key == *key {
+                return Some(Arc::clone(&entry.violations));
+            }
+            entry.content_hash
+        };
+
+        // Metadata changed: hash outside the lock, then refresh the key so
+        // the next run takes the fast path
+        let content = std::fs::read(path).ok()?;
+        if hash_content(&content) != content_hash {
+            return None;
         }
-        self.misses.fetch_add(1, Ordering::Relaxed);
-        None
+        let mut entry = self.inner.get_mut(path)?;
+        entry.key = key.clone();
+        Some(Arc::clone(&entry.violations))
     }
 
     /// Insert or update a file's cached result.
-    pub fn insert(&self, path: PathBuf, key: FileCacheKey, violations: Vec<CachedViolation>) {
+    pub fn insert(
+        &self,
+        path: PathBuf,
+        key: FileCacheKey,
+        content_hash: u64,
+        checks: CheckSet,
+        violations: Vec<CachedViolation>,
+    ) {
         self.inner.insert(
             path,
             CachedFileResult {
                 key,
+                content_hash,
+                checks,
                 violations: Arc::new(violations),
             },
         );
@@ -322,29 +484,11 @@ impl FileCache {
 
     /// Persist cache to disk.
     pub fn persist(&self, path: &Path) -> Result<(), CacheError> {
-        let cache = PersistentCache {
-            version: CACHE_VERSION,
-            quench_version: self.quench_version.clone(),
-            config_hash: self.config_hash,
-            // Convert runtime format to serialized format (extract from Arc)
-            files: self
-                .inner
-                .iter()
-                .map(|e| {
-                    (
-                        e.key().clone(),
-                        SerializedFileResult {
-                            key: e.value().key.clone(),
-                            violations: (*e.value().violations).clone(),
-                        },
-                    )
-                })
-                .collect(),
-        };
+        let cache = self.snapshot();
 
         // Write atomically via temp file
         let temp_path = path.with_extension("tmp");
-        let bytes = postcard::to_allocvec(&cache)?;
+        let bytes = encode(&cache)?;
         std::fs::write(&temp_path, &bytes)?;
         std::fs::rename(&temp_path, path)?;
         Ok(())
@@ -371,7 +515,26 @@ impl FileCache {
     /// ```
     pub fn persist_async(&self, path: PathBuf) -> JoinHandle<Result<(), CacheError>> {
         // Clone data for the background thread
-        let cache = PersistentCache {
+        let cache = self.snapshot();
+
+        std::thread::spawn(move || {
+            let temp_path = path.with_extension("tmp");
+            let bytes = encode(&cache)?;
+
+            // Ensure parent directory exists
+            if let Some(parent) = path.parent() {
+                std::fs::create_dir_all(parent)?;
+            }
+
+            std::fs::write(&temp_path, &bytes)?;
+            std::fs::rename(&temp_path, &path)?;
+            Ok(())
+        })
+    }
+
+    /// Convert runtime format to serialized format (extract from Arc).
+    fn snapshot(&self) -> PersistentCache {
+        PersistentCache {
             version: CACHE_VERSION,
             quench_version: self.quench_version.clone(),
             config_hash: self.config_hash,
@@ -383,26 +546,14 @@ impl FileCache {
                         e.key().clone(),
                         SerializedFileResult {
                             key: e.value().key.clone(),
+                            content_hash: e.value().content_hash,
+                            checks: e.value().checks,
                             violations: (*e.value().violations).clone(),
                         },
                     )
                 })
                 .collect(),
-        };
-
-        std::thread::spawn(move || {
-            let temp_path = path.with_extension("tmp");
-            let bytes = postcard::to_allocvec(&cache)?;
-
-            // Ensure parent directory exists
-            if let Some(parent) = path.parent() {
-                std::fs::create_dir_all(parent)?;
-            }
-
-            std::fs::write(&temp_path, &bytes)?;
-            std::fs::rename(&temp_path, &path)?;
-            Ok(())
-        })
+        }
     }
 
     /// Get cache statistics.
@@ -415,11 +566,30 @@ impl FileCache {
     }
 }
 
+/// Serialize a cache, prefixed with a checksum of the payload.
+fn encode(cache: &PersistentCache) -> Result<Vec<u8>, CacheError> {
+    let payload = postcard::to_allocvec(cache)?;
+    let mut bytes = hash_content(&payload).to_le_bytes().to_vec();
+    bytes.extend_from_slice(&payload);
+    Ok(bytes)
+}
+
+/// Deserialize a cache written by `encode`.
+///
+/// A damaged file fails the checksum instead of decoding into wrong
+/// violations, so the caller starts fresh.
+fn decode(bytes: &[u8]) -> Result<PersistentCache, CacheError> {
+    let Some((checksum, payload)) = bytes.split_first_chunk::<8>() else {
+        return Err(CacheError::Corrupted);
+    };
+    if u64::from_le_bytes(*checksum) != hash_content(payload) {
+        return Err(CacheError::Corrupted);
+    }
+    Ok(postcard::from_bytes(payload)?)
+}
+
 /// Compute a hash of config fields that affect check results.
 pub fn hash_config(config: &crate::config::Config) -> u64 {
-    use std::collections::hash_map::DefaultHasher;
-    use std::hash::{Hash, Hasher};
-
     let mut hasher = DefaultHasher::new();
 
     // Hash check config fields that affect results
@@ -428,17 +598,18 @@ pub fn hash_config(config: &crate::config::Config) -> u64 {
     config.check.cloc.exclude.hash(&mut hasher);
     config.project.packages.hash(&mut hasher);
 
-    // Hash escapes check level and exclude patterns.
-    // Without this, toggling [check.escapes].check = "off" won't
-    // invalidate cached violations, causing stale results.
-    config.check.escapes.check.hash(&mut hasher);
-    config.check.escapes.exclude.hash(&mut hasher);
+    // Hash the escapes check, Go rule, and per-rule settings whole.
+    // Without this, turning a rule on or adding an escape pattern won't
+    // invalidate cached violations, and a list of fields drifts as rules
+    // are added.
+    hash_serialized(&config.check.escapes, &mut hasher);
+    hash_serialized(&config.golang, &mut hasher);
+    hash_serialized(&config.rules, &mut hasher);
 
-    // Hash suppress check levels for all languages.
+    // Hash suppress check levels for the other languages.
     // These control whether the escapes check reports suppress violations.
     config.rust.suppress.check.hash(&mut hasher);
     config.javascript.suppress.check.hash(&mut hasher);
-    config.golang.suppress.check.hash(&mut hasher);
     config.shell.suppress.check.hash(&mut hasher);
     config.ruby.suppress.check.hash(&mut hasher);
     config.python.suppress.check.hash(&mut hasher);
@@ -452,8 +623,6 @@ pub fn hash_config(config: &crate::config::Config) -> u64 {
     config.rust.tests.hash(&mut hasher);
     config.rust.source.hash(&mut hasher);
     config.rust.exclude.hash(&mut hasher);
-    config.golang.tests.hash(&mut hasher);
-    config.golang.source.hash(&mut hasher);
     config.javascript.tests.hash(&mut hasher);
     config.javascript.source.hash(&mut hasher);
     config.shell.tests.hash(&mut hasher);
@@ -462,6 +631,17 @@ pub fn hash_config(config: &crate::config::Config) -> u64 {
     hasher.finish()
 }
 
+/// Hash a config section through its JSON form.
+///
+/// `serde_json::Value` keeps object keys sorted, so `HashMap` fields hash
+/// the same on every run.
+fn hash_serialized(value: &impl Serialize, hasher: &mut DefaultHasher) {
+    serde_json::to_value(value)
+        .map(|json| json.to_string())
+        .unwrap_or_default()
+        .hash(hasher);
+}
+
 #[cfg(test)]
 #[path = "cache_tests.rs"]
 mod tests;
@@ -26,7 +26,7 @@ fn cache_lookup_miss_on_empty() {
         size: 50,
     };
 
-    let result = cache.lookup(Path::new("nonexistent.rs"), &key);
+    let result = cache.lookup(Path::new("nonexistent.rs"), &key, CheckSet::all());
     assert!(result.is_none());
     assert_eq!(cache.stats().misses, 1);
 }
@@ -44,6 +44,7 @@ fn cache_insert_and_lookup_hit() {
     let violations = vec![CachedViolation {
         check: "cloc".to_string(),
         line: Some(10),
+        column: None,
         violation_type: "file_too_large".to_string(),
         advice: "Split the file".to_string(),
         value: None,
@@ -52,11 +53,18 @@ fn cache_insert_and_lookup_hit() {
         lines: None,
         nonblank: None,
         target_path: None,
+        warning: false,
     }];
 
-    cache.insert(path.clone(), key.clone(), violations.clone());
+    cache.insert(
+        path.clone(),
+        key.clone(),
+        0,
+        CheckSet::all(),
+        violations.clone(),
+    );
 
-    let result = cache.lookup(&path, &key);
+    let result = cache.lookup(&path, &key, CheckSet::all());
     assert!(result.is_some());
     let cached = result.unwrap();
     assert_eq!(cached.len(), 1);
@@ -79,9 +87,9 @@ fn cache_miss_on_mtime_change() {
         size: 50,
     };
 
-    cache.insert(path.clone(), old_key, vec![]);
+    cache.insert(path.clone(), old_key, 0, CheckSet::all(), vec![]);
 
-    let result = cache.lookup(&path, &new_key);
+    let result = cache.lookup(&path, &new_key, CheckSet::all());
     assert!(result.is_none());
     assert_eq!(cache.stats().misses, 1);
 }
@@ -101,9 +109,9 @@ fn cache_miss_on_size_change() {
         size: 100, // Changed
     };
 
-    cache.insert(path.clone(), old_key, vec![]);
+    cache.insert(path.clone(), old_key, 0, CheckSet::all(), vec![]);
 
-    let result = cache.lookup(&path, &new_key);
+    let result = cache.lookup(&path, &new_key, CheckSet::all());
     assert!(result.is_none());
 }
 
@@ -124,9 +132,12 @@ fn cache_persist_and_restore() {
     cache.insert(
         file_path.clone(),
         key.clone(),
+        0,
+        CheckSet::all(),
         vec![CachedViolation {
             check: "cloc".to_string(),
             line: Some(42),
+            column: None,
             violation_type: "file_too_large".to_string(),
             advice: "Refactor".to_string(),
             value: Some(100),
@@ -135,6 +146,7 @@ fn cache_persist_and_restore() {
             lines: None,
             nonblank: None,
             target_path: None,
+            warning: false,
         }],
     );
 
@@ -145,7 +157,7 @@ fn cache_persist_and_restore() {
     let restored = FileCache::from_persistent(&cache_path, config_hash).unwrap();
 
     // Verify
-    let result = restored.lookup(&file_path, &key);
+    let result = restored.lookup(&file_path, &key, CheckSet::all());
     assert!(result.is_some());
     let violations = result.unwrap();
     assert_eq!(violations.len(), 1);
@@ -165,13 +177,110 @@ fn cache_rejects_version_mismatch() {
         files: HashMap::new(),
     };
 
-    let bytes = postcard::to_allocvec(&bad_cache).unwrap();
+    let bytes = encode(&bad_cache).unwrap();
     std::fs::write(&cache_path, &bytes).unwrap();
 
     let result = FileCache::from_persistent(&cache_path, 0);
     assert!(matches!(result, Err(CacheError::VersionMismatch)));
 }
 
+#[test]
+fn cache_rejects_corrupted_file() {
+    let dir = tempdir().unwrap();
+    let cache_path = dir.path().join("cache.bin");
+
+    let cache = FileCache::new(0);
+    cache.insert(
+        PathBuf::from("src/lib.rs"),
+        FileCacheKey {
+            mtime_secs: 100,
+            mtime_nanos: 0,
+            size: 10,
+        },
+        0,
+        CheckSet::all(),
+        vec![],
+    );
+    cache.persist(&cache_path).unwrap();
+
+    // Flip a byte in the payload
+    let mut bytes = std::fs::read(&cache_path).unwrap();
+    let last = bytes.len() - 1;
+    bytes[last] ^= 0xff;
+    std::fs::write(&cache_path, &bytes).unwrap();
+    let result = FileCache::from_persistent(&cache_path, 0);
+    assert!(matches!(result, Err(CacheError::Corrupted)));
+
+    // Truncated below the checksum
+    std::fs::write(&cache_path, &bytes[..3]).unwrap();
+    let result = FileCache::from_persistent(&cache_path, 0);
+    assert!(matches!(result, Err(CacheError::Corrupted)));
+}
+
+#[test]
+fn cache_hit_on_touched_file_with_same_content() {
+    let dir = tempdir().unwrap();
+    let path = dir.path().join("main.rs");
+    std::fs::write(&path, "fn main() {}\n").unwrap();
+
+    let cache = FileCache::new(0);
+    let old_key = FileCacheKey {
+        mtime_secs: 100,
+        mtime_nanos: 0,
+        size: 13,
+    };
+    let content_hash = hash_content(b"fn main() {}\n");
+    cache.insert(path.clone(), old_key, content_hash, CheckSet::all(), vec![]);
+
+    let new_key = FileCacheKey::from_metadata(&std::fs::metadata(&path).unwrap());
+    assert!(cache.lookup(&path, &new_key, CheckSet::all()).is_some());
+    assert_eq!(cache.stats().hits, 1);
+
+    // Content changed
+    std::fs::write(&path, "fn main() { todo!() }\n").unwrap();
+    let new_key = FileCacheKey::from_metadata(&std::fs::metadata(&path).unwrap());
+    assert!(cache.lookup(&path, &new_key, CheckSet::all()).is_none());
+}
+
+#[test]
+fn cache_miss_when_checks_not_covered() {
+    let cache = FileCache::new(0);
+    let path = PathBuf::from("src/main.rs");
+    let key = FileCacheKey {
+        mtime_secs: 100,
+        mtime_nanos: 0,
+        size: 50,
+    };
+    cache.insert(
+        path.clone(),
+        key.clone(),
+        0,
+        CheckSet::from_names(["escapes"]),
+        vec![],
+    );
+
+    assert!(
+        cache
+            .lookup(&path, &key, CheckSet::from_names(["cloc", "escapes"]))
+            .is_none()
+    );
+    assert!(
+        cache
+            .lookup(&path, &key, CheckSet::from_names(["escapes"]))
+            .is_some()
+    );
+}
+
+#[test]
+fn check_set_covers_subsets() {
+    let all = CheckSet::all();
+    let escapes = CheckSet::from_names(["escapes"]);
+    assert!(all.covers(escapes));
+    assert!(escapes.covers(escapes));
+    assert!(!escapes.covers(all));
+    assert!(!all.covers(CheckSet::from_names(["unknown"])));
+}
+
 #[test]
 fn cache_rejects_config_change() {
     let dir = tempdir().unwrap();
@@ -222,7 +331,7 @@ fn cache_persist_async_completes() {
         mtime_nanos: 500,
         size: 1000,
     };
-    cache.insert(file_path.clone(), key.clone(), vec![]);
+    cache.insert(file_path.clone(), key.clone(), 0, CheckSet::all(), vec![]);
 
     // Persist asynchronously and wait for completion
     let handle = cache.persist_async(cache_path.clone());
@@ -234,7 +343,7 @@ fn cache_persist_async_completes() {
     // Verify file exists and can be restored
     assert!(cache_path.exists());
     let restored = FileCache::from_persistent(&cache_path, config_hash).unwrap();
-    let result = restored.lookup(&file_path, &key);
+    let result = restored.lookup(&file_path, &key, CheckSet::all());
     assert!(result.is_some());
 }
 
@@ -254,6 +363,7 @@ fn cache_lookup_returns_arc_for_efficient_cloning() {
     let violations = vec![CachedViolation {
         check: "test".to_string(),
         line: Some(1),
+        column: None,
         violation_type: "test".to_string(),
         advice: "test".to_string(),
         value: None,
@@ -262,12 +372,13 @@ fn cache_lookup_returns_arc_for_efficient_cloning() {
         lines: None,
         nonblank: None,
         target_path: None,
+        warning: false,
     }];
-    cache.insert(path.clone(), key.clone(), violations);
+    cache.insert(path.clone(), key.clone(), 0, CheckSet::all(), violations);
 
     // Get two references - should be the same Arc (same pointer)
-    let arc1 = cache.lookup(&path, &key).unwrap();
-    let arc2 = cache.lookup(&path, &key).unwrap();
+    let arc1 = cache.lookup(&path, &key, CheckSet::all()).unwrap();
+    let arc2 = cache.lookup(&path, &key, CheckSet::all()).unwrap();
 
     // Verify both point to same underlying data (Arc::ptr_eq)
     assert!(Arc::ptr_eq(&arc1, &arc2));
@@ -289,8 +400,8 @@ fn cache_handles_epoch_mtime() {
     };
 
     // Should not panic or cause issues
-    cache.insert(path.clone(), key.clone(), vec![]);
-    let result = cache.lookup(&path, &key);
+    cache.insert(path.clone(), key.clone(), 0, CheckSet::all(), vec![]);
+    let result = cache.lookup(&path, &key, CheckSet::all());
     assert!(result.is_some());
     assert_eq!(cache.stats().hits, 1);
 }
@@ -310,8 +421,8 @@ fn cache_handles_pre_epoch_mtime_gracefully() {
         size: 50,
     };
 
-    cache.insert(path.clone(), key.clone(), vec![]);
-    let result = cache.lookup(&path, &key);
+    cache.insert(path.clone(), key.clone(), 0, CheckSet::all(), vec![]);
+    let result = cache.lookup(&path, &key, CheckSet::all());
     assert!(result.is_some());
 }
 
@@ -337,10 +448,10 @@ fn cache_concurrent_insert_lookup() {
                     };
 
                     // Insert
-                    cache.insert(path.clone(), key.clone(), vec![]);
+                    cache.insert(path.clone(), key.clone(), 0, CheckSet::all(), vec![]);
 
                     // Lookup (may hit or miss depending on race with other threads)
-                    let _ = cache.lookup(&path, &key);
+                    let _ = cache.lookup(&path, &key, CheckSet::all());
                 }
             })
         })
@@ -407,3 +518,39 @@ fn hash_config_changes_when_suppress_check_changes() {
         "config hash must change when javascript.suppress.check changes"
     );
 }
+
+#[test]
+fn hash_config_changes_when_rule_settings_change() {
+    use crate::config::{CheckLevel, EscapeAction, EscapePattern, RuleConfig};
+
+    let config = crate::config::Config::default();
+    let hash_default = hash_config(&config);
+
+    let mut go_rule = config.clone();
+    go_rule.golang.panic.check = CheckLevel::Error;
+    assert_ne!(hash_config(&go_rule), hash_default);
+
+    let mut pattern = config.clone();
+    pattern.check.escapes.patterns.push(EscapePattern {
+        name: Some("todo".to_string()),
+        pattern: "TODO".to_string(),
+        action: EscapeAction::Forbid,
+        comment: None,
+        threshold: 0,
+        advice: None,
+        source: Vec::new(),
+        tests: Vec::new(),
+        in_tests: None,
+    });
+    assert_ne!(hash_config(&pattern), hash_default);
+
+    let mut rules = config;
+    rules.rules.insert(
+        "go_panic".to_string(),
+        RuleConfig {
+            apply_to_tests: Some(true),
+            ..RuleConfig::default()
+        },
+    );
+    assert_ne!(hash_config(&rules), hash_default);
+}
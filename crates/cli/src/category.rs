@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Rule categories for turning rules on and off in bulk.
+//!
+//! Every built-in rule belongs to one category ([`RULE_CATEGORIES`]), and
+//! custom rules can declare one through [`crate::rules::Rule::category`].
+//! `[categories]` and `check --enable-category` / `--disable-category` act
+//! on whole categories: enabling turns on a category's opt-in rules at
+//! their default level, and disabling turns every rule in it off. Category
+//! settings resolve first, so `[severity]` and `[rules.<rule>]` still win
+//! for a single rule.
+
+use std::fmt;
+
+use serde::{Deserialize, Serialize};
+
+use crate::config::{CheckLevel, Config};
+use crate::rules::registered_rules;
+
+/// A rule category.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Deserialize, Serialize, clap::ValueEnum)]
+#[serde(rename_all = "lowercase")]
+pub enum Category {
+    /// Code an attacker can exploit: eval, shell commands, secrets, weak randomness
+    Security,
+    /// Avoidable copies and work
+    Performance,
+    /// Code that's likely wrong or unsafe: unchecked errors, unsafe, panics
+    Correctness,
+    /// Conventions and leftovers: debug prints, init functions, TODOs
+    Style,
+}
+
+impl Category {
+    /// Every category, in the order they're listed.
+    pub const ALL: [Category; 4] = [
+        Category::Security,
+        Category::Performance,
+        Category::Correctness,
+        Category::Style,
+    ];
+
+    /// Lowercase name, as in config and on the command line.
+    pub fn as_str(self) -> &'static str {
+        match self {
+            Category::Security => "security",
+            Category::Performance => "performance",
+            Category::Correctness => "correctness",
+            Category::Style => "style",
+        }
+    }
+}
+
+impl fmt::Display for Category {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        f.write_str(self.as_str())
+    }
+}
+
+/// Category of every built-in rule, by rule id.
+///
+/// Escape patterns with the same id in several languages (`eval`) share
+/// one entry.
+pub const RULE_CATEGORIES: &[(&str, Category)] = &[
+    // Go escape patterns
+    ("unsafe_pointer", Category::Correctness),
+    ("unsafe_slice", Category::Correctness),
+    ("unsafe_string", Category::Correctness),
+    ("unsafe_add", Category::Correctness),
+    ("reflect_header", Category::Correctness),
+    ("go_linkname", Category::Correctness),
+    ("go_noescape", Category::Correctness),
+    ("go_nosplit", Category::Correctness),
+    ("go_uintptrescapes", Category::Correctness),
+    ("go_nocheckptr", Category::Correctness),
+    ("go_noinline", Category::Performance),
+    ("go_inline", Category::Performance),
+    ("cgo_import", Category::Correctness),
+    ("exec_import", Category::Security),
+    ("exec_command", Category::Security),
+    ("exec_sprintf", Category::Security),
+    // Go analyzers
+    ("syscall_import", Category::Correctness),
+    ("go_panic", Category::Correctness),
+    ("go_recover", Category::Correctness),
+    ("go_embed", Category::Security),
+    ("unsafe_introspection", Category::Correctness),
+    ("go_linkname_push", Category::Correctness),
+    ("weak_rand", Category::Security),
+    ("unchecked_error", Category::Correctness),
+    ("go_sleep", Category::Correctness),
+    ("go_init", Category::Style),
+    ("context_background", Category::Correctness),
+    ("context_todo", Category::Correctness),
+    ("hardcoded_secret", Category::Security),
+    ("deprecated_use", Category::Style),
+    ("builtin_print", Category::Style),
+    ("exported_any", Category::Style),
+    ("go_goroutine", Category::Correctness),
+    ("large_struct_return", Category::Performance),
+    ("sprintf_concat", Category::Performance),
+    ("time_now", Category::Correctness),
+    ("default_http_client", Category::Correctness),
+    ("map_order", Category::Correctness),
+    ("go_exit", Category::Correctness),
+    ("unlocked_map", Category::Correctness),
+    ("error_string", Category::Style),
+    ("unspread_args", Category::Correctness),
+    ("aliased_append", Category::Correctness),
+    ("waitgroup_add", Category::Correctness),
+    ("context_key", Category::Correctness),
+    ("defer_loop", Category::Correctness),
+    ("must_compile", Category::Correctness),
+    ("parse_error", Category::Correctness),
+    // JavaScript and TypeScript
+    ("as_unknown", Category::Correctness),
+    ("ts_ignore", Category::Correctness),
+    ("eval", Category::Security),
+    ("new_function", Category::Security),
+    ("dangerously_set_inner_html", Category::Security),
+    // Python
+    ("breakpoint", Category::Correctness),
+    ("pdb_set_trace", Category::Correctness),
+    ("import_pdb", Category::Correctness),
+    ("from_pdb", Category::Correctness),
+    ("exec", Category::Security),
+    ("__import__", Category::Security),
+    ("compile", Category::Security),
+    ("os_system", Category::Security),
+    ("subprocess_shell", Category::Security),
+    ("pickle_loads", Category::Security),
+    // Ruby
+    ("binding_pry", Category::Correctness),
+    ("byebug", Category::Correctness),
+    ("debugger", Category::Correctness),
+    ("instance_eval", Category::Security),
+    ("class_eval", Category::Security),
+    // Rust
+    ("unsafe", Category::Correctness),
+    ("unsafe_fn", Category::Correctness),
+    ("unsafe_impl", Category::Correctness),
+    ("unsafe_trait", Category::Correctness),
+    ("transmute", Category::Correctness),
+    // Shell
+    ("set_plus_e", Category::Correctness),
+    // Every language
+    ("unreferenced_todo", Category::Style),
+];
+
+/// Category of a rule: built-in, or declared by a registered custom rule.
+pub fn category_of(rule: &str) -> Option<Category> {
+    RULE_CATEGORIES
+        .iter()
+        .find(|(id, _)| *id == rule)
+        .map(|(_, category)| *category)
+        .or_else(|| {
+            registered_rules()
+                .iter()
+                .find(|r| r.name() == rule)
+                .and_then(|r| r.category())
+        })
+}
+
+/// Ids of the built-in and registered rules in a category.
+pub fn rules_in(category: Category) -> Vec<String> {
+    RULE_CATEGORIES
+        .iter()
+        .filter(|(_, c)| *c == category)
+        .map(|(id, _)| id.to_string())
+        .chain(
+            registered_rules()
+                .iter()
+                .filter(|r| r.category() == Some(category))
+                .map(|r| r.name().to_string()),
+        )
+        .collect()
+}
+
+/// Merge command-line category flags into `[categories]` and turn on the
+/// opt-in rules of enabled categories.
+///
+/// A flag overrides the config for its category: `--enable-category`
+/// takes it out of `disable`, and `--disable-category` out of `enable`.
+/// Disabled categories are applied with the rest of the severity levels
+/// (see [`crate::severity::rule_levels`]).
+pub fn apply(config: &mut Config, enable: &[Category], disable: &[Category]) {
+    let categories = &mut config.categories;
+    for category in enable {
+        categories.disable.retain(|c| c != category);
+        if !categories.enable.contains(category) {
+            categories.enable.push(*category);
+        }
+    }
+    for category in disable {
+        categories.enable.retain(|c| c != category);
+        if !categories.disable.contains(category) {
+            categories.disable.push(*category);
+        }
+    }
+
+    let enabled: Vec<String> = config
+        .categories
+        .enable
+        .iter()
+        .flat_map(|c| rules_in(*c))
+        .collect();
+    for rule in enabled {
+        if let Some(level) = opt_in_level(config, &rule)
+            && *level == CheckLevel::Off
+        {
+            *level = CheckLevel::Error;
+        }
+    }
+}
+
+/// The config level that turns an opt-in rule on, if it has one. Rules
+/// that run by default, like escape patterns, have none.
+fn opt_in_level<'a>(config: &'a mut Config, rule: &str) -> Option<&'a mut CheckLevel> {
+    let golang = &mut config.golang;
+    let level = match rule {
+        "syscall_import" => &mut golang.syscall.check,
+        "go_panic" => &mut golang.panic.check,
+        "go_recover" => &mut golang.recover.check,
+        "go_embed" => &mut golang.embed.check,
+        "unsafe_introspection" => &mut golang.unsafe_.introspection,
+        "go_linkname_push" => &mut golang.linkname.foreign_push,
+        "weak_rand" => &mut golang.weakrand.check,
+        "unchecked_error" => &mut golang.errcheck.check,
+        "go_sleep" => &mut golang.sleep.check,
+        "go_init" => &mut golang.init.check,
+        "context_background" | "context_todo" => &mut golang.context.check,
+        "hardcoded_secret" => &mut golang.secrets.check,
+        "deprecated_use" => &mut golang.deprecated.check,
+        "builtin_print" => &mut golang.print.check,
+        "exported_any" => &mut golang.any.check,
+        "go_goroutine" => &mut golang.goroutine.check,
+        "large_struct_return" => &mut golang.largestruct.check,
+        "sprintf_concat" => &mut golang.sprintf.check,
+        "time_now" => &mut golang.clock.check,
+        "default_http_client" => &mut golang.http.check,
+        "map_order" => &mut golang.maporder.check,
+        "go_exit" => &mut golang.exit.check,
+        "unlocked_map" => &mut golang.maplock.check,
+        "error_string" => &mut golang.errstring.check,
+        "unspread_args" => &mut golang.variadic.check,
+        "aliased_append" => &mut golang.appendalias.check,
+        "waitgroup_add" => &mut golang.waitgroup.check,
+        "context_key" => &mut golang.ctxkey.check,
+        "defer_loop" => &mut golang.deferloop.check,
+        "must_compile" => &mut golang.mustcompile.check,
+        "unreferenced_todo" => &mut config.check.escapes.todo.check,
+        _ => return None,
+    };
+    Some(level)
+}
+
+#[cfg(test)]
+#[path = "category_tests.rs"]
+mod tests;
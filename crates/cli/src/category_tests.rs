@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    exec = { "exec_command", Some(Category::Security) },
+    eval = { "eval", Some(Category::Security) },
+    sprintf = { "sprintf_concat", Some(Category::Performance) },
+    unsafe_pointer = { "unsafe_pointer", Some(Category::Correctness) },
+    print = { "builtin_print", Some(Category::Style) },
+    todo = { "unreferenced_todo", Some(Category::Style) },
+    unknown = { "no_such_rule", None },
+)]
+fn category_of_built_in_rules(rule: &str, expected: Option<Category>) {
+    assert_eq!(category_of(rule), expected);
+}
+
+#[test]
+fn rules_are_listed_once() {
+    let mut ids: Vec<&str> = RULE_CATEGORIES.iter().map(|(id, _)| *id).collect();
+    ids.sort_unstable();
+    let len = ids.len();
+    ids.dedup();
+    assert_eq!(ids.len(), len);
+}
+
+#[test]
+fn enabling_a_category_turns_on_only_its_opt_in_rules() {
+    let mut config = Config::default();
+    apply(&mut config, &[Category::Security], &[]);
+
+    assert_eq!(config.golang.secrets.check, CheckLevel::Error);
+    assert_eq!(config.golang.weakrand.check, CheckLevel::Error);
+    assert_eq!(config.golang.print.check, CheckLevel::Off);
+    assert_eq!(config.check.escapes.todo.check, CheckLevel::Off);
+    assert_eq!(config.categories.enable, vec![Category::Security]);
+}
+
+#[test]
+fn enabling_keeps_configured_levels() {
+    let mut config = Config::default();
+    config.golang.print.check = CheckLevel::Warn;
+    config.categories.enable.push(Category::Style);
+    apply(&mut config, &[], &[]);
+
+    assert_eq!(config.golang.print.check, CheckLevel::Warn);
+    assert_eq!(config.golang.init.check, CheckLevel::Error);
+}
+
+#[test]
+fn flags_override_config_for_their_category() {
+    let mut config = Config::default();
+    config.categories.enable.push(Category::Style);
+    config.categories.disable.push(Category::Security);
+    apply(&mut config, &[Category::Security], &[Category::Style]);
+
+    assert_eq!(config.categories.enable, vec![Category::Security]);
+    assert_eq!(config.categories.disable, vec![Category::Style]);
+    assert_eq!(config.golang.print.check, CheckLevel::Off);
+}
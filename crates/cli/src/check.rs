@@ -5,12 +5,17 @@
 
 use std::collections::HashMap;
 use std::path::{Path, PathBuf};
+use std::sync::Arc;
 use std::sync::atomic::AtomicUsize;
 
 use serde::{Deserialize, Serialize};
 use serde_json::Value as JsonValue;
 
 use crate::config::Config;
+use crate::fix_preview::FixPreview;
+use crate::progress::Progress;
+use crate::rules::Rule;
+use crate::stream::ViolationStream;
 use crate::walker::WalkedFile;
 
 /// Context passed to all checks during execution.
@@ -31,6 +36,8 @@ pub struct CheckContext<'a> {
     pub fix: bool,
     /// Show what --fix would change without modifying files.
     pub dry_run: bool,
+    /// Edits --fix --dry-run would make (None = not a dry run).
+    pub fix_preview: Option<&'a FixPreview>,
     /// Whether running in CI mode (enables slow checks like commit validation).
     pub ci_mode: bool,
     /// Base branch for commit comparison in CI mode.
@@ -39,6 +46,36 @@ pub struct CheckContext<'a> {
     pub staged: bool,
     /// Whether verbose diagnostic output is enabled.
     pub verbose: bool,
+    /// Buffer checked in place of its file on disk (`check --stdin`).
+    pub stdin: Option<&'a SourceBuffer>,
+    /// Custom rules for this run (None = the process-wide registry).
+    pub rules: Option<&'a [Arc<dyn Rule>]>,
+    /// Progress line for scanned files (None = no progress output).
+    pub progress: Option<&'a Progress>,
+    /// Writes each scanned file's violations as it completes (None = report at the end).
+    pub stream: Option<&'a ViolationStream>,
+}
+
+impl CheckContext<'_> {
+    /// Record an edit --fix --dry-run would make instead of writing it.
+    ///
+    /// `before` is None when the fix creates the file.
+    pub fn preview_fix(&self, path: &Path, before: Option<&str>, after: &str) {
+        if let Some(preview) = self.fix_preview {
+            preview.record(path.strip_prefix(self.root).unwrap_or(path), before, after);
+        }
+    }
+}
+
+/// An in-memory buffer checked as if it were the file at `path`.
+///
+/// Editors lint unsaved buffers this way (`check --stdin --filename`).
+#[derive(Debug, Clone)]
+pub struct SourceBuffer {
+    /// Absolute path the buffer stands in for (need not exist).
+    pub path: PathBuf,
+    /// Buffer content.
+    pub content: String,
 }
 
 /// The Check trait defines a single quality check.
@@ -76,6 +113,10 @@ pub struct Violation {
     #[serde(skip_serializing_if = "Option::is_none")]
     pub line: Option<u32>,
 
+    /// Column number, 1-based (None if not applicable).
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub column: Option<u32>,
+
     /// Violation category (check-specific).
     #[serde(rename = "type")]
     pub violation_type: String,
@@ -158,6 +199,14 @@ pub struct Violation {
     /// Found value (for license check violations - e.g., actual license or year).
     #[serde(skip_serializing_if = "Option::is_none")]
     pub found: Option<String>,
+
+    /// Occurrences collapsed into this violation by `--dedup line`.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub count: Option<u32>,
+
+    /// Downgraded to a warning by `[severity]` config in an otherwise failing check.
+    #[serde(default, skip_serializing_if = "std::ops::Not::not")]
+    pub warning: bool,
 }
 
 impl Violation {
@@ -171,6 +220,7 @@ impl Violation {
         Self {
             file: Some(file.into()),
             line: Some(line),
+            column: None,
             violation_type: violation_type.into(),
             advice: advice.into(),
             value: None,
@@ -192,6 +242,8 @@ impl Violation {
             scope: None,
             expected: None,
             found: None,
+            count: None,
+            warning: false,
         }
     }
 
@@ -204,6 +256,7 @@ impl Violation {
         Self {
             file: Some(file.into()),
             line: None,
+            column: None,
             violation_type: violation_type.into(),
             advice: advice.into(),
             value: None,
@@ -225,6 +278,8 @@ impl Violation {
             scope: None,
             expected: None,
             found: None,
+            count: None,
+            warning: false,
         }
     }
 
@@ -238,6 +293,7 @@ impl Violation {
         Self {
             file: None,
             line: None,
+            column: None,
             violation_type: violation_type.into(),
             advice: advice.into(),
             value: None,
@@ -259,6 +315,8 @@ impl Violation {
             scope: None,
             expected: None,
             found: None,
+            count: None,
+            warning: false,
         }
     }
 
@@ -289,6 +347,12 @@ impl Violation {
         self
     }
 
+    /// Add column context to the violation.
+    pub fn with_column(mut self, column: u32) -> Self {
+        self.column = Some(column);
+        self
+    }
+
     /// Add pattern context to the violation.
     pub fn with_pattern(mut self, pattern: impl Into<String>) -> Self {
         self.pattern = Some(pattern.into());
@@ -545,6 +609,53 @@ impl CheckOutput {
     pub fn total_violations(&self) -> usize {
         self.checks.iter().map(|c| c.violations.len()).sum()
     }
+
+    /// Rewrite violation file paths, relative to `root`, as absolute paths.
+    pub fn absolutize_paths(&mut self, root: &Path) {
+        for check in &mut self.checks {
+            for violation in &mut check.violations {
+                if let Some(file) = &mut violation.file {
+                    *file = root.join(&*file);
+                }
+            }
+        }
+    }
+
+    /// Collapse violations of one rule on the same line into the first,
+    /// counting the occurrences (`--dedup line`).
+    pub fn dedup_lines(&mut self) {
+        type Key = (PathBuf, u32, String, Option<String>);
+        for check in &mut self.checks {
+            let mut first: HashMap<Key, usize> = HashMap::new();
+            let mut kept: Vec<Violation> = Vec::with_capacity(check.violations.len());
+            for v in check.violations.drain(..) {
+                let key = match (&v.file, v.line) {
+                    (Some(file), Some(line)) => Some((
+                        file.clone(),
+                        line,
+                        v.violation_type.clone(),
+                        v.pattern.clone(),
+                    )),
+                    _ => None,
+                };
+                let Some(key) = key else {
+                    kept.push(v);
+                    continue;
+                };
+                match first.get(&key) {
+                    Some(&idx) => {
+                        let count = kept[idx].count.get_or_insert(1);
+                        *count += v.count.unwrap_or(1);
+                    }
+                    None => {
+                        first.insert(key, kept.len());
+                        kept.push(v);
+                    }
+                }
+            }
+            check.violations = kept;
+        }
+    }
 }
 
 #[cfg(test)]
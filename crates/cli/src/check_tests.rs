@@ -149,3 +149,70 @@ fn violation_without_scope_omits_field() {
 
     assert!(json.get("scope").is_none());
 }
+
+#[test]
+fn absolutize_paths_joins_file_paths_to_root() {
+    let mut output = CheckOutput::new(
+        "2026-01-01T00:00:00Z".to_string(),
+        vec![CheckResult::failed(
+            "escapes",
+            vec![
+                Violation::file("pkg/a.go", 3, "forbidden", "Remove it."),
+                Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:"),
+            ],
+        )],
+    );
+    output.absolutize_paths(Path::new("/repo"));
+
+    let files: Vec<_> = output.checks[0]
+        .violations
+        .iter()
+        .map(|v| v.file.clone())
+        .collect();
+    assert_eq!(files, vec![Some(PathBuf::from("/repo/pkg/a.go")), None]);
+}
+
+#[test]
+fn dedup_lines_collapses_same_rule_on_one_line() {
+    let unsafe_at = |line, column| {
+        Violation::file("store/ptr.go", line, "missing_comment", "Justify it.")
+            .with_pattern("unsafe_pointer")
+            .with_column(column)
+    };
+    let mut output = CheckOutput::new(
+        "2026-01-01T00:00:00Z".to_string(),
+        vec![CheckResult::failed(
+            "escapes",
+            vec![
+                unsafe_at(7, 21),
+                unsafe_at(7, 44),
+                Violation::file("store/ptr.go", 7, "forbidden", "Remove it.")
+                    .with_pattern("go_linkname"),
+                unsafe_at(9, 9),
+            ],
+        )],
+    );
+    output.dedup_lines();
+
+    let violations = &output.checks[0].violations;
+    let summary: Vec<_> = violations
+        .iter()
+        .map(|v| (v.line, v.column, v.count))
+        .collect();
+    assert_eq!(
+        summary,
+        vec![
+            (Some(7), Some(21), Some(2)),
+            (Some(7), None, None),
+            (Some(9), Some(9), None),
+        ]
+    );
+    let json = serde_json::to_value(&violations[0]).unwrap();
+    assert_eq!(json["count"], 2);
+    assert!(
+        serde_json::to_value(&violations[2])
+            .unwrap()
+            .get("count")
+            .is_none()
+    );
+}
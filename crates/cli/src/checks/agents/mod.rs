@@ -359,6 +359,7 @@ fn check_sync(
 
                 if ctx.dry_run {
                     // Preview only: collect diff data without writing
+                    ctx.preview_fix(&target_file.path, Some(&target_content), &source_content);
                     fixes.add_preview(
                         target_name.clone(),
                         source_name.to_string(),
@@ -666,10 +667,12 @@ fn check_cursor_reconciliation(
             .to_string();
 
         if ctx.dry_run {
+            let before = std::fs::read_to_string(&rf.target_path).ok();
+            ctx.preview_fix(&rf.target_path, before.as_deref(), &rf.content);
             fixes.add_preview(
                 target,
                 "cursor_reconcile".to_string(),
-                String::new(),
+                before.unwrap_or_default(),
                 rf.content,
                 1,
             );
@@ -20,7 +20,7 @@ use std::time::{Duration, Instant};
 use serde_json::json;
 
 use crate::adapter::javascript::PackageManager;
-use crate::adapter::{ProjectLanguage, detect_bundler, detect_language};
+use crate::adapter::{ProjectLanguage, detect_bundler, project_language};
 use crate::check::{Check, CheckContext, CheckResult, Violation};
 use crate::tolerance::{parse_duration, parse_size};
 
@@ -57,7 +57,7 @@ impl Check for BuildCheck {
 
         let mut metrics = BuildMetrics::default();
         let mut violations = Vec::new();
-        let language = detect_language(ctx.root);
+        let language = project_language(ctx.root, ctx.config);
         let build_config = &ctx.config.check.build;
 
         // Parse time thresholds
@@ -105,6 +105,7 @@ impl Check for BuildCheck {
                     violations.push(Violation {
                         file: None,
                         line: None,
+                        column: None,
                         violation_type: "size_exceeded".to_string(),
                         advice: advice.to_string(),
                         value: Some(size as i64),
@@ -126,6 +127,8 @@ impl Check for BuildCheck {
                         scope: None,
                         expected: None,
                         found: None,
+                        count: None,
+                        warning: false,
                     });
                 }
             }
@@ -138,6 +141,7 @@ impl Check for BuildCheck {
                     violations.push(Violation {
                         file: None,
                         line: None,
+                        column: None,
                         violation_type: "missing_target".to_string(),
                         advice:
                             "Configured build target not found. Verify target exists and builds successfully."
@@ -161,6 +165,8 @@ impl Check for BuildCheck {
                         scope: None,
                         expected: None,
                         found: None,
+                        count: None,
+                        warning: false,
                     });
                 }
             }
@@ -411,17 +411,13 @@ impl PackageMetrics {
 fn file_package(path: &Path, root: &Path, packages: &[String]) -> Option<String> {
     let relative = path.strip_prefix(root).ok()?;
 
-    for pkg in packages {
-        // Special case: "." means root package (all files belong to it)
-        if pkg == "." {
-            return Some(pkg.clone());
-        }
-        // Check if file is under the package directory
-        if relative.starts_with(pkg) {
-            return Some(pkg.clone());
-        }
-    }
-    None
+    // The innermost package directory wins (nested Go modules share prefixes).
+    // Special case: "." means root package (all otherwise unclaimed files)
+    packages
+        .iter()
+        .filter(|pkg| pkg.as_str() == "." || relative.starts_with(pkg.as_str()))
+        .max_by_key(|pkg| if pkg.as_str() == "." { 0 } else { pkg.len() })
+        .cloned()
 }
 
 /// Pattern matcher for exclude patterns.
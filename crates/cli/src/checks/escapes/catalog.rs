@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Rule catalog for `quench rules`.
+//!
+//! Lists every rule the escapes check reports: each adapter's default escape
+//! patterns, the Go source analyzers, the language-agnostic TODO reference
+//! rule, and custom rules registered through
+//! [`crate::rules::register_rule`], with each rule's category. Built from
+//! the tables the check itself runs, so the listing can't drift from the
+//! implemented set.
+
+use serde::Serialize;
+
+use crate::adapter::{EscapeAction, ProjectLanguage, default_escapes};
+use crate::category::{Category, category_of};
+use crate::config::{
+    CheckLevel, GoAnyConfig, GoAppendAliasConfig, GoClockConfig, GoContextConfig, GoCtxKeyConfig,
+    GoDeferLoopConfig, GoDeprecatedConfig, GoEmbedConfig, GoErrStringConfig, GoErrcheckConfig,
+    GoExitConfig, GoGoroutineConfig, GoHttpConfig, GoInitConfig, GoLargeStructConfig,
+    GoLinknameConfig, GoMapLockConfig, GoMapOrderConfig, GoMustCompileConfig, GoPanicConfig,
+    GoPrintConfig, GoRecoverConfig, GoSecretsConfig, GoSleepConfig, GoSprintfConfig,
+    GoSyscallConfig, GoUnsafeConfig, GoVariadicConfig, GoWaitGroupConfig, GoWeakRandConfig,
+    TodoConfig,
+};
+use crate::output::violations::stable_rule_id;
+use crate::rules::registered_rules;
+use crate::severity::RULE_DEFAULTS;
+
+use super::PARSE_ERROR;
+use super::go_any::{ANY_COMMENT, EXPORTED_ANY};
+use super::go_appendalias::ALIASED_APPEND;
+use super::go_clock::{CLOCK_COMMENT, TIME_NOW};
+use super::go_context::{CONTEXT_BACKGROUND, CONTEXT_TODO};
+use super::go_ctxkey::CONTEXT_KEY;
+use super::go_deferloop::{DEFER_LOOP, DEFERLOOP_COMMENT};
+use super::go_deprecated::DEPRECATED_USE;
+use super::go_embed::GO_EMBED;
+use super::go_errcheck::UNCHECKED_ERROR;
+use super::go_errstring::ERROR_STRING;
+use super::go_exit::{EXIT_COMMENT, GO_EXIT};
+use super::go_goroutine::{GO_GOROUTINE, GOROUTINE_COMMENT};
+use super::go_http::{DEFAULT_HTTP_CLIENT, HTTP_COMMENT};
+use super::go_init::{GO_INIT, INIT_COMMENT};
+use super::go_largestruct::LARGE_STRUCT_RETURN;
+use super::go_linkname::GO_LINKNAME_PUSH;
+use super::go_maplock::UNLOCKED_MAP;
+use super::go_maporder::MAP_ORDER;
+use super::go_mustcompile::MUST_COMPILE;
+use super::go_panic::{GO_PANIC, PANIC_COMMENT};
+use super::go_print::BUILTIN_PRINT;
+use super::go_recover::GO_RECOVER;
+use super::go_secrets::HARDCODED_SECRET;
+use super::go_sleep::{GO_SLEEP, SLEEP_COMMENT};
+use super::go_sprintf::SPRINTF_CONCAT;
+use super::go_syscall::SYSCALL_IMPORT;
+use super::go_unsafe::UNSAFE_INTROSPECTION;
+use super::go_variadic::UNSPREAD_ARGS;
+use super::go_waitgroup::WAITGROUP_ADD;
+use super::go_weakrand::{WEAK_RAND, WEAKRAND_COMMENT};
+use super::todo::UNREFERENCED_TODO;
+
+/// A rule as listed by `quench rules`.
+#[derive(Debug, Clone, PartialEq, Eq, Serialize)]
+pub struct RuleInfo {
+    /// Rule id, as reported in violations and keyed by `[severity]`.
+    pub id: String,
+    /// Stable rule id, as in every output format's `ruleId`.
+    #[serde(rename = "ruleId")]
+    pub rule_id: String,
+    /// Language whose files the rule checks (`any` for rules that check
+    /// every language, None for custom rules).
+    pub language: Option<String>,
+    /// Level before config overrides: `error`, `warning`, or `off` (opt-in).
+    pub severity: &'static str,
+    /// Category for `--enable-category` and `--disable-category` (None for
+    /// custom rules that don't declare one).
+    pub category: Option<&'static str>,
+    /// Justification comment that satisfies the rule (`|` separates alternatives).
+    pub marker: Option<String>,
+    /// One-line description.
+    pub description: String,
+}
+
+/// Go analyzers beyond pattern matching: id, default level, marker, description.
+fn go_analyzers() -> [(&'static str, CheckLevel, Option<&'static str>, &'static str); 32] {
+    [
+        (
+            SYSCALL_IMPORT,
+            GoSyscallConfig::default_check(),
+            None,
+            "Imports of syscall or golang.org/x/sys outside [golang.syscall].allow packages.",
+        ),
+        (
+            GO_PANIC,
+            GoPanicConfig::default_check(),
+            Some(PANIC_COMMENT),
+            "panic() calls without a comment explaining why the failure is unrecoverable.",
+        ),
+        (
+            GO_RECOVER,
+            GoRecoverConfig::default_check(),
+            None,
+            "recover() calls outside a deferred function, where they always return nil.",
+        ),
+        (
+            GO_EMBED,
+            GoEmbedConfig::default_check(),
+            None,
+            "//go:embed directives matching [golang.embed].sensitive globs.",
+        ),
+        (
+            UNSAFE_INTROSPECTION,
+            GoUnsafeConfig::default_introspection(),
+            Some("// SAFETY:"),
+            "unsafe.Sizeof, unsafe.Alignof, and unsafe.Offsetof calls without a // SAFETY: comment.",
+        ),
+        (
+            GO_LINKNAME_PUSH,
+            GoLinknameConfig::default_foreign_push(),
+            None,
+            "//go:linkname pushes that define a symbol in another package.",
+        ),
+        (
+            WEAK_RAND,
+            GoWeakRandConfig::default_check(),
+            Some(WEAKRAND_COMMENT),
+            "math/rand imports in [golang.weakrand].packages, where crypto/rand is expected.",
+        ),
+        (
+            UNCHECKED_ERROR,
+            GoErrcheckConfig::default_check(),
+            None,
+            "Calls to [golang.errcheck].functions whose returned error is discarded.",
+        ),
+        (
+            GO_SLEEP,
+            GoSleepConfig::default_check(),
+            Some(SLEEP_COMMENT),
+            "time.Sleep calls outside test files without a // SLEEP: comment.",
+        ),
+        (
+            GO_INIT,
+            GoInitConfig::default_check(),
+            Some(INIT_COMMENT),
+            "init() functions outside test files without an // INIT: comment.",
+        ),
+        (
+            CONTEXT_BACKGROUND,
+            GoContextConfig::default_check(),
+            None,
+            "context.Background() calls in [golang.context].packages, where the caller's context should be passed on.",
+        ),
+        (
+            CONTEXT_TODO,
+            GoContextConfig::default_check(),
+            None,
+            "context.TODO() calls in [golang.context].packages; a warning unless [severity] raises it.",
+        ),
+        (
+            HARDCODED_SECRET,
+            GoSecretsConfig::default_check(),
+            None,
+            "String literals assigned to credential names like password or apiKey, outside test files.",
+        ),
+        (
+            DEPRECATED_USE,
+            GoDeprecatedConfig::default_check(),
+            None,
+            "References to the module's own functions, types, variables, and constants marked // Deprecated:.",
+        ),
+        (
+            BUILTIN_PRINT,
+            GoPrintConfig::default_check(),
+            None,
+            "Calls to the print and println builtins outside test files, unless the package shadows them.",
+        ),
+        (
+            EXPORTED_ANY,
+            GoAnyConfig::default_check(),
+            Some(ANY_COMMENT),
+            "Exported functions and methods taking or returning any or interface{} without an // ANY: comment.",
+        ),
+        (
+            GO_GOROUTINE,
+            GoGoroutineConfig::default_check(),
+            Some(GOROUTINE_COMMENT),
+            "go statements whose launched function doesn't defer a recover(), without a // GOROUTINE: comment.",
+        ),
+        (
+            LARGE_STRUCT_RETURN,
+            GoLargeStructConfig::default_check(),
+            None,
+            "Functions returning a struct larger than max_bytes (default 64) by value; a warning by default.",
+        ),
+        (
+            SPRINTF_CONCAT,
+            GoSprintfConfig::default_check(),
+            None,
+            "fmt.Sprintf calls whose format is only %s verbs, one per argument; a warning by default.",
+        ),
+        (
+            TIME_NOW,
+            GoClockConfig::default_check(),
+            Some(CLOCK_COMMENT),
+            "time.Now() calls in [golang.clock].packages without a // CLOCK: comment.",
+        ),
+        (
+            DEFAULT_HTTP_CLIENT,
+            GoHttpConfig::default_check(),
+            Some(HTTP_COMMENT),
+            "http.Get, http.Post, and other uses of http.DefaultClient, which has no timeout, without an // HTTP: comment.",
+        ),
+        (
+            MAP_ORDER,
+            GoMapOrderConfig::default_check(),
+            None,
+            "Slices appended to while ranging over a map and returned unsorted; a warning by default.",
+        ),
+        (
+            GO_EXIT,
+            GoExitConfig::default_check(),
+            Some(EXIT_COMMENT),
+            "log.Fatal, log.Panic, and os.Exit outside main packages without an // EXIT: comment.",
+        ),
+        (
+            UNLOCKED_MAP,
+            GoMapLockConfig::default_check(),
+            None,
+            "Map fields accessed without the struct's mutex held, when another method holds it; a warning by default.",
+        ),
+        (
+            ERROR_STRING,
+            GoErrStringConfig::default_check(),
+            None,
+            "errors.New and fmt.Errorf strings that are capitalized or end with punctuation; a warning by default.",
+        ),
+        (
+            UNSPREAD_ARGS,
+            GoVariadicConfig::default_check(),
+            None,
+            "[]any slices passed to fmt print functions without ..., which print the slice as one value.",
+        ),
+        (
+            ALIASED_APPEND,
+            GoAppendAliasConfig::default_check(),
+            None,
+            "Appends to s[:0] that reuse the backing array of a slice used later; a warning by default.",
+        ),
+        (
+            WAITGROUP_ADD,
+            GoWaitGroupConfig::default_check(),
+            None,
+            "sync.WaitGroup Add calls inside the goroutine they count, which Wait can return before.",
+        ),
+        (
+            CONTEXT_KEY,
+            GoCtxKeyConfig::default_check(),
+            None,
+            "context.WithValue keys of built-in types like string, which can collide; a warning by default.",
+        ),
+        (
+            DEFER_LOOP,
+            GoDeferLoopConfig::default_check(),
+            Some(DEFERLOOP_COMMENT),
+            "defer statements in a loop body, which run only when the function returns; a warning by default.",
+        ),
+        (
+            MUST_COMPILE,
+            GoMustCompileConfig::default_check(),
+            None,
+            "regexp.MustCompile on patterns built at run time, which panics instead of returning an error.",
+        ),
+        (
+            PARSE_ERROR,
+            CheckLevel::Error,
+            None,
+            "Go files with unterminated literals or unbalanced brackets; reported instead of checked.",
+        ),
+    ]
+}
+
+/// Every built-in and registered rule, grouped by language in detection order.
+pub fn rule_catalog() -> Vec<RuleInfo> {
+    let mut rules = Vec::new();
+    for language in ProjectLanguage::ADAPTERS {
+        let name = language.to_string().to_ascii_lowercase();
+        for pattern in default_escapes(language) {
+            rules.push(RuleInfo {
+                id: pattern.name.to_string(),
+                rule_id: stable_rule_id(pattern.name),
+                language: Some(name.clone()),
+                severity: default_severity(pattern.name, CheckLevel::Error),
+                category: category_of(pattern.name).map(Category::as_str),
+                marker: match pattern.action {
+                    EscapeAction::Comment => pattern.comment.map(String::from),
+                    EscapeAction::Count | EscapeAction::Forbid => None,
+                },
+                description: pattern
+                    .advice
+                    .lines()
+                    .next()
+                    .unwrap_or_default()
+                    .to_string(),
+            });
+        }
+        if language == ProjectLanguage::Go {
+            for (id, level, marker, description) in go_analyzers() {
+                rules.push(RuleInfo {
+                    id: id.to_string(),
+                    rule_id: stable_rule_id(id),
+                    language: Some(name.clone()),
+                    severity: default_severity(id, level),
+                    category: category_of(id).map(Category::as_str),
+                    marker: marker.map(String::from),
+                    description: description.to_string(),
+                });
+            }
+        }
+    }
+    rules.push(RuleInfo {
+        id: UNREFERENCED_TODO.to_string(),
+        rule_id: stable_rule_id(UNREFERENCED_TODO),
+        language: Some("any".to_string()),
+        severity: default_severity(UNREFERENCED_TODO, TodoConfig::default_check()),
+        category: category_of(UNREFERENCED_TODO).map(Category::as_str),
+        marker: None,
+        description: "TODO and FIXME comments without an issue reference, like TODO(JIRA-123)."
+            .to_string(),
+    });
+    for rule in registered_rules() {
+        rules.push(RuleInfo {
+            id: rule.name().to_string(),
+            rule_id: stable_rule_id(rule.name()),
+            language: None,
+            severity: default_severity(rule.name(), CheckLevel::Error),
+            category: rule.category().map(Category::as_str),
+            marker: None,
+            description: rule.description().to_string(),
+        });
+    }
+    rules
+}
+
+/// A rule's level name, after built-in [`RULE_DEFAULTS`]. Opt-in rules
+/// stay `off` whatever level they'd default to once enabled.
+fn default_severity(id: &str, level: CheckLevel) -> &'static str {
+    if level == CheckLevel::Off {
+        return "off";
+    }
+    let level = RULE_DEFAULTS
+        .iter()
+        .find(|(rule, _)| *rule == id)
+        .map_or(level, |(_, level)| *level);
+    match level {
+        CheckLevel::Error => "error",
+        CheckLevel::Warn => "warning",
+        CheckLevel::Off => "off",
+    }
+}
+
+#[cfg(test)]
+#[path = "catalog_tests.rs"]
+mod tests;
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use std::collections::HashSet;
+
+use super::*;
+use crate::rules::{Rule, RuleViolation, SourceFile, register_rule};
+
+fn find<'a>(rules: &'a [RuleInfo], language: &str, id: &str) -> &'a RuleInfo {
+    rules
+        .iter()
+        .find(|r| r.language.as_deref() == Some(language) && r.id == id)
+        .unwrap()
+}
+
+#[test]
+fn lists_every_default_escape_pattern() {
+    let rules = rule_catalog();
+    let listed: HashSet<(Option<&str>, &str)> = rules
+        .iter()
+        .map(|r| (r.language.as_deref(), r.id.as_str()))
+        .collect();
+
+    for language in ProjectLanguage::ADAPTERS {
+        let name = language.to_string().to_ascii_lowercase();
+        for pattern in default_escapes(language) {
+            assert!(
+                listed.contains(&(Some(name.as_str()), pattern.name)),
+                "{} rule {} missing from the catalog",
+                name,
+                pattern.name
+            );
+        }
+    }
+}
+
+#[test]
+fn lists_go_analyzers_with_default_levels() {
+    let rules = rule_catalog();
+    assert_eq!(find(&rules, "go", "go_recover").severity, "error");
+    assert_eq!(find(&rules, "go", "go_embed").severity, "off");
+    assert_eq!(find(&rules, "go", "syscall_import").severity, "off");
+    assert_eq!(find(&rules, "go", "parse_error").severity, "warning");
+    assert_eq!(find(&rules, "go", "unsafe_introspection").severity, "off");
+    assert_eq!(find(&rules, "go", "go_linkname_push").severity, "off");
+    assert_eq!(find(&rules, "go", "weak_rand").severity, "off");
+    assert_eq!(find(&rules, "go", "unchecked_error").severity, "off");
+    assert_eq!(find(&rules, "go", "go_sleep").severity, "off");
+    assert_eq!(find(&rules, "go", "go_init").severity, "off");
+    // Opt-in even though it's a warning once enabled
+    assert_eq!(find(&rules, "go", "context_todo").severity, "off");
+    assert_eq!(find(&rules, "go", "context_background").severity, "off");
+    assert_eq!(find(&rules, "go", "hardcoded_secret").severity, "off");
+    assert_eq!(find(&rules, "go", "deprecated_use").severity, "off");
+    assert_eq!(find(&rules, "go", "builtin_print").severity, "off");
+    let any = find(&rules, "go", "exported_any");
+    assert_eq!(any.severity, "off");
+    assert_eq!(any.marker.as_deref(), Some("// ANY:"));
+    let goroutine = find(&rules, "go", "go_goroutine");
+    assert_eq!(goroutine.severity, "off");
+    assert_eq!(goroutine.marker.as_deref(), Some("// GOROUTINE:"));
+    assert_eq!(find(&rules, "go", "large_struct_return").severity, "off");
+    assert_eq!(find(&rules, "go", "sprintf_concat").severity, "off");
+    let clock = find(&rules, "go", "time_now");
+    assert_eq!(clock.severity, "off");
+    assert_eq!(clock.marker.as_deref(), Some("// CLOCK:"));
+    let http = find(&rules, "go", "default_http_client");
+    assert_eq!(http.severity, "off");
+    assert_eq!(http.marker.as_deref(), Some("// HTTP:"));
+    assert_eq!(find(&rules, "go", "map_order").severity, "off");
+    let exit = find(&rules, "go", "go_exit");
+    assert_eq!(exit.severity, "off");
+    assert_eq!(exit.marker.as_deref(), Some("// EXIT:"));
+    assert_eq!(find(&rules, "go", "unlocked_map").severity, "off");
+    assert_eq!(find(&rules, "go", "error_string").severity, "off");
+    assert_eq!(find(&rules, "go", "unspread_args").severity, "error");
+    assert_eq!(find(&rules, "go", "aliased_append").severity, "off");
+    assert_eq!(find(&rules, "go", "waitgroup_add").severity, "error");
+    assert_eq!(find(&rules, "go", "context_key").severity, "warning");
+    let defer_loop = find(&rules, "go", "defer_loop");
+    assert_eq!(defer_loop.severity, "warning");
+    assert_eq!(defer_loop.marker.as_deref(), Some("// DEFERLOOP:"));
+    assert_eq!(find(&rules, "go", "must_compile").severity, "error");
+
+    let panic = find(&rules, "go", "go_panic");
+    assert_eq!(panic.severity, "off");
+    assert_eq!(panic.marker.as_deref(), Some("// PANIC:"));
+}
+
+#[test]
+fn every_built_in_rule_has_a_category() {
+    let rules = rule_catalog();
+    for rule in rules.iter().filter(|r| r.language.is_some()) {
+        assert!(rule.category.is_some(), "{} has no category", rule.id);
+    }
+    assert_eq!(
+        find(&rules, "go", "exec_command").category,
+        Some("security")
+    );
+    assert_eq!(
+        find(&rules, "go", "sprintf_concat").category,
+        Some("performance")
+    );
+    assert_eq!(find(&rules, "rust", "unsafe").category, Some("correctness"));
+    assert_eq!(
+        find(&rules, "any", "unreferenced_todo").category,
+        Some("style")
+    );
+}
+
+#[test]
+fn lists_todo_rule_for_any_language() {
+    let rules = rule_catalog();
+    let todo = find(&rules, "any", "unreferenced_todo");
+    assert_eq!(todo.severity, "off");
+    assert!(todo.marker.is_none());
+}
+
+#[test]
+fn pattern_severity_and_marker() {
+    let rules = rule_catalog();
+
+    let pointer = find(&rules, "go", "unsafe_pointer");
+    assert_eq!(pointer.severity, "error");
+    assert_eq!(pointer.marker.as_deref(), Some("// SAFETY:"));
+    assert_eq!(
+        pointer.description,
+        "Add a // SAFETY: comment explaining pointer validity."
+    );
+
+    // Built-in rule default below its check's level
+    assert_eq!(find(&rules, "go", "go_noescape").severity, "warning");
+    assert_eq!(find(&rules, "go", "go_noescape").rule_id, "noescape");
+    assert_eq!(pointer.rule_id, "unsafe-pointer");
+    // Forbidden patterns have no marker to add
+    assert!(find(&rules, "python", "breakpoint").marker.is_none());
+}
+
+struct NoSleep;
+
+impl Rule for NoSleep {
+    fn name(&self) -> &str {
+        "catalog_no_sleep"
+    }
+
+    fn description(&self) -> &str {
+        "time.Sleep() in request handlers."
+    }
+
+    fn check(&self, file: &SourceFile) -> Vec<RuleViolation> {
+        file.find("time.Sleep(", "Use a timer instead of time.Sleep().")
+    }
+}
+
+#[test]
+fn lists_registered_custom_rules() {
+    register_rule(NoSleep);
+    let rules = rule_catalog();
+    let custom = rules.iter().find(|r| r.id == "catalog_no_sleep").unwrap();
+    assert_eq!(custom.language, None);
+    assert_eq!(custom.severity, "error");
+    assert_eq!(custom.description, "time.Sleep() in request handlers.");
+}
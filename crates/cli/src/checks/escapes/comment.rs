@@ -5,6 +5,14 @@
 
 /// Check if there's a justifying comment for a pattern match.
 ///
+/// The justification is accepted in two places:
+/// - a trailing comment on the same line (`p := unsafe.Pointer(&x) // SAFETY: ...`)
+/// - anywhere in the contiguous comment block directly above the line
+///
+/// A blank line or a code line ends the block, so a comment separated from
+/// the match doesn't count. Go directives (`//go:linkname`) are comment lines,
+/// so stacked directives share the block above them.
+///
 /// The pattern must appear at the start of a comment's content, not embedded
 /// within other text. For example, `// SAFETY:` embedded inside
 /// `// VIOLATION: missing // SAFETY: comment` should NOT match.
@@ -29,19 +37,19 @@ pub(super) fn has_justification_comment(
         }
     }
 
-    // Search upward through comments and blank lines
+    // Search upward through the contiguous comment block
     if line_idx > 0 {
         for i in (0..line_idx).rev() {
             let line = lines[i].trim();
 
-            // Check for comment pattern at start of comment
-            if is_comment_line(line) && comment_starts_with_pattern(line, comment_pattern) {
-                return true;
+            // Stop at blank lines and code
+            if !is_comment_line(line) {
+                break;
             }
 
-            // Stop at non-blank, non-comment lines
-            if !line.is_empty() && !is_comment_line(line) {
-                break;
+            // Check for comment pattern at start of comment
+            if comment_starts_with_pattern(line, comment_pattern) {
+                return true;
             }
         }
     }
@@ -53,10 +61,46 @@ pub(super) fn has_justification_comment(
 ///
 /// Normalizes both the comment and pattern by stripping comment markers,
 /// then checks if the comment content starts with the pattern content.
+///
+/// A combined justification chains several markers before the reason
+/// (e.g., `// NOSPLIT: NOESCAPE: reason` for stacked Go directives),
+/// so each leading `MARKER:` keyword is also tried as a start position.
+///
+/// The pattern may list alternatives separated by `|` (e.g.,
+/// `// SAFETY:|// JUSTIFY:`); any one of them is accepted.
 fn comment_starts_with_pattern(comment: &str, pattern: &str) -> bool {
     let comment_content = strip_comment_markers(comment);
-    let pattern_content = strip_comment_markers(pattern);
-    comment_content.starts_with(&pattern_content)
+    let alternatives: Vec<String> = pattern
+        .split('|')
+        .map(strip_comment_markers)
+        .filter(|alt| !alt.is_empty())
+        .collect();
+
+    let mut rest = comment_content.as_str();
+    loop {
+        if alternatives
+            .iter()
+            .any(|alt| rest.starts_with(alt.as_str()))
+        {
+            return true;
+        }
+        match strip_leading_marker(rest) {
+            Some(next) => rest = next,
+            None => return false,
+        }
+    }
+}
+
+/// Strip one leading `MARKER:` keyword (uppercase letters and underscores)
+/// and the whitespace after it. Returns None if the text doesn't start with one.
+fn strip_leading_marker(s: &str) -> Option<&str> {
+    let end = s
+        .find(|c: char| !(c.is_ascii_uppercase() || c == '_'))
+        .unwrap_or(s.len());
+    if end == 0 {
+        return None;
+    }
+    s[end..].strip_prefix(':').map(str::trim_start)
 }
 
 /// Strip comment markers and leading whitespace to get the content.
@@ -77,7 +121,7 @@ pub(super) fn strip_comment_markers(s: &str) -> String {
 }
 
 /// Find the start of a comment in a line (returns byte offset of comment marker).
-fn find_comment_start(line: &str) -> Option<usize> {
+pub(super) fn find_comment_start(line: &str) -> Option<usize> {
     // Find // comment (most common)
     if let Some(pos) = line.find("//") {
         return Some(pos);
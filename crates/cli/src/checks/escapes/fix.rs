@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Justification stubs for `--fix`.
+//!
+//! For patterns that require a comment (`unsafe.Pointer`, `//go:linkname`,
+//! ...), `--fix` inserts `<marker> TODO explain` on the line above each
+//! unjustified match, at the match line's indentation, so engineers only
+//! have to fill in the reason.
+
+use std::collections::BTreeMap;
+
+/// Placeholder reason written after the marker.
+pub const STUB_REASON: &str = "TODO explain";
+
+/// The stub for a configured marker (`// SAFETY:` -> `// SAFETY: TODO explain`).
+///
+/// Alternatives (`// SAFETY:|// JUSTIFY:`) use the first. Markers that aren't
+/// line comments (`//` or `#`) return None and are left for manual fixing.
+pub fn stub_comment(marker: &str) -> Option<String> {
+    let marker = marker.split('|').next().unwrap_or(marker).trim();
+    if !(marker.starts_with("//") || marker.starts_with('#')) {
+        return None;
+    }
+    Some(format!("{} {}", marker, STUB_REASON))
+}
+
+/// Insert stubs above 1-based lines, returning the new content and the
+/// number of stubs inserted.
+///
+/// Each stub takes the indentation and line ending of the line below it.
+/// A stub already in the comment block directly above a line isn't added
+/// again, so re-running never stacks stubs.
+pub fn insert_stubs(content: &str, stubs: &[(u32, String)]) -> (String, usize) {
+    let mut by_line: BTreeMap<u32, Vec<&str>> = BTreeMap::new();
+    for (line, stub) in stubs {
+        let entry = by_line.entry(*line).or_default();
+        if !entry.contains(&stub.as_str()) {
+            entry.push(stub);
+        }
+    }
+
+    let lines: Vec<&str> = content.split_inclusive('\n').collect();
+    let mut out = String::with_capacity(content.len() + stubs.len() * 32);
+    let mut added = 0;
+    for (idx, line) in lines.iter().enumerate() {
+        if let Some(line_stubs) = by_line.get(&(idx as u32 + 1)) {
+            let indent = &line[..line.len() - line.trim_start().len()];
+            let eol = if line.ends_with("\r\n") { "\r\n" } else { "\n" };
+            let block = comment_block_above(&lines, idx);
+            for stub in line_stubs {
+                if block.contains(stub) {
+                    continue;
+                }
+                out.push_str(indent);
+                out.push_str(stub);
+                out.push_str(eol);
+                added += 1;
+            }
+        }
+        out.push_str(line);
+    }
+    (out, added)
+}
+
+/// Trimmed lines of the contiguous comment block directly above `idx`.
+fn comment_block_above<'a>(lines: &[&'a str], idx: usize) -> Vec<&'a str> {
+    lines[..idx]
+        .iter()
+        .rev()
+        .map(|line| line.trim())
+        .take_while(|line| line.starts_with("//") || line.starts_with('#'))
+        .collect()
+}
+
+#[cfg(test)]
+#[path = "fix_tests.rs"]
+mod tests;
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    safety = { "// SAFETY:", Some("// SAFETY: TODO explain") },
+    alternatives = { "// SAFETY:|// JUSTIFY:", Some("// SAFETY: TODO explain") },
+    hash = { "# NOQA:", Some("# NOQA: TODO explain") },
+    not_a_comment = { "SAFETY:", None },
+)]
+fn stub_comment_for_marker(marker: &str, expected: Option<&str>) {
+    assert_eq!(stub_comment(marker).as_deref(), expected);
+}
+
+#[test]
+fn inserts_stub_with_indentation() {
+    let content = "func f() {\n\tp := unsafe.Pointer(&x)\n}\n";
+    let (fixed, added) = insert_stubs(content, &[(2, "// SAFETY: TODO explain".into())]);
+    assert_eq!(added, 1);
+    assert_eq!(
+        fixed,
+        "func f() {\n\t// SAFETY: TODO explain\n\tp := unsafe.Pointer(&x)\n}\n"
+    );
+}
+
+#[test]
+fn preserves_crlf_line_endings() {
+    let content = "package p\r\n//go:linkname now runtime.now\r\n";
+    let (fixed, _) = insert_stubs(content, &[(2, "// LINKNAME: TODO explain".into())]);
+    assert_eq!(
+        fixed,
+        "package p\r\n// LINKNAME: TODO explain\r\n//go:linkname now runtime.now\r\n"
+    );
+}
+
+#[test]
+fn different_markers_on_one_line_stack_in_order() {
+    let content = "x\n";
+    let stubs = [
+        (1, "// A: TODO explain".to_string()),
+        (1, "// B: TODO explain".to_string()),
+        (1, "// A: TODO explain".to_string()),
+    ];
+    let (fixed, added) = insert_stubs(content, &stubs);
+    assert_eq!(added, 2);
+    assert_eq!(fixed, "// A: TODO explain\n// B: TODO explain\nx\n");
+}
+
+#[test]
+fn existing_stub_is_not_repeated() {
+    let content = "// SAFETY: TODO explain\n//go:nosplit\nfunc f() {}\n";
+    let (fixed, added) = insert_stubs(content, &[(2, "// SAFETY: TODO explain".into())]);
+    assert_eq!(added, 0);
+    assert_eq!(fixed, content);
+}
+
+#[test]
+fn rerun_is_idempotent() {
+    let content = "a\n  b\n";
+    let stubs = [(2, "// SAFETY: TODO explain".to_string())];
+    let (once, _) = insert_stubs(content, &stubs);
+    // The match moved down a line
+    let (twice, added) = insert_stubs(&once, &[(3, stubs[0].1.clone())]);
+    assert_eq!(added, 0);
+    assert_eq!(twice, once);
+}
+
+#[test]
+fn missing_trailing_newline_is_kept() {
+    let (fixed, _) = insert_stubs("a\nb", &[(2, "// X: TODO explain".into())]);
+    assert_eq!(fixed, "a\n// X: TODO explain\nb");
+}
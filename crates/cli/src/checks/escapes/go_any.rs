@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go exported `any` checking for the escapes check.
+//!
+//! `any` and `interface{}` in an exported signature push type checking onto
+//! every caller. Projects can opt in via `[golang.any]` to require an
+//! `// ANY:` comment on exported functions and methods that take or return
+//! them, optionally only in packages matching `packages`. Type parameter
+//! lists are skipped, so `any` as a constraint isn't matched.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::module_for;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoAnyConfig};
+
+use super::comment::has_justification_comment;
+use super::go_init::is_scoped_package;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for exported signatures using `any`.
+pub const EXPORTED_ANY: &str = "exported_any";
+
+/// Required justification marker.
+pub const ANY_COMMENT: &str = "// ANY:";
+
+/// Signatures longer than this many lines aren't read.
+const MAX_SIGNATURE_LINES: usize = 50;
+
+/// A top-level function or method declaration.
+#[allow(clippy::expect_used)]
+pub(super) static FUNC_DECL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^func\b").expect("valid regex pattern"));
+
+/// `any` or the empty interface anywhere in a type.
+#[allow(clippy::expect_used)]
+static ANY_TYPE: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"\bany\b|\binterface\s*\{\s*\}").expect("valid regex pattern"));
+
+/// A parameter or result with a name: `v any`, `args ...any`.
+#[allow(clippy::expect_used)]
+static NAMED_FIELD: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^\s*\w+\s+(\S.*)$").expect("valid regex pattern"));
+
+/// An exported function or method whose signature uses `any`.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct ExportedAny {
+    /// 1-based line of the declaration.
+    pub line: u32,
+    /// 1-based column of the function's name.
+    pub column: u32,
+    /// The function or method name.
+    pub name: String,
+}
+
+/// A declaration's signature, split into its parts.
+pub(super) struct Signature<'a> {
+    /// Receiver list of a method (without parentheses).
+    pub(super) receiver: Option<&'a str>,
+    pub(super) name: &'a str,
+    /// Byte offset of the name in the signature.
+    pub(super) name_offset: usize,
+    /// Parameter list (without parentheses).
+    pub(super) params: &'a str,
+    /// Result list (without parentheses) or single result type.
+    pub(super) results: &'a str,
+}
+
+impl Signature<'_> {
+    /// Whether the function is exported, and for a method, its receiver type too.
+    pub(super) fn is_exported(&self) -> bool {
+        is_exported(self.name) && self.receiver.is_none_or(|r| is_exported(receiver_type(r)))
+    }
+
+    /// Whether a parameter or result type uses `any` or `interface{}`.
+    fn uses_any(&self) -> bool {
+        field_types(self.params)
+            .into_iter()
+            .chain(field_types(self.results))
+            .any(|t| ANY_TYPE.is_match(t))
+    }
+}
+
+fn is_exported(name: &str) -> bool {
+    name.starts_with(|c: char| c.is_uppercase())
+}
+
+/// Base type name of a receiver: `s *Set[T]` is `Set`.
+fn receiver_type(receiver: &str) -> &str {
+    let receiver = receiver.split('[').next().unwrap_or(receiver);
+    receiver
+        .split_whitespace()
+        .last()
+        .unwrap_or("")
+        .trim_start_matches('*')
+}
+
+/// Find exported functions and methods whose parameters or results use
+/// `any` or `interface{}`, skipping comments and strings.
+pub fn find_exported_any(source: &GoSource) -> Vec<ExportedAny> {
+    let mut found = Vec::new();
+    for (idx, line, code) in source.lines() {
+        if !FUNC_DECL.is_match(code) {
+            continue;
+        }
+        let text = signature_text(&source.masked[idx..]);
+        let Some(signature) = parse_signature(&text) else {
+            continue;
+        };
+        if !signature.is_exported() || !signature.uses_any() {
+            continue;
+        }
+        let column = line
+            .get(..signature.name_offset)
+            .map_or(0, |before| before.chars().count());
+        found.push(ExportedAny {
+            line: idx as u32 + 1,
+            column: column as u32 + 1,
+            name: signature.name.to_string(),
+        });
+    }
+    found
+}
+
+/// The declaration starting at the first line, joined with spaces until its
+/// brackets balance.
+pub(super) fn signature_text(lines: &[String]) -> String {
+    let mut text = String::new();
+    let mut depth = 0i32;
+    for line in lines.iter().take(MAX_SIGNATURE_LINES) {
+        if !text.is_empty() {
+            text.push(' ');
+        }
+        text.push_str(line);
+        for b in line.bytes() {
+            match b {
+                b'(' | b'[' => depth += 1,
+                b')' | b']' => depth -= 1,
+                _ => {}
+            }
+        }
+        if depth <= 0 {
+            break;
+        }
+    }
+    text
+}
+
+pub(super) fn parse_signature(text: &str) -> Option<Signature<'_>> {
+    let mut pos = skip_space(text, "func".len());
+    let receiver = if text[pos..].starts_with('(') {
+        let end = closing(text, pos)?;
+        let receiver = &text[pos + 1..end];
+        pos = skip_space(text, end + 1);
+        Some(receiver)
+    } else {
+        None
+    };
+
+    let name_len = text[pos..]
+        .find(|c: char| !(c.is_alphanumeric() || c == '_'))
+        .unwrap_or(text.len() - pos);
+    if name_len == 0 {
+        return None;
+    }
+    let name_offset = pos;
+    let name = &text[pos..pos + name_len];
+    pos = skip_space(text, pos + name_len);
+
+    // Type parameters and their constraints aren't part of the API's types
+    if text[pos..].starts_with('[') {
+        pos = skip_space(text, closing(text, pos)? + 1);
+    }
+    if !text[pos..].starts_with('(') {
+        return None;
+    }
+    let end = closing(text, pos)?;
+    let params = &text[pos + 1..end];
+
+    let rest = text[end + 1..].trim_start();
+    let results = if rest.starts_with('(') {
+        &rest[1..closing(rest, 0)?]
+    } else {
+        result_type(rest)
+    };
+    Some(Signature {
+        receiver,
+        name,
+        name_offset,
+        params,
+        results,
+    })
+}
+
+fn skip_space(text: &str, pos: usize) -> usize {
+    text[pos..]
+        .find(|c: char| !c.is_whitespace())
+        .map_or(text.len(), |offset| pos + offset)
+}
+
+/// Byte index of the bracket closing the one at `open`.
+pub(super) fn closing(text: &str, open: usize) -> Option<usize> {
+    let mut depth = 0usize;
+    for (i, b) in text.bytes().enumerate().skip(open) {
+        match b {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' => {
+                depth = depth.checked_sub(1)?;
+                if depth == 0 {
+                    return Some(i);
+                }
+            }
+            _ => {}
+        }
+    }
+    None
+}
+
+/// A single unparenthesized result type, up to the function body.
+fn result_type(rest: &str) -> &str {
+    let mut i = 0;
+    while i < rest.len() {
+        match rest.as_bytes()[i] {
+            b'(' | b'[' => match closing(rest, i) {
+                Some(end) => i = end,
+                None => break,
+            },
+            b'{' => {
+                let before = rest[..i].trim_end();
+                let is_type = before.ends_with("interface") || before.ends_with("struct");
+                match closing(rest, i) {
+                    Some(end) if is_type => i = end,
+                    _ => return rest[..i].trim(),
+                }
+            }
+            _ => {}
+        }
+        i += 1;
+    }
+    rest.trim()
+}
+
+/// Types of a parameter or result list, without names.
+pub(super) fn field_types(list: &str) -> Vec<&str> {
+    let mut fields = Vec::new();
+    let mut depth = 0usize;
+    let mut start = 0;
+    for (i, b) in list.bytes().enumerate() {
+        match b {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' => depth = depth.saturating_sub(1),
+            b',' if depth == 0 => {
+                fields.push(&list[start..i]);
+                start = i + 1;
+            }
+            _ => {}
+        }
+    }
+    fields.push(&list[start..]);
+    fields
+        .into_iter()
+        .map(|field| {
+            NAMED_FIELD
+                .captures(field)
+                .and_then(|c| c.get(1))
+                .map_or(field, |m| m.as_str())
+        })
+        .collect()
+}
+
+/// Check exported Go signatures using `any` and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoAnyConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !(file.source.content.contains("any") || file.source.content.contains("interface"))
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let globs = (!self.packages.is_empty()).then(|| build_glob_set(&self.packages));
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if !is_scoped_package(globs.as_ref(), &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        for func in find_exported_any(&file.source) {
+            if has_justification_comment(file.source.content, func.line, ANY_COMMENT) {
+                continue;
+            }
+
+            let advice = format!(
+                "{} takes or returns any, so callers lose type checking. \
+Use a concrete type, an interface with the methods it needs, or a type parameter, \
+or add an // ANY: comment explaining why.",
+                func.name
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                func.line,
+                "missing_comment",
+                &advice,
+                EXPORTED_ANY,
+            ) {
+                let mut v = v.with_column(func.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_any_tests.rs"]
+mod tests;
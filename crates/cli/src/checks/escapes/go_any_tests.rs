@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn names_in(content: &str) -> Vec<String> {
+    find_exported_any(&GoSource::new(content))
+        .into_iter()
+        .map(|f| f.name)
+        .collect()
+}
+
+#[test]
+fn finds_exported_functions_and_methods() {
+    let content = "package codec
+
+func Decode(data []byte, v any) error { return nil }
+
+func (c *Codec) Load(key string) (interface{}, error) {
+	return nil, nil
+}
+";
+    assert_eq!(
+        find_exported_any(&GoSource::new(content)),
+        vec![
+            ExportedAny {
+                line: 3,
+                column: 6,
+                name: "Decode".to_string(),
+            },
+            ExportedAny {
+                line: 5,
+                column: 17,
+                name: "Load".to_string(),
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    variadic = { "package p\n\nfunc Log(args ...any) {}\n" },
+    nested = { "package p\n\nfunc Index(m map[string][]any) {}\n" },
+    single_result = { "package p\n\nfunc Get() any {\n\treturn nil\n}\n" },
+    empty_interface_result = { "package p\n\nfunc Get() interface{} { return nil }\n" },
+    func_param = { "package p\n\nfunc Walk(fn func(v any) error) {}\n" },
+    grouped = { "package p\n\nfunc Swap(a, b any) {}\n" },
+    multi_line = { "package p\n\nfunc Merge(\n\tdst map[string]string,\n\tsrc any,\n) error {\n\treturn nil\n}\n" },
+    generic_receiver = { "package p\n\nfunc (s *Set[T]) Raw() []any { return nil }\n" },
+)]
+fn flags_any_in_exported_signatures(content: &str) {
+    assert_eq!(names_in(content).len(), 1, "{:?}", names_in(content));
+}
+
+#[parameterized(
+    unexported = { "package p\n\nfunc decode(v any) error { return nil }\n" },
+    unexported_receiver = { "package p\n\nfunc (c *codec) Decode(v any) error { return nil }\n" },
+    type_parameter = { "package p\n\nfunc Map[T, U any](s []T, f func(T) U) []U { return nil }\n" },
+    constraint = { "package p\n\nfunc Keys[M ~map[K]V, K comparable, V any](m M) []K { return nil }\n" },
+    parameter_named_any = { "package p\n\nfunc Count(any int) int { return any }\n" },
+    non_empty_interface = { "package p\n\nfunc Open() interface{ Close() error } { return nil }\n" },
+    body_only = { "package p\n\nfunc Run() {\n\tvar v any = 1\n\t_ = v\n}\n" },
+    comment = { "package p\n\n// func Decode(v any) error\nfunc Decode(v []byte) error { return nil }\n" },
+    func_literal = { "package p\n\nvar Decode = func(v any) error { return nil }\n" },
+)]
+fn ignores_other_declarations(content: &str) {
+    assert!(names_in(content).is_empty(), "{:?}", names_in(content));
+}
+
+#[parameterized(
+    plain = { "", "" },
+    name = { "v any", "any" },
+    variadic = { "args ...any", "...any" },
+    func_type = { "f func(a, b int) error", "func(a, b int) error" },
+)]
+fn field_types_drop_names(list: &str, expected: &str) {
+    assert_eq!(field_types(list), vec![expected]);
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go aliasing append checking for the escapes check.
+//!
+//! `b := append(a[:0], x)` reuses `a`'s backing array: the append writes `x`
+//! over `a[0]`, so `b` and `a` now share their elements. That's the point
+//! when `a` is done with, but when `a` is read afterwards it sees `b`'s
+//! elements, not its own. Flags appends to `s[:0]` or `s[:0:n]` assigned to
+//! another variable when `s` is used again later in the same function. Off by
+//! default; enable via `[golang.appendalias]`.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoAppendAliasConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for appends that alias a slice used later.
+pub const ALIASED_APPEND: &str = "aliased_append";
+
+/// An append to a truncated slice assigned to a variable or field:
+/// `b := append(a[:0], x)`, `r.buf = append(a[:0:n], x...)`.
+#[allow(clippy::expect_used)]
+static TRUNCATE_APPEND: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(
+        r"^\s*([A-Za-z_]\w*(?:\.\w+)*)\s*:?=\s*append\s*\(\s*([A-Za-z_]\w*)\s*\[\s*:\s*0\s*(?::\s*([^\]]+?)\s*)?\]",
+    )
+    .expect("valid regex pattern")
+});
+
+/// An append whose result shares its backing array with a slice used later.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct AliasedAppend {
+    /// 1-based line number of the append.
+    pub line: u32,
+    /// 1-based column of the truncated slice.
+    pub column: u32,
+    /// The variable assigned the append's result, e.g. `b`.
+    pub target: String,
+    /// The slice whose backing array is reused, e.g. `a`.
+    pub source: String,
+    /// 1-based line number where `source` is used again.
+    pub used_at: u32,
+}
+
+/// Byte offset of the first use of `name` in a line of masked code, skipping
+/// fields and methods of other values (`x.name`).
+fn find_use(code: &str, name: &str) -> Option<usize> {
+    let bytes = code.as_bytes();
+    let is_ident = |b: u8| b == b'_' || b.is_ascii_alphanumeric();
+    code.match_indices(name).map(|(pos, _)| pos).find(|&pos| {
+        let end = pos + name.len();
+        let before = pos.checked_sub(1).map(|i| bytes[i]);
+        !before.is_some_and(|b| is_ident(b) || b == b'.')
+            && !bytes.get(end).is_some_and(|&b| is_ident(b))
+    })
+}
+
+/// Whether a line assigns `name` a new value (`name = ...`, `name := ...`),
+/// after which it no longer aliases anything.
+fn reassigns(code: &str, name: &str) -> bool {
+    let Some(rest) = code.trim_start().strip_prefix(name) else {
+        return false;
+    };
+    let rest = rest.trim_start();
+    (rest.starts_with('=') && !rest.starts_with("==")) || rest.starts_with(":=")
+}
+
+/// Find appends to `s[:0]` or `s[:0:n]` assigned to another variable when `s`
+/// is used again later in the same top-level function, skipping comments and
+/// strings.
+///
+/// `s[:0:0]` caps the slice at zero, so the append copies and is not found,
+/// nor is `s = append(s[:0], ...)`, which filters `s` in place. A later
+/// assignment to `s` ends the search, since `s` no longer holds the shared
+/// array. Only plain names are followed as the source: `r.buf[:0]` isn't
+/// checked.
+pub fn find_aliased_appends(source: &GoSource) -> Vec<AliasedAppend> {
+    let code = &source.masked;
+
+    let mut found = Vec::new();
+    for (idx, line, masked) in source.lines() {
+        let Some(captures) = TRUNCATE_APPEND.captures(masked) else {
+            continue;
+        };
+        let (Some(target), Some(aliased)) = (captures.get(1), captures.get(2)) else {
+            continue;
+        };
+        if target.as_str() == aliased.as_str() {
+            continue;
+        }
+        if captures.get(3).is_some_and(|cap| cap.as_str() == "0") {
+            continue;
+        }
+
+        // Search the rest of the function, which ends at a closing brace in
+        // the first column
+        let used_at = code[idx + 1..]
+            .iter()
+            .enumerate()
+            .take_while(|(_, later)| !later.starts_with('}') && !later.starts_with("func"))
+            .find_map(|(offset, later)| {
+                if reassigns(later, aliased.as_str()) {
+                    Some(None)
+                } else {
+                    find_use(later, aliased.as_str()).map(|_| Some(idx + offset + 2))
+                }
+            })
+            .flatten();
+        let Some(used_at) = used_at else {
+            continue;
+        };
+
+        found.push(AliasedAppend {
+            line: idx as u32 + 1,
+            column: line[..aliased.start()].chars().count() as u32 + 1,
+            target: target.as_str().to_string(),
+            source: aliased.as_str().to_string(),
+            used_at: used_at as u32,
+        });
+    }
+    found
+}
+
+/// Check Go appends for reuse of a slice used later and return violations.
+impl GoRule for GoAppendAliasConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("append") {
+            return violations;
+        }
+
+        for found in find_aliased_appends(&file.source) {
+            let advice = format!(
+                "{target} reuses the backing array of {source}, which is used again on line {used_at}: \
+the append overwrites {source}'s elements. Append to {source}[:0:0] or use slices.Clone to copy.",
+                target = found.target,
+                source = found.source,
+                used_at = found.used_at
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                ALIASED_APPEND,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_appendalias_tests.rs"]
+mod tests;
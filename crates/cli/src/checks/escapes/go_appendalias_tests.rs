@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn sources_in(body: &str) -> Vec<String> {
+    let content = format!("package p\n\nfunc f(a, xs []int, r *R) {{\n{}\n}}\n", body);
+    find_aliased_appends(&GoSource::new(&content))
+        .into_iter()
+        .map(|found| found.source)
+        .collect()
+}
+
+#[test]
+fn finds_aliased_append_with_column() {
+    let content = "package p
+
+func evens(nums []int) ([]int, int) {
+	out := append(nums[:0:len(nums)], 0)
+	for _, n := range nums {
+		if n%2 == 0 {
+			out = append(out, n)
+		}
+	}
+	return out, len(nums)
+}
+";
+    assert_eq!(
+        find_aliased_appends(&GoSource::new(content)),
+        vec![AliasedAppend {
+            line: 4,
+            column: 16,
+            target: "out".to_string(),
+            source: "nums".to_string(),
+            used_at: 5,
+        }]
+    );
+}
+
+#[parameterized(
+    truncate = { "\tb := append(a[:0], 1)\n\tuse(a)" },
+    full_slice = { "\tb := append(a[:0:4], 1)\n\tuse(a)" },
+    assign = { "\tvar b []int\n\tb = append(a[:0], xs...)\n\t_ = a[0]" },
+    to_field = { "\tr.buf = append(a[:0], 1)\n\treturn len(a)" },
+    spaced = { "\tb := append( a[ : 0 ], 1)\n\tuse(a)" },
+)]
+fn aliasing_appends_are_found(body: &str) {
+    assert_eq!(sources_in(body), vec!["a".to_string()]);
+}
+
+#[parameterized(
+    in_place = { "\ta = append(a[:0], 1)\n\tuse(a)" },
+    zero_cap = { "\tb := append(a[:0:0], 1)\n\tuse(a)" },
+    not_truncated = { "\tb := append(a[:1], 1)\n\tuse(a)" },
+    not_used_again = { "\tb := append(a[:0], 1)\n\tuse(b)" },
+    reassigned = { "\tb := append(a[:0], 1)\n\ta = b\n\tuse(a)" },
+    field_of_other = { "\tb := append(a[:0], 1)\n\tuse(r.a)" },
+    longer_name = { "\tb := append(a[:0], 1)\n\tuse(ab)" },
+    commented = { "\t// b := append(a[:0], 1)\n\tuse(a)" },
+    in_string = { "\tb := append(a[:0], 1)\n\tuse(\"a\")" },
+    field_source = { "\tb := append(r.a[:0], 1)\n\tuse(r.a)" },
+)]
+fn non_aliasing_appends_are_ok(body: &str) {
+    assert!(sources_in(body).is_empty());
+}
+
+#[test]
+fn uses_are_scoped_to_their_function() {
+    let content = "package p
+
+func reset(a []int) []int {
+	b := append(a[:0], 1)
+	return b
+}
+
+func other(a []int) int {
+	return len(a)
+}
+";
+    assert!(find_aliased_appends(&GoSource::new(content)).is_empty());
+}
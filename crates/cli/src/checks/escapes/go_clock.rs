@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go wall clock checking for the escapes check.
+//!
+//! Code that reads the wall clock directly gives a different result on
+//! every run, so it can't be replayed or tested without sleeping.
+//! Deterministic packages should take the time from an injected clock.
+//! Projects can opt in via `[golang.clock]` to flag `time.Now()` calls in
+//! packages matching `packages`, unless a `// CLOCK:` comment explains
+//! them; other packages are never checked.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::{module_for, parse_imports};
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoClockConfig};
+
+use super::comment::has_justification_comment;
+use super::go_init::is_scoped_package;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for wall clock reads in deterministic packages.
+pub const TIME_NOW: &str = "time_now";
+
+/// Required justification marker.
+pub const CLOCK_COMMENT: &str = "// CLOCK:";
+
+/// A selector call of `Now`: `time.Now(`.
+#[allow(clippy::expect_used)]
+static NOW_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])(\w+)\.Now\s*\(").expect("valid regex pattern"));
+
+/// A `time.Now()` call site.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct NowCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+}
+
+/// Find `time.Now()` calls in code, skipping comments and strings.
+///
+/// Calls are matched under the local name `time` is imported as, so
+/// `stdtime.Now()` counts with `import stdtime "time"`. Dot-imported calls
+/// aren't matched.
+pub fn find_now_calls(source: &GoSource) -> Vec<NowCall> {
+    let names: Vec<String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| import.path == "time")
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some("time".to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect();
+    if names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut calls = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in NOW_CALL.captures_iter(code) {
+            let Some(qualifier) = captures.get(1) else {
+                continue;
+            };
+            if !names.iter().any(|name| name == qualifier.as_str()) {
+                continue;
+            }
+            calls.push(NowCall {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+            });
+        }
+    }
+    calls
+}
+
+/// Check Go `time.Now()` calls and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoClockConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || self.packages.is_empty()
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("Now")
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let globs = build_glob_set(&self.packages);
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if !is_scoped_package(Some(&globs), &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        for call in find_now_calls(&file.source) {
+            if has_justification_comment(file.source.content, call.line, CLOCK_COMMENT) {
+                continue;
+            }
+
+            let advice = format!(
+                "Package {} is deterministic; time.Now() makes results depend on when it runs. \
+Take the time from an injected clock (a func() time.Time or Clock interface) instead. \
+If the wall clock is intended, add a // CLOCK: comment explaining why.",
+                dir
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "missing_comment",
+                &advice,
+                TIME_NOW,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_clock_tests.rs"]
+mod tests;
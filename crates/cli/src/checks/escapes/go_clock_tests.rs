@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_now_calls_with_columns() {
+    let content = "package ledger\n\nimport \"time\"\n\nfunc stamp() {\n\tat := time.Now()\n\tlog(time.Now().Unix())\n}\n";
+    assert_eq!(
+        find_now_calls(&GoSource::new(content)),
+        vec![
+            NowCall { line: 6, column: 8 },
+            NowCall { line: 7, column: 6 }
+        ]
+    );
+}
+
+#[test]
+fn matches_aliased_import() {
+    let content = "package ledger\n\nimport stdtime \"time\"\n\nfunc stamp() {\n\t_ = stdtime.Now()\n\t_ = time.Now()\n}\n";
+    assert_eq!(
+        find_now_calls(&GoSource::new(content)),
+        vec![NowCall { line: 6, column: 6 }]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package lib\n\nfunc f() {\n\t_ = time.Now()\n}\n" },
+    dot_import = { "package lib\n\nimport . \"time\"\n\nfunc f() {\n\t_ = Now()\n}\n" },
+    comment = { "package lib\n\nimport \"time\"\n\n// time.Now() differs per run\n" },
+    string = { "package lib\n\nimport \"time\"\n\nvar s = \"time.Now()\"\n" },
+    injected_clock = { "package lib\n\nimport \"time\"\n\nfunc f(clock Clock) time.Time {\n\treturn clock.Now()\n}\n" },
+    selector_chain = { "package lib\n\nimport \"time\"\n\nfunc f() {\n\t_ = s.time.Now()\n}\n" },
+    other_function = { "package lib\n\nimport \"time\"\n\nfunc f(t time.Time) {\n\t_ = time.Since(t)\n}\n" },
+)]
+fn ignores_other_calls(content: &str) {
+    assert!(find_now_calls(&GoSource::new(content)).is_empty());
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go root context checking for the escapes check.
+//!
+//! Request-scoped code should pass on the context it was handed, so
+//! cancellation, deadlines, and values reach every call it makes. A fresh
+//! `context.Background()` or `context.TODO()` cuts that chain. Projects can
+//! opt in via `[golang.context]` to flag both in packages matching
+//! `packages`; other packages are never checked.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::{module_for, parse_imports};
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoContextConfig};
+
+use super::go_init::is_scoped_package;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `context.Background()` calls.
+pub const CONTEXT_BACKGROUND: &str = "context_background";
+
+/// Violation pattern name for `context.TODO()` calls (a warning by default).
+pub const CONTEXT_TODO: &str = "context_todo";
+
+/// A selector call of `Background` or `TODO`: `context.Background(`.
+#[allow(clippy::expect_used)]
+static ROOT_CONTEXT_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(Background|TODO)\s*\(").expect("valid regex pattern")
+});
+
+/// A `context.Background()` or `context.TODO()` call site.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct RootContextCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+    /// Pattern name: [`CONTEXT_BACKGROUND`] or [`CONTEXT_TODO`].
+    pub pattern: &'static str,
+}
+
+/// Find `context.Background()` and `context.TODO()` calls in code, skipping
+/// comments and strings.
+///
+/// Calls are matched under the local name `context` is imported as, so
+/// `stdctx.TODO()` counts with `import stdctx "context"`. Dot-imported calls
+/// aren't matched.
+pub fn find_root_context_calls(source: &GoSource) -> Vec<RootContextCall> {
+    let names: Vec<String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| import.path == "context")
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some("context".to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect();
+    if names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut calls = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in ROOT_CONTEXT_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(function)) = (captures.get(1), captures.get(2)) else {
+                continue;
+            };
+            if !names.iter().any(|name| name == qualifier.as_str()) {
+                continue;
+            }
+            calls.push(RootContextCall {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+                pattern: match function.as_str() {
+                    "TODO" => CONTEXT_TODO,
+                    _ => CONTEXT_BACKGROUND,
+                },
+            });
+        }
+    }
+    calls
+}
+
+/// Check Go root context calls and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoContextConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || self.packages.is_empty()
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("context")
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let globs = build_glob_set(&self.packages);
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if !is_scoped_package(Some(&globs), &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        for call in find_root_context_calls(&file.source) {
+            let advice = format!(
+                "Package {} is request-scoped; a fresh root context drops the caller's cancellation and deadline. \
+Accept a ctx context.Context parameter and pass it on, or use the request's r.Context().",
+                dir
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "forbidden",
+                &advice,
+                call.pattern,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_context_tests.rs"]
+mod tests;
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_background_and_todo_calls() {
+    let content = "package handler\n\nimport \"context\"\n\nfunc Serve() {\n\tctx := context.Background()\n\tload(context.TODO())\n}\n";
+    assert_eq!(
+        find_root_context_calls(&GoSource::new(content)),
+        vec![
+            RootContextCall {
+                line: 6,
+                column: 9,
+                pattern: CONTEXT_BACKGROUND
+            },
+            RootContextCall {
+                line: 7,
+                column: 7,
+                pattern: CONTEXT_TODO
+            },
+        ]
+    );
+}
+
+#[test]
+fn matches_aliased_import() {
+    let content = "package handler\n\nimport stdctx \"context\"\n\nfunc Serve() {\n\t_ = stdctx.TODO()\n\t_ = context.TODO()\n}\n";
+    assert_eq!(
+        find_root_context_calls(&GoSource::new(content)),
+        vec![RootContextCall {
+            line: 6,
+            column: 6,
+            pattern: CONTEXT_TODO
+        }]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package lib\n\nfunc f() {\n\t_ = context.Background()\n}\n" },
+    comment = { "package lib\n\nimport \"context\"\n\n// context.Background() loses the deadline\n" },
+    string = { "package lib\n\nimport \"context\"\n\nvar s = \"context.TODO()\"\n" },
+    other_receiver = { "package lib\n\nimport \"context\"\n\nfunc f() {\n\t_ = app.Background()\n}\n" },
+    selector_chain = { "package lib\n\nimport \"context\"\n\nfunc f() {\n\t_ = s.context.TODO()\n}\n" },
+    other_function = { "package lib\n\nimport \"context\"\n\nfunc f(ctx context.Context) {\n\t_, cancel := context.WithCancel(ctx)\n\tcancel()\n}\n" },
+)]
+fn ignores_other_calls(content: &str) {
+    assert!(find_root_context_calls(&GoSource::new(content)).is_empty());
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go context value key checking for the escapes check.
+//!
+//! `context.WithValue` looks a value up by its key's type as well as its
+//! value, so a key of a built-in type like `string` collides with any other
+//! package that picked the same string. Go's documentation asks for an
+//! unexported key type instead (`type ctxKey struct{}`). Flags
+//! `context.WithValue` calls whose key is a literal, a conversion to a
+//! built-in type, or a name declared with one. On by default via
+//! `[golang.ctxkey]`, as a warning.
+
+use std::collections::HashMap;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoCtxKeyConfig};
+
+use super::go_exit::import_names;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for context value keys of built-in types.
+pub const CONTEXT_KEY: &str = "context_key";
+
+/// Go's predeclared basic types.
+const BASIC_TYPES: &[&str] = &[
+    "bool",
+    "byte",
+    "complex64",
+    "complex128",
+    "float32",
+    "float64",
+    "int",
+    "int8",
+    "int16",
+    "int32",
+    "int64",
+    "rune",
+    "string",
+    "uint",
+    "uint8",
+    "uint16",
+    "uint32",
+    "uint64",
+    "uintptr",
+];
+
+/// A `WithValue` selector call: `context.WithValue(`.
+#[allow(clippy::expect_used)]
+static WITH_VALUE_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.WithValue\s*\(").expect("valid regex pattern")
+});
+
+/// A const or var spec, or a short variable declaration: `const k = "v"`,
+/// `var k string`, `k := "v"`, or `k = iota` inside a `const (` block.
+#[allow(clippy::expect_used)]
+static VALUE_SPEC: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^\s*(const\s+|var\s+)?([A-Za-z_]\w*)(?:\s+([A-Za-z_][\w.]*))?\s*(:?=)?")
+        .expect("valid regex pattern")
+});
+
+/// A conversion to a type: `string(`, `pkg.Key(`.
+#[allow(clippy::expect_used)]
+static CONVERSION: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^([A-Za-z_][\w.]*)\s*\(").expect("valid regex pattern"));
+
+/// A `context.WithValue` call whose key has a built-in type.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct BasicContextKey {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the key.
+    pub column: u32,
+    /// The key as written, e.g. `"user"`.
+    pub key: String,
+    /// The key's built-in type, e.g. `string`.
+    pub ty: String,
+}
+
+/// The built-in type of an expression, when it's a literal, a conversion to
+/// a built-in type, or a name declared with one.
+fn basic_type(expr: &str, names: &HashMap<String, String>) -> Option<String> {
+    let expr = expr.trim();
+    let first = expr.chars().next()?;
+    let ty = match first {
+        '"' | '`' => "string",
+        '\'' => "rune",
+        '0'..='9' | '-' | '.' if expr[1..].contains(['.', 'e', 'E']) && !expr.starts_with("0x") => {
+            "float64"
+        }
+        '0'..='9' | '-' => "int",
+        _ if expr == "true" || expr == "false" => "bool",
+        _ if expr == "iota" => "int",
+        _ => {
+            if let Some(captures) = CONVERSION.captures(expr) {
+                let ty = &captures[1];
+                return BASIC_TYPES.contains(&ty).then(|| ty.to_string());
+            }
+            return names.get(expr).cloned();
+        }
+    };
+    Some(ty.to_string())
+}
+
+/// Names declared with a built-in type, mapped to that type.
+///
+/// A const or var with a built-in type (`var k string`) or an untyped value
+/// of one (`const k = "user"`, `k := 1`) is recorded, as are the specs of a
+/// `const (` block that repeat the one above them. A name declared with any
+/// other type is dropped, since the last declaration wins.
+fn basic_names(source: &GoSource) -> HashMap<String, String> {
+    let mut names = HashMap::new();
+    let mut block: Option<&str> = None;
+    let mut previous: Option<String> = None;
+    for (_, line, code) in source.lines() {
+        let trimmed = code.trim();
+        if block.is_some() && trimmed.starts_with(')') {
+            block = None;
+            continue;
+        }
+        if let Some(keyword) = ["const", "var"]
+            .into_iter()
+            .find(|kw| trimmed.strip_prefix(kw).is_some_and(|r| r.trim() == "("))
+        {
+            block = Some(keyword);
+            previous = None;
+            continue;
+        }
+
+        let Some(captures) = VALUE_SPEC.captures(code) else {
+            continue;
+        };
+        let (Some(name), Some(end)) = (captures.get(2), captures.get(0)) else {
+            continue;
+        };
+        let keyword = captures.get(1).map(|kw| kw.as_str().trim());
+        let op = captures.get(4).map(|op| op.as_str());
+        if keyword.is_none() && block.is_none() && op != Some(":=") {
+            continue;
+        }
+
+        let ty = match (captures.get(3), op) {
+            (Some(ty), _) => BASIC_TYPES
+                .contains(&ty.as_str())
+                .then(|| ty.as_str().to_string()),
+            (None, Some(_)) => {
+                let value = line[end.end()..].split(',').next().unwrap_or_default();
+                basic_type(value, &names)
+            }
+            // A bare name in a const block repeats the spec above it
+            (None, None) if block == Some("const") && code[end.end()..].trim().is_empty() => {
+                previous.clone()
+            }
+            (None, None) => continue,
+        };
+        previous = ty.clone();
+        match ty {
+            Some(ty) => names.insert(name.as_str().to_string(), ty),
+            None => names.remove(name.as_str()),
+        };
+    }
+    names
+}
+
+/// Byte range of a call's second top-level argument, from just after its
+/// `(`, or None when it doesn't end on this line.
+fn second_argument(code: &str, open: usize) -> Option<(usize, usize)> {
+    let mut depth = 0usize;
+    let mut start = None;
+    for (pos, byte) in code.bytes().enumerate().skip(open) {
+        match byte {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' if depth > 0 => depth -= 1,
+            b',' | b')' if depth == 0 => match start {
+                Some(start) => return Some((start, pos)),
+                None if byte == b',' => start = Some(pos + 1),
+                None => return None,
+            },
+            _ => {}
+        }
+    }
+    None
+}
+
+/// Find `context.WithValue` calls whose key has a built-in type, skipping
+/// comments and strings.
+///
+/// A key is taken as built-in from a string, rune, number, or bool literal,
+/// a conversion like `string(name)`, or a name declared with a built-in type
+/// anywhere in the file ([`basic_names`]); shadowing isn't followed. Only
+/// keys on the call's own line are checked, under the local names `context`
+/// is imported as.
+pub fn find_basic_context_keys(source: &GoSource) -> Vec<BasicContextKey> {
+    let context_names = import_names(source.content, "context");
+    if context_names.is_empty() {
+        return Vec::new();
+    }
+    let names = basic_names(source);
+
+    let mut found = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in WITH_VALUE_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(whole)) = (captures.get(1), captures.get(0)) else {
+                continue;
+            };
+            if !context_names.iter().any(|n| n == qualifier.as_str()) {
+                continue;
+            }
+            let Some((start, end)) = second_argument(code, whole.end()) else {
+                continue;
+            };
+            let key = line[start..end].trim();
+            let Some(ty) = basic_type(key, &names) else {
+                continue;
+            };
+            let offset = start + (line[start..end].len() - line[start..end].trim_start().len());
+            found.push(BasicContextKey {
+                line: idx as u32 + 1,
+                column: line[..offset].chars().count() as u32 + 1,
+                key: key.to_string(),
+                ty,
+            });
+        }
+    }
+    found
+}
+
+/// Check Go `context.WithValue` calls for built-in key types and return
+/// violations.
+impl GoRule for GoCtxKeyConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("WithValue") {
+            return violations;
+        }
+
+        for found in find_basic_context_keys(&file.source) {
+            let advice = format!(
+                "The context key {key} has built-in type {ty}, so it can collide with keys set by \
+other packages. Declare an unexported key type, e.g. type ctxKey struct{{}}, and use a value of it.",
+                key = found.key,
+                ty = found.ty
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                CONTEXT_KEY,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_ctxkey_tests.rs"]
+mod tests;
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn types_in(decls: &str, body: &str) -> Vec<String> {
+    let content = format!(
+        "package p\n\nimport \"context\"\n\n{}\n\nfunc with(ctx context.Context, name string) context.Context {{\n{}\n}}\n",
+        decls, body
+    );
+    find_basic_context_keys(&GoSource::new(&content))
+        .into_iter()
+        .map(|found| found.ty)
+        .collect()
+}
+
+#[test]
+fn finds_string_key_with_column() {
+    let content = "package p
+
+import (
+	stdctx \"context\"
+)
+
+func WithUser(ctx stdctx.Context, user string) stdctx.Context {
+	return stdctx.WithValue(ctx, \"user\", user)
+}
+";
+    assert_eq!(
+        find_basic_context_keys(&GoSource::new(content)),
+        vec![BasicContextKey {
+            line: 8,
+            column: 31,
+            key: "\"user\"".to_string(),
+            ty: "string".to_string(),
+        }]
+    );
+}
+
+#[parameterized(
+    string_literal = { "", "\treturn context.WithValue(ctx, \"user\", name)", "string" },
+    raw_string = { "", "\treturn context.WithValue(ctx, `user`, name)", "string" },
+    int_literal = { "", "\treturn context.WithValue(ctx, 1, name)", "int" },
+    float_literal = { "", "\treturn context.WithValue(ctx, 1.5, name)", "float64" },
+    rune_literal = { "", "\treturn context.WithValue(ctx, 'u', name)", "rune" },
+    bool_literal = { "", "\treturn context.WithValue(ctx, true, name)", "bool" },
+    conversion = { "", "\treturn context.WithValue(ctx, string(name), name)", "string" },
+    untyped_const = { "const userKey = \"user\"", "\treturn context.WithValue(ctx, userKey, name)", "string" },
+    typed_var = { "var userKey string", "\treturn context.WithValue(ctx, userKey, name)", "string" },
+    short_decl = { "", "\tkey := \"user\"\n\treturn context.WithValue(ctx, key, name)", "string" },
+    iota_block = { "const (\n\tuserKey = iota\n\trequestKey\n)", "\treturn context.WithValue(ctx, requestKey, name)", "int" },
+    const_of_const = { "const base = \"user\"\nconst userKey = base", "\treturn context.WithValue(ctx, userKey, name)", "string" },
+)]
+fn basic_keys_are_found(decls: &str, body: &str, ty: &str) {
+    assert_eq!(types_in(decls, body), vec![ty.to_string()]);
+}
+
+#[parameterized(
+    struct_key = { "type ctxKey struct{}", "\treturn context.WithValue(ctx, ctxKey{}, name)" },
+    typed_const = { "type ctxKey string\n\nconst userKey ctxKey = \"user\"", "\treturn context.WithValue(ctx, userKey, name)" },
+    typed_iota = { "type ctxKey int\n\nconst (\n\tuserKey ctxKey = iota\n\trequestKey\n)", "\treturn context.WithValue(ctx, requestKey, name)" },
+    custom_conversion = { "type ctxKey string", "\treturn context.WithValue(ctx, ctxKey(\"user\"), name)" },
+    other_package = { "", "\treturn context.WithValue(ctx, keys.User, name)" },
+    unknown_name = { "", "\treturn context.WithValue(ctx, userKey, name)" },
+    commented = { "", "\t// context.WithValue(ctx, \"user\", name)\n\treturn ctx" },
+    in_string = { "", "\tlog(\"context.WithValue(ctx, \\\"user\\\", name)\")\n\treturn ctx" },
+    other_method = { "", "\treturn store.WithValue(ctx, \"user\", name)" },
+)]
+fn custom_keys_are_ok(decls: &str, body: &str) {
+    assert!(types_in(decls, body).is_empty());
+}
+
+#[test]
+fn keys_are_not_checked_without_context_import() {
+    let content = "package p
+
+func with(ctx Store) Store {
+	return context.WithValue(ctx, \"user\", 1)
+}
+";
+    assert!(find_basic_context_keys(&GoSource::new(content)).is_empty());
+}
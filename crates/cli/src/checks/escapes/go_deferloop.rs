@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go defer-in-loop checking for the escapes check.
+//!
+//! A deferred call runs when the surrounding function returns, not at the
+//! end of the loop iteration, so `defer f.Close()` in a loop holds every file
+//! open until the whole loop is done. Flags `defer` statements whose nearest
+//! enclosing function or loop is a `for` loop, unless a `// DEFERLOOP:`
+//! comment explains why. A defer inside a function literal called in the
+//! loop runs each iteration and passes. On by default via
+//! `[golang.deferloop]`, as a warning.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoDeferLoopConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `defer` statements in a loop body.
+pub const DEFER_LOOP: &str = "defer_loop";
+
+/// Required justification marker.
+pub const DEFERLOOP_COMMENT: &str = "// DEFERLOOP:";
+
+/// A `defer` statement: `defer`, at the start of a statement.
+#[allow(clippy::expect_used)]
+static DEFER_STATEMENT: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[;{}])\s*(defer)\b").expect("valid regex pattern"));
+
+/// A `for` statement header, optionally labeled: `for`, `outer: for`.
+#[allow(clippy::expect_used)]
+static FOR_HEADER: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^\s*(?:[A-Za-z_]\w*\s*:\s*)?for\b").expect("valid regex pattern")
+});
+
+/// The `func` keyword of a declaration or literal.
+#[allow(clippy::expect_used)]
+static FUNC_KEYWORD: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])func\b").expect("valid regex pattern"));
+
+/// What an open brace starts.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+enum Scope {
+    /// A function declaration's or literal's body.
+    Func,
+    /// A `for` loop's body.
+    Loop,
+    /// Any other block or literal: `if`, `switch`, a composite literal.
+    Block,
+}
+
+/// A `defer` statement in a loop body.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct LoopDefer {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `defer`.
+    pub column: u32,
+}
+
+/// What the brace at `open` starts, from the code before it on the line,
+/// back to an unclosed brace: `for ... {` is a loop, `func(...) {` a
+/// function. Braces closed before it, like a composite literal in
+/// `for _, x := range []int{1, 2} {`, are part of the header.
+fn scope_of(code: &str, open: usize) -> Scope {
+    let bytes = code.as_bytes();
+    let mut depth = 0usize;
+    let mut from = 0;
+    for pos in (0..open).rev() {
+        match bytes[pos] {
+            b'}' => depth += 1,
+            b'{' if depth == 0 => {
+                from = pos + 1;
+                break;
+            }
+            b'{' => depth -= 1,
+            _ => {}
+        }
+    }
+    let header = &code[from..open];
+    if FUNC_KEYWORD.is_match(header) {
+        Scope::Func
+    } else if FOR_HEADER.is_match(header) {
+        Scope::Loop
+    } else {
+        Scope::Block
+    }
+}
+
+/// Find `defer` statements whose nearest enclosing function or loop is a
+/// `for` loop, skipping comments and strings.
+///
+/// Braces are matched across the file, and a brace is taken as a loop's
+/// when the code before it on its line starts with `for`, or a function's
+/// when it holds `func`. A defer in an `if` or `switch` inside a loop is
+/// found; one in a function literal inside a loop, like
+/// `func() { defer mu.Unlock() }()`, isn't. Only `for` headers on one line
+/// are recognized.
+pub fn find_loop_defers(source: &GoSource) -> Vec<LoopDefer> {
+    let mut found = Vec::new();
+    let mut scopes: Vec<Scope> = Vec::new();
+    for (idx, line, code) in source.lines() {
+        let defers: Vec<usize> = DEFER_STATEMENT
+            .captures_iter(code)
+            .filter_map(|caps| caps.get(1))
+            .map(|m| m.start())
+            .collect();
+
+        for (pos, byte) in code.bytes().enumerate() {
+            if defers.contains(&pos) {
+                let enclosing = scopes.iter().rev().find(|scope| **scope != Scope::Block);
+                if enclosing == Some(&Scope::Loop) {
+                    found.push(LoopDefer {
+                        line: idx as u32 + 1,
+                        column: line[..pos].chars().count() as u32 + 1,
+                    });
+                }
+            }
+            match byte {
+                b'{' => scopes.push(scope_of(code, pos)),
+                b'}' => {
+                    scopes.pop();
+                }
+                _ => {}
+            }
+        }
+    }
+    found
+}
+
+/// Check Go loops for `defer` statements and return violations.
+impl GoRule for GoDeferLoopConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("defer") {
+            return violations;
+        }
+
+        for found in find_loop_defers(&file.source) {
+            if has_justification_comment(file.source.content, found.line, DEFERLOOP_COMMENT) {
+                continue;
+            }
+
+            let advice = "This defer runs when the function returns, not at the end of the \
+iteration, so each pass holds its resource until the loop is done. Move the loop body \
+into a function, release the resource explicitly, or add a // DEFERLOOP: comment \
+explaining why it's safe.";
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "missing_comment",
+                advice,
+                DEFER_LOOP,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_deferloop_tests.rs"]
+mod tests;
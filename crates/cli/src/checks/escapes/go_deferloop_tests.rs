@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn lines_in(body: &str) -> Vec<u32> {
+    let content = format!("package p\n\nfunc run(paths []string) {{\n{}\n}}\n", body);
+    find_loop_defers(&GoSource::new(&content))
+        .into_iter()
+        .map(|found| found.line)
+        .collect()
+}
+
+#[test]
+fn finds_defer_in_loop_with_column() {
+    let content = "package p
+
+func readAll(paths []string) error {
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+	}
+	return nil
+}
+";
+    assert_eq!(
+        find_loop_defers(&GoSource::new(content)),
+        vec![LoopDefer { line: 9, column: 3 }]
+    );
+}
+
+#[parameterized(
+    range_loop = { "\tfor _, p := range paths {\n\t\tdefer release(p)\n\t}" },
+    bare_loop = { "\tfor {\n\t\tdefer mu.Unlock()\n\t}" },
+    three_clause = { "\tfor i := 0; i < 3; i++ {\n\t\tdefer log(i)\n\t}" },
+    labeled = { "outer:\n\tfor range paths {\n\t\tdefer done()\n\t}" },
+    labeled_same_line = { "\touter: for range paths {\n\t\tdefer done()\n\t}" },
+    in_if = { "\tfor _, p := range paths {\n\t\tif p != \"\" {\n\t\t\tdefer release(p)\n\t\t}\n\t}" },
+    in_switch = { "\tfor _, p := range paths {\n\t\tswitch p {\n\t\tcase \"a\":\n\t\t\tdefer release(p)\n\t\t}\n\t}" },
+    composite_header = { "\tfor _, n := range []int{1, 2} {\n\t\tdefer log(n)\n\t}" },
+    one_line = { "\tfor _, p := range paths { defer release(p) }" },
+    nested_loop = { "\tfor range paths {\n\t\tfor range paths {\n\t\t\tdefer done()\n\t\t}\n\t}" },
+    loop_in_literal = { "\tgo func() {\n\t\tfor range paths {\n\t\t\tdefer done()\n\t\t}\n\t}()" },
+)]
+fn defers_in_loops_are_found(body: &str) {
+    assert_eq!(lines_in(body).len(), 1);
+}
+
+#[parameterized(
+    function_body = { "\tdefer done()\n\tfor range paths {\n\t}" },
+    after_loop = { "\tfor range paths {\n\t}\n\tdefer done()" },
+    in_literal = { "\tfor _, p := range paths {\n\t\tfunc() {\n\t\t\tdefer release(p)\n\t\t}()\n\t}" },
+    in_goroutine = { "\tfor _, p := range paths {\n\t\tgo func() {\n\t\t\tdefer release(p)\n\t\t}()\n\t}" },
+    literal_same_line = { "\tfor _, p := range paths {\n\t\tfunc() { defer release(p) }()\n\t}" },
+    in_if = { "\tif len(paths) > 0 {\n\t\tdefer done()\n\t}" },
+    commented = { "\tfor range paths {\n\t\t// defer done()\n\t}" },
+    in_string = { "\tfor range paths {\n\t\tlog(\"defer done()\")\n\t}" },
+    named = { "\tfor range paths {\n\t\tdeferred = true\n\t}" },
+)]
+fn defers_outside_loops_are_ok(body: &str) {
+    assert!(lines_in(body).is_empty());
+}
+
+#[test]
+fn loops_are_scoped_to_their_function() {
+    let content = "package p
+
+func loop(paths []string) {
+	for range paths {
+	}
+}
+
+func cleanup() {
+	defer done()
+}
+";
+    assert!(find_loop_defers(&GoSource::new(content)).is_empty());
+}
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go deprecated symbol checking for the escapes check.
+//!
+//! Go marks deprecations with a doc comment paragraph starting with
+//! `Deprecated:`. Projects can opt in via `[golang.deprecated]` to flag
+//! uses of the module's own deprecated functions, types, variables, and
+//! constants from other packages, so callers migrate off them. Declarations
+//! are indexed across the tree first, then each `pkg.Name` reference is
+//! resolved through the file's imports to the package that declares it.
+//! Methods and struct fields need type information and aren't matched.
+
+use std::collections::HashMap;
+use std::path::Path;
+use std::sync::LazyLock;
+
+use rayon::prelude::*;
+use regex::Regex;
+
+use crate::adapter::go::{GoModule, enumerate_packages, module_for, parse_imports};
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoDeprecatedConfig};
+
+use super::go_panic::Lexer;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for uses of deprecated symbols.
+pub const DEPRECATED_USE: &str = "deprecated_use";
+
+/// A top-level declaration: `func Name(`, `type Name`, `var Name`,
+/// `const Name`. Methods have a receiver and don't match.
+#[allow(clippy::expect_used)]
+static DECLARATION: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^(?:func|type|var|const)\s+(\w+)").expect("valid regex pattern"));
+
+/// The start of a grouped declaration: `const (`.
+#[allow(clippy::expect_used)]
+static GROUP_START: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^(?:type|var|const)\s*\(\s*$").expect("valid regex pattern"));
+
+/// A spec inside a grouped declaration.
+#[allow(clippy::expect_used)]
+static GROUP_SPEC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^\s+(\w+)").expect("valid regex pattern"));
+
+/// A package-qualified identifier: `pkg.Name`, but not `a.pkg.Name`.
+#[allow(clippy::expect_used)]
+static QUALIFIED: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])(\w+)\.(\w+)").expect("valid regex pattern"));
+
+/// A declaration whose doc comment marks it deprecated.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct DeprecatedDecl {
+    /// 1-based line of the declaration.
+    pub line: u32,
+    /// The declared name.
+    pub name: String,
+    /// Text after `Deprecated:` on its line (often the replacement).
+    pub note: String,
+}
+
+/// Find top-level declarations and grouped specs whose doc comment has a
+/// paragraph starting with `Deprecated:`.
+pub fn find_deprecated_decls(content: &str) -> Vec<DeprecatedDecl> {
+    let mut decls = Vec::new();
+    let mut doc: Vec<&str> = Vec::new();
+    let mut in_group = false;
+    let mut lexer = Lexer::default();
+    for (idx, line) in content.lines().enumerate() {
+        let code = lexer.mask(line);
+        if code.trim().is_empty() {
+            match line.trim_start().strip_prefix("//") {
+                Some(text) => doc.push(text.trim()),
+                None => doc.clear(),
+            }
+            continue;
+        }
+
+        let name = if in_group {
+            if code.trim_start().starts_with(')') {
+                in_group = false;
+                None
+            } else {
+                GROUP_SPEC.captures(&code).and_then(|c| c.get(1))
+            }
+        } else if GROUP_START.is_match(&code) {
+            in_group = true;
+            None
+        } else {
+            DECLARATION.captures(&code).and_then(|c| c.get(1))
+        };
+        if let Some(name) = name
+            && let Some(note) = deprecation_note(&doc)
+        {
+            decls.push(DeprecatedDecl {
+                line: idx as u32 + 1,
+                name: name.as_str().to_string(),
+                note,
+            });
+        }
+        doc.clear();
+    }
+    decls
+}
+
+/// The note of a doc comment's `Deprecated:` paragraph, if it has one.
+fn deprecation_note(doc: &[&str]) -> Option<String> {
+    doc.iter().enumerate().find_map(|(i, text)| {
+        let starts_paragraph = i == 0 || doc[i - 1].is_empty();
+        text.strip_prefix("Deprecated:")
+            .filter(|_| starts_paragraph)
+            .map(|note| note.trim().to_string())
+    })
+}
+
+/// Exported deprecated declarations by import path and name.
+#[derive(Debug, Default)]
+pub struct DeprecationIndex {
+    packages: HashMap<String, HashMap<String, String>>,
+}
+
+impl DeprecationIndex {
+    /// Index the exported deprecations declared in a file of the package
+    /// at `import_path`.
+    pub fn add(&mut self, import_path: &str, content: &str) {
+        for decl in find_deprecated_decls(content) {
+            if decl.name.starts_with(|c: char| c.is_ascii_uppercase()) {
+                self.packages
+                    .entry(import_path.to_string())
+                    .or_default()
+                    .insert(decl.name, decl.note);
+            }
+        }
+    }
+
+    /// Index the deprecations in every non-test Go file of every package
+    /// under `root` that belongs to a module.
+    ///
+    /// Packages are read from disk rather than the files being checked, so
+    /// a check of changed files still sees every declaration.
+    pub fn build(root: &Path, modules: &[GoModule]) -> Self {
+        let packages: Vec<(String, Vec<String>)> = enumerate_packages(root)
+            .par_iter()
+            .filter_map(|dir| {
+                let import_path = module_for(modules, dir)?.import_path(dir);
+                Some((import_path, deprecating_sources(&root.join(dir))))
+            })
+            .collect();
+
+        let mut index = Self::default();
+        for (import_path, sources) in &packages {
+            for content in sources {
+                index.add(import_path, content);
+            }
+        }
+        index
+    }
+
+    /// Whether no deprecations were found.
+    pub fn is_empty(&self) -> bool {
+        self.packages.is_empty()
+    }
+
+    /// Note of a deprecated name in a package, or None if it isn't deprecated.
+    pub fn note(&self, import_path: &str, name: &str) -> Option<&str> {
+        self.packages
+            .get(import_path)?
+            .get(name)
+            .map(String::as_str)
+    }
+
+    fn has_package(&self, import_path: &str) -> bool {
+        self.packages.contains_key(import_path)
+    }
+}
+
+/// Contents of a package directory's non-test Go files that mention
+/// `Deprecated:`.
+fn deprecating_sources(dir: &Path) -> Vec<String> {
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return Vec::new();
+    };
+    entries
+        .filter_map(|entry| entry.ok())
+        .map(|entry| entry.path())
+        .filter(|path| {
+            let name = path.file_name().and_then(|n| n.to_str()).unwrap_or("");
+            name.ends_with(".go") && !name.ends_with("_test.go") && path.is_file()
+        })
+        .filter_map(|path| std::fs::read_to_string(path).ok())
+        .filter(|content| content.contains("Deprecated:"))
+        .collect()
+}
+
+/// A reference to a deprecated symbol in another package.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct DeprecatedUse {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+    /// The reference as written: `oldapi.Fetch`.
+    pub symbol: String,
+    /// The declaration's `Deprecated:` note.
+    pub note: String,
+}
+
+/// Find references to deprecated symbols in code, skipping comments and
+/// strings.
+///
+/// References are resolved through the file's imports, under the local name
+/// each package is imported as. Dot-imported names aren't matched.
+pub fn find_deprecated_uses(source: &GoSource, index: &DeprecationIndex) -> Vec<DeprecatedUse> {
+    let imports: HashMap<String, String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| index.has_package(&import.path))
+        .filter_map(|import| Some((import.local_name()?.to_string(), import.path)))
+        .collect();
+    if imports.is_empty() {
+        return Vec::new();
+    }
+
+    let mut uses = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in QUALIFIED.captures_iter(code) {
+            let (Some(qualifier), Some(name)) = (captures.get(1), captures.get(2)) else {
+                continue;
+            };
+            let Some(note) = imports
+                .get(qualifier.as_str())
+                .and_then(|path| index.note(path, name.as_str()))
+            else {
+                continue;
+            };
+            uses.push(DeprecatedUse {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+                symbol: format!("{}.{}", qualifier.as_str(), name.as_str()),
+                note: note.to_string(),
+            });
+        }
+    }
+    uses
+}
+
+/// Check uses of deprecated symbols and return violations.
+///
+/// `index` holds the module's deprecations. Test files are not checked.
+impl GoRule for GoDeprecatedConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.deprecations.is_empty()
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+        {
+            return violations;
+        }
+
+        for found in find_deprecated_uses(&file.source, file.deprecations) {
+            let deprecated = if found.note.is_empty() {
+                format!("{} is deprecated.", found.symbol)
+            } else {
+                format!("{} is deprecated: {}", found.symbol, found.note)
+            };
+            let advice = format!(
+                "{} Migrate to its replacement, or add // quench:ignore deprecated_use reason: <why>.",
+                deprecated
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                DEPRECATED_USE,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_deprecated_tests.rs"]
+mod tests;
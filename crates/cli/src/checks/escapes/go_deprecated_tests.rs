@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+const OLDAPI: &str = "package oldapi
+
+// Fetch loads a record.
+//
+// Deprecated: Use Load instead.
+func Fetch(id string) string { return id }
+
+// Load loads a record.
+func Load(id string) string { return id }
+
+const (
+	// Deprecated: Use Timeout.
+	DefaultTimeout = 5
+
+	Timeout = 10
+)
+
+// Deprecated:
+type Record struct{}
+";
+
+fn index() -> DeprecationIndex {
+    let mut index = DeprecationIndex::default();
+    index.add("example.com/app/oldapi", OLDAPI);
+    index
+}
+
+#[test]
+fn finds_deprecated_declarations_and_specs() {
+    assert_eq!(
+        find_deprecated_decls(OLDAPI),
+        vec![
+            DeprecatedDecl {
+                line: 6,
+                name: "Fetch".to_string(),
+                note: "Use Load instead.".to_string(),
+            },
+            DeprecatedDecl {
+                line: 13,
+                name: "DefaultTimeout".to_string(),
+                note: "Use Timeout.".to_string(),
+            },
+            DeprecatedDecl {
+                line: 19,
+                name: "Record".to_string(),
+                note: String::new(),
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    mid_paragraph = { "// Fetch is old. Deprecated: use Load.\nfunc Fetch() {}\n" },
+    detached = { "// Deprecated: use Load.\n\nfunc Fetch() {}\n" },
+    method = { "// Deprecated: use Load.\nfunc (c *Client) Fetch() {}\n" },
+    block_comment = { "/* Deprecated: use Load. */\nfunc Fetch() {}\n" },
+)]
+fn ignores_other_comments(content: &str) {
+    assert!(find_deprecated_decls(content).is_empty());
+}
+
+#[test]
+fn finds_uses_under_import_alias() {
+    let content = "package handler
+
+import legacy \"example.com/app/oldapi\"
+
+func Serve() {
+	_ = legacy.Fetch(\"1\")
+	_ = legacy.Load(\"1\")
+	_ = legacy.Record{}
+}
+";
+    assert_eq!(
+        find_deprecated_uses(&GoSource::new(content), &index()),
+        vec![
+            DeprecatedUse {
+                line: 6,
+                column: 6,
+                symbol: "legacy.Fetch".to_string(),
+                note: "Use Load instead.".to_string(),
+            },
+            DeprecatedUse {
+                line: 8,
+                column: 6,
+                symbol: "legacy.Record".to_string(),
+                note: String::new(),
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package handler\n\nfunc f() {\n\t_ = oldapi.Fetch(\"1\")\n}\n" },
+    other_package = { "package handler\n\nimport \"example.com/app/newapi\"\n\nfunc f() {\n\t_ = newapi.Fetch(\"1\")\n}\n" },
+    comment = { "package handler\n\nimport \"example.com/app/oldapi\"\n\n// oldapi.Fetch is gone\nvar _ = oldapi.Load\n" },
+    string = { "package handler\n\nimport \"example.com/app/oldapi\"\n\nvar s = \"oldapi.Fetch\"\nvar _ = oldapi.Load\n" },
+    field = { "package handler\n\nimport \"example.com/app/oldapi\"\n\nfunc f(s server) {\n\t_ = s.oldapi.Fetch\n\t_ = oldapi.Load\n}\n" },
+)]
+fn ignores_other_references(content: &str) {
+    assert!(find_deprecated_uses(&GoSource::new(content), &index()).is_empty());
+}
+
+#[test]
+fn unexported_deprecations_are_not_indexed() {
+    let mut index = DeprecationIndex::default();
+    index.add(
+        "example.com/app/oldapi",
+        "package oldapi\n\n// Deprecated: inline it.\nfunc fetch() {}\n",
+    );
+    assert!(index.is_empty());
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `//go:embed` checking for the escapes check.
+//!
+//! Flags `//go:embed` directives, which compile files into the binary. With
+//! `[golang.embed].sensitive` set, only patterns matching one of those globs
+//! are flagged; patterns matching `allow` never are. Opt-in via
+//! `[golang.embed]`.
+
+use globset::{Glob, GlobMatcher};
+
+use crate::adapter::glob::build_glob_set;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoEmbedConfig};
+
+use super::go_rule::{GoFile, GoRule};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for embed directives.
+pub const GO_EMBED: &str = "go_embed";
+
+/// Directive prefix; Go requires it at the start of a line comment.
+const EMBED_DIRECTIVE: &str = "//go:embed";
+
+/// One pattern from a `//go:embed` directive.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct EmbedPattern {
+    /// The pattern, unquoted.
+    pub pattern: String,
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the pattern.
+    pub column: u32,
+}
+
+/// Find the patterns of each `//go:embed` directive.
+///
+/// Patterns are separated by spaces and may be quoted with `"` or a
+/// backtick.
+pub fn find_embed_patterns(content: &str) -> Vec<EmbedPattern> {
+    let mut patterns = Vec::new();
+    for (idx, line) in content.lines().enumerate() {
+        let indent = line.len() - line.trim_start().len();
+        let Some(rest) = line[indent..].strip_prefix(EMBED_DIRECTIVE) else {
+            continue;
+        };
+        if !rest.starts_with([' ', '\t']) {
+            continue;
+        }
+
+        let start = indent + EMBED_DIRECTIVE.len();
+        let bytes = line.as_bytes();
+        let mut i = start;
+        while i < bytes.len() {
+            if bytes[i].is_ascii_whitespace() {
+                i += 1;
+                continue;
+            }
+            let begin = i;
+            let (text, end) = match bytes[i] {
+                quote @ (b'"' | b'`') => {
+                    let close = line[i + 1..]
+                        .find(quote as char)
+                        .map_or(line.len(), |at| i + 1 + at);
+                    (&line[i + 1..close], (close + 1).min(line.len()))
+                }
+                _ => {
+                    let end = line[i..]
+                        .find(|c: char| c.is_ascii_whitespace())
+                        .map_or(line.len(), |at| i + at);
+                    (&line[i..end], end)
+                }
+            };
+            if !text.is_empty() {
+                patterns.push(EmbedPattern {
+                    pattern: text.to_string(),
+                    line: idx as u32 + 1,
+                    column: line[..begin].chars().count() as u32 + 1,
+                });
+            }
+            i = end;
+        }
+    }
+    patterns
+}
+
+/// The strings a glob is tried against: the pattern (without an `all:`
+/// prefix) and its final path component, so `*.pem` matches
+/// `certs/server.pem`.
+fn candidates(pattern: &str) -> [&str; 2] {
+    let pattern = pattern.strip_prefix("all:").unwrap_or(pattern);
+    let name = pattern.rsplit('/').next().unwrap_or(pattern);
+    [pattern, name]
+}
+
+/// Compile globs, keeping each source string for messages.
+fn compile_matchers(globs: &[String]) -> Vec<(&str, GlobMatcher)> {
+    globs
+        .iter()
+        .filter_map(|glob| match Glob::new(glob) {
+            Ok(compiled) => Some((glob.as_str(), compiled.compile_matcher())),
+            Err(e) => {
+                tracing::warn!("invalid glob pattern '{}': {}", glob, e);
+                None
+            }
+        })
+        .collect()
+}
+
+/// Check Go `//go:embed` directives and return violations.
+///
+/// Test files are not checked, since test binaries don't ship.
+impl GoRule for GoEmbedConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+        {
+            return violations;
+        }
+
+        let embeds = find_embed_patterns(file.source.content);
+        if embeds.is_empty() {
+            return violations;
+        }
+        let allow = build_glob_set(&self.allow);
+        let sensitive = compile_matchers(&self.sensitive);
+
+        for embed in embeds {
+            let candidates = candidates(&embed.pattern);
+            if candidates.iter().any(|c| allow.is_match(c)) {
+                continue;
+            }
+
+            let advice = if self.sensitive.is_empty() {
+                format!(
+                    "//go:embed {} compiles files into the binary. \
+Add the pattern to [golang.embed].allow if that's intended.",
+                    embed.pattern
+                )
+            } else {
+                let Some((glob, _)) = sensitive
+                    .iter()
+                    .find(|(_, matcher)| candidates.iter().any(|c| matcher.is_match(c)))
+                else {
+                    continue;
+                };
+                format!(
+                    "//go:embed {} matches sensitive glob '{}'. \
+Load the file at runtime instead of compiling it into the binary.",
+                    embed.pattern, glob
+                )
+            };
+
+            if let Some(v) =
+                try_create_violation(ctx, file.path, embed.line, "forbidden", &advice, GO_EMBED)
+            {
+                let mut v = v.with_column(embed.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_embed_tests.rs"]
+mod tests;
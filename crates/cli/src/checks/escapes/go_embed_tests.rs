@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn patterns(content: &str) -> Vec<String> {
+    find_embed_patterns(content)
+        .into_iter()
+        .map(|p| p.pattern)
+        .collect()
+}
+
+#[test]
+fn finds_embed_patterns_with_columns() {
+    let content = "package web\n\nimport \"embed\"\n\n//go:embed static/*.html certs/server.pem\nvar files embed.FS\n";
+    assert_eq!(
+        find_embed_patterns(content),
+        vec![
+            EmbedPattern {
+                pattern: "static/*.html".to_string(),
+                line: 5,
+                column: 12,
+            },
+            EmbedPattern {
+                pattern: "certs/server.pem".to_string(),
+                line: 5,
+                column: 26,
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    double_quoted = { "//go:embed \"my file.txt\" b.txt\n", &["my file.txt", "b.txt"] },
+    backtick = { "//go:embed `a b.txt`\n", &["a b.txt"] },
+    indented = { "\t//go:embed .env\n", &[".env"] },
+    all_prefix = { "//go:embed all:static\n", &["all:static"] },
+)]
+fn parses_pattern_forms(content: &str, expected: &[&str]) {
+    assert_eq!(patterns(content), expected);
+}
+
+#[parameterized(
+    spaced_comment = { "// go:embed secrets.pem\n" },
+    no_patterns = { "//go:embed\n" },
+    other_directive = { "//go:embedded x\n" },
+    trailing_comment = { "var x = 1 //go:embed a.pem\n" },
+)]
+fn ignores_non_directives(content: &str) {
+    assert!(patterns(content).is_empty());
+}
+
+#[parameterized(
+    plain = { "server.pem", ["server.pem", "server.pem"] },
+    nested = { "certs/server.pem", ["certs/server.pem", "server.pem"] },
+    all_prefix = { "all:config/.env", ["config/.env", ".env"] },
+)]
+fn matches_pattern_and_file_name(pattern: &str, expected: [&str; 2]) {
+    assert_eq!(candidates(pattern), expected);
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go unchecked error detection for the escapes check.
+//!
+//! Flags calls to the functions listed in `[golang.errcheck].functions` that
+//! stand alone as statements, discarding the error they return. Without type
+//! information quench can't tell which results are errors, so only listed
+//! functions are checked: package functions by import path, methods by name.
+//! Assigning the result, even to `_`, handles it. Opt-in.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoErrcheckConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for discarded errors.
+pub const UNCHECKED_ERROR: &str = "unchecked_error";
+
+/// A selector call at the start of a statement: `resp.Body.Close(`.
+#[allow(clippy::expect_used)]
+static STATEMENT_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)+)\s*\(").expect("valid regex pattern")
+});
+
+/// A function whose error result must be used.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum ErrorFunc {
+    /// Package function, by import path and name (`os.Remove`).
+    Package { path: String, name: String },
+    /// Method on any receiver, by name (`Close`).
+    Method(String),
+}
+
+impl ErrorFunc {
+    /// Parse a config entry: `importpath.Func`, or a bare method name.
+    pub fn parse(entry: &str) -> Self {
+        match entry.rsplit_once('.') {
+            Some((path, name)) if !path.is_empty() => ErrorFunc::Package {
+                path: path.to_string(),
+                name: name.to_string(),
+            },
+            _ => ErrorFunc::Method(entry.trim_start_matches('.').to_string()),
+        }
+    }
+}
+
+/// A listed function called as a statement, discarding its error.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct DiscardedCall {
+    /// The call as written (e.g., "resp.Body.Close").
+    pub call: String,
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the call.
+    pub column: u32,
+}
+
+/// Whether a line ending with `code` continues onto the next, so the next
+/// line can't start a statement.
+fn continues(code: &str) -> bool {
+    let code = code.trim_end();
+    if code.ends_with("++") || code.ends_with("--") {
+        return false;
+    }
+    code.ends_with([
+        ',', '(', '[', '=', '+', '-', '*', '/', '%', '&', '|', '^', '<', '>', '!', '.',
+    ])
+}
+
+/// Find calls to `functions` whose results are dropped, skipping comments
+/// and strings.
+///
+/// A call is dropped when it is the whole statement: it starts the line and
+/// nothing follows its closing parenthesis. `defer` and `go` statements
+/// can't use results, so they aren't reported.
+pub fn find_discarded_calls(source: &GoSource, functions: &[ErrorFunc]) -> Vec<DiscardedCall> {
+    let imports = parse_imports(source.content);
+
+    let mut calls = Vec::new();
+    let mut previous = "";
+    for (idx, text, line) in source.lines() {
+        let trimmed = line.trim_start();
+        if trimmed.is_empty() {
+            continue;
+        }
+        let starts_statement = !continues(previous);
+        previous = line;
+        if !starts_statement {
+            continue;
+        }
+        let Some(captures) = STATEMENT_CALL.captures(trimmed) else {
+            continue;
+        };
+        let chain = &captures[1];
+        let segments: Vec<&str> = chain.split('.').collect();
+        let Some(&name) = segments.last() else {
+            continue;
+        };
+        let package = match segments.as_slice() {
+            [qualifier, _] => imports
+                .iter()
+                .find(|import| import.local_name() == Some(*qualifier))
+                .map(|import| import.path.as_str()),
+            _ => None,
+        };
+        let listed = functions.iter().any(|function| match (function, package) {
+            (ErrorFunc::Package { path, name: func }, Some(package)) => {
+                path == package && func == name
+            }
+            (ErrorFunc::Method(method), None) => method == name,
+            _ => false,
+        });
+        if !listed {
+            continue;
+        }
+
+        let indent = line.len() - trimmed.len();
+        let open = indent + captures[0].len() - 1;
+        if !call_ends_statement(&source.masked, idx, open) {
+            continue;
+        }
+        calls.push(DiscardedCall {
+            call: chain.to_string(),
+            line: idx as u32 + 1,
+            column: text[..indent].chars().count() as u32 + 1,
+        });
+    }
+    calls
+}
+
+/// Whether the call opened at `code[line][open]` closes with nothing after
+/// it on its last line.
+fn call_ends_statement(code: &[String], line: usize, open: usize) -> bool {
+    let mut depth = 0usize;
+    let mut start = open;
+    for text in &code[line..] {
+        let bytes = text.as_bytes();
+        for (i, byte) in bytes.iter().enumerate().skip(start) {
+            match byte {
+                b'(' | b'[' | b'{' => depth += 1,
+                b')' | b']' | b'}' => {
+                    depth = depth.saturating_sub(1);
+                    if depth == 0 {
+                        let rest = text[i + 1..].trim();
+                        return rest.is_empty() || rest == ";";
+                    }
+                }
+                _ => {}
+            }
+        }
+        start = 0;
+    }
+    false
+}
+
+/// Check listed functions for discarded errors and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoErrcheckConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || self.functions.is_empty()
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+        {
+            return violations;
+        }
+
+        let functions: Vec<ErrorFunc> =
+            self.functions.iter().map(|f| ErrorFunc::parse(f)).collect();
+        for call in find_discarded_calls(&file.source, &functions) {
+            let advice = format!(
+                "The error returned by {}() is discarded. Handle it, or assign it to _ to ignore it explicitly.",
+                call.call
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "forbidden",
+                &advice,
+                UNCHECKED_ERROR,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_errcheck_tests.rs"]
+mod tests;
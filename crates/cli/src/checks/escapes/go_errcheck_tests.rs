@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn discarded(body: &str) -> Vec<(String, u32, u32)> {
+    let content = format!(
+        "package lib\n\nimport (\n\t\"net/http\"\n\t\"os\"\n)\n\nfunc f(w http.ResponseWriter, resp *http.Response) {{\n{}}}\n",
+        body
+    );
+    let functions = [
+        ErrorFunc::parse("os.Remove"),
+        ErrorFunc::parse("Close"),
+        ErrorFunc::parse("Write"),
+    ];
+    find_discarded_calls(&GoSource::new(&content), &functions)
+        .into_iter()
+        .map(|c| (c.call, c.line, c.column))
+        .collect()
+}
+
+#[parameterized(
+    package_function = { "os.Remove", ErrorFunc::Package { path: "os".to_string(), name: "Remove".to_string() } },
+    import_path = { "example.com/lib/store.Open", ErrorFunc::Package { path: "example.com/lib/store".to_string(), name: "Open".to_string() } },
+    method = { "Close", ErrorFunc::Method("Close".to_string()) },
+    leading_dot_method = { ".Close", ErrorFunc::Method("Close".to_string()) },
+)]
+fn parses_config_entries(entry: &str, expected: ErrorFunc) {
+    assert_eq!(ErrorFunc::parse(entry), expected);
+}
+
+#[test]
+fn finds_statement_calls_with_columns() {
+    assert_eq!(
+        discarded("\tos.Remove(\"tmp\")\n\tresp.Body.Close()\n\tw.Write([]byte(\"ok\"));\n"),
+        vec![
+            ("os.Remove".to_string(), 9, 2),
+            ("resp.Body.Close".to_string(), 10, 2),
+            ("w.Write".to_string(), 11, 2),
+        ]
+    );
+}
+
+#[test]
+fn finds_multi_line_calls() {
+    assert_eq!(
+        discarded("\tw.Write(\n\t\t[]byte(\"ok\"),\n\t)\n"),
+        vec![("w.Write".to_string(), 9, 2)]
+    );
+}
+
+#[test]
+fn finds_calls_after_increments_and_case_labels() {
+    assert_eq!(
+        discarded(
+            "\tn++\n\tresp.Body.Close()\n\tswitch {\n\tdefault:\n\t\tos.Remove(\"tmp\")\n\t}\n"
+        ),
+        vec![
+            ("resp.Body.Close".to_string(), 10, 2),
+            ("os.Remove".to_string(), 13, 3),
+        ]
+    );
+}
+
+#[parameterized(
+    assigned = { "\terr := os.Remove(\"tmp\")\n" },
+    blank = { "\t_ = resp.Body.Close()\n" },
+    blank_pair = { "\t_, _ = w.Write(nil)\n" },
+    returned = { "\treturn os.Remove(\"tmp\")\n" },
+    condition = { "\tif err := resp.Body.Close(); err != nil {\n\t}\n" },
+    deferred = { "\tdefer resp.Body.Close()\n" },
+    goroutine = { "\tgo resp.Body.Close()\n" },
+    argument = { "\tcheck(\n\t\tos.Remove(\"tmp\"),\n\t)\n" },
+    assigned_on_next_line = { "\terr =\n\t\tos.Remove(\"tmp\")\n" },
+    chained = { "\tw.Write(nil).Error()\n" },
+    unlisted_function = { "\tos.Exit(1)\n" },
+    method_name_on_package = { "\tos.Close()\n" },
+    comment = { "\t// resp.Body.Close()\n" },
+    string = { "\tprintln(\"resp.Body.Close()\")\n" },
+)]
+fn ignores_handled_or_unlisted_calls(body: &str) {
+    assert!(discarded(body).is_empty(), "{:?}", discarded(body));
+}
+
+#[test]
+fn matches_package_functions_under_alias() {
+    let content = "package lib\n\nimport fs \"os\"\n\nfunc f() {\n\tfs.Remove(\"tmp\")\n\tos.Remove(\"tmp\")\n}\n";
+    let calls = find_discarded_calls(&GoSource::new(content), &[ErrorFunc::parse("os.Remove")]);
+    assert_eq!(
+        calls,
+        vec![DiscardedCall {
+            call: "fs.Remove".to_string(),
+            line: 6,
+            column: 2,
+        }]
+    );
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go error string style checking for the escapes check.
+//!
+//! Error strings are usually wrapped in other messages (`"load config: %w"`),
+//! so Go style says they start lowercase and don't end with punctuation.
+//! Projects can opt in via `[golang.errstring]` to flag `errors.New` and
+//! `fmt.Errorf` strings that do, outside `_test.go` files. A capitalized
+//! first word is allowed when it's an acronym or proper noun on the
+//! allowlist, like `HTTP` or `JSON`, so the check warns by default.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoErrStringConfig};
+
+use super::go_exit::import_names;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for error strings that break Go style.
+pub const ERROR_STRING: &str = "error_string";
+
+/// Capitalized words an error string may start with.
+pub const DEFAULT_ALLOWED_WORDS: &[&str] = &[
+    "API", "CPU", "CSV", "DNS", "EOF", "GRPC", "HTML", "HTTP", "HTTPS", "ID", "IO", "IP", "JSON",
+    "JWT", "OK", "SQL", "SSH", "TCP", "TLS", "TOML", "UDP", "URI", "URL", "UTF", "UUID", "XML",
+    "YAML",
+];
+
+/// An error constructor call: `errors.New(`, `fmt.Errorf(`.
+#[allow(clippy::expect_used)]
+static ERROR_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(New|Errorf)\s*\(").expect("valid regex pattern")
+});
+
+/// What's wrong with an error string.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum ErrorStringIssue {
+    /// The first word is capitalized.
+    Capitalized,
+    /// The string ends with `.` or `!`.
+    Punctuated,
+}
+
+/// An `errors.New` or `fmt.Errorf` string that breaks Go style.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct BadErrorString {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+    /// The function under its canonical package, e.g. `fmt.Errorf`.
+    pub call: String,
+    /// What's wrong with the string.
+    pub issue: ErrorStringIssue,
+}
+
+/// The string literal an argument list starts with, and whether it's the
+/// whole first argument.
+fn leading_literal(args: &str) -> Option<(String, bool)> {
+    let args = args.trim_start();
+    let mut chars = args.char_indices();
+    let (_, quote) = chars.next()?;
+    if quote != '"' && quote != '`' {
+        return None;
+    }
+    let mut text = String::new();
+    let mut escaped = false;
+    for (pos, c) in chars {
+        if escaped {
+            text.push(c);
+            escaped = false;
+        } else if c == '\\' && quote == '"' {
+            text.push(c);
+            escaped = true;
+        } else if c == quote {
+            let rest = args[pos + 1..].trim_start();
+            let whole = rest.starts_with(',') || rest.starts_with(')');
+            return Some((text, whole));
+        } else {
+            text.push(c);
+        }
+    }
+    // A raw string continuing on the next line
+    Some((text, false))
+}
+
+/// What's wrong with an error string, if anything.
+fn issue(text: &str, literal_is_whole: bool, allowed: &[String]) -> Option<ErrorStringIssue> {
+    let first_word: String = text
+        .chars()
+        .take_while(|c| c.is_alphanumeric() || *c == '_')
+        .collect();
+    let capitalized = first_word.chars().next().is_some_and(char::is_uppercase);
+    if capitalized
+        && !DEFAULT_ALLOWED_WORDS.contains(&first_word.as_str())
+        && !allowed.iter().any(|w| *w == first_word)
+    {
+        return Some(ErrorStringIssue::Capitalized);
+    }
+    if literal_is_whole && text.ends_with(['.', '!']) {
+        return Some(ErrorStringIssue::Punctuated);
+    }
+    None
+}
+
+/// Find `errors.New` and `fmt.Errorf` calls whose string starts with a
+/// capitalized word or ends with `.` or `!`, skipping comments and strings.
+///
+/// Only a string literal starting the argument list on the call's line is
+/// checked; the ending only when the literal is the whole argument, not
+/// the first part of a concatenation. First words in `DEFAULT_ALLOWED_WORDS`
+/// or `allowed` may be capitalized. Calls are matched under the local names
+/// `errors` and `fmt` are imported as.
+pub fn find_bad_error_strings(source: &GoSource, allowed: &[String]) -> Vec<BadErrorString> {
+    let errors_names = import_names(source.content, "errors");
+    let fmt_names = import_names(source.content, "fmt");
+    if errors_names.is_empty() && fmt_names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut found = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in ERROR_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(func), Some(whole)) =
+                (captures.get(1), captures.get(2), captures.get(0))
+            else {
+                continue;
+            };
+            let (package, names) = match func.as_str() {
+                "New" => ("errors", &errors_names),
+                _ => ("fmt", &fmt_names),
+            };
+            if !names.iter().any(|n| n == qualifier.as_str()) {
+                continue;
+            }
+            let Some((text, whole_arg)) = leading_literal(&line[whole.end()..]) else {
+                continue;
+            };
+            let Some(issue) = issue(&text, whole_arg, allowed) else {
+                continue;
+            };
+            found.push(BadErrorString {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+                call: format!("{}.{}", package, func.as_str()),
+                issue,
+            });
+        }
+    }
+    found
+}
+
+/// Check Go error strings and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoErrStringConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+        {
+            return violations;
+        }
+
+        for found in find_bad_error_strings(&file.source, &self.allow) {
+            let fix = match found.issue {
+                ErrorStringIssue::Capitalized => {
+                    "Start it with a lowercase letter, or add the word to [golang.errstring].allow if it's a proper noun."
+                }
+                ErrorStringIssue::Punctuated => "Drop the trailing punctuation.",
+            };
+            let advice = format!(
+                "{} strings are wrapped in other messages, so they shouldn't be capitalized or end with punctuation. {}",
+                found.call, fix
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                ERROR_STRING,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_errstring_tests.rs"]
+mod tests;
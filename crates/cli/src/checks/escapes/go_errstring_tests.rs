@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn issues(call: &str) -> Vec<ErrorStringIssue> {
+    let content = format!(
+        "package p\n\nimport (\n\t\"errors\"\n\t\"fmt\"\n)\n\nvar err = {}\n",
+        call
+    );
+    find_bad_error_strings(&GoSource::new(&content), &["Postgres".to_string()])
+        .into_iter()
+        .map(|found| found.issue)
+        .collect()
+}
+
+#[test]
+fn finds_bad_strings_with_columns() {
+    let content = "package p
+
+import (
+	\"errors\"
+	xfmt \"fmt\"
+)
+
+func load(path string) error {
+	if path == \"\" {
+		return errors.New(\"Path is empty\")
+	}
+	return xfmt.Errorf(\"loading %s failed.\", path)
+}
+";
+    assert_eq!(
+        find_bad_error_strings(&GoSource::new(content), &[]),
+        vec![
+            BadErrorString {
+                line: 10,
+                column: 10,
+                call: "errors.New".to_string(),
+                issue: ErrorStringIssue::Capitalized,
+            },
+            BadErrorString {
+                line: 12,
+                column: 9,
+                call: "fmt.Errorf".to_string(),
+                issue: ErrorStringIssue::Punctuated,
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    capitalized = { r#"errors.New("Not found")"#, ErrorStringIssue::Capitalized },
+    capitalized_format = { r#"fmt.Errorf("Open %s: %w", name, err)"#, ErrorStringIssue::Capitalized },
+    period = { r#"errors.New("not found.")"#, ErrorStringIssue::Punctuated },
+    exclamation = { r#"fmt.Errorf("disk %s is full!", name)"#, ErrorStringIssue::Punctuated },
+    raw_string = { "errors.New(`Not found`)", ErrorStringIssue::Capitalized },
+)]
+fn bad_strings_are_found(call: &str, issue: ErrorStringIssue) {
+    assert_eq!(issues(call), vec![issue]);
+}
+
+#[parameterized(
+    lowercase = { r#"errors.New("not found")"# },
+    wrapped = { r#"fmt.Errorf("open %s: %w", name, err)"# },
+    acronym = { r#"fmt.Errorf("HTTP %d from %s", code, url)"# },
+    configured_word = { r#"errors.New("Postgres is unavailable")"# },
+    concatenation = { r#"errors.New("retry in " + delay + ".")"# },
+    variable = { "errors.New(msg)" },
+    escaped_quote = { r#"fmt.Errorf("unknown key \"%s\"", key)"# },
+    other_package = { r#"pkgerrors.New("Not found.")"# },
+    commented = { r#"nil // errors.New("Not found.")"# },
+)]
+fn good_strings_are_ok(call: &str) {
+    assert!(issues(call).is_empty());
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go process exit checking for the escapes check.
+//!
+//! `log.Fatal` and `os.Exit` end the process, and `log.Panic` unwinds it,
+//! so a library that calls them takes the decision away from its callers.
+//! Projects can opt in via `[golang.exit]` to flag these calls outside
+//! `main` packages and test files, unless an `// EXIT:` comment explains
+//! them.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoExitConfig};
+
+use super::comment::has_justification_comment;
+use super::go_panic::package_name;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for process exits in library code.
+pub const GO_EXIT: &str = "go_exit";
+
+/// Required justification marker.
+pub const EXIT_COMMENT: &str = "// EXIT:";
+
+/// A selector call of an exiting function: `log.Fatal(`, `os.Exit(`.
+#[allow(clippy::expect_used)]
+static EXIT_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(Fatal|Fatalf|Fatalln|Panic|Panicf|Panicln|Exit)\s*\(")
+        .expect("valid regex pattern")
+});
+
+/// A call that exits or panics the process.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct ExitCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+    /// The function under its canonical package, e.g. `log.Fatalf`.
+    pub name: String,
+}
+
+/// Local names a package is imported as, skipping blank and dot imports.
+pub(super) fn import_names(content: &str, path: &str) -> Vec<String> {
+    parse_imports(content)
+        .into_iter()
+        .filter(|import| import.path == path)
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some(path.to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect()
+}
+
+/// Find `log.Fatal*`, `log.Panic*`, and `os.Exit` calls in code, skipping
+/// comments and strings.
+///
+/// Calls are matched under the local names `log` and `os` are imported as,
+/// so `stdlog.Fatal(err)` counts with `import stdlog "log"`. Methods on a
+/// `*log.Logger` and dot-imported calls aren't matched.
+pub fn find_exit_calls(source: &GoSource) -> Vec<ExitCall> {
+    let log_names = import_names(source.content, "log");
+    let os_names = import_names(source.content, "os");
+    if log_names.is_empty() && os_names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut calls = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in EXIT_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(func)) = (captures.get(1), captures.get(2)) else {
+                continue;
+            };
+            let package = if func.as_str() == "Exit" {
+                os_names
+                    .iter()
+                    .any(|n| n == qualifier.as_str())
+                    .then_some("os")
+            } else {
+                log_names
+                    .iter()
+                    .any(|n| n == qualifier.as_str())
+                    .then_some("log")
+            };
+            let Some(package) = package else {
+                continue;
+            };
+            calls.push(ExitCall {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+                name: format!("{}.{}", package, func.as_str()),
+            });
+        }
+    }
+    calls
+}
+
+/// Check Go process exits and return violations.
+///
+/// `main` packages and test files are not checked.
+impl GoRule for GoExitConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || package_name(file.source.content).as_deref() == Some("main")
+        {
+            return violations;
+        }
+
+        for call in find_exit_calls(&file.source) {
+            if has_justification_comment(file.source.content, call.line, EXIT_COMMENT) {
+                continue;
+            }
+
+            let advice = format!(
+                "{} in a library exits or panics, so callers can't handle the failure. \
+Return an error instead, and leave exiting to package main. \
+If exiting here is intended, add an // EXIT: comment explaining why.",
+                call.name
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "missing_comment",
+                &advice,
+                GO_EXIT,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_exit_tests.rs"]
+mod tests;
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_exit_calls_with_columns() {
+    let content = "package store
+
+import (
+	\"log\"
+	\"os\"
+)
+
+func Open(path string) {
+	if path == \"\" {
+		log.Fatalf(\"no path\")
+	}
+	log.Panicln(\"bad\")
+	os.Exit(2)
+}
+";
+    assert_eq!(
+        find_exit_calls(&GoSource::new(content)),
+        vec![
+            ExitCall {
+                line: 10,
+                column: 3,
+                name: "log.Fatalf".to_string(),
+            },
+            ExitCall {
+                line: 12,
+                column: 2,
+                name: "log.Panicln".to_string(),
+            },
+            ExitCall {
+                line: 13,
+                column: 2,
+                name: "os.Exit".to_string(),
+            },
+        ]
+    );
+}
+
+#[test]
+fn aliased_imports_are_matched() {
+    let content = "package store
+
+import (
+	stdlog \"log\"
+	goos \"os\"
+)
+
+func Open() {
+	stdlog.Fatal(\"no\")
+	goos.Exit(1)
+}
+";
+    let names: Vec<String> = find_exit_calls(&GoSource::new(content))
+        .into_iter()
+        .map(|c| c.name)
+        .collect();
+    assert_eq!(names, vec!["log.Fatal", "os.Exit"]);
+}
+
+#[parameterized(
+    logger_method = { "import \"log\"\n\nfunc f(l *log.Logger) { l.Fatal(\"x\") }\n" },
+    os_fatal = { "import \"os\"\n\nfunc f() { os.Fatal(\"x\") }\n" },
+    log_exit = { "import \"log\"\n\nfunc f() { log.Exit(1) }\n" },
+    not_imported = { "func f() { log.Fatal(\"x\") }\n" },
+    other_log = { "import log \"github.com/sirupsen/logrus\"\n\nfunc f() { log.Fatal(\"x\") }\n" },
+    commented = { "import \"os\"\n\n// os.Exit(1)\nvar s = \"os.Exit(1)\"\n" },
+)]
+fn other_calls_are_not_matched(body: &str) {
+    let content = format!("package p\n\n{}", body);
+    assert!(find_exit_calls(&GoSource::new(&content)).is_empty());
+}
@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go goroutine recovery checking for the escapes check.
+//!
+//! A panic in a goroutine can't be recovered by the code that launched it,
+//! so it crashes the whole process. Projects can opt in via
+//! `[golang.goroutine]` to require each `go` statement's launched function
+//! to defer a `recover()`, or a `// GOROUTINE:` comment, optionally only in
+//! packages matching `packages`.
+
+use std::collections::HashMap;
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::module_for;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoGoroutineConfig};
+
+use super::comment::has_justification_comment;
+use super::go_init::is_scoped_package;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for goroutines launched without recovery.
+pub const GO_GOROUTINE: &str = "go_goroutine";
+
+/// Required justification marker.
+pub const GOROUTINE_COMMENT: &str = "// GOROUTINE:";
+
+/// A `go` statement and what it launches: `func` for a literal, or the
+/// (possibly selector-qualified) name of the function called.
+#[allow(clippy::expect_used)]
+static GO_STATEMENT: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[;{])\s*(go)\s+(func\b|[\w.]+)").expect("valid regex pattern")
+});
+
+/// A top-level function declaration's name, after `func` (skipping a receiver).
+#[allow(clippy::expect_used)]
+static DECLARED_NAME: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?m)^func\s*(?:\([^)]*\)\s*)?(\w+)").expect("valid regex pattern")
+});
+
+/// A deferred function literal or named call: `defer func`,
+/// `defer s.handlePanic(`.
+#[allow(clippy::expect_used)]
+static DEFER: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\bdefer\s+(?:(func)\b|(?:[\w.]+\.)?(\w+)\s*\()").expect("valid regex pattern")
+});
+
+/// A `recover()` call.
+#[allow(clippy::expect_used)]
+static RECOVER_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])recover\s*\(").expect("valid regex pattern"));
+
+/// A `go` statement whose launched function doesn't defer a `recover()`.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct Goroutine {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `go`.
+    pub column: u32,
+    /// Name of the launched function, or None for a function literal.
+    pub name: Option<String>,
+}
+
+/// Byte range of the body of the function whose signature starts at
+/// `from`, or None if it has none (a func type, an assembly stub).
+fn body_at(code: &str, from: usize) -> Option<(usize, usize)> {
+    let bytes = code.as_bytes();
+    let mut parens = 0usize;
+    let mut i = from;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'(' | b'[' => parens += 1,
+            b')' | b']' => parens = parens.saturating_sub(1),
+            b'\n' if parens == 0 => return None,
+            b'{' => {
+                let end = closing_brace(code, i)?;
+                let before = code[..i].trim_end();
+                if parens == 0 && !before.ends_with("interface") && !before.ends_with("struct") {
+                    return Some((i + 1, end));
+                }
+                i = end;
+            }
+            _ => {}
+        }
+        i += 1;
+    }
+    None
+}
+
+/// Byte index of the brace closing the one at `open`.
+fn closing_brace(code: &str, open: usize) -> Option<usize> {
+    let mut depth = 0usize;
+    for (i, b) in code.bytes().enumerate().skip(open) {
+        match b {
+            b'{' => depth += 1,
+            b'}' => {
+                depth -= 1;
+                if depth == 0 {
+                    return Some(i);
+                }
+            }
+            _ => {}
+        }
+    }
+    None
+}
+
+/// Bodies of the top-level functions and methods declared in masked code,
+/// by name.
+fn function_bodies(code: &str) -> HashMap<String, String> {
+    DECLARED_NAME
+        .captures_iter(code)
+        .filter_map(|caps| {
+            let name = caps.get(1)?;
+            let (start, end) = body_at(code, name.end())?;
+            Some((name.as_str().to_string(), code[start..end].to_string()))
+        })
+        .collect()
+}
+
+/// Whether a function body defers a `recover()`: in a deferred literal, in
+/// a deferred function of the package that calls it, or through a deferred
+/// helper named for it (`defer log.Recover()`).
+fn defers_recover(body: &str, functions: &HashMap<String, String>) -> bool {
+    DEFER.captures_iter(body).any(|caps| {
+        if let Some(literal) = caps.get(1) {
+            return body_at(body, literal.end())
+                .is_some_and(|(start, end)| RECOVER_CALL.is_match(&body[start..end]));
+        }
+        caps.get(2).is_some_and(|name| {
+            let name = name.as_str();
+            name.to_ascii_lowercase().contains("recover")
+                || functions
+                    .get(name)
+                    .is_some_and(|deferred| RECOVER_CALL.is_match(deferred))
+        })
+    })
+}
+
+/// Find `go` statements whose launched function doesn't defer a
+/// `recover()`, skipping comments and strings.
+///
+/// A function literal is read in place; a named function (`go serve(c)`,
+/// `go s.loop()`) is looked up among the functions and methods declared in
+/// this file and `package_sources`, the package's other files. Functions
+/// the package doesn't declare can't be read, and are flagged.
+pub fn find_unrecovered_goroutines(
+    source: &GoSource,
+    package_sources: &[String],
+) -> Vec<Goroutine> {
+    let code = source.masked.join("\n");
+    let mut functions = function_bodies(&code);
+    for sibling in package_sources {
+        for (name, body) in function_bodies(&GoSource::new(sibling).masked.join("\n")) {
+            functions.entry(name).or_insert(body);
+        }
+    }
+
+    let mut goroutines = Vec::new();
+    let mut line_start = 0;
+    for (idx, line, masked) in source.lines() {
+        for caps in GO_STATEMENT.captures_iter(masked) {
+            let (Some(go), Some(launched)) = (caps.get(1), caps.get(2)) else {
+                continue;
+            };
+            let (recovers, name) = if launched.as_str() == "func" {
+                let body = body_at(&code, line_start + launched.end());
+                let recovers =
+                    body.is_some_and(|(start, end)| defers_recover(&code[start..end], &functions));
+                (recovers, None)
+            } else {
+                let name = launched.as_str().rsplit('.').next().unwrap_or_default();
+                let recovers = functions
+                    .get(name)
+                    .is_some_and(|body| defers_recover(body, &functions));
+                (recovers, Some(launched.as_str().to_string()))
+            };
+            if !recovers {
+                goroutines.push(Goroutine {
+                    line: idx as u32 + 1,
+                    column: line[..go.start()].chars().count() as u32 + 1,
+                    name,
+                });
+            }
+        }
+        line_start += masked.len() + 1;
+    }
+    goroutines
+}
+
+/// Contents of the other non-test Go files in `file`'s directory.
+fn sibling_sources(file: &Path) -> Vec<String> {
+    let Some(dir) = file.parent() else {
+        return Vec::new();
+    };
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return Vec::new();
+    };
+    entries
+        .filter_map(|entry| entry.ok())
+        .map(|entry| entry.path())
+        .filter(|path| {
+            let name = path.file_name().and_then(|n| n.to_str()).unwrap_or("");
+            path != file && name.ends_with(".go") && !name.ends_with("_test.go")
+        })
+        .filter_map(|path| std::fs::read_to_string(path).ok())
+        .collect()
+}
+
+/// Check Go `go` statements and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoGoroutineConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("go ")
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let globs = (!self.packages.is_empty()).then(|| build_glob_set(&self.packages));
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if !is_scoped_package(globs.as_ref(), &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        let mut goroutines = find_unrecovered_goroutines(&file.source, &[]);
+        if goroutines.iter().any(|g| g.name.is_some()) {
+            // Named functions may be declared in another file of the package
+            goroutines = find_unrecovered_goroutines(
+                &file.source,
+                &sibling_sources(&ctx.root.join(file.path)),
+            );
+        }
+
+        for goroutine in goroutines {
+            if has_justification_comment(file.source.content, goroutine.line, GOROUTINE_COMMENT) {
+                continue;
+            }
+
+            let launched = match &goroutine.name {
+                Some(name) => format!("{} doesn't defer a recover()", name),
+                None => "The launched function doesn't defer a recover()".to_string(),
+            };
+            let advice = format!(
+                "{}, so a panic in this goroutine crashes the whole process. \
+Start it with a deferred func that recovers and reports the panic, \
+or add a // GOROUTINE: comment explaining why it can't panic.",
+                launched
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                goroutine.line,
+                "missing_comment",
+                &advice,
+                GO_GOROUTINE,
+            ) {
+                let mut v = v.with_column(goroutine.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_goroutine_tests.rs"]
+mod tests;
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn lines_in(content: &str) -> Vec<u32> {
+    find_unrecovered_goroutines(&GoSource::new(content), &[])
+        .into_iter()
+        .map(|g| g.line)
+        .collect()
+}
+
+#[test]
+fn finds_bare_goroutines_with_columns() {
+    let content = "package server
+
+func Start(s *Server) {
+	go func() {
+		s.loop()
+	}()
+	if s.debug { go s.watch() }
+}
+";
+    assert_eq!(
+        find_unrecovered_goroutines(&GoSource::new(content), &[]),
+        vec![
+            Goroutine {
+                line: 4,
+                column: 2,
+                name: None,
+            },
+            Goroutine {
+                line: 7,
+                column: 15,
+                name: Some("s.watch".to_string()),
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    deferred_literal = { "package p\n\nfunc run() {\n\tgo func() {\n\t\tdefer func() {\n\t\t\tif r := recover(); r != nil {\n\t\t\t\tlog.Print(r)\n\t\t\t}\n\t\t}()\n\t\twork()\n\t}()\n}\n" },
+    deferred_helper = { "package p\n\nfunc run() {\n\tgo func() {\n\t\tdefer handlePanic()\n\t\twork()\n\t}()\n}\n\nfunc handlePanic() {\n\tif r := recover(); r != nil {\n\t\tlog.Print(r)\n\t}\n}\n" },
+    named_recover_helper = { "package p\n\nfunc run() {\n\tgo func() {\n\t\tdefer safe.Recover(logger)\n\t\twork()\n\t}()\n}\n" },
+    named_function = { "package p\n\nfunc run() {\n\tgo serve(conn)\n}\n\nfunc serve(c net.Conn) {\n\tdefer func() { recover() }()\n\tc.Close()\n}\n" },
+    method = { "package p\n\nfunc (s *Server) Start() {\n\tgo s.loop()\n}\n\nfunc (s *Server) loop() {\n\tdefer s.recoverPanic()\n}\n" },
+    comment = { "package p\n\n// go func() { work() }()\nfunc run() {}\n" },
+    string = { "package p\n\nvar usage = \"go run ./cmd\"\n" },
+)]
+fn ignores_recovering_and_non_goroutine_code(content: &str) {
+    assert!(lines_in(content).is_empty(), "{:?}", lines_in(content));
+}
+
+#[parameterized(
+    recover_outside_defer = { "package p\n\nfunc run() {\n\tgo func() {\n\t\trecover()\n\t\twork()\n\t}()\n}\n" },
+    deferred_without_recover = { "package p\n\nfunc run() {\n\tgo func() {\n\t\tdefer wg.Done()\n\t\twork()\n\t}()\n}\n" },
+    undeclared_function = { "package p\n\nfunc run() {\n\tgo worker.Run(ctx)\n}\n" },
+    recover_method = { "package p\n\nfunc run() {\n\tgo func() {\n\t\tdefer func() { state.recover() }()\n\t}()\n}\n" },
+)]
+fn flags_goroutines_without_recovery(content: &str) {
+    assert_eq!(lines_in(content), vec![4]);
+}
+
+#[test]
+fn package_functions_in_other_files_are_read() {
+    let content = "package p\n\nfunc run() {\n\tgo serve()\n}\n";
+    assert_eq!(lines_in(content), vec![4]);
+    let sibling = "package p\n\nfunc serve() {\n\tdefer func() { recover() }()\n}\n".to_string();
+    assert!(find_unrecovered_goroutines(&GoSource::new(content), &[sibling]).is_empty());
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go default HTTP client checking for the escapes check.
+//!
+//! `http.Get`, `http.Post`, and the other package-level helpers send through
+//! `http.DefaultClient`, which has no timeout, so a server that never
+//! answers hangs the caller. Projects can opt in via `[golang.http]` to flag
+//! them and uses of `http.DefaultClient` outside test files, unless an
+//! `// HTTP:` comment explains them.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoHttpConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for requests through the default client.
+pub const DEFAULT_HTTP_CLIENT: &str = "default_http_client";
+
+/// Required justification marker.
+pub const HTTP_COMMENT: &str = "// HTTP:";
+
+/// A package-level helper call, `http.Get(`, or a `http.DefaultClient`
+/// reference.
+#[allow(clippy::expect_used)]
+static DEFAULT_CLIENT_USE: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(?:(Get|Head|Post|PostForm)\s*\(|(DefaultClient)\b)")
+        .expect("valid regex pattern")
+});
+
+/// A use of the default HTTP client.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct DefaultClientUse {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+    /// `Get`, `Head`, `Post`, `PostForm`, or `DefaultClient`.
+    pub name: String,
+}
+
+/// Find package-level `net/http` request helpers and `http.DefaultClient`
+/// references in code, skipping comments and strings.
+///
+/// Uses are matched under the local name `net/http` is imported as, so
+/// `nethttp.Get(url)` counts with `import nethttp "net/http"`. Dot-imported
+/// uses aren't matched.
+pub fn find_default_client_uses(source: &GoSource) -> Vec<DefaultClientUse> {
+    let names: Vec<String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| import.path == "net/http")
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some("http".to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect();
+    if names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut uses = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in DEFAULT_CLIENT_USE.captures_iter(code) {
+            let Some(qualifier) = captures.get(1) else {
+                continue;
+            };
+            let Some(name) = captures.get(2).or_else(|| captures.get(3)) else {
+                continue;
+            };
+            if !names.iter().any(|n| n == qualifier.as_str()) {
+                continue;
+            }
+            uses.push(DefaultClientUse {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+                name: name.as_str().to_string(),
+            });
+        }
+    }
+    uses
+}
+
+/// Check Go default HTTP client uses and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoHttpConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("net/http")
+        {
+            return violations;
+        }
+
+        for found in find_default_client_uses(&file.source) {
+            if has_justification_comment(file.source.content, found.line, HTTP_COMMENT) {
+                continue;
+            }
+
+            let subject = match found.name.as_str() {
+                "DefaultClient" => "http.DefaultClient".to_string(),
+                name => format!("http.{} uses http.DefaultClient, which", name),
+            };
+            let advice = format!(
+                "{} has no timeout, so a server that never answers hangs the caller. \
+Send through a client with an explicit timeout, like &http.Client{{Timeout: 10 * time.Second}}. \
+If no timeout is intended, add an // HTTP: comment explaining why.",
+                subject
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "missing_comment",
+                &advice,
+                DEFAULT_HTTP_CLIENT,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_http_tests.rs"]
+mod tests;
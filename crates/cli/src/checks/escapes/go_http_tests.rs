@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn found(line: u32, column: u32, name: &str) -> DefaultClientUse {
+    DefaultClientUse {
+        line,
+        column,
+        name: name.to_string(),
+    }
+}
+
+#[test]
+fn finds_helpers_and_default_client_with_columns() {
+    let content = "package api\n\nimport \"net/http\"\n\nfunc fetch(req *http.Request) {\n\tresp, err := http.Get(url)\n\t_, _ = http.PostForm(url, form), http.Head(url)\n\thttp.DefaultClient.Do(req)\n}\n";
+    assert_eq!(
+        find_default_client_uses(&GoSource::new(content)),
+        vec![
+            found(6, 15, "Get"),
+            found(7, 9, "PostForm"),
+            found(7, 35, "Head"),
+            found(8, 2, "DefaultClient"),
+        ]
+    );
+}
+
+#[test]
+fn matches_aliased_import() {
+    let content = "package api\n\nimport nethttp \"net/http\"\n\nfunc fetch() {\n\t_, _ = nethttp.Post(url, \"text/plain\", nil)\n\t_, _ = http.Get(url)\n}\n";
+    assert_eq!(
+        find_default_client_uses(&GoSource::new(content)),
+        vec![found(6, 9, "Post")]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package api\n\nfunc f() {\n\t_, _ = http.Get(url)\n}\n" },
+    dot_import = { "package api\n\nimport . \"net/http\"\n\nfunc f() {\n\t_, _ = Get(url)\n}\n" },
+    comment = { "package api\n\nimport \"net/http\"\n\n// http.Get(url) hangs forever\n" },
+    string = { "package api\n\nimport \"net/http\"\n\nvar s = \"http.DefaultClient\"\n" },
+    custom_client = { "package api\n\nimport \"net/http\"\n\nvar client = &http.Client{Timeout: timeout}\n\nfunc f() {\n\t_, _ = client.Get(url)\n}\n" },
+    selector_chain = { "package api\n\nimport \"net/http\"\n\nfunc f() {\n\t_, _ = s.http.Get(url)\n}\n" },
+    other_name = { "package api\n\nimport \"net/http\"\n\nvar c = http.DefaultClientTimeout\n" },
+    handler = { "package api\n\nimport \"net/http\"\n\nfunc f(mux *http.ServeMux) {\n\thttp.Handle(\"/\", mux)\n}\n" },
+)]
+fn ignores_custom_clients_and_other_calls(content: &str) {
+    assert!(find_default_client_uses(&GoSource::new(content)).is_empty());
+}
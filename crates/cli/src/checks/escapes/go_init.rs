@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `init()` function checking for the escapes check.
+//!
+//! Package `init()` functions run on import, before `main`, so I/O or
+//! registration in them makes startup hard to trace. Projects can opt in via
+//! `[golang.init]` to require an `// INIT:` comment on each `init()` outside
+//! test files, optionally only in packages matching `packages`.
+
+use std::sync::LazyLock;
+
+use globset::GlobSet;
+use regex::Regex;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::module_for;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoInitConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for unjustified `init()` functions.
+pub const GO_INIT: &str = "go_init";
+
+/// Required justification marker.
+pub const INIT_COMMENT: &str = "// INIT:";
+
+/// A package-level `func init()` declaration (methods named `init` have a
+/// receiver and don't match).
+#[allow(clippy::expect_used)]
+static INIT_FUNC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^\s*func\s+init\s*\(\s*\)").expect("valid regex pattern"));
+
+/// A `func init()` declaration.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct InitFunc {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `func`.
+    pub column: u32,
+}
+
+/// Find `init()` function declarations in code, skipping comments and
+/// strings. A file may declare several; each is returned.
+pub fn find_init_funcs(source: &GoSource) -> Vec<InitFunc> {
+    let mut funcs = Vec::new();
+    for (idx, line, code) in source.lines() {
+        if INIT_FUNC.is_match(code) {
+            let indent = line.len() - line.trim_start().len();
+            funcs.push(InitFunc {
+                line: idx as u32 + 1,
+                column: line[..indent].chars().count() as u32 + 1,
+            });
+        }
+    }
+    funcs
+}
+
+/// Whether a package is in scope, by its directory or its import path.
+/// Every package is in scope when no globs are configured.
+pub fn is_scoped_package(globs: Option<&GlobSet>, dir: &str, import_path: Option<&str>) -> bool {
+    let Some(globs) = globs else {
+        return true;
+    };
+    std::iter::once(dir)
+        .chain(import_path)
+        .any(|candidate| globs.is_match(candidate))
+}
+
+/// Check Go `init()` functions and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoInitConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("init")
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let globs = (!self.packages.is_empty()).then(|| build_glob_set(&self.packages));
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if !is_scoped_package(globs.as_ref(), &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        for func in find_init_funcs(&file.source) {
+            if has_justification_comment(file.source.content, func.line, INIT_COMMENT) {
+                continue;
+            }
+
+            let advice = "init() runs on import, before main, so its side effects are hard to trace. \
+Move the setup into a function main calls, or add an // INIT: comment explaining why it must run at import.";
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                func.line,
+                "missing_comment",
+                advice,
+                GO_INIT,
+            ) {
+                let mut v = v.with_column(func.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_init_tests.rs"]
+mod tests;
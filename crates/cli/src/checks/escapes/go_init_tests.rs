@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_each_init_function() {
+    let content = "package codec\n\nfunc init() {\n\tregister(\"json\")\n}\n\nfunc init() {\n\tregister(\"yaml\")\n}\n";
+    assert_eq!(
+        find_init_funcs(&GoSource::new(content)),
+        vec![
+            InitFunc { line: 3, column: 1 },
+            InitFunc { line: 7, column: 1 },
+        ]
+    );
+}
+
+#[parameterized(
+    method = { "package lib\n\nfunc (s *Server) init() {\n}\n" },
+    called = { "package lib\n\nfunc setup() {\n\tinit()\n}\n" },
+    named_prefix = { "package lib\n\nfunc initialize() {\n}\n" },
+    with_params = { "package lib\n\nfunc init(n int) {\n}\n" },
+    comment = { "package lib\n\n// func init() {\n" },
+    raw_string = { "package lib\n\nvar src = `\nfunc init() {\n}\n`\n" },
+)]
+fn ignores_other_declarations(content: &str) {
+    assert!(find_init_funcs(&GoSource::new(content)).is_empty());
+}
+
+#[parameterized(
+    no_globs = { &[], "internal/store", None, true },
+    directory = { &["internal/**"], "internal/store", None, true },
+    import_path = { &["example.com/app/internal/**"], "internal/store", Some("example.com/app/internal/store"), true },
+    unmatched = { &["internal/**"], "cmd/app", Some("example.com/app/cmd/app"), false },
+)]
+fn scopes_packages_by_glob(
+    patterns: &[&str],
+    dir: &str,
+    import_path: Option<&str>,
+    expected: bool,
+) {
+    let patterns: Vec<String> = patterns.iter().map(|p| p.to_string()).collect();
+    let globs = (!patterns.is_empty()).then(|| build_glob_set(&patterns));
+    assert_eq!(
+        is_scoped_package(globs.as_ref(), dir, import_path),
+        expected
+    );
+}
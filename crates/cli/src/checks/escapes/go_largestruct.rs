@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go large struct return checking for the escapes check.
+//!
+//! Returning a struct by value copies it on every call. Projects can opt in
+//! via `[golang.largestruct]` to flag functions returning a struct of the
+//! package larger than `max_bytes` (default 64), optionally only exported
+//! ones. Sizes follow the Go compiler's layout on 64-bit platforms; a
+//! struct with a field whose size can't be known from the package, like a
+//! type from another package, isn't flagged.
+
+use std::collections::HashMap;
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoLargeStructConfig};
+
+use super::go_any::{FUNC_DECL, closing, field_types, parse_signature, signature_text};
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for large structs returned by value.
+pub const LARGE_STRUCT_RETURN: &str = "large_struct_return";
+
+/// Nesting deeper than this is treated as unknown, which also stops cycles.
+const MAX_TYPE_DEPTH: usize = 16;
+
+/// A top-level type declaration: `type Config struct {`, `type ID = [16]byte`.
+/// Generic types don't match.
+#[allow(clippy::expect_used)]
+static TYPE_DECL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?m)^type\s+(\w+)\s+(?:=\s*)?").expect("valid regex pattern"));
+
+/// The start of a grouped type declaration: `type (`.
+#[allow(clippy::expect_used)]
+static TYPE_GROUP: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?m)^type\s*\(").expect("valid regex pattern"));
+
+/// A spec inside a grouped type declaration.
+#[allow(clippy::expect_used)]
+static GROUP_SPEC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?m)^\s+(\w+)\s+(?:=\s*)?").expect("valid regex pattern"));
+
+/// A struct field with names: `A, B int`.
+#[allow(clippy::expect_used)]
+static NAMED_FIELD: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^(\w+(?:\s*,\s*\w+)*)\s+(\S.*)$").expect("valid regex pattern"));
+
+/// A function returning a large struct by value.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct LargeReturn {
+    /// 1-based line of the declaration.
+    pub line: u32,
+    /// 1-based column of the function's name.
+    pub column: u32,
+    /// The function or method name.
+    pub name: String,
+    /// The struct type returned.
+    pub type_name: String,
+    /// Size of the struct in bytes.
+    pub size: u64,
+}
+
+/// Size and alignment of a type, in bytes.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+struct Layout {
+    size: u64,
+    align: u64,
+}
+
+impl Layout {
+    const fn new(size: u64, align: u64) -> Self {
+        Self { size, align }
+    }
+}
+
+/// Layouts of predeclared types and common standard library types.
+fn known_layout(name: &str) -> Option<Layout> {
+    let layout = match name {
+        "bool" | "int8" | "uint8" | "byte" => Layout::new(1, 1),
+        "int16" | "uint16" => Layout::new(2, 2),
+        "int32" | "uint32" | "rune" | "float32" => Layout::new(4, 4),
+        "complex64" => Layout::new(8, 4),
+        "int" | "uint" | "int64" | "uint64" | "uintptr" | "float64" => Layout::new(8, 8),
+        "unsafe.Pointer" | "time.Duration" => Layout::new(8, 8),
+        "complex128" | "string" | "error" | "any" => Layout::new(16, 8),
+        "time.Time" => Layout::new(24, 8),
+        _ => return None,
+    };
+    Some(layout)
+}
+
+fn align_up(offset: u64, align: u64) -> u64 {
+    offset.div_ceil(align) * align
+}
+
+/// Type declarations of a package, by name, as masked source text.
+#[derive(Debug, Default)]
+struct TypeIndex {
+    types: HashMap<String, String>,
+}
+
+impl TypeIndex {
+    /// Index the type declarations of masked sources.
+    fn add(&mut self, code: &str) {
+        for caps in TYPE_DECL.captures_iter(code) {
+            let (Some(name), Some(whole)) = (caps.get(1), caps.get(0)) else {
+                continue;
+            };
+            self.insert(name.as_str(), type_expr(code, whole.end()));
+        }
+        for group in TYPE_GROUP.find_iter(code) {
+            let open = group.end() - 1;
+            let Some(close) = closing(code, open) else {
+                continue;
+            };
+            let body = &code[open + 1..close];
+            let mut pos = 0;
+            while let Some(caps) = GROUP_SPEC.captures_at(body, pos) {
+                let (Some(name), Some(whole)) = (caps.get(1), caps.get(0)) else {
+                    break;
+                };
+                let expr = type_expr(body, whole.end());
+                self.insert(name.as_str(), expr);
+                pos = whole.end() + expr.len();
+            }
+        }
+    }
+
+    fn insert(&mut self, name: &str, expr: &str) {
+        self.types
+            .entry(name.to_string())
+            .or_insert_with(|| expr.trim().to_string());
+    }
+
+    fn is_struct(&self, name: &str) -> bool {
+        self.types
+            .get(name)
+            .is_some_and(|expr| expr.starts_with("struct"))
+    }
+
+    /// Layout of a type expression, or None when it can't be known.
+    fn layout(&self, expr: &str, depth: usize) -> Option<Layout> {
+        let expr = expr.trim();
+        if depth > MAX_TYPE_DEPTH {
+            return None;
+        }
+        if expr.starts_with('*')
+            || expr.starts_with("map[")
+            || expr.starts_with("chan")
+            || expr.starts_with("<-")
+            || expr.starts_with("func")
+        {
+            return Some(Layout::new(8, 8));
+        }
+        if expr.starts_with("[]") {
+            return Some(Layout::new(24, 8));
+        }
+        if expr.starts_with('[') {
+            let end = expr.find(']')?;
+            let len: u64 = expr[1..end].trim().parse().ok()?;
+            let elem = self.layout(&expr[end + 1..], depth + 1)?;
+            return Some(Layout::new(len.checked_mul(elem.size)?, elem.align));
+        }
+        if expr.starts_with("interface") {
+            return Some(Layout::new(16, 8));
+        }
+        if expr.starts_with("struct") {
+            let open = expr.find('{')?;
+            let close = closing(expr, open)?;
+            return self.struct_layout(&expr[open + 1..close], depth);
+        }
+        if let Some(layout) = known_layout(expr) {
+            return Some(layout);
+        }
+        if !expr.chars().all(|c| c.is_alphanumeric() || c == '_') {
+            // Another package's type, or a generic instantiation
+            return None;
+        }
+        self.layout(self.types.get(expr)?, depth + 1)
+    }
+
+    /// Layout of a struct's fields, padded like the compiler does.
+    fn struct_layout(&self, body: &str, depth: usize) -> Option<Layout> {
+        let mut offset = 0u64;
+        let mut align = 1u64;
+        for field in fields(body) {
+            let (count, ty) = match NAMED_FIELD.captures(field) {
+                Some(caps) => (caps[1].split(',').count() as u64, caps.get(2)?.as_str()),
+                // Embedded: `Base`, `*Base`, `sync.Mutex`
+                None => (1, field),
+            };
+            let layout = self.layout(ty, depth + 1)?;
+            offset = align_up(offset, layout.align);
+            offset = offset.checked_add(layout.size.checked_mul(count)?)?;
+            align = align.max(layout.align);
+        }
+        Some(Layout::new(align_up(offset, align), align))
+    }
+}
+
+/// The type expression starting at `from`, up to the end of its line or
+/// `;`, including bracketed parts spanning lines.
+fn type_expr(code: &str, from: usize) -> &str {
+    let mut depth = 0usize;
+    for (i, b) in code.bytes().enumerate().skip(from) {
+        match b {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' => {
+                if depth == 0 {
+                    return &code[from..i];
+                }
+                depth -= 1;
+            }
+            b'\n' | b';' if depth == 0 => return &code[from..i],
+            _ => {}
+        }
+    }
+    &code[from..]
+}
+
+/// Fields of a struct body, split at newlines and `;` outside brackets.
+fn fields(body: &str) -> Vec<&str> {
+    let mut fields = Vec::new();
+    let mut depth = 0usize;
+    let mut start = 0;
+    for (i, b) in body.bytes().enumerate() {
+        match b {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' => depth = depth.saturating_sub(1),
+            b'\n' | b';' if depth == 0 => {
+                fields.push(&body[start..i]);
+                start = i + 1;
+            }
+            _ => {}
+        }
+    }
+    fields.push(&body[start..]);
+    fields
+        .into_iter()
+        .map(str::trim)
+        .filter(|f| !f.is_empty())
+        .collect()
+}
+
+/// Result types of the file's function declarations that name a type,
+/// which may be declared in another file of the package.
+fn result_names(masked: &[String]) -> Vec<String> {
+    let mut names = Vec::new();
+    for (idx, code) in masked.iter().enumerate() {
+        if !FUNC_DECL.is_match(code) {
+            continue;
+        }
+        let text = signature_text(&masked[idx..]);
+        if let Some(signature) = parse_signature(&text) {
+            names.extend(
+                field_types(signature.results)
+                    .into_iter()
+                    .map(str::trim)
+                    .filter(|t| !t.is_empty() && t.chars().all(|c| c.is_alphanumeric() || c == '_'))
+                    .map(str::to_string),
+            );
+        }
+    }
+    names
+}
+
+/// Whether the file returns a type it doesn't declare itself, so the
+/// package's other files are needed.
+fn needs_package_types(source: &GoSource) -> bool {
+    let mut index = TypeIndex::default();
+    index.add(&source.masked.join("\n"));
+    result_names(&source.masked)
+        .iter()
+        .any(|name| known_layout(name).is_none() && !index.types.contains_key(name))
+}
+
+/// Find functions and methods returning a struct of the package larger
+/// than `max_bytes` by value, skipping comments and strings.
+///
+/// `package_sources` are the package's other files, whose type
+/// declarations are read too. With `exported_only`, only exported functions
+/// and methods of exported types are checked.
+pub fn find_large_returns(
+    source: &GoSource,
+    package_sources: &[String],
+    max_bytes: u64,
+    exported_only: bool,
+) -> Vec<LargeReturn> {
+    let mut index = TypeIndex::default();
+    index.add(&source.masked.join("\n"));
+    for sibling in package_sources {
+        index.add(&GoSource::new(sibling).masked.join("\n"));
+    }
+
+    let mut found = Vec::new();
+    for (idx, line, code) in source.lines() {
+        if !FUNC_DECL.is_match(code) {
+            continue;
+        }
+        let text = signature_text(&source.masked[idx..]);
+        let Some(signature) = parse_signature(&text) else {
+            continue;
+        };
+        if exported_only && !signature.is_exported() {
+            continue;
+        }
+        let large = field_types(signature.results).into_iter().find_map(|t| {
+            let t = t.trim();
+            if !index.is_struct(t) {
+                return None;
+            }
+            let layout = index.layout(t, 0)?;
+            (layout.size > max_bytes).then(|| (t.to_string(), layout.size))
+        });
+        let Some((type_name, size)) = large else {
+            continue;
+        };
+        let column = line
+            .get(..signature.name_offset)
+            .map_or(0, |before| before.chars().count());
+        found.push(LargeReturn {
+            line: idx as u32 + 1,
+            column: column as u32 + 1,
+            name: signature.name.to_string(),
+            type_name,
+            size,
+        });
+    }
+    found
+}
+
+/// Contents of the other non-test Go files in `file`'s directory.
+fn sibling_sources(file: &Path) -> Vec<String> {
+    let Some(dir) = file.parent() else {
+        return Vec::new();
+    };
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return Vec::new();
+    };
+    entries
+        .filter_map(|entry| entry.ok())
+        .map(|entry| entry.path())
+        .filter(|path| {
+            let name = path.file_name().and_then(|n| n.to_str()).unwrap_or("");
+            path != file && name.ends_with(".go") && !name.ends_with("_test.go")
+        })
+        .filter_map(|path| std::fs::read_to_string(path).ok())
+        .collect()
+}
+
+/// Check Go functions returning large structs by value and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoLargeStructConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("func")
+        {
+            return violations;
+        }
+
+        // Returned types may be declared in another file of the package
+        let siblings = if needs_package_types(&file.source) {
+            sibling_sources(&ctx.root.join(file.path))
+        } else {
+            Vec::new()
+        };
+
+        for found in find_large_returns(&file.source, &siblings, self.max_bytes, self.exported_only)
+        {
+            let advice = format!(
+                "{} returns {} by value, copying {} bytes on every call (max: {}). \
+Return *{} instead, or split the struct.",
+                found.name, found.type_name, found.size, self.max_bytes, found.type_name
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                LARGE_STRUCT_RETURN,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_largestruct_tests.rs"]
+mod tests;
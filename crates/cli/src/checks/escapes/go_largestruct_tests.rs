@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn struct_size(decls: &str, name: &str) -> Option<u64> {
+    let mut index = TypeIndex::default();
+    index.add(&GoSource::new(decls).masked.join("\n"));
+    index.layout(name, 0).map(|l| l.size)
+}
+
+#[test]
+fn finds_large_struct_returns() {
+    let content = "package geo
+
+type Box struct {
+	Min, Max [5]float64
+}
+
+func Bounds() Box { return Box{} }
+
+func (b *Box) Grow() (Box, error) {
+	return *b, nil
+}
+
+func Empty() *Box { return nil }
+";
+    assert_eq!(
+        find_large_returns(&GoSource::new(content), &[], 64, false),
+        vec![
+            LargeReturn {
+                line: 7,
+                column: 6,
+                name: "Bounds".to_string(),
+                type_name: "Box".to_string(),
+                size: 80,
+            },
+            LargeReturn {
+                line: 9,
+                column: 15,
+                name: "Grow".to_string(),
+                type_name: "Box".to_string(),
+                size: 80,
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    scalars = { "type T struct {\n\tA, B int64\n\tC float32\n}", 24 },
+    padding = { "type T struct {\n\ta bool\n\tb int64\n\tc bool\n}", 24 },
+    packed = { "type T struct {\n\ta, c bool\n\tb int64\n}", 16 },
+    strings_and_slices = { "type T struct {\n\tName string\n\tTags []string\n}", 40 },
+    references = { "type T struct {\n\tp *int\n\tm map[string]int\n\tc chan int\n\tf func()\n\te error\n}", 48 },
+    array = { "type T struct {\n\tBuf [3]uint16\n}", 6 },
+    nested = { "type T struct {\n\tIn Inner\n\tOk bool\n}\n\ntype Inner struct {\n\tA int64\n\tB int32\n}", 24 },
+    embedded = { "type T struct {\n\t*Inner\n\tInner\n}\n\ntype Inner struct {\n\tA [2]int32\n}", 16 },
+    anonymous = { "type T struct {\n\tPos struct{ X, Y float64 }\n}", 16 },
+    grouped = { "type (\n\tID [16]byte\n\tT struct {\n\t\tA, B ID\n\t}\n)", 32 },
+    alias = { "type T = struct{ a int64; b int8 }", 16 },
+    tags = { "type T struct {\n\tName string `json:\"name\"`\n}", 16 },
+    time = { "type T struct {\n\tAt time.Time\n\tTTL time.Duration\n}", 32 },
+)]
+fn struct_sizes_follow_go_layout(decls: &str, size: u64) {
+    assert_eq!(struct_size(decls, "T"), Some(size));
+}
+
+#[parameterized(
+    other_package = { "type T struct {\n\tmu sync.Mutex\n}" },
+    undeclared = { "type T struct {\n\tc Config\n}" },
+    const_length = { "type T struct {\n\tbuf [size]byte\n}" },
+    generic = { "type T struct {\n\tv List[int]\n}" },
+)]
+fn unknown_sizes_are_none(decls: &str) {
+    assert_eq!(struct_size(decls, "T"), None);
+}
+
+#[parameterized(
+    small = { "package p\n\ntype Small struct {\n\tX, Y float64\n}\n\nfunc New() Small { return Small{} }\n" },
+    pointer = { "package p\n\ntype Big struct {\n\tA [9]int64\n}\n\nfunc New() *Big { return nil }\n" },
+    parameter = { "package p\n\ntype Big struct {\n\tA [9]int64\n}\n\nfunc Use(b Big) error { return nil }\n" },
+    array = { "package p\n\ntype Hash [128]byte\n\nfunc Sum() Hash { return Hash{} }\n" },
+    unknown_field = { "package p\n\ntype Big struct {\n\tA [9]int64\n\tmu sync.Mutex\n}\n\nfunc New() Big { return Big{} }\n" },
+    comment = { "package p\n\ntype Big struct {\n\tA [9]int64\n}\n\n// func New() Big\nfunc New() *Big { return nil }\n" },
+)]
+fn ignores_small_pointer_and_unknown_returns(content: &str) {
+    assert!(find_large_returns(&GoSource::new(content), &[], 64, false).is_empty());
+}
+
+#[test]
+fn exported_only_skips_unexported_functions() {
+    let content = "package p\n\ntype big struct {\n\tA [9]int64\n}\n\nfunc newBig() big { return big{} }\n\ntype Big struct {\n\tA [9]int64\n}\n\nfunc NewBig() Big { return Big{} }\n";
+    assert_eq!(
+        find_large_returns(&GoSource::new(content), &[], 64, false).len(),
+        2
+    );
+    let found = find_large_returns(&GoSource::new(content), &[], 64, true);
+    assert_eq!(
+        found.into_iter().map(|f| f.name).collect::<Vec<_>>(),
+        vec!["NewBig"]
+    );
+}
+
+#[test]
+fn package_types_in_other_files_are_read() {
+    let content = "package p\n\nfunc Load() Config { return Config{} }\n";
+    assert!(needs_package_types(&GoSource::new(content)));
+    assert!(find_large_returns(&GoSource::new(content), &[], 64, false).is_empty());
+    let sibling = "package p\n\ntype Config struct {\n\tName, Addr, User, Pass, Token string\n}\n";
+    let found = find_large_returns(&GoSource::new(content), &[sibling.to_string()], 64, false);
+    assert_eq!(found.len(), 1);
+    assert_eq!(found[0].size, 80);
+    assert!(!needs_package_types(&GoSource::new(
+        "package p\n\nfunc Count() (int, error) { return 0, nil }\n"
+    )));
+}
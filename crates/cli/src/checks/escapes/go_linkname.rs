@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `//go:linkname` direction checking for the escapes check.
+//!
+//! Every `//go:linkname` needs a `// LINKNAME:` comment (a default escape
+//! pattern). This module tells the directive's forms apart: one argument
+//! exports a local symbol, two arguments on a bodyless declaration pull
+//! another package's symbol in, and two arguments on a definition push it
+//! out under another name. Go 1.23 restricts pushes into packages that
+//! don't expect them, so pushes targeting another package can be flagged.
+//! Opt-in via `[golang.linkname]`. Targets listed in its `allow` are
+//! reviewed once, so their directives need no comment.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::module_for;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoLinknameConfig};
+
+use super::go_panic::package_name;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for pushes into another package.
+pub const GO_LINKNAME_PUSH: &str = "go_linkname_push";
+
+/// Directive prefix; Go requires it at the start of a line comment.
+const LINKNAME_DIRECTIVE: &str = "//go:linkname";
+
+/// A top-level function or variable declaration's name.
+#[allow(clippy::expect_used)]
+static DECLARATION: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^(func|var)\s+(\w+)").expect("valid regex pattern"));
+
+/// What a `//go:linkname` directive does.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum LinknameKind {
+    /// `//go:linkname local`: lets other packages link to a local symbol.
+    Export,
+    /// `//go:linkname local target` on a bodyless declaration: uses `target`.
+    Pull,
+    /// `//go:linkname local target` on a definition: provides `target`.
+    Push,
+}
+
+/// A parsed `//go:linkname` directive.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct LinknameDirective {
+    /// Local symbol name.
+    pub local: String,
+    /// Linked symbol (`importpath.name`), or None for the one-argument form.
+    pub target: Option<String>,
+    pub kind: LinknameKind,
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the target (or the local name, without one).
+    pub column: u32,
+}
+
+/// Find every `//go:linkname` directive with one or two arguments.
+///
+/// A two-argument directive is a push when the file defines `local` as a
+/// function with a body, and a pull otherwise (a bodyless function, a
+/// variable, or no declaration in this file).
+pub fn find_linkname_directives(source: &GoSource) -> Vec<LinknameDirective> {
+    let code = &source.masked;
+    let mut directives = Vec::new();
+    for (idx, line) in source.content.lines().enumerate() {
+        let Some((local, target)) = parse_directive(line) else {
+            continue;
+        };
+        let arg = target.unwrap_or(local);
+        let offset = line.rfind(arg).unwrap_or(0);
+        let kind = match target {
+            None => LinknameKind::Export,
+            Some(_) if defines_function(code, local) => LinknameKind::Push,
+            Some(_) => LinknameKind::Pull,
+        };
+        directives.push(LinknameDirective {
+            local: local.to_string(),
+            target: target.map(String::from),
+            kind,
+            line: idx as u32 + 1,
+            column: line[..offset].chars().count() as u32 + 1,
+        });
+    }
+    directives
+}
+
+/// Split a directive line into its local name and optional target.
+fn parse_directive(line: &str) -> Option<(&str, Option<&str>)> {
+    let rest = line.strip_prefix(LINKNAME_DIRECTIVE)?;
+    if !rest.starts_with([' ', '\t']) {
+        return None;
+    }
+    let args: Vec<&str> = rest.split_whitespace().collect();
+    match args.as_slice() {
+        [local] => Some((*local, None)),
+        [local, target] => Some((*local, Some(*target))),
+        _ => None,
+    }
+}
+
+/// Whether `line` is a directive whose target is listed in
+/// `[golang.linkname].allow`, so it needs no `// LINKNAME:` comment.
+pub fn is_allowed_target(line: &str, allow: &[String]) -> bool {
+    parse_directive(line)
+        .and_then(|(_, target)| target)
+        .is_some_and(|target| allow.iter().any(|allowed| allowed == target))
+}
+
+/// Whether masked source declares `name` as a function with a body.
+fn defines_function(code: &[String], name: &str) -> bool {
+    let Some(start) = code.iter().position(|line| {
+        DECLARATION
+            .captures(line)
+            .is_some_and(|c| &c[1] == "func" && &c[2] == name)
+    }) else {
+        return false;
+    };
+
+    // The body's `{` is the first brace outside the signature's parentheses;
+    // the declaration ends at a line break with none open
+    let mut depth = 0usize;
+    for line in &code[start..] {
+        for byte in line.bytes() {
+            match byte {
+                b'(' | b'[' => depth += 1,
+                b')' | b']' => depth = depth.saturating_sub(1),
+                b'{' if depth == 0 => return true,
+                _ => {}
+            }
+        }
+        if depth == 0 {
+            return false;
+        }
+    }
+    false
+}
+
+/// Package path of a linkname target: `runtime` for `runtime.nanotime`,
+/// `example.com/lib` for `example.com/lib.(*T).m`.
+pub fn target_package(target: &str) -> &str {
+    let name_start = target.rfind('/').map_or(0, |slash| slash + 1);
+    match target[name_start..].find('.') {
+        Some(dot) => &target[..name_start + dot],
+        None => target,
+    }
+}
+
+/// Check `//go:linkname` pushes and return violations.
+///
+/// The file's package is its import path from the enclosing module, or
+/// `main` for main packages, which the linker names `main`.
+impl GoRule for GoLinknameConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.foreign_push == CheckLevel::Off || !file.source.content.contains(LINKNAME_DIRECTIVE)
+        {
+            return violations;
+        }
+
+        let package = package_name(file.source.content);
+        let current = if package.as_deref() == Some("main") {
+            package
+        } else {
+            let dir = match file.path.parent().and_then(|p| p.to_str()) {
+                Some("") | None => ".".to_string(),
+                Some(dir) => dir.replace('\\', "/"),
+            };
+            module_for(file.modules, &dir)
+                .map(|m| m.import_path(&dir))
+                .or(package)
+        };
+
+        for directive in find_linkname_directives(&file.source) {
+            let (LinknameKind::Push, Some(target)) = (directive.kind, &directive.target) else {
+                continue;
+            };
+            if current.as_deref() == Some(target_package(target)) {
+                continue;
+            }
+
+            let advice = format!(
+                "//go:linkname pushes {} into package {} as {}. Go 1.23+ rejects linknames into \
+packages that don't expect them; define the function in {} instead, or pull it from there.",
+                directive.local,
+                target_package(target),
+                target,
+                target_package(target)
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                directive.line,
+                "forbidden",
+                &advice,
+                GO_LINKNAME_PUSH,
+            ) {
+                let mut v = v.with_column(directive.column);
+                v.warning = self.foreign_push == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_linkname_tests.rs"]
+mod tests;
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn kinds(content: &str) -> Vec<LinknameKind> {
+    find_linkname_directives(&GoSource::new(content))
+        .into_iter()
+        .map(|d| d.kind)
+        .collect()
+}
+
+#[test]
+fn parses_one_and_two_argument_forms() {
+    let content = "package lib\n\n//go:linkname exported\nfunc exported() {}\n\n//go:linkname nanotime runtime.nanotime\nfunc nanotime() int64\n";
+    assert_eq!(
+        find_linkname_directives(&GoSource::new(content)),
+        vec![
+            LinknameDirective {
+                local: "exported".to_string(),
+                target: None,
+                kind: LinknameKind::Export,
+                line: 3,
+                column: 15,
+            },
+            LinknameDirective {
+                local: "nanotime".to_string(),
+                target: Some("runtime.nanotime".to_string()),
+                kind: LinknameKind::Pull,
+                line: 6,
+                column: 24,
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    bodyless_function = { "//go:linkname now runtime.nanotime\nfunc now() int64\n", LinknameKind::Pull },
+    variable = { "//go:linkname zero runtime.zeroVal\nvar zero [1024]byte\n", LinknameKind::Pull },
+    declared_elsewhere = { "//go:linkname now runtime.nanotime\n", LinknameKind::Pull },
+    definition = { "//go:linkname now runtime.nanotime\nfunc now() int64 { return 0 }\n", LinknameKind::Push },
+    multi_line_signature = { "//go:linkname now runtime.nanotime\nfunc now(\n\ta int,\n) int64 {\n\treturn 0\n}\n", LinknameKind::Push },
+    generic_definition = { "//go:linkname now runtime.nanotime\nfunc now[T any](t T) int64 {\n\treturn 0\n}\n", LinknameKind::Push },
+    brace_in_comment = { "//go:linkname now runtime.nanotime\nfunc now() int64 // {\n", LinknameKind::Pull },
+)]
+fn classifies_two_argument_form(body: &str, kind: LinknameKind) {
+    let content = format!("package lib\n\n{}", body);
+    assert_eq!(kinds(&content), vec![kind]);
+}
+
+#[parameterized(
+    no_arguments = { "package lib\n\n//go:linkname\n" },
+    three_arguments = { "package lib\n\n//go:linkname a b c\n" },
+    spaced_comment = { "package lib\n\n// go:linkname now runtime.nanotime\n" },
+    indented = { "package lib\n\n\t//go:linkname now runtime.nanotime\n" },
+    other_directive = { "package lib\n\n//go:linknamed now\n" },
+)]
+fn ignores_non_directives(content: &str) {
+    assert!(kinds(content).is_empty());
+}
+
+#[parameterized(
+    standard_library = { "runtime.nanotime", "runtime" },
+    nested_package = { "internal/poll.runtime_Semacquire", "internal/poll" },
+    module_path = { "example.com/lib.helper", "example.com/lib" },
+    method = { "example.com/lib.(*T).m", "example.com/lib" },
+    no_name = { "runtime", "runtime" },
+)]
+fn target_package_strips_symbol(target: &str, package: &str) {
+    assert_eq!(target_package(target), package);
+}
+
+#[parameterized(
+    listed_pull = { "//go:linkname now runtime.nanotime", true },
+    listed_push = { "//go:linkname rand runtime.fastrand", true },
+    unlisted_target = { "//go:linkname sema runtime.semacquire", false },
+    listed_local_name = { "//go:linkname runtime.nanotime", false },
+    prefix_of_listed = { "//go:linkname now runtime.nano", false },
+    not_a_directive = { "// see runtime.nanotime", false },
+)]
+fn allow_matches_directive_target(line: &str, expected: bool) {
+    let allow = vec![
+        "runtime.nanotime".to_string(),
+        "runtime.fastrand".to_string(),
+    ];
+    assert_eq!(is_allowed_target(line, &allow), expected);
+}
@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go unlocked map access checking for the escapes check.
+//!
+//! A map field of a struct that also holds a `sync.Mutex` is usually meant
+//! to be accessed with the mutex held, and a concurrent unlocked access is a
+//! data race that can crash the process. Projects can opt in via
+//! `[golang.maplock]` to flag accesses of such a map in a method that hasn't
+//! locked the mutex, when another method of the type does lock it around the
+//! same map. The check is a heuristic over one file at a time, so it warns
+//! by default.
+
+use std::collections::{HashMap, HashSet};
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoMapLockConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for map accesses without the struct's mutex held.
+pub const UNLOCKED_MAP: &str = "unlocked_map";
+
+/// A top-level struct declaration: `type Cache struct {`.
+#[allow(clippy::expect_used)]
+static STRUCT_DECL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^type\s+(\w+)(?:\[[^\]]*\])?\s+struct\s*\{").expect("valid regex pattern")
+});
+
+/// A struct field with names: `mu sync.Mutex`, `a, b map[string]int`.
+#[allow(clippy::expect_used)]
+static NAMED_FIELD: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^\s*(\w+(?:\s*,\s*\w+)*)\s+(\*?[\w.\[]\S*)").expect("valid regex pattern")
+});
+
+/// A method declaration: `func (c *Cache) Get(`.
+#[allow(clippy::expect_used)]
+static METHOD_DECL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^func\s*\(\s*(\w+)\s+\*?\s*(\w+)(?:\[[^\]]*\])?\s*\)\s*(\w+)")
+        .expect("valid regex pattern")
+});
+
+/// A mutex call on the receiver: `c.mu.Lock()`, `c.RUnlock()`, `defer c.mu.Unlock()`.
+#[allow(clippy::expect_used)]
+static LOCK_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(defer\s+)?(\w+)(?:\.(\w+))?\.(Lock|RLock|Unlock|RUnlock)\s*\(\s*\)")
+        .expect("valid regex pattern")
+});
+
+/// An indexed field: `c.items[key]`.
+#[allow(clippy::expect_used)]
+static FIELD_INDEX: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])(\w+)\.(\w+)\s*\[").expect("valid regex pattern"));
+
+/// A field ranged over or passed to a map builtin: `range c.items`,
+/// `delete(c.items, key)`, `len(c.items)`, `clear(c.items)`.
+#[allow(clippy::expect_used)]
+static FIELD_USE: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(?:(?:delete|len|clear)\s*\(\s*|range\s+)(\w+)\.(\w+)\b")
+        .expect("valid regex pattern")
+});
+
+/// A goroutine started from a function literal: `go func(`.
+#[allow(clippy::expect_used)]
+static GO_FUNC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"\bgo\s+func\s*\(").expect("valid regex pattern"));
+
+/// A map field accessed without the struct's mutex held.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct UnlockedMapAccess {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the receiver.
+    pub column: u32,
+    /// The struct type, e.g. `Cache`.
+    pub type_name: String,
+    /// The map field, e.g. `items`.
+    pub field: String,
+}
+
+/// Map and mutex fields of a struct.
+#[derive(Debug, Default)]
+struct Guarded {
+    maps: HashSet<String>,
+    mutexes: HashSet<String>,
+    /// Whether a `sync.Mutex` or `sync.RWMutex` is embedded.
+    embedded: bool,
+}
+
+/// Structs declared in `masked` with both a map field and a mutex.
+fn guarded_structs(masked: &[String]) -> HashMap<String, Guarded> {
+    let mut structs = HashMap::new();
+    let mut current: Option<(String, Guarded)> = None;
+    for code in masked {
+        if let Some((name, mut guarded)) = current.take() {
+            if code.trim_start().starts_with('}') {
+                let has_mutex = guarded.embedded || !guarded.mutexes.is_empty();
+                if has_mutex && !guarded.maps.is_empty() {
+                    structs.insert(name, guarded);
+                }
+                continue;
+            }
+            let field = code.trim();
+            if is_mutex(field) {
+                guarded.embedded = true;
+            } else if let Some(caps) = NAMED_FIELD.captures(code) {
+                let names = caps[1].split(',').map(|n| n.trim().to_string());
+                if caps[2].starts_with("map[") {
+                    guarded.maps.extend(names);
+                } else if is_mutex(&caps[2]) {
+                    guarded.mutexes.extend(names);
+                }
+            }
+            current = Some((name, guarded));
+        } else if let Some(caps) = STRUCT_DECL.captures(code) {
+            // One-line structs have no fields worth guarding
+            if !code.trim_end().ends_with('}') {
+                current = Some((caps[1].to_string(), Guarded::default()));
+            }
+        }
+    }
+    structs
+}
+
+fn is_mutex(ty: &str) -> bool {
+    matches!(ty.trim_start_matches('*'), "sync.Mutex" | "sync.RWMutex")
+}
+
+/// A method of a guarded struct whose body is being scanned.
+struct Method<'a> {
+    receiver: String,
+    type_name: String,
+    guarded: &'a Guarded,
+}
+
+/// A function body, or a `go func` literal inside one, with its lock state.
+struct Scope {
+    /// Brace depth inside the body.
+    depth: usize,
+    locked: bool,
+}
+
+enum Event {
+    Open,
+    Close,
+    GoFunc,
+    Lock(bool),
+    Access(String, u32),
+}
+
+/// An access of a guarded map field, and whether the mutex was held.
+struct Access {
+    line: u32,
+    column: u32,
+    type_name: String,
+    field: String,
+    locked: bool,
+}
+
+/// Find accesses of a struct's map field in methods that haven't locked the
+/// struct's mutex, skipping comments and strings.
+///
+/// Only maps that some method of the type does access with the mutex held
+/// are reported. The lock state is followed top to bottom through each
+/// method: `Lock` and `RLock` hold the mutex until a non-deferred `Unlock`
+/// or `RUnlock`, so a read before the lock in double-checked locking is
+/// reported. A `go func` literal starts unlocked, since the goroutine runs
+/// after the enclosing lock may be released. Methods named `...Locked` are
+/// assumed to be called with the mutex held, and accesses through anything
+/// but the receiver, like a local copy of the map, aren't followed.
+pub fn find_unlocked_map_accesses(source: &GoSource) -> Vec<UnlockedMapAccess> {
+    let structs = guarded_structs(&source.masked);
+    if structs.is_empty() {
+        return Vec::new();
+    }
+
+    let mut accesses: Vec<Access> = Vec::new();
+    let mut depth = 0usize;
+    let mut method: Option<Method> = None;
+    let mut scopes: Vec<Scope> = Vec::new();
+    let mut pending_scope = false;
+
+    for (idx, line, code) in source.lines() {
+        let line_no = idx as u32 + 1;
+
+        if depth == 0 {
+            method = None;
+            scopes.clear();
+            pending_scope = false;
+            if let Some(caps) = METHOD_DECL.captures(code)
+                && let Some(guarded) = structs.get(&caps[2])
+                && !caps[3].ends_with("Locked")
+                && !caps[3].ends_with("_locked")
+            {
+                method = Some(Method {
+                    receiver: caps[1].to_string(),
+                    type_name: caps[2].to_string(),
+                    guarded,
+                });
+                pending_scope = true;
+            }
+        }
+
+        let mut events: Vec<(usize, Event)> = code
+            .bytes()
+            .enumerate()
+            .filter_map(|(pos, byte)| match byte {
+                b'{' => Some((pos, Event::Open)),
+                b'}' => Some((pos, Event::Close)),
+                _ => None,
+            })
+            .collect();
+        if let Some(ref m) = method {
+            collect_events(m, code, line, &mut events);
+        }
+        events.sort_by_key(|(pos, _)| *pos);
+
+        for (_, event) in events {
+            match event {
+                Event::Open => {
+                    depth += 1;
+                    if pending_scope {
+                        scopes.push(Scope {
+                            depth,
+                            locked: false,
+                        });
+                        pending_scope = false;
+                    }
+                }
+                Event::Close => {
+                    if scopes.last().is_some_and(|s| s.depth == depth) {
+                        scopes.pop();
+                    }
+                    depth = depth.saturating_sub(1);
+                }
+                Event::GoFunc => pending_scope = true,
+                Event::Lock(locked) => {
+                    if let Some(scope) = scopes.last_mut() {
+                        scope.locked = locked;
+                    }
+                }
+                Event::Access(field, column) => {
+                    let (Some(m), Some(scope)) = (&method, scopes.last()) else {
+                        continue;
+                    };
+                    accesses.push(Access {
+                        line: line_no,
+                        column,
+                        type_name: m.type_name.clone(),
+                        field,
+                        locked: scope.locked,
+                    });
+                }
+            }
+        }
+    }
+
+    let locked: HashSet<(&str, &str)> = accesses
+        .iter()
+        .filter(|a| a.locked)
+        .map(|a| (a.type_name.as_str(), a.field.as_str()))
+        .collect();
+    let mut found: Vec<UnlockedMapAccess> = accesses
+        .iter()
+        .filter(|a| !a.locked && locked.contains(&(a.type_name.as_str(), a.field.as_str())))
+        .map(|a| UnlockedMapAccess {
+            line: a.line,
+            column: a.column,
+            type_name: a.type_name.clone(),
+            field: a.field.clone(),
+        })
+        .collect();
+    found.sort_by_key(|f| (f.line, f.column));
+    found.dedup_by_key(|f| (f.line, f.column));
+    found
+}
+
+/// Lock calls, map field accesses, and `go func` literals in a method line.
+fn collect_events(method: &Method, code: &str, line: &str, events: &mut Vec<(usize, Event)>) {
+    let column = |pos: usize| line[..pos].chars().count() as u32 + 1;
+
+    for caps in LOCK_CALL.captures_iter(code) {
+        let (Some(receiver), Some(call)) = (caps.get(2), caps.get(4)) else {
+            continue;
+        };
+        if receiver.as_str() != method.receiver {
+            continue;
+        }
+        let ours = match caps.get(3) {
+            Some(field) => method.guarded.mutexes.contains(field.as_str()),
+            None => method.guarded.embedded,
+        };
+        if !ours {
+            continue;
+        }
+        let locks = matches!(call.as_str(), "Lock" | "RLock");
+        // A deferred unlock runs when the method returns
+        if locks || caps.get(1).is_none() {
+            events.push((call.start(), Event::Lock(locks)));
+        }
+    }
+
+    for caps in FIELD_INDEX
+        .captures_iter(code)
+        .chain(FIELD_USE.captures_iter(code))
+    {
+        let (Some(receiver), Some(field)) = (caps.get(1), caps.get(2)) else {
+            continue;
+        };
+        if receiver.as_str() == method.receiver && method.guarded.maps.contains(field.as_str()) {
+            events.push((
+                receiver.start(),
+                Event::Access(field.as_str().to_string(), column(receiver.start())),
+            ));
+        }
+    }
+
+    for found in GO_FUNC.find_iter(code) {
+        events.push((found.start(), Event::GoFunc));
+    }
+}
+
+/// Check Go map accesses without the struct's mutex and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoMapLockConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("sync.")
+        {
+            return violations;
+        }
+
+        for found in find_unlocked_map_accesses(&file.source) {
+            let advice = format!(
+                "{}.{} is accessed with the mutex held elsewhere, but not here. \
+Unlocked concurrent map access is a data race. \
+Lock the mutex around this access, or rename the method to end in Locked if callers hold it.",
+                found.type_name, found.field
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                UNLOCKED_MAP,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_maplock_tests.rs"]
+mod tests;
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+const CACHE: &str = "package p
+
+import \"sync\"
+
+type Cache struct {
+	mu    sync.RWMutex
+	items map[string]int
+}
+
+func (c *Cache) Set(key string, v int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = v
+}
+";
+
+fn with_cache(method: &str) -> String {
+    format!("{}\n{}", CACHE, method)
+}
+
+#[test]
+fn finds_unlocked_access_with_column() {
+    let content = with_cache(
+        "func (c *Cache) Get(key string) int {
+	return c.items[key]
+}
+",
+    );
+    assert_eq!(
+        find_unlocked_map_accesses(&GoSource::new(&content)),
+        vec![UnlockedMapAccess {
+            line: 17,
+            column: 9,
+            type_name: "Cache".to_string(),
+            field: "items".to_string(),
+        }]
+    );
+}
+
+#[parameterized(
+    read_lock = { "func (c *Cache) Get(key string) int {\n\tc.mu.RLock()\n\tdefer c.mu.RUnlock()\n\treturn c.items[key]\n}\n" },
+    unlock_after = { "func (c *Cache) Len() int {\n\tc.mu.RLock()\n\tn := len(c.items)\n\tc.mu.RUnlock()\n\treturn n\n}\n" },
+    locked_suffix = { "func (c *Cache) getLocked(key string) int {\n\treturn c.items[key]\n}\n" },
+    other_receiver = { "func (c *Cache) Copy(other *Cache) int {\n\treturn other.items[\"a\"]\n}\n" },
+    commented = { "func (c *Cache) Get(key string) int {\n\t// return c.items[key]\n\treturn 0\n}\n" },
+    locked_goroutine = { "func (c *Cache) Warm() {\n\tgo func() {\n\t\tc.mu.Lock()\n\t\tdefer c.mu.Unlock()\n\t\tc.items[\"a\"] = 1\n\t}()\n}\n" },
+)]
+fn guarded_accesses_are_ok(method: &str) {
+    assert!(find_unlocked_map_accesses(&GoSource::new(&with_cache(method))).is_empty());
+}
+
+#[parameterized(
+    plain = { "func (c *Cache) Get(key string) int {\n\treturn c.items[key]\n}\n", 17 },
+    after_unlock = { "func (c *Cache) Bump(key string) {\n\tc.mu.Lock()\n\tc.mu.Unlock()\n\tc.items[key]++\n}\n", 19 },
+    double_checked = { "func (c *Cache) Load(key string) int {\n\tif v, ok := c.items[key]; ok {\n\t\treturn v\n\t}\n\tc.mu.Lock()\n\tdefer c.mu.Unlock()\n\treturn c.items[key]\n}\n", 17 },
+    goroutine = { "func (c *Cache) Warm() {\n\tc.mu.Lock()\n\tdefer c.mu.Unlock()\n\tgo func() {\n\t\tc.items[\"a\"] = 1\n\t}()\n}\n", 20 },
+    delete = { "func (c *Cache) Drop(key string) {\n\tdelete(c.items, key)\n}\n", 17 },
+    range_loop = { "func (c *Cache) Keys() (keys []string) {\n\tfor k := range c.items {\n\t\tkeys = append(keys, k)\n\t}\n\treturn keys\n}\n", 17 },
+)]
+fn unlocked_accesses_are_found(method: &str, line: u32) {
+    let lines: Vec<u32> = find_unlocked_map_accesses(&GoSource::new(&with_cache(method)))
+        .iter()
+        .map(|f| f.line)
+        .collect();
+    assert_eq!(lines, vec![line]);
+}
+
+#[test]
+fn maps_never_accessed_under_lock_are_not_reported() {
+    let content = "package p
+
+import \"sync\"
+
+type Index struct {
+	mu    sync.Mutex
+	names map[string]int
+	count int
+}
+
+func (i *Index) Add() {
+	i.mu.Lock()
+	i.count++
+	i.mu.Unlock()
+}
+
+func (i *Index) Lookup(name string) int {
+	return i.names[name]
+}
+";
+    assert!(find_unlocked_map_accesses(&GoSource::new(content)).is_empty());
+}
+
+#[test]
+fn embedded_mutex_guards_the_map() {
+    let content = "package p
+
+import \"sync\"
+
+type Registry struct {
+	sync.Mutex
+	byName map[string]int
+}
+
+func (r *Registry) Put(name string) {
+	r.Lock()
+	r.byName[name] = 1
+	r.Unlock()
+}
+
+func (r *Registry) Has(name string) bool {
+	_, ok := r.byName[name]
+	return ok
+}
+";
+    let lines: Vec<u32> = find_unlocked_map_accesses(&GoSource::new(content))
+        .iter()
+        .map(|f| f.line)
+        .collect();
+    assert_eq!(lines, vec![17]);
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go map iteration order checking for the escapes check.
+//!
+//! Map iteration order is randomized, so a slice appended to in a
+//! `for ... range m` loop comes out in a different order on every run.
+//! Projects can opt in via `[golang.maporder]` to flag loops whose slice is
+//! returned without being sorted first, outside `_test.go` files. The check
+//! is a heuristic over one function at a time, so it warns by default.
+
+use std::collections::HashSet;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoMapOrderConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for unsorted slices built from map iteration.
+pub const MAP_ORDER: &str = "map_order";
+
+/// A map declared in a function: `m := make(map[`, `m := map[`,
+/// `var m map[`, or a `m map[` parameter.
+#[allow(clippy::expect_used)]
+static MAP_DECL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(\w+)\s*(?::?=\s*|\s)(?:make\s*\(\s*)?map\[").expect("valid regex pattern")
+});
+
+/// A range loop over a plain name: `for k, v := range m {`.
+#[allow(clippy::expect_used)]
+static RANGE_LOOP: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\bfor\s+(?:[\w\s,]*:?=\s*)?range\s+(\w+)\s*\{").expect("valid regex pattern")
+});
+
+/// A self-append: `out = append(out, ...`.
+#[allow(clippy::expect_used)]
+static SELF_APPEND: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(\w+)\s*=\s*append\s*\(\s*(\w+)\b").expect("valid regex pattern")
+});
+
+/// A sort of a slice: `sort.Strings(out)`, `slices.SortFunc(out, ...)`,
+/// `sort.Sort(sort.StringSlice(out))`.
+#[allow(clippy::expect_used)]
+static SORT_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(?:sort\.\w+|slices\.Sort\w*)\s*\(\s*(?:sort\.\w+\s*\(\s*)?(\w+)\b")
+        .expect("valid regex pattern")
+});
+
+/// A map range loop whose appended slice is returned unsorted.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct UnsortedMapAppend {
+    /// 1-based line number of the `for`.
+    pub line: u32,
+    /// 1-based column of the `for`.
+    pub column: u32,
+    /// The returned slice.
+    pub slice: String,
+}
+
+/// A range loop over a map, while its body is open or once it has closed.
+struct MapLoop {
+    line: u32,
+    column: u32,
+    /// Brace depth inside the loop body.
+    depth: usize,
+    /// Whether the body hasn't closed yet.
+    open: bool,
+    /// Slices appended to in the body that haven't been sorted since.
+    slices: Vec<String>,
+    reported: bool,
+}
+
+/// Find range loops over a map that append to a slice the function then
+/// returns without sorting, skipping comments and strings.
+///
+/// Maps are recognized by their declaration in the same function (a
+/// parameter, `make(map[...])`, a map literal, or `var m map[...]`), so
+/// ranges over struct fields and function results aren't matched. Sorting
+/// with any `sort` function or `slices.Sort*` after the loop clears it.
+pub fn find_unsorted_map_appends(source: &GoSource) -> Vec<UnsortedMapAppend> {
+    let mut found = Vec::new();
+    let mut depth = 0usize;
+    let mut maps: HashSet<String> = HashSet::new();
+    let mut loops: Vec<MapLoop> = Vec::new();
+
+    for (idx, line, code) in source.lines() {
+        let line_no = idx as u32 + 1;
+
+        // A top-level function starts a new scope
+        if depth == 0 && code.trim_start().starts_with("func") {
+            maps.clear();
+            loops.clear();
+        }
+
+        for captures in MAP_DECL.captures_iter(code) {
+            maps.insert(captures[1].to_string());
+        }
+
+        for captures in SORT_CALL.captures_iter(code) {
+            for map_loop in &mut loops {
+                map_loop.slices.retain(|slice| *slice != captures[1]);
+            }
+        }
+
+        for captures in SELF_APPEND.captures_iter(code) {
+            if captures[1] != captures[2] {
+                continue;
+            }
+            for map_loop in loops.iter_mut().filter(|l| l.open) {
+                if !map_loop.slices.iter().any(|s| *s == captures[1]) {
+                    map_loop.slices.push(captures[1].to_string());
+                }
+            }
+        }
+
+        if let Some(returned) = code.split_once("return").and_then(|(before, after)| {
+            let bounded = before.is_empty() || before.ends_with(|c: char| !is_word(c));
+            let bounded = bounded && !after.starts_with(is_word);
+            bounded.then_some(after)
+        }) {
+            let names: Vec<&str> = returned.split(|c: char| !is_word(c)).collect();
+            // Only loops that have closed: a return inside the loop exits early
+            for map_loop in loops.iter_mut().filter(|l| !l.open) {
+                if map_loop.reported {
+                    continue;
+                }
+                if let Some(slice) = map_loop.slices.iter().find(|s| names.contains(&s.as_str())) {
+                    found.push(UnsortedMapAppend {
+                        line: map_loop.line,
+                        column: map_loop.column,
+                        slice: slice.clone(),
+                    });
+                    map_loop.reported = true;
+                }
+            }
+        }
+
+        let loop_start = RANGE_LOOP
+            .captures(code)
+            .filter(|captures| maps.contains(&captures[1]))
+            .and_then(|captures| captures.get(0));
+
+        for byte in code.bytes() {
+            match byte {
+                b'{' => depth += 1,
+                b'}' => depth = depth.saturating_sub(1),
+                _ => {}
+            }
+        }
+        for map_loop in &mut loops {
+            map_loop.open &= map_loop.depth <= depth;
+        }
+
+        if let Some(start) = loop_start {
+            loops.push(MapLoop {
+                line: line_no,
+                column: line[..start.start()].chars().count() as u32 + 1,
+                depth,
+                open: true,
+                slices: Vec::new(),
+                reported: false,
+            });
+        }
+    }
+
+    found.sort_by_key(|f| (f.line, f.column));
+    found
+}
+
+fn is_word(c: char) -> bool {
+    c.is_alphanumeric() || c == '_'
+}
+
+/// Check Go map iteration order and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoMapOrderConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("range")
+        {
+            return violations;
+        }
+
+        for found in find_unsorted_map_appends(&file.source) {
+            let advice = format!(
+                "Map iteration order is random, so {} is returned in a different order on every run. \
+Sort {} before returning it (sort.Strings, slices.Sort), or range over sorted keys.",
+                found.slice, found.slice
+            );
+            if let Some(v) =
+                try_create_violation(ctx, file.path, found.line, "forbidden", &advice, MAP_ORDER)
+            {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_maporder_tests.rs"]
+mod tests;
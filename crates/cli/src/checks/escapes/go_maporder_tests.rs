@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_unsorted_appends_with_columns() {
+    let content = "package p
+
+func Names(byID map[string]int) []string {
+	var names []string
+	for name := range byID {
+		names = append(names, name)
+	}
+	return names
+}
+
+func Keys() []string {
+	seen := make(map[string]bool)
+	keys := []string{}
+	for k, ok := range seen {
+		if ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		return keys
+	}
+	return nil
+}
+";
+    assert_eq!(
+        find_unsorted_map_appends(&GoSource::new(content)),
+        vec![
+            UnsortedMapAppend {
+                line: 5,
+                column: 2,
+                slice: "names".to_string(),
+            },
+            UnsortedMapAppend {
+                line: 14,
+                column: 2,
+                slice: "keys".to_string(),
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    sort_strings = { "sort.Strings(names)" },
+    sort_slice = { "sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })" },
+    sort_sort = { "sort.Sort(sort.StringSlice(names))" },
+    slices_sort = { "slices.Sort(names)" },
+    slices_sort_func = { "slices.SortFunc(names, strings.Compare)" },
+)]
+fn sorting_after_the_loop_is_ok(sort: &str) {
+    let content = format!(
+        "package p
+
+func Names(byID map[string]int) []string {{
+	var names []string
+	for name := range byID {{
+		names = append(names, name)
+	}}
+	{}
+	return names
+}}
+",
+        sort
+    );
+    assert!(find_unsorted_map_appends(&GoSource::new(&content)).is_empty());
+}
+
+#[parameterized(
+    slice = { "func Names(ids []string) []string {\n\tvar names []string\n\tfor _, id := range ids {\n\t\tnames = append(names, id)\n\t}\n\treturn names\n}\n" },
+    not_returned = { "func Count(byID map[string]int) int {\n\tvar names []string\n\tfor name := range byID {\n\t\tnames = append(names, name)\n\t}\n\treturn len(byID)\n}\n" },
+    early_return = { "func First(byID map[string]int) []string {\n\tvar names []string\n\tfor name := range byID {\n\t\tnames = append(names, name)\n\t\treturn names\n\t}\n\treturn nil\n}\n" },
+    other_function = { "func Build(byID map[string]int) {\n\tfor name := range byID {\n\t\tnames = append(names, name)\n\t}\n}\n\nfunc Get() []string {\n\treturn names\n}\n" },
+    commented = { "func Names(byID map[string]int) []string {\n\t// for name := range byID {\n\t// names = append(names, name)\n\t// }\n\treturn names\n}\n" },
+)]
+fn other_loops_are_not_matched(body: &str) {
+    let content = format!("package p\n\n{}", body);
+    assert!(find_unsorted_map_appends(&GoSource::new(&content)).is_empty());
+}
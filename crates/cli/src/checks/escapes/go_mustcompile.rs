@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `regexp.MustCompile` checking for the escapes check.
+//!
+//! `regexp.MustCompile` panics when its pattern doesn't compile. For a
+//! pattern fixed in the source that's a bug caught on the first run, but
+//! for one built at run time it turns a bad config value or user input into
+//! a crash instead of an error. Flags `MustCompile` and `MustCompilePOSIX`
+//! calls whose pattern isn't a string literal, a const, or a concatenation
+//! of them. On by default via `[golang.mustcompile]`.
+
+use std::collections::HashSet;
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoMustCompileConfig};
+
+use super::go_exit::import_names;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `MustCompile` on patterns built at run time.
+pub const MUST_COMPILE: &str = "must_compile";
+
+/// A `MustCompile` selector call: `regexp.MustCompile(`.
+#[allow(clippy::expect_used)]
+static MUST_COMPILE_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(MustCompile(?:POSIX)?)\s*\(").expect("valid regex pattern")
+});
+
+/// The names of a const spec: `const a, b = ...`, or `a = ...` in a
+/// `const (` block.
+#[allow(clippy::expect_used)]
+static CONST_SPEC: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"^\s*(?:const\s+)?([A-Za-z_]\w*(?:\s*,\s*[A-Za-z_]\w*)*)")
+        .expect("valid regex pattern")
+});
+
+/// What a pattern argument is known to be.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+enum PatternKind {
+    /// String literals and consts: fixed at compile time.
+    Constant,
+    /// A variable, call, or other expression evaluated at run time.
+    Dynamic,
+    /// Refers to another package, whose consts aren't known.
+    Unknown,
+}
+
+/// A `MustCompile` call whose pattern is built at run time.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct DynamicPattern {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the pattern argument.
+    pub column: u32,
+    /// The function called: `MustCompile` or `MustCompilePOSIX`.
+    pub function: String,
+    /// The pattern argument as written, on one line.
+    pub pattern: String,
+}
+
+/// Add the names declared by `const` specs in `source` to `names`.
+fn collect_const_names(source: &GoSource, names: &mut HashSet<String>) {
+    let mut in_block = false;
+    for code in &source.masked {
+        let trimmed = code.trim();
+        if in_block {
+            if trimmed.starts_with(')') {
+                in_block = false;
+                continue;
+            }
+        } else if let Some(rest) = trimmed.strip_prefix("const") {
+            if rest.trim() == "(" {
+                in_block = true;
+                continue;
+            }
+            if !rest.starts_with(char::is_whitespace) {
+                continue;
+            }
+        } else {
+            continue;
+        }
+
+        if let Some(captures) = CONST_SPEC.captures(code) {
+            names.extend(captures[1].split(',').map(|name| name.trim().to_string()));
+        }
+    }
+}
+
+/// Byte offset of the `)` closing a call, from just after its `(`.
+fn closing_paren(code: &str, from: usize) -> Option<usize> {
+    let mut depth = 0usize;
+    for (pos, byte) in code.bytes().enumerate().skip(from) {
+        match byte {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' if depth == 0 => return Some(pos),
+            b')' | b']' | b'}' => depth = depth.saturating_sub(1),
+            _ => {}
+        }
+    }
+    None
+}
+
+fn is_ident_byte(byte: u8) -> bool {
+    byte == b'_' || byte.is_ascii_alphanumeric() || byte >= 0x80
+}
+
+/// Names and packages a pattern argument is resolved against.
+struct Scope<'a> {
+    consts: HashSet<String>,
+    /// Local names of the file's imports.
+    packages: HashSet<&'a str>,
+    /// Local names `regexp` is imported as.
+    regexp: Vec<String>,
+}
+
+/// Classify a pattern argument from its masked code.
+///
+/// String literals are blanked, so a constant pattern leaves only `+`,
+/// parentheses, and const names. `regexp.QuoteMeta(...)` always gives a
+/// valid pattern and counts as constant. Any other call or a name that
+/// isn't a const makes it dynamic, as does a selector on anything but a
+/// package (`cfg.Pattern`); a name from another package (`patterns.Email`)
+/// may be a const and is unknown.
+fn classify(code: &str, scope: &Scope) -> PatternKind {
+    let bytes = code.as_bytes();
+    let mut kind = PatternKind::Constant;
+    let mut i = 0;
+    while i < bytes.len() {
+        let byte = bytes[i];
+        if byte.is_ascii_whitespace() || matches!(byte, b'+' | b'(' | b')') {
+            i += 1;
+            continue;
+        }
+        if !is_ident_byte(byte) || byte.is_ascii_digit() {
+            return PatternKind::Dynamic;
+        }
+
+        let start = i;
+        while i < bytes.len() && (is_ident_byte(bytes[i]) || bytes[i] == b'.') {
+            i += 1;
+        }
+        let name = &code[start..i];
+        if code[i..].trim_start().starts_with('(') {
+            let quote_meta = name
+                .strip_suffix(".QuoteMeta")
+                .is_some_and(|qualifier| scope.regexp.iter().any(|n| n == qualifier));
+            let open = i + (code[i..].len() - code[i..].trim_start().len());
+            match closing_paren(code, open + 1) {
+                Some(close) if quote_meta => {
+                    i = close + 1;
+                    continue;
+                }
+                _ => return PatternKind::Dynamic,
+            }
+        }
+        match name.split_once('.') {
+            Some((qualifier, _)) if scope.packages.contains(qualifier) => {
+                kind = PatternKind::Unknown;
+            }
+            Some(_) => return PatternKind::Dynamic,
+            None if scope.consts.contains(name) => {}
+            None => return PatternKind::Dynamic,
+        }
+    }
+    kind
+}
+
+/// Find `regexp.MustCompile` and `MustCompilePOSIX` calls whose pattern is
+/// built at run time, skipping comments and strings.
+///
+/// Consts are collected from the file and `siblings`, the other files of
+/// its package; shadowing isn't followed. Calls are matched under the local
+/// names `regexp` is imported as, and may span lines.
+pub fn find_dynamic_patterns(source: &GoSource, siblings: &[String]) -> Vec<DynamicPattern> {
+    let regexp = import_names(source.content, "regexp");
+    if regexp.is_empty() {
+        return Vec::new();
+    }
+    let imports = parse_imports(source.content);
+    let mut scope = Scope {
+        consts: HashSet::new(),
+        packages: imports.iter().filter_map(|i| i.local_name()).collect(),
+        regexp,
+    };
+    collect_const_names(source, &mut scope.consts);
+    for sibling in siblings {
+        collect_const_names(&GoSource::new(sibling), &mut scope.consts);
+    }
+
+    // Masking keeps byte offsets, so offsets in `code` index `text` too
+    let text = source.content.lines().collect::<Vec<_>>().join("\n");
+    let code = source.masked.join("\n");
+
+    let mut found = Vec::new();
+    for captures in MUST_COMPILE_CALL.captures_iter(&code) {
+        let (Some(qualifier), Some(function), Some(whole)) =
+            (captures.get(1), captures.get(2), captures.get(0))
+        else {
+            continue;
+        };
+        if !scope.regexp.iter().any(|n| n == qualifier.as_str()) {
+            continue;
+        }
+        let Some(close) = closing_paren(&code, whole.end()) else {
+            continue;
+        };
+        // A multi-line call may leave a trailing comma
+        let argument = code[whole.end()..close].trim_end();
+        let end = whole.end() + argument.strip_suffix(',').unwrap_or(argument).len();
+        if classify(&code[whole.end()..end], &scope) != PatternKind::Dynamic {
+            continue;
+        }
+
+        let pattern = &text[whole.end()..end];
+        let start = end - pattern.trim_start().len();
+        let line_start = text[..start].rfind('\n').map_or(0, |pos| pos + 1);
+        found.push(DynamicPattern {
+            line: text[..start].matches('\n').count() as u32 + 1,
+            column: text[line_start..start].chars().count() as u32 + 1,
+            function: function.as_str().to_string(),
+            pattern: pattern.split_whitespace().collect::<Vec<_>>().join(" "),
+        });
+    }
+    found
+}
+
+/// Contents of the other non-test Go files in `file`'s directory.
+fn sibling_sources(file: &Path) -> Vec<String> {
+    let Some(dir) = file.parent() else {
+        return Vec::new();
+    };
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return Vec::new();
+    };
+    entries
+        .filter_map(|entry| entry.ok())
+        .map(|entry| entry.path())
+        .filter(|path| {
+            let name = path.file_name().and_then(|n| n.to_str()).unwrap_or("");
+            path != file && name.ends_with(".go") && !name.ends_with("_test.go")
+        })
+        .filter_map(|path| std::fs::read_to_string(path).ok())
+        .collect()
+}
+
+/// Check Go `regexp.MustCompile` calls for patterns built at run time and
+/// return violations.
+impl GoRule for GoMustCompileConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("MustCompile") {
+            return violations;
+        }
+
+        // Consts used as patterns may be declared in another file of the package
+        let siblings = sibling_sources(&ctx.root.join(file.path));
+        for found in find_dynamic_patterns(&file.source, &siblings) {
+            let compile = found.function.trim_start_matches("Must");
+            let advice = format!(
+                "regexp.{function} panics if {pattern} isn't a valid pattern, and it's only known at \
+run time. Use regexp.{compile} and handle the error, or pass a string literal or const.",
+                function = found.function,
+                pattern = found.pattern,
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                MUST_COMPILE,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_mustcompile_tests.rs"]
+mod tests;
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn patterns_in(decls: &str, body: &str) -> Vec<String> {
+    let content = format!(
+        "package p\n\nimport (\n\t\"regexp\"\n\t\"strings\"\n)\n\n{}\n\nfunc compile(pattern string, cfg Config) *regexp.Regexp {{\n{}\n}}\n",
+        decls, body
+    );
+    find_dynamic_patterns(&GoSource::new(&content), &[])
+        .into_iter()
+        .map(|found| found.pattern)
+        .collect()
+}
+
+#[test]
+fn finds_variable_pattern_with_column() {
+    let content = "package p
+
+import (
+	re \"regexp\"
+)
+
+func Matcher(pattern string) *re.Regexp {
+	return re.MustCompilePOSIX(pattern)
+}
+";
+    assert_eq!(
+        find_dynamic_patterns(&GoSource::new(content), &[]),
+        vec![DynamicPattern {
+            line: 8,
+            column: 29,
+            function: "MustCompilePOSIX".to_string(),
+            pattern: "pattern".to_string(),
+        }]
+    );
+}
+
+#[parameterized(
+    parameter = { "", "\treturn regexp.MustCompile(pattern)", "pattern" },
+    field = { "", "\treturn regexp.MustCompile(cfg.Pattern)", "cfg.Pattern" },
+    concatenated = { "", "\treturn regexp.MustCompile(\"^\" + pattern + \"$\")", "\"^\" + pattern + \"$\"" },
+    call = { "", "\treturn regexp.MustCompile(strings.Join(cfg.Words, \"|\"))", "strings.Join(cfg.Words, \"|\")" },
+    conversion = { "", "\treturn regexp.MustCompile(string(cfg.Raw))", "string(cfg.Raw)" },
+    index = { "", "\treturn regexp.MustCompile(cfg.Patterns[0])", "cfg.Patterns[0]" },
+    package_var = { "var wordPattern = `\\w+`", "\treturn regexp.MustCompile(wordPattern)", "wordPattern" },
+    multi_line = { "", "\treturn regexp.MustCompile(\n\t\tpattern,\n\t)", "pattern" },
+)]
+fn dynamic_patterns_are_found(decls: &str, body: &str, pattern: &str) {
+    assert_eq!(patterns_in(decls, body), vec![pattern.to_string()]);
+}
+
+#[parameterized(
+    literal = { "", "\treturn regexp.MustCompile(\"^[a-z]+$\")" },
+    raw_literal = { "", "\treturn regexp.MustCompile(`^\\d+$`)" },
+    literal_with_paren = { "", "\treturn regexp.MustCompile(\"(a|b))\")" },
+    concatenated_literals = { "", "\treturn regexp.MustCompile(\"^\" + `\\w+` + \"$\")" },
+    const_name = { "const wordPattern = `\\w+`", "\treturn regexp.MustCompile(wordPattern)" },
+    const_block = { "const (\n\tprefix = \"^\"\n\tword, digit = `\\w+`, `\\d`\n)", "\treturn regexp.MustCompile(prefix + (word + digit))" },
+    typed_const = { "const wordPattern string = `\\w+`", "\treturn regexp.MustCompile(wordPattern)" },
+    quoted = { "", "\treturn regexp.MustCompile(\"^\" + regexp.QuoteMeta(pattern) + \"$\")" },
+    other_package = { "", "\treturn regexp.MustCompile(strings.Pattern)" },
+    compile = { "", "\tre, _ := regexp.Compile(pattern)\n\treturn re" },
+    commented = { "", "\t// regexp.MustCompile(pattern)\n\treturn nil" },
+    in_string = { "", "\tlog(\"regexp.MustCompile(pattern)\")\n\treturn nil" },
+    other_receiver = { "", "\treturn cache.MustCompile(pattern)" },
+)]
+fn constant_patterns_are_ok(decls: &str, body: &str) {
+    assert!(patterns_in(decls, body).is_empty());
+}
+
+#[test]
+fn consts_in_sibling_files_are_followed() {
+    let content = "package p
+
+import \"regexp\"
+
+var word = regexp.MustCompile(wordPattern)
+";
+    let siblings = ["package p\n\nconst wordPattern = `\\w+`\n".to_string()];
+    assert!(find_dynamic_patterns(&GoSource::new(content), &siblings).is_empty());
+    assert_eq!(find_dynamic_patterns(&GoSource::new(content), &[]).len(), 1);
+}
+
+#[test]
+fn patterns_are_not_checked_without_regexp_import() {
+    let content = "package p
+
+func compile(pattern string) *Regexp {
+	return regexp.MustCompile(pattern)
+}
+";
+    assert!(find_dynamic_patterns(&GoSource::new(content), &[]).is_empty());
+}
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `panic()` justification checking for the escapes check.
+//!
+//! Flags `panic(...)` calls without a `// PANIC:` comment, so the few
+//! genuinely unrecoverable failures in library code are documented. Opt-in
+//! via `[golang.panic]`.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoPanicConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for unjustified panics.
+pub const GO_PANIC: &str = "go_panic";
+
+/// Required justification marker.
+pub const PANIC_COMMENT: &str = "// PANIC:";
+
+/// A call to the `panic` builtin (not a method named `panic`).
+#[allow(clippy::expect_used)]
+static PANIC_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(^|[^.\w])panic\s*\(").expect("valid regex pattern"));
+
+#[allow(clippy::expect_used)]
+static PACKAGE_CLAUSE: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^\s*package\s+(\w+)").expect("valid regex pattern"));
+
+/// A `panic(...)` call site.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct PanicCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `panic`.
+    pub column: u32,
+    /// Inside a deferred function literal that calls `recover()`.
+    pub repanic: bool,
+}
+
+/// Lexer state carried across lines.
+#[derive(Default)]
+pub(super) struct Lexer {
+    in_block_comment: bool,
+    in_raw_string: bool,
+}
+
+impl Lexer {
+    /// Blank out comments and string literals, keeping byte offsets.
+    pub(super) fn mask(&mut self, line: &str) -> String {
+        let bytes = line.as_bytes();
+        let mut out = bytes.to_vec();
+        let mut i = 0;
+        while i < bytes.len() {
+            if self.in_block_comment {
+                if bytes[i..].starts_with(b"*/") {
+                    self.in_block_comment = false;
+                    out[i] = b' ';
+                    out[i + 1] = b' ';
+                    i += 2;
+                } else {
+                    out[i] = b' ';
+                    i += 1;
+                }
+                continue;
+            }
+            if self.in_raw_string {
+                self.in_raw_string = bytes[i] != b'`';
+                out[i] = b' ';
+                i += 1;
+                continue;
+            }
+            match bytes[i] {
+                b'/' if bytes.get(i + 1) == Some(&b'/') => {
+                    out[i..].fill(b' ');
+                    break;
+                }
+                b'/' if bytes.get(i + 1) == Some(&b'*') => {
+                    self.in_block_comment = true;
+                    out[i] = b' ';
+                    out[i + 1] = b' ';
+                    i += 2;
+                }
+                b'`' => {
+                    self.in_raw_string = true;
+                    out[i] = b' ';
+                    i += 1;
+                }
+                quote @ (b'"' | b'\'') => {
+                    out[i] = b' ';
+                    i += 1;
+                    while i < bytes.len() && bytes[i] != quote {
+                        if bytes[i] == b'\\' && i + 1 < bytes.len() {
+                            out[i] = b' ';
+                            i += 1;
+                        }
+                        out[i] = b' ';
+                        i += 1;
+                    }
+                    if i < bytes.len() {
+                        out[i] = b' ';
+                        i += 1;
+                    }
+                }
+                _ => i += 1,
+            }
+        }
+        String::from_utf8_lossy(&out).into_owned()
+    }
+}
+
+/// The package name from a Go file's package clause.
+pub fn package_name(content: &str) -> Option<String> {
+    let mut lexer = Lexer::default();
+    content.lines().find_map(|line| {
+        PACKAGE_CLAUSE
+            .captures(&lexer.mask(line))
+            .map(|c| c[1].to_string())
+    })
+}
+
+/// Find `panic(...)` calls in code, skipping comments and strings.
+///
+/// A call inside a `defer func() { ... }()` body that calls `recover()`
+/// before it (e.g., `if r := recover(); r != nil { panic(r) }`) is marked as
+/// a re-panic.
+pub fn find_panic_calls(source: &GoSource) -> Vec<PanicCall> {
+    let mut calls = Vec::new();
+    let mut depth: usize = 0;
+    // Brace depth of each open deferred body, and whether it called recover()
+    let mut deferred: Vec<(usize, bool)> = Vec::new();
+    let mut pending_defer = false;
+
+    for (idx, line, code) in source.lines() {
+        let defer_at = code.find("defer func");
+        let recover_at = code.find("recover()");
+        let panics: Vec<usize> = PANIC_CALL
+            .captures_iter(code)
+            .filter_map(|c| c.get(0).map(|m| m.end()))
+            .map(|end| code[..end].rfind("panic").unwrap_or(0))
+            .collect();
+
+        for (offset, byte) in code.bytes().enumerate() {
+            if defer_at == Some(offset) {
+                pending_defer = true;
+            }
+            if recover_at == Some(offset)
+                && let Some(frame) = deferred.last_mut()
+            {
+                frame.1 = true;
+            }
+            if panics.contains(&offset) {
+                calls.push(PanicCall {
+                    line: idx as u32 + 1,
+                    column: line[..offset].chars().count() as u32 + 1,
+                    repanic: deferred.last().is_some_and(|frame| frame.1),
+                });
+            }
+            match byte {
+                b'{' => {
+                    depth += 1;
+                    if pending_defer {
+                        deferred.push((depth, false));
+                        pending_defer = false;
+                    }
+                }
+                b'}' => {
+                    deferred.retain(|frame| frame.0 < depth);
+                    depth = depth.saturating_sub(1);
+                }
+                _ => {}
+            }
+        }
+    }
+    calls
+}
+
+/// Check Go `panic()` calls and return violations.
+impl GoRule for GoPanicConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off {
+            return violations;
+        }
+        if self.library_only
+            && (file.is_test
+                || file.path.to_string_lossy().ends_with("_test.go")
+                || package_name(file.source.content).as_deref() == Some("main"))
+        {
+            return violations;
+        }
+
+        for call in find_panic_calls(&file.source) {
+            if (self.allow_repanic && call.repanic)
+                || has_justification_comment(file.source.content, call.line, PANIC_COMMENT)
+            {
+                continue;
+            }
+
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "missing_comment",
+                "Add a // PANIC: comment explaining why this failure is unrecoverable.",
+                GO_PANIC,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_panic_tests.rs"]
+mod tests;
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn lines(content: &str) -> Vec<u32> {
+    find_panic_calls(&GoSource::new(content))
+        .iter()
+        .map(|c| c.line)
+        .collect()
+}
+
+#[test]
+fn finds_panic_calls_with_columns() {
+    let content =
+        "package lib\n\nfunc Must(err error) {\n\tif err != nil {\n\t\tpanic(err)\n\t}\n}\n";
+    assert_eq!(
+        find_panic_calls(&GoSource::new(content)),
+        vec![PanicCall {
+            line: 5,
+            column: 3,
+            repanic: false,
+        }]
+    );
+}
+
+#[parameterized(
+    line_comment = { "// panic(err) is never called\n" },
+    trailing_comment = { "x := 1 // then panic(x)\n" },
+    block_comment = { "/*\npanic(err)\n*/\n" },
+    string = { "msg := \"panic(now)\"\n" },
+    raw_string = { "msg := `\npanic(now)\n`\n" },
+    method = { "t.panic(err)\n" },
+    identifier = { "dontpanic(err)\n" },
+)]
+fn ignores_non_calls(content: &str) {
+    assert!(lines(content).is_empty());
+}
+
+#[test]
+fn marks_repanic_in_deferred_recover() {
+    let content = "func Run() {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\tpanic(r)\n\t\t}\n\t}()\n\tpanic(\"boom\")\n}\n";
+    let calls = find_panic_calls(&GoSource::new(content));
+    assert_eq!(calls.len(), 2);
+    assert_eq!((calls[0].line, calls[0].repanic), (4, true));
+    assert_eq!((calls[1].line, calls[1].repanic), (7, false));
+}
+
+#[test]
+fn deferred_func_without_recover_is_not_repanic() {
+    let content = "func Run() {\n\tdefer func() {\n\t\tpanic(\"cleanup failed\")\n\t}()\n}\n";
+    assert!(!find_panic_calls(&GoSource::new(content))[0].repanic);
+}
+
+#[parameterized(
+    main = { "// Command app.\npackage main\n", Some("main") },
+    library = { "package store // import \"example.com/store\"\n", Some("store") },
+    after_block_comment = { "/*\npackage fake\n*/\npackage real\n", Some("real") },
+    missing = { "func f() {}\n", None },
+)]
+fn parses_package_name(content: &str, expected: Option<&str>) {
+    assert_eq!(package_name(content).as_deref(), expected);
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go builtin print checking for the escapes check.
+//!
+//! The `print` and `println` builtins write unbuffered to stderr in an
+//! implementation-defined format; they're for bootstrapping and debugging,
+//! not for shipping. Projects can opt in via `[golang.print]` to flag calls
+//! outside `_test.go` files. Selector calls like `fmt.Println` aren't
+//! matched, nor are calls to a `print` or `println` the package or the
+//! enclosing function declares, which shadows the builtin.
+
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoPrintConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for calls to the `print` and `println` builtins.
+pub const BUILTIN_PRINT: &str = "builtin_print";
+
+/// The builtins this rule flags.
+const BUILTINS: [&str; 2] = ["print", "println"];
+
+/// A call of `print(` or `println(` that isn't a selector (`fmt.Println(`).
+#[allow(clippy::expect_used)]
+static BUILTIN_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])(print|println)\s*\(").expect("valid regex pattern"));
+
+/// A declaration by keyword: `func print(`, `var println =`. Methods have a
+/// receiver and don't match, since they don't shadow the builtin.
+#[allow(clippy::expect_used)]
+static DECLARATION: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(?:func|var|const|type)\s+(\w+)").expect("valid regex pattern")
+});
+
+/// The names a short variable declaration declares: `a, print :=`.
+#[allow(clippy::expect_used)]
+static SHORT_VAR: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"((?:\w+\s*,\s*)*\w+)\s*:=").expect("valid regex pattern"));
+
+/// A parameter named like a builtin: `(print func(string))`, `(println, log T)`.
+#[allow(clippy::expect_used)]
+static PARAMETER: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"[(,]\s*(print|println)(?:\s*,|\s+[\w*\[.(])").expect("valid regex pattern")
+});
+
+/// The start of a grouped declaration: `var (`.
+#[allow(clippy::expect_used)]
+static GROUP_START: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^(?:var|const)\s*\(\s*$").expect("valid regex pattern"));
+
+/// A spec inside a grouped declaration.
+#[allow(clippy::expect_used)]
+static GROUP_SPEC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^\s+(\w+)").expect("valid regex pattern"));
+
+/// An interface type's body opening at the end of a line:
+/// `type Logger interface {`.
+#[allow(clippy::expect_used)]
+static INTERFACE_START: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"\binterface\s*\{\s*$").expect("valid regex pattern"));
+
+/// A call to the `print` or `println` builtin.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct BuiltinPrint {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the builtin's name.
+    pub column: u32,
+    /// `print` or `println`.
+    pub name: &'static str,
+}
+
+fn builtin(name: &str) -> Option<&'static str> {
+    BUILTINS.iter().copied().find(|b| *b == name)
+}
+
+/// Builtins a file declares at package level, shadowing them in every file
+/// of the package.
+pub fn package_builtins(source: &GoSource) -> Vec<&'static str> {
+    let mut names = Vec::new();
+    let mut depth = 0usize;
+    let mut in_group = false;
+    for code in &source.masked {
+        if depth == 0 {
+            if in_group {
+                if code.trim_start().starts_with(')') {
+                    in_group = false;
+                } else if let Some(name) = GROUP_SPEC.captures(code).and_then(|c| c.get(1)) {
+                    names.extend(builtin(name.as_str()));
+                }
+            } else if GROUP_START.is_match(code) {
+                in_group = true;
+            } else if let Some(name) = DECLARATION.captures(code).and_then(|c| c.get(1)) {
+                names.extend(builtin(name.as_str()));
+            }
+        }
+        depth = brace_depth(depth, code);
+    }
+    names
+}
+
+/// Builtins a line inside a function declares: variables, constants,
+/// types, and parameters.
+fn local_builtins(code: &str) -> Vec<&'static str> {
+    let mut names = Vec::new();
+    for captures in DECLARATION.captures_iter(code) {
+        names.extend(captures.get(1).and_then(|m| builtin(m.as_str())));
+    }
+    for captures in SHORT_VAR.captures_iter(code) {
+        if let Some(lhs) = captures.get(1) {
+            names.extend(lhs.as_str().split(',').filter_map(|n| builtin(n.trim())));
+        }
+    }
+    if code.contains("func") {
+        for captures in PARAMETER.captures_iter(code) {
+            names.extend(captures.get(1).and_then(|m| builtin(m.as_str())));
+        }
+    }
+    names
+}
+
+fn brace_depth(depth: usize, code: &str) -> usize {
+    code.bytes().fold(depth, |depth, b| match b {
+        b'{' => depth + 1,
+        b'}' => depth.saturating_sub(1),
+        _ => depth,
+    })
+}
+
+/// Find calls to the `print` and `println` builtins in code, skipping
+/// comments, strings, and calls to a declaration that shadows the builtin.
+///
+/// `package_declared` are the builtins the package's other files declare.
+/// A declaration inside a function shadows the builtin from that line to
+/// the end of the top-level function, a close approximation of block scope.
+pub fn find_builtin_prints(source: &GoSource, package_declared: &[&str]) -> Vec<BuiltinPrint> {
+    let package = package_builtins(source);
+    let mut local: Vec<&'static str> = Vec::new();
+    let mut depth = 0usize;
+    // Depth outside the interface body being read, whose method specs
+    // look like calls
+    let mut interface: Option<usize> = None;
+    let mut prints = Vec::new();
+    for (idx, line, code) in source.lines() {
+        if depth == 0 {
+            local.clear();
+        }
+        if depth > 0 || code.starts_with("func") {
+            local.extend(local_builtins(code));
+        }
+        let next_depth = brace_depth(depth, code);
+        if interface.is_none() && INTERFACE_START.is_match(code) && next_depth > depth {
+            interface = Some(depth);
+        }
+        if interface.is_none() {
+            for name in BUILTIN_CALL.captures_iter(code).filter_map(|c| c.get(1)) {
+                let Some(found) = builtin(name.as_str()) else {
+                    continue;
+                };
+                if package.contains(&found)
+                    || package_declared.contains(&found)
+                    || local.contains(&found)
+                    || is_declared_name(&code[..name.start()])
+                {
+                    continue;
+                }
+                prints.push(BuiltinPrint {
+                    line: idx as u32 + 1,
+                    column: line[..name.start()].chars().count() as u32 + 1,
+                    name: found,
+                });
+            }
+        }
+        depth = next_depth;
+        if interface.is_some_and(|outer| depth <= outer) {
+            interface = None;
+        }
+    }
+    prints
+}
+
+/// Whether a name after `before` is being declared as a function or
+/// method (`func print(`, `func (l *Log) println(`), not called.
+fn is_declared_name(before: &str) -> bool {
+    let before = before.trim_end();
+    before.ends_with("func")
+        || (before.starts_with("func") && before.ends_with(')') && !before.contains('{'))
+}
+
+/// Builtins declared at package level by the other non-test Go files in
+/// `file`'s directory.
+fn sibling_builtins(file: &Path) -> Vec<&'static str> {
+    let Some(dir) = file.parent() else {
+        return Vec::new();
+    };
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return Vec::new();
+    };
+    entries
+        .filter_map(|entry| entry.ok())
+        .map(|entry| entry.path())
+        .filter(|path| {
+            let name = path.file_name().and_then(|n| n.to_str()).unwrap_or("");
+            path != file && name.ends_with(".go") && !name.ends_with("_test.go")
+        })
+        .filter_map(|path| std::fs::read_to_string(path).ok())
+        .flat_map(|content| package_builtins(&GoSource::new(&content)))
+        .collect()
+}
+
+/// Check Go builtin print calls and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoPrintConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("print")
+        {
+            return violations;
+        }
+
+        let mut prints = find_builtin_prints(&file.source, &[]);
+        if !prints.is_empty() {
+            // Another file of the package may declare its own print
+            let declared = sibling_builtins(&ctx.root.join(file.path));
+            if !declared.is_empty() {
+                prints = find_builtin_prints(&file.source, &declared);
+            }
+        }
+
+        for call in prints {
+            let advice = format!(
+                "The {} builtin writes unbuffered to stderr and is meant for debugging. \
+Use a logger or fmt.Fprintln(os.Stderr, ...), or remove the call.",
+                call.name
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "forbidden",
+                &advice,
+                BUILTIN_PRINT,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_print_tests.rs"]
+mod tests;
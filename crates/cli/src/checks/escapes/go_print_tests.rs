@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn names_in(content: &str) -> Vec<&'static str> {
+    find_builtin_prints(&GoSource::new(content), &[])
+        .into_iter()
+        .map(|p| p.name)
+        .collect()
+}
+
+#[test]
+fn finds_builtin_calls_with_columns() {
+    let content =
+        "package main\n\nfunc main() {\n\tprintln(\"start\")\n\tif debug { print(\"x\") }\n}\n";
+    assert_eq!(
+        find_builtin_prints(&GoSource::new(content), &[]),
+        vec![
+            BuiltinPrint {
+                line: 4,
+                column: 2,
+                name: "println",
+            },
+            BuiltinPrint {
+                line: 5,
+                column: 13,
+                name: "print",
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    fmt_println = { "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"x\")\n}\n" },
+    method_call = { "package main\n\nfunc main() {\n\tlog.print(\"x\")\n}\n" },
+    other_function = { "package main\n\nfunc main() {\n\tsprintln(\"x\")\n}\n" },
+    comment = { "package main\n\n// println(\"x\") for debugging\nfunc main() {}\n" },
+    string = { "package main\n\nvar s = \"println(x)\"\n" },
+    method_declaration = { "package main\n\nfunc (l *Log) println(s string) {}\n" },
+    interface_method = { "package main\n\ntype Log interface {\n\tprintln(s string)\n}\n" },
+)]
+fn ignores_other_code(content: &str) {
+    assert!(names_in(content).is_empty(), "{:?}", names_in(content));
+}
+
+#[parameterized(
+    package_func = { "package main\n\nfunc main() {\n\tprintln(\"x\")\n}\n\nfunc println(s string) {}\n" },
+    package_var = { "package main\n\nvar println = log.Println\n\nfunc main() {\n\tprintln(\"x\")\n}\n" },
+    grouped_var = { "package main\n\nvar (\n\tprintln = log.Println\n)\n\nfunc main() {\n\tprintln(\"x\")\n}\n" },
+    short_var = { "package main\n\nfunc main() {\n\tprint := log.Print\n\tprint(\"x\")\n}\n" },
+    parameter = { "package main\n\nfunc run(print func(...any)) {\n\tprint(\"x\")\n}\n" },
+)]
+fn shadowed_builtins_are_not_flagged(content: &str) {
+    assert!(names_in(content).is_empty(), "{:?}", names_in(content));
+}
+
+#[test]
+fn local_shadow_ends_with_its_function() {
+    let content = "package main\n\nfunc a() {\n\tprintln := log.Println\n\tprintln(\"a\")\n}\n\nfunc b() {\n\tprintln(\"b\")\n}\n";
+    assert_eq!(
+        find_builtin_prints(&GoSource::new(content), &[]),
+        vec![BuiltinPrint {
+            line: 9,
+            column: 2,
+            name: "println",
+        }]
+    );
+}
+
+#[test]
+fn package_declarations_in_other_files_shadow() {
+    let content = "package main\n\nfunc main() {\n\tprintln(\"x\")\n\tprint(\"y\")\n}\n";
+    assert_eq!(names_in(content), vec!["println", "print"]);
+    let prints = find_builtin_prints(
+        &GoSource::new(content),
+        &package_builtins(&GoSource::new(
+            "package main\n\nfunc println(s string) {}\n",
+        )),
+    );
+    assert_eq!(
+        prints.into_iter().map(|p| p.name).collect::<Vec<_>>(),
+        vec!["print"]
+    );
+}
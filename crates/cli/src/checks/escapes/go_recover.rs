@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `recover()` placement checking for the escapes check.
+//!
+//! `recover()` only stops a panic when called directly by a deferred
+//! function; anywhere else it returns nil and the panic keeps unwinding.
+//! Flags calls outside a deferred function body, which are always bugs.
+//! On by default via `[golang.recover]`.
+
+use std::collections::HashSet;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoRecoverConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for misplaced recovers.
+pub const GO_RECOVER: &str = "go_recover";
+
+/// Names of deferred calls: `defer cleanup()`, `defer s.handlePanic()`.
+#[allow(clippy::expect_used)]
+static DEFERRED_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\bdefer\s+(?:[\w.]+\.)?(\w+)\s*\(").expect("valid regex pattern")
+});
+
+/// A function declaration's name, after `func` (skipping a receiver).
+#[allow(clippy::expect_used)]
+static DECLARED_NAME: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"^func\s*(?:\([^)]*\)\s*)?(\w+)").expect("valid regex pattern"));
+
+/// A name a function literal is bound to: `f := func`, `var f = func`.
+#[allow(clippy::expect_used)]
+static BOUND_NAME: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(\w+)\s*:?=\s*$").expect("valid regex pattern"));
+
+/// A `recover()` call that can't stop a panic.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct RecoverCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `recover`.
+    pub column: u32,
+}
+
+/// The function a brace body belongs to.
+#[derive(Debug, Clone, PartialEq, Eq)]
+enum Frame {
+    /// `defer func() { ... }()`
+    Deferred,
+    /// A declared or bound function, deferred by name if at all.
+    Named(String),
+    /// Any other function literal.
+    Literal,
+}
+
+/// Find `recover()` calls not made directly by a deferred function.
+///
+/// A call is fine in the body of a `defer func() { ... }` literal, or of a
+/// named function (`func handlePanic()`, `f := func()`) that the file
+/// defers by name. Calls nested in another literal inside a deferred one,
+/// and `defer recover()` itself, are flagged.
+pub fn find_misplaced_recovers(source: &GoSource) -> Vec<RecoverCall> {
+    let deferred: HashSet<String> = source
+        .masked
+        .iter()
+        .flat_map(|code| DEFERRED_CALL.captures_iter(code))
+        .map(|c| c[1].to_string())
+        .collect();
+
+    let mut calls = Vec::new();
+    let mut frames: Vec<(usize, Frame)> = Vec::new();
+    let mut depth = 0usize;
+    // A `func` whose body brace hasn't opened yet, and its signature's
+    // paren depth
+    let mut pending: Option<Frame> = None;
+    let mut parens = 0usize;
+
+    for (idx, line, code) in source.lines() {
+        let bytes = code.as_bytes();
+        let mut prev_word = "";
+        let mut i = 0;
+        while i < bytes.len() {
+            let byte = bytes[i];
+            if byte.is_ascii_alphabetic() || byte == b'_' {
+                let start = i;
+                while i < bytes.len() && (bytes[i].is_ascii_alphanumeric() || bytes[i] == b'_') {
+                    i += 1;
+                }
+                let word = &code[start..i];
+                let is_call = code[i..].trim_start().starts_with('(');
+                match word {
+                    // A func type inside a signature has no body
+                    "func" if pending.is_some() && parens > 0 => {}
+                    "func" => {
+                        pending = Some(func_frame(code, start, depth, prev_word));
+                        parens = 0;
+                    }
+                    "recover" if is_call && !prev_word.ends_with('.') => {
+                        let direct = prev_word != "defer"
+                            && match frames.last() {
+                                Some((_, Frame::Deferred)) => true,
+                                Some((_, Frame::Named(name))) => deferred.contains(name),
+                                _ => false,
+                            };
+                        if !direct {
+                            calls.push(RecoverCall {
+                                line: idx as u32 + 1,
+                                column: line[..start].chars().count() as u32 + 1,
+                            });
+                        }
+                    }
+                    _ => {}
+                }
+                prev_word = word;
+                continue;
+            }
+
+            match byte {
+                b'(' if pending.is_some() => parens += 1,
+                b')' if pending.is_some() => parens = parens.saturating_sub(1),
+                b'{' => {
+                    depth += 1;
+                    let type_brace = matches!(prev_word, "interface" | "struct");
+                    if parens == 0
+                        && !type_brace
+                        && let Some(frame) = pending.take()
+                    {
+                        frames.push((depth, frame));
+                    }
+                }
+                b'}' => {
+                    frames.retain(|frame| frame.0 < depth);
+                    depth = depth.saturating_sub(1);
+                }
+                _ => {}
+            }
+            if byte == b'.' {
+                prev_word = ".";
+            } else if !byte.is_ascii_whitespace() {
+                prev_word = "";
+            }
+            i += 1;
+        }
+        // A body brace must be on the signature's last line
+        if parens == 0 {
+            pending = None;
+        }
+    }
+    calls
+}
+
+/// Classify the function started by the `func` keyword at `start`.
+fn func_frame(code: &str, start: usize, depth: usize, prev_word: &str) -> Frame {
+    if prev_word == "defer" {
+        return Frame::Deferred;
+    }
+    if depth == 0
+        && code[..start].trim().is_empty()
+        && let Some(caps) = DECLARED_NAME.captures(&code[start..])
+    {
+        return Frame::Named(caps[1].to_string());
+    }
+    match BOUND_NAME.captures(&code[..start]) {
+        Some(caps) => Frame::Named(caps[1].to_string()),
+        None => Frame::Literal,
+    }
+}
+
+/// Check Go `recover()` calls and return violations.
+impl GoRule for GoRecoverConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("recover") {
+            return violations;
+        }
+
+        for call in find_misplaced_recovers(&file.source) {
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "forbidden",
+                "recover() only stops a panic when called directly by a deferred function; \
+here it always returns nil. Move it into a defer func() { ... }() body.",
+                GO_RECOVER,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_recover_tests.rs"]
+mod tests;
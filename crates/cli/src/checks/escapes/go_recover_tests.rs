@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn lines(content: &str) -> Vec<u32> {
+    find_misplaced_recovers(&GoSource::new(content))
+        .iter()
+        .map(|c| c.line)
+        .collect()
+}
+
+#[test]
+fn finds_top_level_recover_with_column() {
+    let content = "package main\n\nfunc main() {\n\tif r := recover(); r != nil {\n\t}\n}\n";
+    assert_eq!(
+        find_misplaced_recovers(&GoSource::new(content)),
+        vec![RecoverCall {
+            line: 4,
+            column: 10,
+        }]
+    );
+}
+
+#[parameterized(
+    deferred_closure = { "func safe() {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\tlog.Println(r)\n\t\t}\n\t}()\n}\n" },
+    deferred_one_line = { "func safe() {\n\tdefer func() { _ = recover() }()\n}\n" },
+    deferred_with_params = { "func safe() {\n\tdefer func(v interface{}) {\n\t\trecover()\n\t}(nil)\n}\n" },
+    deferred_helper = { "func handlePanic() {\n\trecover()\n}\n\nfunc run() {\n\tdefer handlePanic()\n}\n" },
+    deferred_method = { "func (s *Server) cleanup() {\n\trecover()\n}\n\nfunc (s *Server) run() {\n\tdefer s.cleanup()\n}\n" },
+    deferred_bound_literal = { "func run() {\n\tf := func() { recover() }\n\tdefer f()\n}\n" },
+    in_comment = { "func main() {\n\t// recover() only works when deferred\n}\n" },
+    in_string = { "func main() {\n\tfmt.Println(\"recover()\")\n}\n" },
+    method_named_recover = { "func main() {\n\tpool.recover()\n}\n" },
+)]
+fn allows_recover_in_deferred_functions(content: &str) {
+    assert!(lines(content).is_empty());
+}
+
+#[parameterized(
+    top_level = { "func main() {\n\trecover()\n}\n", 2 },
+    deferred_directly = { "func main() {\n\tdefer recover()\n}\n", 2 },
+    nested_in_deferred = { "func main() {\n\tdefer func() {\n\t\tfunc() {\n\t\t\trecover()\n\t\t}()\n\t}()\n}\n", 4 },
+    goroutine = { "func main() {\n\tgo func() {\n\t\trecover()\n\t}()\n}\n", 3 },
+    helper_never_deferred = { "func handlePanic() {\n\trecover()\n}\n", 2 },
+    wrapped_literal = { "func main() {\n\tdefer wrap(func() { recover() })\n}\n", 2 },
+)]
+fn flags_recover_outside_deferred_functions(content: &str, line: u32) {
+    assert_eq!(lines(content), vec![line]);
+}
+
+#[test]
+fn func_types_in_signatures_do_not_open_bodies() {
+    let content = "func run(cb func() error) {\n\trecover()\n}\n\nfunc main() {\n\tdefer func() {\n\t\trecover()\n\t}()\n}\n";
+    assert_eq!(lines(content), vec![2]);
+}
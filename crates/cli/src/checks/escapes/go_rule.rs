@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go rule dispatch for the escapes check.
+//!
+//! Each Go analyzer implements [`GoRule`] on its `[golang.*]` section, and
+//! the scanner runs every one listed in [`go_rules`] over a single
+//! [`GoFile`], so a file is masked once however many rules are on.
+
+use std::path::Path;
+
+use regex::Regex;
+
+use crate::adapter::go::GoModule;
+use crate::check::{CheckContext, Violation};
+use crate::config::GoConfig;
+
+use super::go_deprecated::DeprecationIndex;
+use super::go_panic::Lexer;
+
+/// Go source text alongside its masked lines.
+pub struct GoSource<'a> {
+    pub content: &'a str,
+    /// Each line with comments and string literals blanked out, keeping
+    /// byte offsets (see `Lexer::mask`).
+    pub masked: Vec<String>,
+}
+
+impl<'a> GoSource<'a> {
+    pub fn new(content: &'a str) -> Self {
+        let mut lexer = Lexer::default();
+        let masked = content.lines().map(|line| lexer.mask(line)).collect();
+        Self { content, masked }
+    }
+
+    /// Lines as `(index, source, masked)`.
+    pub fn lines(&self) -> impl Iterator<Item = (usize, &'a str, &str)> {
+        self.content
+            .lines()
+            .zip(&self.masked)
+            .enumerate()
+            .map(|(idx, (line, code))| (idx, line, code.as_str()))
+    }
+}
+
+/// A Go file being scanned, with what the tree knows about it.
+pub struct GoFile<'a> {
+    /// Path relative to the project root.
+    pub path: &'a Path,
+    pub source: GoSource<'a>,
+    pub is_test: bool,
+    pub modules: &'a [GoModule],
+    /// Deprecated symbols declared in the tree's modules.
+    pub deprecations: &'a DeprecationIndex,
+    /// Compiled `[golang.secrets].names` (None = check off).
+    pub secret_names: Option<&'a Regex>,
+}
+
+/// A Go analyzer, implemented by its config section.
+pub trait GoRule {
+    /// Violations in one file; none when the rule is off.
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation>;
+}
+
+/// Every Go analyzer, in the order its violations are reported.
+pub fn go_rules(config: &GoConfig) -> [&dyn GoRule; 30] {
+    [
+        &config.syscall,
+        &config.panic,
+        &config.recover,
+        &config.embed,
+        &config.unsafe_,
+        &config.linkname,
+        &config.weakrand,
+        &config.errcheck,
+        &config.sleep,
+        &config.init,
+        &config.context,
+        &config.secrets,
+        &config.deprecated,
+        &config.print,
+        &config.any,
+        &config.goroutine,
+        &config.largestruct,
+        &config.sprintf,
+        &config.clock,
+        &config.http,
+        &config.maporder,
+        &config.exit,
+        &config.maplock,
+        &config.errstring,
+        &config.variadic,
+        &config.appendalias,
+        &config.waitgroup,
+        &config.ctxkey,
+        &config.deferloop,
+        &config.mustcompile,
+    ]
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go hardcoded credential checking for the escapes check.
+//!
+//! Flags string literals assigned to variables, constants, and struct fields
+//! named like credentials (`password`, `apiKey`, `secret`, `token`), so keys
+//! don't ship in source. Empty values, placeholders (`"changeme"`,
+//! `"${API_KEY}"`), and low-entropy values are skipped to keep false
+//! positives down. Opt-in via `[golang.secrets]`; test files are not
+//! checked, and reviewed values are suppressed with `quench:ignore`.
+
+use std::collections::HashMap;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoSecretsConfig};
+
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for hardcoded credentials.
+pub const HARDCODED_SECRET: &str = "hardcoded_secret";
+
+/// A name assigned a string literal on one line: `password := "..."`,
+/// `const apiKey string = "..."`, `Token: "..."`, `cfg.Secret = "..."`.
+/// Map keys (`"token": ...`) aren't names, and `==` isn't an assignment.
+#[allow(clippy::expect_used)]
+static STRING_ASSIGNMENT: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(
+        r#"(?:^|[^\w"])(?:(?:var|const)\s+)?(\w+)(?:\s+[\w.\[\]*]+)?\s*(?::=|=|:)\s*("(?:[^"\\]|\\.)*"|`[^`]*`)"#,
+    )
+    .expect("valid regex pattern")
+});
+
+/// Words in values that stand in for a real credential.
+const PLACEHOLDER_WORDS: &[&str] = &[
+    "changeme",
+    "change-me",
+    "change_me",
+    "placeholder",
+    "example",
+    "dummy",
+    "fake",
+    "redacted",
+    "replace",
+    "your",
+];
+
+/// Prefixes of template and format values filled in at runtime.
+const TEMPLATE_PREFIXES: &[&str] = &["${", "{{", "$(", "<", "%"];
+
+/// Compile `[golang.secrets].names`.
+pub fn names_regex(names: &str) -> Result<Regex, regex::Error> {
+    Regex::new(names)
+}
+
+/// A string literal assigned to a credential-like name.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct SecretAssignment {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the name.
+    pub column: u32,
+    /// The name assigned to.
+    pub name: String,
+    /// The literal, without its quotes or backticks.
+    pub value: String,
+}
+
+/// Find string literals assigned to names matching `names`, skipping
+/// comments and strings.
+pub fn find_secret_assignments(source: &GoSource, names: &Regex) -> Vec<SecretAssignment> {
+    let mut assignments = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in STRING_ASSIGNMENT.captures_iter(line) {
+            let (Some(name), Some(literal)) = (captures.get(1), captures.get(2)) else {
+                continue;
+            };
+            // The name must be code, not text in a comment or string
+            if code.get(name.range()) != Some(name.as_str()) || !names.is_match(name.as_str()) {
+                continue;
+            }
+            let quoted = literal.as_str();
+            assignments.push(SecretAssignment {
+                line: idx as u32 + 1,
+                column: line[..name.start()].chars().count() as u32 + 1,
+                name: name.as_str().to_string(),
+                value: quoted[1..quoted.len() - 1].to_string(),
+            });
+        }
+    }
+    assignments
+}
+
+/// Shannon entropy of a value, in bits per character.
+pub fn shannon_entropy(value: &str) -> f64 {
+    let mut counts: HashMap<char, usize> = HashMap::new();
+    for c in value.chars() {
+        *counts.entry(c).or_default() += 1;
+    }
+    let len = value.chars().count() as f64;
+    counts
+        .values()
+        .map(|&count| {
+            let p = count as f64 / len;
+            -p * p.log2()
+        })
+        .sum()
+}
+
+/// Whether a value is obviously not a real credential: empty, one repeated
+/// character (`"xxxx"`), a placeholder word, or a template.
+pub fn is_placeholder(value: &str) -> bool {
+    let value = value.trim();
+    let Some(first) = value.chars().next() else {
+        return true;
+    };
+    let lower = value.to_ascii_lowercase();
+    value.chars().all(|c| c == first)
+        || PLACEHOLDER_WORDS.iter().any(|word| lower.contains(word))
+        || TEMPLATE_PREFIXES
+            .iter()
+            .any(|prefix| value.starts_with(prefix))
+}
+
+/// Check Go hardcoded credentials and return violations.
+///
+/// Files are only checked once `[golang.secrets].names` compiles. Test
+/// files are not checked.
+impl GoRule for GoSecretsConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+        {
+            return violations;
+        }
+        let Some(names) = file.secret_names else {
+            return violations;
+        };
+
+        for assignment in find_secret_assignments(&file.source, names) {
+            if is_placeholder(&assignment.value)
+                || shannon_entropy(&assignment.value) < self.min_entropy
+            {
+                continue;
+            }
+
+            let advice = format!(
+                "{} looks like a hardcoded credential. Load it from the environment or a secret manager. \
+If the value isn't secret, add // quench:ignore hardcoded_secret reason: <why>.",
+                assignment.name
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                assignment.line,
+                "forbidden",
+                &advice,
+                HARDCODED_SECRET,
+            ) {
+                let mut v = v.with_column(assignment.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_secrets_tests.rs"]
+mod tests;
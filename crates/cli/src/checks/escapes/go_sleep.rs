@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `time.Sleep` checking for the escapes check.
+//!
+//! A sleep in production code is usually papering over a race or standing
+//! in for a proper wait (a ticker, a channel, a context deadline). Projects
+//! can opt in via `[golang.sleep]` to require a `// SLEEP:` comment on each
+//! call outside test files.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoSleepConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for unjustified sleeps.
+pub const GO_SLEEP: &str = "go_sleep";
+
+/// Required justification marker.
+pub const SLEEP_COMMENT: &str = "// SLEEP:";
+
+/// A selector call of `Sleep`: `time.Sleep(`.
+#[allow(clippy::expect_used)]
+static SLEEP_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])(\w+)\.Sleep\s*\(").expect("valid regex pattern"));
+
+/// A `time.Sleep` call site.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct SleepCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+}
+
+/// Find `time.Sleep` calls in code, skipping comments and strings.
+///
+/// Calls are matched under the local name `time` is imported as, so
+/// `t.Sleep(d)` counts with `import t "time"`. Dot-imported calls aren't
+/// matched.
+pub fn find_sleep_calls(source: &GoSource) -> Vec<SleepCall> {
+    let names: Vec<String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| import.path == "time")
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some("time".to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect();
+    if names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut calls = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in SLEEP_CALL.captures_iter(code) {
+            let Some(qualifier) = captures.get(1) else {
+                continue;
+            };
+            if !names.iter().any(|name| name == qualifier.as_str()) {
+                continue;
+            }
+            calls.push(SleepCall {
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+            });
+        }
+    }
+    calls
+}
+
+/// Check Go `time.Sleep` calls and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoSleepConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("Sleep")
+        {
+            return violations;
+        }
+
+        for call in find_sleep_calls(&file.source) {
+            if has_justification_comment(file.source.content, call.line, SLEEP_COMMENT) {
+                continue;
+            }
+
+            let advice = "Wait on a channel, ticker, or context instead of sleeping. \
+If the delay is intended, add a // SLEEP: comment explaining why.";
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "missing_comment",
+                advice,
+                GO_SLEEP,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_sleep_tests.rs"]
+mod tests;
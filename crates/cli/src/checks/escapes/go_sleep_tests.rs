@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[test]
+fn finds_sleep_calls_with_columns() {
+    let content = "package lib\n\nimport \"time\"\n\nfunc wait() {\n\ttime.Sleep(time.Second)\n\tfor { time.Sleep(10 * time.Millisecond) }\n}\n";
+    assert_eq!(
+        find_sleep_calls(&GoSource::new(content)),
+        vec![
+            SleepCall { line: 6, column: 2 },
+            SleepCall { line: 7, column: 8 },
+        ]
+    );
+}
+
+#[test]
+fn matches_aliased_import() {
+    let content = "package lib\n\nimport t \"time\"\n\nfunc wait() {\n\tt.Sleep(t.Second)\n\ttime.Sleep(1)\n}\n";
+    assert_eq!(
+        find_sleep_calls(&GoSource::new(content)),
+        vec![SleepCall { line: 6, column: 2 }]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package lib\n\nfunc f() {\n\ttime.Sleep(1)\n}\n" },
+    comment = { "package lib\n\nimport \"time\"\n\n// time.Sleep(1) would race\n" },
+    string = { "package lib\n\nimport \"time\"\n\nvar s = \"time.Sleep(1)\"\n" },
+    other_receiver = { "package lib\n\nimport \"time\"\n\nfunc f() {\n\tclock.Sleep(time.Second)\n}\n" },
+    selector_chain = { "package lib\n\nimport \"time\"\n\nfunc f() {\n\ts.time.Sleep(1)\n}\n" },
+    other_function = { "package lib\n\nimport \"time\"\n\nfunc f() {\n\t<-time.After(time.Second)\n}\n" },
+)]
+fn ignores_other_calls(content: &str) {
+    assert!(find_sleep_calls(&GoSource::new(content)).is_empty());
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `fmt.Sprintf` concatenation checking for the escapes check.
+//!
+//! `fmt.Sprintf("%s%s", a, b)` parses a format string at run time to do
+//! what `a + b` does. Projects can opt in via `[golang.sprintf]` to flag
+//! calls whose format string is only `%s` verbs, one per argument, outside
+//! `_test.go` files.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoSprintfConfig};
+
+use super::go_any::closing;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `fmt.Sprintf` calls that only concatenate.
+pub const SPRINTF_CONCAT: &str = "sprintf_concat";
+
+/// A `fmt.Sprintf(` call.
+#[allow(clippy::expect_used)]
+static SPRINTF_CALL: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"\bfmt\.Sprintf\s*\(").expect("valid regex pattern"));
+
+/// A `fmt.Sprintf` call that only concatenates its arguments.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct SprintfConcat {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of `fmt`.
+    pub column: u32,
+    /// Number of `%s` verbs and arguments.
+    pub args: usize,
+}
+
+/// Number of verbs in a format string made only of `%s` verbs, like
+/// `%s%s`, or None if it has other text, other verbs, or escapes.
+pub fn concat_verbs(format: &str) -> Option<usize> {
+    if format.is_empty() || format.len() % 2 != 0 {
+        return None;
+    }
+    let verbs = format.len() / 2;
+    (format == "%s".repeat(verbs)).then_some(verbs)
+}
+
+/// The contents of the string literal starting at `start`, and the byte
+/// index after it.
+fn string_literal(source: &str, start: usize) -> Option<(&str, usize)> {
+    let bytes = source.as_bytes();
+    let quote = *bytes.get(start)?;
+    if quote != b'"' && quote != b'`' {
+        return None;
+    }
+    let mut i = start + 1;
+    while i < bytes.len() {
+        match bytes[i] {
+            b'\\' if quote == b'"' => i += 1,
+            b'\n' if quote == b'"' => return None,
+            b if b == quote => return Some((&source[start + 1..i], i + 1)),
+            _ => {}
+        }
+        i += 1;
+    }
+    None
+}
+
+/// Arguments of a call after its first, split at top-level commas.
+fn rest_args(args: &str) -> Vec<&str> {
+    let mut parts = Vec::new();
+    let mut depth = 0usize;
+    let mut start = 0;
+    for (i, b) in args.bytes().enumerate() {
+        match b {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' => depth = depth.saturating_sub(1),
+            b',' if depth == 0 => {
+                parts.push(&args[start..i]);
+                start = i + 1;
+            }
+            _ => {}
+        }
+    }
+    parts.push(&args[start..]);
+    // A trailing comma in a multi-line call leaves an empty last part
+    if parts.last().is_some_and(|p| p.trim().is_empty()) {
+        parts.pop();
+    }
+    parts
+}
+
+/// Find `fmt.Sprintf` calls whose format string literal is two or more
+/// `%s` verbs and nothing else, with one argument per verb, skipping
+/// comments and strings.
+///
+/// Calls spreading a slice (`args...`) aren't matched, since the argument
+/// count isn't known.
+pub fn find_sprintf_concats(source: &GoSource) -> Vec<SprintfConcat> {
+    let text = source.content.lines().collect::<Vec<_>>().join("\n");
+    let code = source.masked.join("\n");
+
+    let mut found = Vec::new();
+    for call in SPRINTF_CALL.find_iter(&code) {
+        let open = call.end() - 1;
+        let Some(close) = closing(&code, open) else {
+            continue;
+        };
+        // Strings are masked in `code`, so the format is read from the source
+        let inside = &text[open + 1..close];
+        let first = close - inside.trim_start().len();
+        let Some((format, after)) = string_literal(&text, first) else {
+            continue;
+        };
+        let Some(verbs) = concat_verbs(format) else {
+            continue;
+        };
+        if verbs < 2 || after > close {
+            continue;
+        }
+        let rest = &code[after..close];
+        let Some(rest) = rest.trim_start().strip_prefix(',') else {
+            continue;
+        };
+        let args = rest_args(rest);
+        if args.len() != verbs || args.iter().any(|a| a.trim().ends_with("...")) {
+            continue;
+        }
+
+        let line_start = code[..call.start()].rfind('\n').map_or(0, |i| i + 1);
+        found.push(SprintfConcat {
+            line: code[..call.start()].matches('\n').count() as u32 + 1,
+            column: text[line_start..call.start()].chars().count() as u32 + 1,
+            args: verbs,
+        });
+    }
+    found
+}
+
+/// Check Go `fmt.Sprintf` concatenation and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoSprintfConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("Sprintf")
+        {
+            return violations;
+        }
+
+        for call in find_sprintf_concats(&file.source) {
+            let advice = format!(
+                "This fmt.Sprintf only joins its {} arguments. \
+Concatenate strings with + or a strings.Builder, which don't parse a format string.",
+                call.args
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "forbidden",
+                &advice,
+                SPRINTF_CONCAT,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_sprintf_tests.rs"]
+mod tests;
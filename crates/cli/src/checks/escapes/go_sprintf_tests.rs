@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    two = { "%s%s", Some(2) },
+    three = { "%s%s%s", Some(3) },
+    one = { "%s", Some(1) },
+    empty = { "", None },
+    separator = { "%s/%s", None },
+    other_verb = { "%s%d", None },
+    quoted = { "%q%s", None },
+    literal_percent = { "%%s", None },
+    trailing_text = { "%s%s\\n", None },
+)]
+fn concat_verbs_accepts_only_s_verbs(format: &str, expected: Option<usize>) {
+    assert_eq!(concat_verbs(format), expected);
+}
+
+#[test]
+fn finds_concatenating_calls_with_columns() {
+    let content = "package p
+
+func key(ns, id string) string {
+	return fmt.Sprintf(\"%s%s\", ns, id)
+}
+
+func path(a, b, c string) string {
+	return fmt.Sprintf(
+		`%s%s%s`,
+		a,
+		b,
+		c,
+	)
+}
+";
+    assert_eq!(
+        find_sprintf_concats(&GoSource::new(content)),
+        vec![
+            SprintfConcat {
+                line: 4,
+                column: 9,
+                args: 2,
+            },
+            SprintfConcat {
+                line: 8,
+                column: 9,
+                args: 3,
+            },
+        ]
+    );
+}
+
+#[parameterized(
+    formatted = { "package p\n\nvar s = fmt.Sprintf(\"%s: %s\", a, b)\n" },
+    single = { "package p\n\nvar s = fmt.Sprintf(\"%s\", a)\n" },
+    mismatched = { "package p\n\nvar s = fmt.Sprintf(\"%s%s\", a)\n" },
+    spread = { "package p\n\nvar s = fmt.Sprintf(\"%s%s\", parts...)\n" },
+    variable_format = { "package p\n\nvar s = fmt.Sprintf(format, a, b)\n" },
+    escaped = { "package p\n\nvar s = fmt.Sprintf(\"%s\\t%s\", a, b)\n" },
+    sprint = { "package p\n\nvar s = fmt.Sprint(a, b)\n" },
+    comment = { "package p\n\n// fmt.Sprintf(\"%s%s\", a, b)\nvar s = a + b\n" },
+    string = { "package p\n\nvar s = \"fmt.Sprintf(\\\"%s%s\\\", a, b)\"\n" },
+)]
+fn ignores_formatting_calls(content: &str) {
+    assert!(find_sprintf_concats(&GoSource::new(content)).is_empty());
+}
+
+#[test]
+fn nested_calls_count_as_one_argument() {
+    let content =
+        "package p\n\nvar s = fmt.Sprintf(\"%s%s\", strings.Join(parts, \",\"), name(a, b))\n";
+    assert_eq!(find_sprintf_concats(&GoSource::new(content)).len(), 1);
+}
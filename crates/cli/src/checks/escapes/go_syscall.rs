@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go syscall import checking for the escapes check.
+//!
+//! Flags imports of `syscall` and `golang.org/x/sys/...` outside the
+//! packages listed in `[golang.syscall].allow`, so low-level calls are
+//! funneled through wrapper packages.
+
+use crate::adapter::go::{module_for, parse_imports};
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoSyscallConfig};
+
+use super::go_rule::{GoFile, GoRule};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for syscall imports.
+pub const SYSCALL_IMPORT: &str = "syscall_import";
+
+/// Whether an import path is a syscall package.
+pub fn is_syscall_import(path: &str) -> bool {
+    path == "syscall"
+        || path.starts_with("syscall/")
+        || path == "golang.org/x/sys"
+        || path.starts_with("golang.org/x/sys/")
+}
+
+/// Whether a package matches an allowlist entry.
+///
+/// An entry matches the package directory or import path exactly; a
+/// trailing `/...` also matches subpackages.
+pub fn is_allowed(entry: &str, dir: &str, import_path: Option<&str>) -> bool {
+    let entry = entry.trim_start_matches("./");
+    let mut candidates = std::iter::once(dir).chain(import_path);
+    match entry.strip_suffix("/...") {
+        Some(prefix) => candidates.any(|c| {
+            c == prefix
+                || c.strip_prefix(prefix)
+                    .is_some_and(|rest| rest.starts_with('/'))
+        }),
+        None => candidates.any(|c| c == entry),
+    }
+}
+
+/// Check Go syscall imports and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoSyscallConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || file.is_test {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        if self
+            .allow
+            .iter()
+            .any(|entry| is_allowed(entry, &dir, import_path.as_deref()))
+        {
+            return violations;
+        }
+
+        for import in parse_imports(file.source.content) {
+            if !is_syscall_import(&import.path) {
+                continue;
+            }
+
+            let advice = format!(
+                "Direct use of \"{}\" is restricted to wrapper packages. \
+Call the wrapper instead, or add \"{}\" to [golang.syscall].allow.",
+                import.path, dir
+            );
+            if let Some(mut v) = try_create_violation(
+                ctx,
+                file.path,
+                import.line,
+                "forbidden",
+                &advice,
+                SYSCALL_IMPORT,
+            ) {
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_syscall_tests.rs"]
+mod tests;
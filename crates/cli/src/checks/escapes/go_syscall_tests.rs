@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    syscall = { "syscall", true },
+    syscall_js = { "syscall/js", true },
+    x_sys = { "golang.org/x/sys", true },
+    x_sys_unix = { "golang.org/x/sys/unix", true },
+    x_sys_windows = { "golang.org/x/sys/windows", true },
+    os = { "os", false },
+    x_sync = { "golang.org/x/sync/errgroup", false },
+    lookalike = { "example.com/syscall", false },
+    x_sysfoo = { "golang.org/x/sysfoo", false },
+)]
+fn detects_syscall_imports(path: &str, expected: bool) {
+    assert_eq!(is_syscall_import(path), expected);
+}
+
+#[parameterized(
+    exact_dir = { "internal/sys", "internal/sys", None, true },
+    leading_dot = { "./internal/sys", "internal/sys", None, true },
+    subpackage_not_covered = { "internal/sys", "internal/sys/unix", None, false },
+    recursive_self = { "internal/sys/...", "internal/sys", None, true },
+    recursive_sub = { "internal/sys/...", "internal/sys/unix", None, true },
+    recursive_prefix_only = { "internal/sys/...", "internal/system", None, false },
+    import_path = { "example.com/app/internal/sys", "internal/sys", Some("example.com/app/internal/sys"), true },
+    recursive_import_path = { "example.com/app/...", "cmd/app", Some("example.com/app/cmd/app"), true },
+    other_package = { "internal/sys", "cmd/app", Some("example.com/app/cmd/app"), false },
+)]
+fn matches_allowlist_entries(entry: &str, dir: &str, import_path: Option<&str>, expected: bool) {
+    assert_eq!(is_allowed(entry, dir, import_path), expected);
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `unsafe` introspection checking for the escapes check.
+//!
+//! `unsafe.Sizeof`, `unsafe.Alignof`, and `unsafe.Offsetof` only report type
+//! layout, so unlike `unsafe.Pointer` and `unsafe.Add` they are allowed by
+//! default. Projects that want every use of `unsafe` justified opt in via
+//! `[golang.unsafe].introspection` to require a `// SAFETY:` comment.
+
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::adapter::go::parse_imports;
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoUnsafeConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for unjustified introspection calls.
+pub const UNSAFE_INTROSPECTION: &str = "unsafe_introspection";
+
+/// Required justification marker, shared with the other `unsafe` rules.
+const SAFETY_COMMENT: &str = "// SAFETY:";
+
+/// A selector call of an introspection function: `u.Sizeof(`.
+#[allow(clippy::expect_used)]
+static INTROSPECTION_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])(\w+)\.(Sizeof|Alignof|Offsetof)\s*\(").expect("valid regex pattern")
+});
+
+/// An `unsafe.Sizeof`, `unsafe.Alignof`, or `unsafe.Offsetof` call site.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct IntrospectionCall {
+    /// Function name (e.g., "Sizeof").
+    pub function: String,
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the package qualifier.
+    pub column: u32,
+}
+
+/// Find introspection calls in code, skipping comments and strings.
+///
+/// Calls are matched under the local name `unsafe` is imported as, so
+/// `u.Sizeof(x)` counts with `import u "unsafe"`. Dot-imported calls aren't
+/// matched.
+pub fn find_introspection_calls(source: &GoSource) -> Vec<IntrospectionCall> {
+    let names: Vec<String> = parse_imports(source.content)
+        .into_iter()
+        .filter(|import| import.path == "unsafe")
+        .filter_map(|import| match import.name.as_deref() {
+            None => Some("unsafe".to_string()),
+            Some("_" | ".") => None,
+            Some(name) => Some(name.to_string()),
+        })
+        .collect();
+    if names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut calls = Vec::new();
+    for (idx, line, code) in source.lines() {
+        for captures in INTROSPECTION_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(function)) = (captures.get(1), captures.get(2)) else {
+                continue;
+            };
+            if !names.iter().any(|name| name == qualifier.as_str()) {
+                continue;
+            }
+            calls.push(IntrospectionCall {
+                function: function.as_str().to_string(),
+                line: idx as u32 + 1,
+                column: line[..qualifier.start()].chars().count() as u32 + 1,
+            });
+        }
+    }
+    calls
+}
+
+/// Check Go `unsafe` introspection calls and return violations.
+///
+/// Test files are not checked, like the other `unsafe` rules.
+impl GoRule for GoUnsafeConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.introspection == CheckLevel::Off
+            || file.is_test
+            || file.path.to_string_lossy().ends_with("_test.go")
+            || !file.source.content.contains("unsafe")
+        {
+            return violations;
+        }
+
+        for call in find_introspection_calls(&file.source) {
+            if has_justification_comment(file.source.content, call.line, SAFETY_COMMENT) {
+                continue;
+            }
+
+            let advice = format!(
+                "Add a // SAFETY: comment explaining what depends on the layout unsafe.{} reports.",
+                call.function
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                call.line,
+                "missing_comment",
+                &advice,
+                UNSAFE_INTROSPECTION,
+            ) {
+                let mut v = v.with_column(call.column);
+                v.warning = self.introspection == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_unsafe_tests.rs"]
+mod tests;
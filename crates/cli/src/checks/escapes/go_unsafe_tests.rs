@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn functions(content: &str) -> Vec<String> {
+    find_introspection_calls(&GoSource::new(content))
+        .into_iter()
+        .map(|c| c.function)
+        .collect()
+}
+
+#[test]
+fn finds_introspection_calls_with_columns() {
+    let content =
+        "package lib\n\nimport \"unsafe\"\n\nvar n = unsafe.Sizeof(x) + unsafe.Alignof(x)\n";
+    assert_eq!(
+        find_introspection_calls(&GoSource::new(content)),
+        vec![
+            IntrospectionCall {
+                function: "Sizeof".to_string(),
+                line: 5,
+                column: 9,
+            },
+            IntrospectionCall {
+                function: "Alignof".to_string(),
+                line: 5,
+                column: 28,
+            },
+        ]
+    );
+}
+
+#[test]
+fn matches_aliased_import() {
+    let content = "package lib\n\nimport u \"unsafe\"\n\nvar off = u.Offsetof(s.f)\nvar n = unsafe.Sizeof(x)\n";
+    assert_eq!(
+        find_introspection_calls(&GoSource::new(content)),
+        vec![IntrospectionCall {
+            function: "Offsetof".to_string(),
+            line: 5,
+            column: 11,
+        }]
+    );
+}
+
+#[parameterized(
+    not_imported = { "package lib\n\nvar n = unsafe.Sizeof(x)\n" },
+    comment = { "package lib\n\nimport \"unsafe\"\n\n// unsafe.Sizeof(x) is constant\n" },
+    string = { "package lib\n\nimport \"unsafe\"\n\nvar s = \"unsafe.Sizeof(x)\"\n" },
+    other_package = { "package lib\n\nimport \"unsafe\"\n\nvar n = binary.Sizeof(x)\n" },
+    selector_chain = { "package lib\n\nimport \"unsafe\"\n\nvar n = p.unsafe.Sizeof(x)\n" },
+    pointer_arithmetic = { "package lib\n\nimport \"unsafe\"\n\nvar p = unsafe.Add(base, 8)\n" },
+)]
+fn ignores_other_calls(content: &str) {
+    assert!(functions(content).is_empty());
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go unspread variadic argument checking for the escapes check.
+//!
+//! `fmt.Printf(format, args)` with `args []any` passes the slice as a single
+//! value, so it prints `[a b]` where `a b` was meant; the slice needs `args...`
+//! to spread it into the variadic parameter. The usual shape is a logging
+//! helper forwarding its own `args ...any`. Flags fmt print calls whose only
+//! variadic argument is a `[]any` declared in the same function. On by
+//! default via `[golang.variadic]`.
+
+use std::collections::HashSet;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoVariadicConfig};
+
+use super::go_exit::import_names;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `[]any` slices passed without `...`.
+pub const UNSPREAD_ARGS: &str = "unspread_args";
+
+/// A fmt print call: `fmt.Printf(`, `fmt.Sprintln(`, `fmt.Errorf(`.
+#[allow(clippy::expect_used)]
+static PRINT_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(
+        r"(?:^|[^.\w])(\w+)\.(Printf|Sprintf|Fprintf|Errorf|Appendf|Print|Sprint|Fprint|Append|Println|Sprintln|Fprintln|Appendln)\s*\(",
+    )
+    .expect("valid regex pattern")
+});
+
+/// A variadic parameter of type any: `args ...any`, `v ...interface{}`.
+#[allow(clippy::expect_used)]
+static VARIADIC_PARAM: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(\w+)\s+\.\.\.\s*(?:any\b|interface\s*\{\s*\})").expect("valid regex pattern")
+});
+
+/// A parameter or variable declared `[]any`: `args []any`, `var v []interface{}`.
+#[allow(clippy::expect_used)]
+static SLICE_DECL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(\w+)\s+\[\]\s*(?:any\b|interface\s*\{\s*\})").expect("valid regex pattern")
+});
+
+/// A variable assigned a new `[]any`: `args := []any{`, `v = make([]any, 0)`.
+#[allow(clippy::expect_used)]
+static SLICE_INIT: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b(\w+)\s*:?=\s*(?:make\s*\(\s*)?\[\]\s*(?:any\b|interface\s*\{\s*\})")
+        .expect("valid regex pattern")
+});
+
+/// A `[]any` passed to a fmt print function without `...`.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct UnspreadCall {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the slice argument.
+    pub column: u32,
+    /// The function under its canonical package, e.g. `fmt.Printf`.
+    pub call: String,
+    /// The slice passed, e.g. `args`.
+    pub arg: String,
+}
+
+/// Arguments before the variadic ones: a writer, a byte slice, a format.
+fn fixed_params(func: &str) -> usize {
+    match func {
+        "Printf" | "Sprintf" | "Errorf" | "Fprint" | "Append" | "Fprintln" | "Appendln" => 1,
+        "Fprintf" | "Appendf" => 2,
+        _ => 0,
+    }
+}
+
+/// Byte ranges of the top-level arguments of a call, from just after its
+/// `(`, or None when the call doesn't close on this line.
+pub(super) fn argument_ranges(code: &str, open: usize) -> Option<Vec<(usize, usize)>> {
+    let mut ranges = Vec::new();
+    let mut depth = 0usize;
+    let mut start = open;
+    for (pos, byte) in code.bytes().enumerate().skip(open) {
+        match byte {
+            b'(' | b'[' | b'{' => depth += 1,
+            b')' | b']' | b'}' if depth > 0 => depth -= 1,
+            b')' => {
+                ranges.push((start, pos));
+                return Some(ranges);
+            }
+            b',' if depth == 0 => {
+                ranges.push((start, pos));
+                start = pos + 1;
+            }
+            _ => {}
+        }
+    }
+    None
+}
+
+/// Find fmt print calls whose only variadic argument is a `[]any` slice
+/// without `...`, skipping comments and strings.
+///
+/// A name is taken as a `[]any` from its declaration earlier in the same
+/// top-level function: a `...any` or `[]any` parameter, a `var` of type
+/// `[]any`, or an assignment of a `[]any{...}` literal or `make([]any, n)`.
+/// Shadowing isn't followed, and a slice passed alongside other variadic
+/// arguments is taken as meant to print as one value. Only calls that close
+/// on their own line are checked, under the local names `fmt` is imported as.
+pub fn find_unspread_calls(source: &GoSource) -> Vec<UnspreadCall> {
+    let fmt_names = import_names(source.content, "fmt");
+    if fmt_names.is_empty() {
+        return Vec::new();
+    }
+
+    let mut found = Vec::new();
+    let mut slices: HashSet<String> = HashSet::new();
+    for (idx, line, code) in source.lines() {
+        // Top-level functions start at the first column
+        if code.starts_with("func") {
+            slices.clear();
+        }
+        for regex in [&*VARIADIC_PARAM, &*SLICE_DECL, &*SLICE_INIT] {
+            slices.extend(regex.captures_iter(code).map(|caps| caps[1].to_string()));
+        }
+        if slices.is_empty() {
+            continue;
+        }
+
+        for captures in PRINT_CALL.captures_iter(code) {
+            let (Some(qualifier), Some(func), Some(whole)) =
+                (captures.get(1), captures.get(2), captures.get(0))
+            else {
+                continue;
+            };
+            if !fmt_names.iter().any(|n| n == qualifier.as_str()) {
+                continue;
+            }
+            let Some(args) = argument_ranges(code, whole.end()) else {
+                continue;
+            };
+            if args.len() != fixed_params(func.as_str()) + 1 {
+                continue;
+            }
+            let Some(&(start, end)) = args.last() else {
+                continue;
+            };
+            let arg = code[start..end].trim();
+            if !slices.contains(arg) {
+                continue;
+            }
+            let offset = start + (code[start..end].len() - code[start..end].trim_start().len());
+            found.push(UnspreadCall {
+                line: idx as u32 + 1,
+                column: line[..offset].chars().count() as u32 + 1,
+                call: format!("fmt.{}", func.as_str()),
+                arg: arg.to_string(),
+            });
+        }
+    }
+    found
+}
+
+/// Check Go fmt print calls for unspread `[]any` slices and return violations.
+impl GoRule for GoVariadicConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("\"fmt\"") {
+            return violations;
+        }
+
+        for found in find_unspread_calls(&file.source) {
+            let advice = format!(
+                "{arg} is a []any passed to {call} as a single value, so it prints as a slice. \
+Pass {arg}... to spread it into the arguments.",
+                arg = found.arg,
+                call = found.call
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                UNSPREAD_ARGS,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_variadic_tests.rs"]
+mod tests;
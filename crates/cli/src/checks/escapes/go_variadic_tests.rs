@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn args_in(body: &str) -> Vec<String> {
+    let content = format!(
+        "package p\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n\nfunc logf(format string, args ...any) {{\n{}\n}}\n",
+        body
+    );
+    find_unspread_calls(&GoSource::new(&content))
+        .into_iter()
+        .map(|found| found.arg)
+        .collect()
+}
+
+#[test]
+fn finds_unspread_call_with_column() {
+    let content = "package p
+
+import (
+	xfmt \"fmt\"
+)
+
+func logf(format string, args ...interface{}) {
+	xfmt.Printf(format, args)
+}
+";
+    assert_eq!(
+        find_unspread_calls(&GoSource::new(content)),
+        vec![UnspreadCall {
+            line: 8,
+            column: 22,
+            call: "fmt.Printf".to_string(),
+            arg: "args".to_string(),
+        }]
+    );
+}
+
+#[parameterized(
+    printf = { "\tfmt.Printf(format, args)" },
+    sprintf = { "\t_ = fmt.Sprintf(format, args)" },
+    errorf = { "\t_ = fmt.Errorf(format, args)" },
+    fprintf = { "\tfmt.Fprintf(os.Stderr, format, args)" },
+    println = { "\tfmt.Println(args)" },
+    local_literal = { "\tvals := []any{format, 1}\n\tfmt.Println(vals)" },
+    local_make = { "\tvals := make([]interface{}, 0)\n\tfmt.Print(vals)" },
+    local_var = { "\tvar vals []any\n\tfmt.Sprint(vals)" },
+)]
+fn unspread_slices_are_found(body: &str) {
+    assert_eq!(args_in(body).len(), 1);
+}
+
+#[parameterized(
+    spread = { "\tfmt.Printf(format, args...)" },
+    spread_fprintf = { "\tfmt.Fprintf(os.Stderr, format, args...)" },
+    with_other_args = { "\tfmt.Printf(\"%s %v\", format, args)" },
+    not_a_slice = { "\tfmt.Println(format)" },
+    format_only = { "\tfmt.Printf(format)" },
+    nested_call = { "\tfmt.Println(len(args))" },
+    in_string = { "\tfmt.Println(\"args\")" },
+    commented = { "\t// fmt.Println(args)" },
+    multi_line = { "\tfmt.Printf(format,\n\t\targs)" },
+)]
+fn spread_or_other_arguments_are_ok(body: &str) {
+    assert!(args_in(body).is_empty());
+}
+
+#[test]
+fn slices_are_scoped_to_their_function() {
+    let content = "package p
+
+import \"fmt\"
+
+func collect() []any {
+	vals := []any{1, 2}
+	return vals
+}
+
+func show(vals string) {
+	fmt.Println(vals)
+}
+";
+    assert!(find_unspread_calls(&GoSource::new(content)).is_empty());
+}
+
+#[test]
+fn other_packages_are_not_checked() {
+    let content = "package p
+
+import \"log\"
+
+func logf(format string, args ...any) {
+	log.Printf(format, args)
+}
+";
+    assert!(find_unspread_calls(&GoSource::new(content)).is_empty());
+}
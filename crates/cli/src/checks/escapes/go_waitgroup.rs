@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go WaitGroup Add placement checking for the escapes check.
+//!
+//! `go func() { wg.Add(1); defer wg.Done(); ... }()` counts the goroutine
+//! only once it starts running, so a `wg.Wait()` after the `go` statement
+//! can return before the goroutine has been counted, and before its work is
+//! done. `wg.Add` belongs before the `go` statement. Flags `Add` calls on a
+//! `sync.WaitGroup` inside a function literal launched by `go`, following the
+//! WaitGroup whether it's captured or passed as an argument. On by default
+//! via `[golang.waitgroup]`.
+
+use std::collections::HashSet;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoWaitGroupConfig};
+
+use super::go_exit::import_names;
+use super::go_rule::{GoFile, GoRule, GoSource};
+use super::go_variadic::argument_ranges;
+use super::violations::try_create_violation;
+
+/// Violation pattern name for `wg.Add` calls inside the goroutine they count.
+pub const WAITGROUP_ADD: &str = "waitgroup_add";
+
+/// A goroutine launched on a function literal: `go func(`.
+#[allow(clippy::expect_used)]
+static GO_FUNC: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"(?:^|[^.\w])go\s+func\s*\(").expect("valid regex pattern"));
+
+/// An `Add` call on a plain name: `wg.Add(`, not `s.wg.Add(`.
+#[allow(clippy::expect_used)]
+static ADD_CALL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"(?:^|[^.\w])([A-Za-z_]\w*)\.Add\s*\(").expect("valid regex pattern")
+});
+
+/// A variable or parameter of WaitGroup type: `var wg sync.WaitGroup`,
+/// `wg *sync.WaitGroup`.
+#[allow(clippy::expect_used)]
+static WAITGROUP_DECL: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b([A-Za-z_]\w*)\s+\*?\s*(\w+)\.WaitGroup\b").expect("valid regex pattern")
+});
+
+/// A variable assigned a new WaitGroup: `wg := &sync.WaitGroup{}`,
+/// `wg := new(sync.WaitGroup)`.
+#[allow(clippy::expect_used)]
+static WAITGROUP_INIT: LazyLock<Regex> = LazyLock::new(|| {
+    Regex::new(r"\b([A-Za-z_]\w*)\s*:?=\s*(?:&\s*)?(?:new\s*\(\s*)?(\w+)\.WaitGroup\b")
+        .expect("valid regex pattern")
+});
+
+/// A WaitGroup `Add` call inside the goroutine it counts.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct GoroutineAdd {
+    /// 1-based line number of the `Add` call.
+    pub line: u32,
+    /// 1-based column of the WaitGroup receiver.
+    pub column: u32,
+    /// The WaitGroup as named inside the goroutine, e.g. `wg`.
+    pub name: String,
+    /// The WaitGroup as named where the goroutine is launched.
+    pub waitgroup: String,
+}
+
+/// A `go func(...) {` literal whose body is open.
+struct GoLiteral {
+    /// Brace depth outside the literal's body.
+    depth: usize,
+    /// Parameter names, and whether each is a WaitGroup.
+    params: Vec<(String, bool)>,
+    /// WaitGroups already added to before the `go` statement.
+    counted: HashSet<String>,
+    /// `Add` calls in the body: line, column, receiver.
+    adds: Vec<(u32, u32, String)>,
+}
+
+/// Whether a type is `sync.WaitGroup` or a pointer to one.
+fn is_waitgroup_type(ty: &str, sync_names: &[String]) -> bool {
+    let ty = ty.trim().trim_start_matches('*').trim_start();
+    ty.strip_suffix("WaitGroup")
+        .and_then(|qualifier| qualifier.strip_suffix('.'))
+        .is_some_and(|qualifier| sync_names.iter().any(|n| n == qualifier.trim()))
+}
+
+/// Parameter names of a function literal, and whether each is a WaitGroup,
+/// carrying a grouped type back to its names: `a, b *sync.WaitGroup`.
+fn parse_params(code: &str, sync_names: &[String]) -> Vec<(String, bool)> {
+    let mut params = Vec::new();
+    let mut untyped = 0;
+    for part in code.split(',') {
+        let part = part.trim();
+        if part.is_empty() {
+            continue;
+        }
+        match part.split_once(char::is_whitespace) {
+            Some((name, ty)) => {
+                let is_wg = is_waitgroup_type(ty, sync_names);
+                for (_, grouped) in params.iter_mut().rev().take(untyped) {
+                    *grouped = is_wg;
+                }
+                untyped = 0;
+                params.push((name.to_string(), is_wg));
+            }
+            None => {
+                untyped += 1;
+                params.push((part.to_string(), false));
+            }
+        }
+    }
+    params
+}
+
+/// Byte offset of the `)` closing a parenthesis opened just before `open`.
+fn closing_paren(code: &str, open: usize) -> Option<usize> {
+    let mut depth = 0usize;
+    for (pos, byte) in code.bytes().enumerate().skip(open) {
+        match byte {
+            b'(' => depth += 1,
+            b')' if depth == 0 => return Some(pos),
+            b')' => depth -= 1,
+            _ => {}
+        }
+    }
+    None
+}
+
+/// Arguments of the call right after a literal's closing brace: `}(&wg, i)`.
+fn call_arguments(code: &str, close: usize) -> Vec<String> {
+    let rest = &code[close + 1..];
+    let trimmed = rest.trim_start();
+    if !trimmed.starts_with('(') {
+        return Vec::new();
+    }
+    let open = close + 1 + (rest.len() - trimmed.len()) + 1;
+    argument_ranges(code, open)
+        .unwrap_or_default()
+        .into_iter()
+        .map(|(start, end)| code[start..end].trim().to_string())
+        .collect()
+}
+
+/// Find `Add` calls on a `sync.WaitGroup` inside a function literal launched
+/// by `go`, skipping comments and strings.
+///
+/// A name is taken as a WaitGroup from its declaration in the same top-level
+/// function or at package level: a variable or parameter of type
+/// `sync.WaitGroup` or `*sync.WaitGroup`, or an assignment of
+/// `&sync.WaitGroup{}` or `new(sync.WaitGroup)`. Inside the literal, the
+/// WaitGroup is followed through a captured name, or through a WaitGroup
+/// parameter to the argument the literal is called with (`&wg`). A goroutine
+/// whose WaitGroup was already added to before the `go` statement is taken as
+/// counted, so a worker adding for the tasks it spawns passes. Struct fields
+/// (`s.wg.Add`) and goroutines launched on named functions aren't checked,
+/// and only literals whose parameters and opening brace are on the `go` line
+/// are followed.
+pub fn find_goroutine_adds(source: &GoSource) -> Vec<GoroutineAdd> {
+    let sync_names = import_names(source.content, "sync");
+    if sync_names.is_empty() {
+        return Vec::new();
+    }
+    let is_sync = |qualifier: &str| sync_names.iter().any(|n| n == qualifier);
+
+    let mut found = Vec::new();
+    let mut globals: HashSet<String> = HashSet::new();
+    let mut locals: HashSet<String> = HashSet::new();
+    let mut counted: HashSet<String> = HashSet::new();
+    let mut literals: Vec<GoLiteral> = Vec::new();
+    let mut in_func = false;
+    let mut depth = 0usize;
+    for (idx, line, code) in source.lines() {
+        // Top-level functions start and end at the first column
+        if code.starts_with("func") {
+            in_func = true;
+            locals.clear();
+            counted.clear();
+            literals.clear();
+            depth = 0;
+        }
+
+        let declared = WAITGROUP_DECL
+            .captures_iter(code)
+            .chain(WAITGROUP_INIT.captures_iter(code))
+            .filter(|caps| is_sync(&caps[2]))
+            .map(|caps| caps[1].to_string());
+        if in_func {
+            locals.extend(declared);
+        } else {
+            globals.extend(declared);
+        }
+        if !in_func {
+            continue;
+        }
+
+        // Body braces of the `go func(...) {` literals on this line
+        let mut opens = Vec::new();
+        for go in GO_FUNC.find_iter(code) {
+            let Some(close) = closing_paren(code, go.end()) else {
+                continue;
+            };
+            let Some(brace) = code[close..].find('{') else {
+                continue;
+            };
+            let params = parse_params(&code[go.end()..close], &sync_names);
+            opens.push((close + brace, params));
+        }
+        let adds: Vec<(usize, String)> = ADD_CALL
+            .captures_iter(code)
+            .filter_map(|caps| caps.get(1))
+            .map(|name| (name.start(), name.as_str().to_string()))
+            .collect();
+
+        for (pos, byte) in code.bytes().enumerate() {
+            if let Some((_, name)) = adds.iter().find(|(start, _)| *start == pos) {
+                let column = line[..pos].chars().count() as u32 + 1;
+                match literals.last_mut() {
+                    Some(literal) => literal.adds.push((idx as u32 + 1, column, name.clone())),
+                    None => {
+                        counted.insert(name.clone());
+                    }
+                }
+            }
+            match byte {
+                b'{' => {
+                    if let Some(at) = opens.iter().position(|(brace, _)| *brace == pos) {
+                        let (_, params) = opens.swap_remove(at);
+                        literals.push(GoLiteral {
+                            depth,
+                            params,
+                            counted: counted.clone(),
+                            adds: Vec::new(),
+                        });
+                    }
+                    depth += 1;
+                }
+                b'}' => {
+                    depth = depth.saturating_sub(1);
+                    if literals.last().is_none_or(|literal| literal.depth != depth) {
+                        continue;
+                    }
+                    let Some(literal) = literals.pop() else {
+                        continue;
+                    };
+                    let args = call_arguments(code, pos);
+                    for (add_line, column, name) in literal.adds {
+                        let waitgroup = match literal.params.iter().position(|(p, _)| *p == name) {
+                            Some(at) if literal.params[at].1 => args
+                                .get(at)
+                                .map(|arg| arg.trim_start_matches('&').trim().to_string()),
+                            // A parameter of another type shadows the name
+                            Some(_) => None,
+                            None => Some(name.clone()),
+                        };
+                        let Some(waitgroup) = waitgroup else {
+                            continue;
+                        };
+                        if !locals.contains(&waitgroup) && !globals.contains(&waitgroup) {
+                            continue;
+                        }
+                        if literal.counted.contains(&waitgroup) {
+                            continue;
+                        }
+                        found.push(GoroutineAdd {
+                            line: add_line,
+                            column,
+                            name,
+                            waitgroup,
+                        });
+                    }
+                }
+                _ => {}
+            }
+        }
+
+        if code.starts_with('}') {
+            in_func = false;
+        }
+    }
+    found.sort_by_key(|found| (found.line, found.column));
+    found
+}
+
+/// Check Go goroutines for WaitGroup `Add` calls inside them and return
+/// violations.
+impl GoRule for GoWaitGroupConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off || !file.source.content.contains("WaitGroup") {
+            return violations;
+        }
+
+        for found in find_goroutine_adds(&file.source) {
+            let advice = format!(
+                "{name}.Add runs inside the goroutine it counts, so {waitgroup}.Wait() can return \
+before the goroutine starts. Call {waitgroup}.Add before the go statement.",
+                name = found.name,
+                waitgroup = found.waitgroup
+            );
+            if let Some(v) = try_create_violation(
+                ctx,
+                file.path,
+                found.line,
+                "forbidden",
+                &advice,
+                WAITGROUP_ADD,
+            ) {
+                let mut v = v.with_column(found.column);
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_waitgroup_tests.rs"]
+mod tests;
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn waitgroups_in(body: &str) -> Vec<String> {
+    let content = format!(
+        "package p\n\nimport \"sync\"\n\nfunc run(jobs []int, n int) {{\n\tvar wg sync.WaitGroup\n{}\n\twg.Wait()\n}}\n",
+        body
+    );
+    find_goroutine_adds(&GoSource::new(&content))
+        .into_iter()
+        .map(|found| found.waitgroup)
+        .collect()
+}
+
+#[test]
+fn finds_add_inside_goroutine_with_column() {
+    let content = "package p
+
+import \"sync\"
+
+func run(jobs []int) {
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		go func() {
+			wg.Add(1)
+			defer wg.Done()
+			work(job)
+		}()
+	}
+	wg.Wait()
+}
+";
+    assert_eq!(
+        find_goroutine_adds(&GoSource::new(content)),
+        vec![GoroutineAdd {
+            line: 9,
+            column: 4,
+            name: "wg".to_string(),
+            waitgroup: "wg".to_string(),
+        }]
+    );
+}
+
+#[test]
+fn follows_waitgroup_passed_as_argument() {
+    let content = "package p
+
+import xsync \"sync\"
+
+func run(n int) {
+	done := new(xsync.WaitGroup)
+	go func(id int, group *xsync.WaitGroup) {
+		group.Add(1)
+		defer group.Done()
+	}(n, done)
+	done.Wait()
+}
+";
+    assert_eq!(
+        find_goroutine_adds(&GoSource::new(content)),
+        vec![GoroutineAdd {
+            line: 8,
+            column: 3,
+            name: "group".to_string(),
+            waitgroup: "done".to_string(),
+        }]
+    );
+}
+
+#[parameterized(
+    captured = { "\tgo func() {\n\t\twg.Add(1)\n\t\tdefer wg.Done()\n\t}()" },
+    one_line = { "\tgo func() { wg.Add(1); defer wg.Done() }()" },
+    in_loop = { "\tfor range jobs {\n\t\tgo func() {\n\t\t\twg.Add(1)\n\t\t\twg.Done()\n\t\t}()\n\t}" },
+    pointer_arg = { "\tgo func(w *sync.WaitGroup) {\n\t\tw.Add(1)\n\t\tw.Done()\n\t}(&wg)" },
+    grouped_params = { "\tgo func(a, b *sync.WaitGroup) {\n\t\ta.Add(1)\n\t}(&wg, &wg)" },
+    nested_block = { "\tgo func() {\n\t\tif n > 0 {\n\t\t\twg.Add(n)\n\t\t}\n\t}()" },
+)]
+fn adds_inside_goroutines_are_found(body: &str) {
+    assert_eq!(waitgroups_in(body), vec!["wg".to_string()]);
+}
+
+#[parameterized(
+    before_go = { "\twg.Add(1)\n\tgo func() {\n\t\tdefer wg.Done()\n\t}()" },
+    counted_before = { "\twg.Add(1)\n\tgo func() {\n\t\tdefer wg.Done()\n\t\tfor range jobs {\n\t\t\twg.Add(1)\n\t\t\tgo func() { wg.Done() }()\n\t\t}\n\t}()" },
+    after_literal = { "\tgo func() {\n\t\tdefer wg.Done()\n\t}()\n\twg.Add(1)" },
+    shadowed = { "\tgo func(wg counter) {\n\t\twg.Add(1)\n\t}(c)" },
+    other_type = { "\tvar c Counter\n\tgo func() {\n\t\tc.Add(1)\n\t}()" },
+    field = { "\tgo func() {\n\t\ts.wg.Add(1)\n\t}()" },
+    plain_closure = { "\tadd := func() {\n\t\twg.Add(1)\n\t}\n\tadd()" },
+    commented = { "\tgo func() {\n\t\t// wg.Add(1)\n\t\twg.Done()\n\t}()" },
+    in_string = { "\tgo func() {\n\t\tlog(\"wg.Add(1)\")\n\t}()" },
+)]
+fn adds_before_go_or_elsewhere_are_ok(body: &str) {
+    assert!(waitgroups_in(body).is_empty());
+}
+
+#[test]
+fn package_level_waitgroups_are_followed() {
+    let content = "package p
+
+import \"sync\"
+
+var pending sync.WaitGroup
+
+func start() {
+	go func() {
+		pending.Add(1)
+		defer pending.Done()
+	}()
+}
+";
+    assert_eq!(find_goroutine_adds(&GoSource::new(content)).len(), 1);
+}
+
+#[test]
+fn waitgroups_are_scoped_to_their_function() {
+    let content = "package p
+
+import \"sync\"
+
+func setup() {
+	var wg sync.WaitGroup
+	wg.Wait()
+}
+
+func run(wg *Counter) {
+	go func() {
+		wg.Add(1)
+	}()
+}
+";
+    assert!(find_goroutine_adds(&GoSource::new(content)).is_empty());
+}
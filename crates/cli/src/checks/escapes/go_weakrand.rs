@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Go `math/rand` import checking for the escapes check.
+//!
+//! `math/rand` is predictable, so tokens, keys, and nonces drawn from it can
+//! be guessed. Flags imports of `math/rand` and `math/rand/v2` in packages
+//! matching `[golang.weakrand].packages`, unless a `// WEAKRAND:` comment
+//! explains why a weak source is fine there. Opt-in; other packages are
+//! never checked.
+
+use globset::GlobSet;
+
+use crate::adapter::glob::build_glob_set;
+use crate::adapter::go::{module_for, parse_imports};
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, GoWeakRandConfig};
+
+use super::comment::has_justification_comment;
+use super::go_rule::{GoFile, GoRule};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for weak random imports.
+pub const WEAK_RAND: &str = "weak_rand";
+
+/// Required justification marker.
+pub const WEAKRAND_COMMENT: &str = "// WEAKRAND:";
+
+/// Whether an import path is a non-cryptographic random package.
+pub fn is_weak_rand_import(path: &str) -> bool {
+    path == "math/rand" || path == "math/rand/v2"
+}
+
+/// Whether a package matches one of the security-sensitive globs, by its
+/// directory or its import path.
+pub fn is_sensitive_package(globs: &GlobSet, dir: &str, import_path: Option<&str>) -> bool {
+    std::iter::once(dir)
+        .chain(import_path)
+        .any(|candidate| globs.is_match(candidate))
+}
+
+/// Check Go `math/rand` imports and return violations.
+///
+/// Test files are not checked.
+impl GoRule for GoWeakRandConfig {
+    fn check(&self, ctx: &CheckContext, file: &GoFile) -> Vec<Violation> {
+        let mut violations = Vec::new();
+        if self.check == CheckLevel::Off
+            || self.packages.is_empty()
+            || file.is_test
+            || !file.source.content.contains("math/rand")
+        {
+            return violations;
+        }
+
+        let dir = match file.path.parent().and_then(|p| p.to_str()) {
+            Some("") | None => ".".to_string(),
+            Some(dir) => dir.replace('\\', "/"),
+        };
+        let import_path = module_for(file.modules, &dir).map(|m| m.import_path(&dir));
+        let globs = build_glob_set(&self.packages);
+        if !is_sensitive_package(&globs, &dir, import_path.as_deref()) {
+            return violations;
+        }
+
+        let lines: Vec<&str> = file.source.content.lines().collect();
+        for import in parse_imports(file.source.content) {
+            if !is_weak_rand_import(&import.path)
+                || has_justification_comment(file.source.content, import.line, WEAKRAND_COMMENT)
+            {
+                continue;
+            }
+
+            let advice = format!(
+                "\"{}\" is predictable; package {} generates security-sensitive values. \
+Use \"crypto/rand\" instead, or add a // WEAKRAND: comment explaining why a weak source is safe here.",
+                import.path, dir
+            );
+            if let Some(v) =
+                try_create_violation(ctx, file.path, import.line, "forbidden", &advice, WEAK_RAND)
+            {
+                let quoted = format!("\"{}\"", import.path);
+                let line = lines.get(import.line as usize - 1).copied().unwrap_or("");
+                let mut v = match line.find(&quoted) {
+                    Some(offset) => v.with_column(line[..offset].chars().count() as u32 + 1),
+                    None => v,
+                };
+                v.warning = self.check == CheckLevel::Warn;
+                violations.push(v);
+            }
+        }
+
+        violations
+    }
+}
+
+#[cfg(test)]
+#[path = "go_weakrand_tests.rs"]
+mod tests;
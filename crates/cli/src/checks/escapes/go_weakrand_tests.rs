@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    math_rand = { "math/rand", true },
+    math_rand_v2 = { "math/rand/v2", true },
+    crypto_rand = { "crypto/rand", false },
+    math = { "math", false },
+    lookalike = { "example.com/math/rand", false },
+    exp_rand = { "golang.org/x/exp/rand", false },
+)]
+fn detects_weak_rand_imports(path: &str, expected: bool) {
+    assert_eq!(is_weak_rand_import(path), expected);
+}
+
+#[parameterized(
+    exact_dir = { "internal/token", "internal/token", None, true },
+    dir_glob = { "internal/auth/**", "internal/auth/session", None, true },
+    glob_excludes_parent = { "internal/auth/**", "internal/auth", None, false },
+    other_dir = { "internal/token", "internal/sim", None, false },
+    import_path = { "example.com/app/*/token", "internal/token", Some("example.com/app/internal/token"), true },
+    wildcard_name = { "**/*token*", "pkg/apitokens", None, true },
+)]
+fn matches_sensitive_packages(glob: &str, dir: &str, import_path: Option<&str>, expected: bool) {
+    let globs = build_glob_set(&[glob.to_string()]);
+    assert_eq!(is_sensitive_package(&globs, dir, import_path), expected);
+}
@@ -8,7 +8,7 @@ use std::path::Path;
 use crate::adapter::common::policy::{self, PolicyConfig};
 use crate::adapter::{
     GoAdapter, JavaScriptAdapter, ProjectLanguage, PythonAdapter, RubyAdapter, RustAdapter,
-    ShellAdapter, detect_language,
+    ShellAdapter, project_language,
 };
 use crate::check::{CheckContext, Violation};
 use crate::config::{CheckLevel, LintChangesPolicy};
@@ -23,7 +23,7 @@ pub struct PolicyCheckResult {
 
 /// Check lint policy and return violations with their check level.
 pub fn check_lint_policy(ctx: &CheckContext) -> PolicyCheckResult {
-    match detect_language(ctx.root) {
+    match project_language(ctx.root, ctx.config) {
         ProjectLanguage::Rust => check_language_lint_policy(
             ctx,
             "rust",
@@ -134,6 +134,7 @@ fn make_policy_violation(
     vec![Violation {
         file: None,
         line: None,
+        column: None,
         violation_type: "lint_policy".to_string(),
         advice: format!(
             "Changed lint config: {}\nAlso changed source: {}\nSubmit lint config changes in a separate PR.",
@@ -159,6 +160,8 @@ fn make_policy_violation(
         scope: None,
         expected: None,
         found: None,
+        count: None,
+        warning: false,
     }]
 }
 
@@ -51,6 +51,17 @@ impl EscapesMetrics {
         *map.entry(pattern_name.to_string()).or_insert(0) += 1;
     }
 
+    /// Add counts from another scan (e.g., a single file's metrics).
+    pub(super) fn merge(&mut self, other: EscapesMetrics) {
+        merge_counts(&mut self.source, other.source);
+        merge_counts(&mut self.test, other.test);
+        for (package, counts) in other.packages {
+            let pkg = self.packages.entry(package).or_default();
+            merge_counts(&mut pkg.source, counts.source);
+            merge_counts(&mut pkg.test, counts.test);
+        }
+    }
+
     pub(super) fn source_count(&self, pattern_name: &str) -> usize {
         self.source.get(pattern_name).copied().unwrap_or(0)
     }
@@ -115,3 +126,9 @@ impl EscapesMetrics {
         Some(result)
     }
 }
+
+fn merge_counts(into: &mut HashMap<String, usize>, from: HashMap<String, usize>) {
+    for (name, count) in from {
+        *into.entry(name).or_insert(0) += count;
+    }
+}
@@ -6,8 +6,41 @@
 //! Detects patterns that bypass type safety or error handling.
 //! See docs/specs/checks/escape-hatches.md.
 
+mod catalog;
 mod comment;
+mod fix;
+mod go_any;
+mod go_appendalias;
+mod go_clock;
+mod go_context;
+mod go_ctxkey;
+mod go_deferloop;
+mod go_deprecated;
+mod go_embed;
+mod go_errcheck;
+mod go_errstring;
+mod go_exit;
+mod go_goroutine;
+mod go_http;
+mod go_init;
+mod go_largestruct;
+mod go_linkname;
+mod go_maplock;
+mod go_maporder;
+mod go_mustcompile;
+mod go_panic;
+mod go_print;
+mod go_recover;
+mod go_rule;
+mod go_secrets;
+mod go_sleep;
+mod go_sprintf;
 mod go_suppress;
+mod go_syscall;
+mod go_unsafe;
+mod go_variadic;
+mod go_waitgroup;
+mod go_weakrand;
 mod javascript_suppress;
 mod lint_policy;
 mod metrics;
@@ -16,18 +49,35 @@ mod python_suppress;
 mod ruby_suppress;
 mod shell_suppress;
 mod suppress_common;
+mod todo;
 mod violations;
 
-use std::collections::HashSet;
+use std::collections::{HashMap, HashSet};
 use std::path::Path;
+use std::sync::Arc;
+use std::sync::atomic::AtomicUsize;
 
 use globset::GlobSet;
+use rayon::prelude::*;
+use regex::Regex;
 
 use crate::adapter::glob::build_glob_set;
-use crate::adapter::{CfgTestInfo, FileKind, GenericAdapter, parse_suppress_attrs};
+use crate::adapter::go::{GoModule, find_modules, find_syntax_error};
+use crate::adapter::{
+    CfgTestInfo, FileKind, GenericAdapter, ProjectLanguage, javascript, language_for_file,
+    mask_cgo_preambles, normalize_escape_source, parse_suppress_attrs, project_language, python,
+};
 use crate::check::{Check, CheckContext, CheckResult, Violation};
 use crate::config::{CheckLevel, EscapeAction, SuppressConfig, SuppressLevel};
 use crate::file_reader::FileContent;
+use crate::output::violations::rule_id;
+use crate::rules::{self, Rule, SourceFile};
+use crate::severity;
+use crate::walker::WalkedFile;
+use go_deprecated::DeprecationIndex;
+use go_linkname::is_allowed_target;
+use go_rule::{GoFile, GoSource, go_rules};
+use go_secrets::names_regex;
 use go_suppress::check_go_suppress_violations;
 use javascript_suppress::check_javascript_suppress_violations;
 use python_suppress::check_python_suppress_violations;
@@ -36,13 +86,19 @@ use shell_suppress::check_shell_suppress_violations;
 use suppress_common::{
     SuppressAttrInfo, SuppressCheckParams, SuppressViolationKind, check_suppress_attr,
 };
+use todo::check_todo_violations;
 
 use comment::{has_justification_comment, is_match_in_comment};
 use metrics::EscapesMetrics;
-use patterns::{
-    compile_merged_patterns, default_test_patterns, get_adapter_escape_patterns, merge_patterns,
+use patterns::{LanguagePatterns, default_test_patterns};
+use violations::{
+    claim_violation, create_threshold_violation, format_comment_advice, try_create_violation,
 };
-use violations::{create_threshold_violation, format_comment_advice, try_create_violation};
+
+pub use catalog::{RuleInfo, rule_catalog};
+
+/// Violation type for source files that don't parse.
+pub const PARSE_ERROR: &str = "parse_error";
 
 /// The escapes check detects escape hatch patterns.
 pub struct EscapesCheck;
@@ -66,25 +122,67 @@ impl Check for EscapesCheck {
         // Check lint policy for language-specific projects (only when --base is provided)
         let policy_result = lint_policy::check_lint_policy(ctx);
 
-        // Get adapter default patterns for the detected language
-        let adapter_patterns = get_adapter_escape_patterns(ctx.root);
+        // Adapter defaults are dispatched per file by extension; the project
+        // language always gets a set so its metrics are reported
+        let present: HashSet<ProjectLanguage> = ctx
+            .files
+            .iter()
+            .filter_map(|file| language_for_file(&file.path))
+            .collect();
+        let project = project_language(ctx.root, ctx.config);
+        let mut languages = Vec::new();
+        if project != ProjectLanguage::Generic {
+            languages.push(Some(project));
+        }
+        for language in ProjectLanguage::ADAPTERS {
+            if present.contains(&language) && !languages.contains(&Some(language)) {
+                languages.push(Some(language));
+            }
+        }
+        languages.push(None);
 
-        // Merge patterns: config patterns override adapter defaults by name
-        let merged_patterns = merge_patterns(&config.patterns, &adapter_patterns);
+        // Merge and compile patterns once: config patterns override adapter
+        // defaults by name
+        let patterns = match LanguagePatterns::compile(config, &languages) {
+            Ok(p) => p,
+            Err(e) => return CheckResult::skipped(self.name(), e.to_string()),
+        };
 
         // No patterns to check = pass
-        if merged_patterns.is_empty() {
+        if patterns.is_empty() {
             return CheckResult::passed(self.name());
         }
 
-        // Compile patterns once
-        let patterns = match compile_merged_patterns(&merged_patterns) {
-            Ok(p) => p,
-            Err(e) => return CheckResult::skipped(self.name(), e.to_string()),
+        let todo_reference = if config.todo.check == CheckLevel::Off {
+            None
+        } else {
+            match todo::reference_regex(&config.todo.reference) {
+                Ok(r) => Some(r),
+                Err(e) => {
+                    return CheckResult::skipped(
+                        self.name(),
+                        format!("invalid [check.escapes.todo] reference: {}", e),
+                    );
+                }
+            }
+        };
+
+        let secret_names = if ctx.config.golang.secrets.check == CheckLevel::Off {
+            None
+        } else {
+            match names_regex(&ctx.config.golang.secrets.names) {
+                Ok(r) => Some(r),
+                Err(e) => {
+                    return CheckResult::skipped(
+                        self.name(),
+                        format!("invalid [golang.secrets] names: {}", e),
+                    );
+                }
+            }
         };
 
         // Collect pattern names for metrics output
-        let pattern_names: Vec<String> = patterns.iter().map(|p| p.name.clone()).collect();
+        let pattern_names: Vec<String> = patterns.unique().iter().map(|p| p.name.clone()).collect();
 
         // Get packages for by_package tracking
         let packages = &ctx.config.project.packages;
@@ -102,263 +200,111 @@ impl Check for EscapesCheck {
         // Build exclude matcher
         let exclude_matcher = ExcludeMatcher::new(&config.exclude);
 
+        // Module paths resolve:
+        // - `[golang.syscall].allow` import paths
+        // - the `packages` import paths of `[golang.weakrand]`,
+        //   `[golang.init]`, `[golang.context]`, `[golang.any]`,
+        //   `[golang.goroutine]` and `[golang.clock]`
+        // - the package a `//go:linkname` push lands in
+        // - the imports of deprecated symbols
+        let go_modules = if ctx.config.golang.syscall.check == CheckLevel::Off
+            && ctx.config.golang.linkname.foreign_push == CheckLevel::Off
+            && ctx.config.golang.weakrand.check == CheckLevel::Off
+            && (ctx.config.golang.init.check == CheckLevel::Off
+                || ctx.config.golang.init.packages.is_empty())
+            && ctx.config.golang.context.check == CheckLevel::Off
+            && (ctx.config.golang.any.check == CheckLevel::Off
+                || ctx.config.golang.any.packages.is_empty())
+            && (ctx.config.golang.goroutine.check == CheckLevel::Off
+                || ctx.config.golang.goroutine.packages.is_empty())
+            && (ctx.config.golang.clock.check == CheckLevel::Off
+                || ctx.config.golang.clock.packages.is_empty())
+            && ctx.config.golang.deprecated.check == CheckLevel::Off
+        {
+            Vec::new()
+        } else {
+            find_modules(ctx.root)
+        };
+        // Deprecations are indexed across the tree before scanning, since a
+        // use and its declaration are in different packages
+        let deprecations = if ctx.config.golang.deprecated.check == CheckLevel::Off {
+            DeprecationIndex::default()
+        } else {
+            DeprecationIndex::build(ctx.root, &go_modules)
+        };
+        let custom_rules = match ctx.rules {
+            Some(custom) => custom.to_vec(),
+            None => rules::registered_rules(),
+        };
+        let test_scopes = severity::test_scopes(ctx.config);
+
+        // Files are scanned in parallel against an unlimited context, then
+        // the violation limit is applied in file order so results don't
+        // depend on which worker finishes first. Batching keeps early
+        // termination once the limit is reached.
+        let scan_count = AtomicUsize::new(0);
+        let scan_ctx = CheckContext {
+            limit: None,
+            violation_count: &scan_count,
+            ..*ctx
+        };
+        let scanner = FileScanner {
+            ctx: &scan_ctx,
+            patterns: &patterns,
+            file_adapter: &file_adapter,
+            exclude_matcher: &exclude_matcher,
+            packages,
+            go_modules: &go_modules,
+            todo_reference: todo_reference.as_ref(),
+            secret_names: secret_names.as_ref(),
+            deprecations: &deprecations,
+            custom_rules: &custom_rules,
+            test_scopes: &test_scopes,
+        };
+
         let mut violations = Vec::new();
         let mut metrics = EscapesMetrics::new();
-        let mut limit_reached = false;
-
-        for file in ctx.files {
-            if limit_reached {
-                break;
-            }
-
-            // Skip non-source files (configs, docs, etc.)
-            if !is_source_file(&file.path) {
-                continue;
-            }
-
-            // Skip excluded files
-            if exclude_matcher.is_excluded(&file.path, ctx.root) {
-                continue;
-            }
-
-            // Read file content (uses mmap for large files per performance spec)
-            let file_content = match FileContent::read(&file.path) {
-                Ok(c) => c,
-                Err(_) => continue,
-            };
-            let Some(content) = file_content.as_str() else {
-                continue; // Skip non-UTF-8 files
-            };
-
-            let relative = file.path.strip_prefix(ctx.root).unwrap_or(&file.path);
-
-            // Classify file as source or test
-            let is_test_file = classify_file(&file_adapter, &file.path, ctx.root) == FileKind::Test;
-            let package = find_package(&file.path, ctx.root, packages);
-
-            // Parse cfg(test) info for Rust files (reuse for suppress + escape checking)
-            let cfg_info = if has_extension(&file.path, &["rs"]) {
-                Some(CfgTestInfo::parse(content))
-            } else {
-                None
-            };
-
-            // Check for Rust suppress attribute violations
-            if let Some(ref info) = cfg_info {
-                let suppress_violations = check_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.rust.suppress,
-                    is_test_file,
-                    info,
-                    &mut limit_reached,
-                );
-                violations.extend(suppress_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
-
-            // Check for Shell shellcheck suppress directive violations
-            if has_extension(&file.path, &["sh", "bash", "bats"]) {
-                let shell_violations = check_shell_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.shell.suppress,
-                    is_test_file,
-                    &mut limit_reached,
-                );
-                violations.extend(shell_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
-
-            // Check for Go nolint directive violations
-            if has_extension(&file.path, &["go"]) {
-                let go_violations = check_go_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.golang.suppress,
-                    is_test_file,
-                    &mut limit_reached,
-                );
-                violations.extend(go_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
-
-            // Check for JavaScript/TypeScript suppress directive violations
-            if has_extension(&file.path, &["js", "jsx", "ts", "tsx", "mjs", "mts"]) {
-                let js_violations = check_javascript_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.javascript.suppress,
-                    is_test_file,
-                    &mut limit_reached,
-                );
-                violations.extend(js_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
-
-            // Check for Ruby RuboCop/Standard suppress directive violations
-            if has_extension(&file.path, &["rb", "rake"]) {
-                let ruby_violations = check_ruby_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.ruby.suppress,
-                    is_test_file,
-                    &mut limit_reached,
-                );
-                violations.extend(ruby_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
-
-            // Check for Python suppress directive violations (noqa, type: ignore, pylint)
-            if has_extension(&file.path, &["py"]) {
-                let python_violations = check_python_suppress_violations(
-                    ctx,
-                    relative,
-                    content,
-                    &ctx.config.python.suppress,
-                    is_test_file,
-                    &mut limit_reached,
-                );
-                violations.extend(python_violations);
-
-                if limit_reached {
-                    break;
-                }
-            }
+        let mut stubbed = Vec::new();
+        let batch_size = rayon::current_num_threads() * SCAN_BATCH_PER_THREAD;
+        if let Some(progress) = ctx.progress {
+            progress.start(ctx.files.len());
+        }
+        if let Some(stream) = ctx.stream {
+            stream.start();
+        }
 
-            // Find matches for each pattern
-            for pattern in &patterns {
-                let matches = pattern.matcher.find_all_with_lines(content);
-
-                // Deduplicate matches by line - keep only first match per line
-                // This prevents duplicate violations when pattern appears multiple
-                // times on same line (e.g., in code AND in a comment)
-                let mut seen_lines = HashSet::new();
-                let unique_matches: Vec<_> = matches
-                    .into_iter()
-                    .filter(|m| seen_lines.insert(m.line))
-                    .collect();
-
-                for m in unique_matches {
-                    // Calculate offset of match within the line
-                    let line_start = content[..m.offset].rfind('\n').map(|i| i + 1).unwrap_or(0);
-                    let offset_in_line = m.offset - line_start;
-
-                    // For comment and forbid actions, skip matches that appear only in comments.
-                    // This prevents false positives like "don't use eval" in explanatory comments.
-                    // Count action patterns (like TODO/FIXME) are often legitimately in comments.
-                    let skip_comment_matches =
-                        matches!(pattern.action, EscapeAction::Comment | EscapeAction::Forbid);
-                    if skip_comment_matches && is_match_in_comment(&m.line_content, offset_in_line)
-                    {
-                        continue;
+        'batches: for (batch_index, batch) in ctx.files.chunks(batch_size).enumerate() {
+            let scans: Vec<FileScan> = batch
+                .par_iter()
+                .enumerate()
+                .filter_map(|(i, file)| {
+                    let scan = scanner.scan(file);
+                    if let Some(progress) = ctx.progress {
+                        progress.tick(file.path.strip_prefix(ctx.root).unwrap_or(&file.path));
                     }
-
-                    // Check if line is in test code (file-level OR inline #[cfg(test)])
-                    // Note: m.line is 1-indexed, but is_test_line expects 0-indexed
-                    let is_test_code = is_test_file
-                        || cfg_info.as_ref().is_some_and(|info| {
-                            info.is_test_line(m.line.saturating_sub(1) as usize)
-                        });
-
-                    // Always track metrics (both source and test)
-                    metrics.increment(&pattern.name, is_test_code);
-                    if let Some(ref pkg) = package {
-                        metrics.increment_package(pkg, &pattern.name, is_test_code);
+                    if let Some(stream) = ctx.stream {
+                        let violations = scan.as_ref().map(|s| s.violations.clone());
+                        stream
+                            .complete(batch_index * batch_size + i, violations.unwrap_or_default());
                     }
-
-                    // Handle test code based on pattern's in_tests setting
-                    if is_test_code {
-                        // Determine effective action for test code
-                        let test_action = match pattern.in_tests.as_deref() {
-                            Some("allow") => None, // Skip violations
-                            Some("forbid") => Some(EscapeAction::Forbid),
-                            Some("comment") => Some(EscapeAction::Comment),
-                            // Default: all patterns are allowed in tests (original behavior)
-                            // To make a pattern forbidden in tests, set in_tests = "forbid"
-                            None => None,
-                            _ => None, // Unknown value -> allow
-                        };
-
-                        // Skip if no violations needed for test code
-                        if test_action.is_none() {
-                            continue;
-                        }
+                    scan
+                })
+                .collect();
+            // Stubs are already written, so record them even past the limit
+            stubbed.extend(scans.iter().filter_map(|scan| scan.stubbed.clone()));
+            for scan in scans {
+                metrics.merge(scan.metrics);
+                for v in scan.violations {
+                    if !claim_violation(ctx) {
+                        break 'batches;
                     }
-
-                    // Source code: apply action logic
-                    match pattern.action {
-                        EscapeAction::Count => {
-                            // Just count - threshold check happens after all files
-                        }
-                        EscapeAction::Comment => {
-                            let comment_pattern =
-                                pattern.comment.as_deref().unwrap_or("// JUSTIFIED:");
-
-                            if !has_justification_comment(content, m.line, comment_pattern) {
-                                let advice =
-                                    format_comment_advice(&pattern.advice, comment_pattern);
-                                if let Some(v) = try_create_violation(
-                                    ctx,
-                                    relative,
-                                    m.line,
-                                    "missing_comment",
-                                    &advice,
-                                    &pattern.name,
-                                ) {
-                                    violations.push(v);
-                                } else {
-                                    limit_reached = true;
-                                    break;
-                                }
-                            }
-                        }
-                        EscapeAction::Forbid => {
-                            if let Some(v) = try_create_violation(
-                                ctx,
-                                relative,
-                                m.line,
-                                "forbidden",
-                                &pattern.advice,
-                                &pattern.name,
-                            ) {
-                                violations.push(v);
-                            } else {
-                                limit_reached = true;
-                                break;
-                            }
-                        }
-                    }
-                }
-
-                if limit_reached {
-                    break;
+                    violations.push(v);
                 }
             }
         }
 
         // Check count thresholds after scanning all files (uses metrics)
-        for pattern in &patterns {
+        for pattern in patterns.unique() {
             if pattern.action == EscapeAction::Count {
                 let count = metrics.source_count(&pattern.name);
                 if count > pattern.threshold
@@ -377,14 +323,26 @@ impl Check for EscapesCheck {
 
         // Handle policy violations based on their check level
         let has_escape_violations = !violations.is_empty();
+        // Warn-level rules (e.g., `[golang.syscall] check = "warn"`) mark theirs as warnings
+        let escapes_fail = violations.iter().any(|v| !v.warning);
         let policy_is_warning = policy_result.check_level == CheckLevel::Warn;
         let policy_violations = policy_result.violations;
 
         // Build result with metrics
         let result = if has_escape_violations {
-            // Escape violations always cause failure, include policy violations too
+            // Escape violations fail unless the check is at warn level;
+            // include policy violations too
+            let policy_fails = !policy_violations.is_empty() && !policy_is_warning;
             violations.extend(policy_violations);
-            CheckResult::failed(self.name(), violations)
+            if (config.check == CheckLevel::Warn || !escapes_fail) && !policy_fails {
+                // A passing check's violations are already warnings
+                for v in &mut violations {
+                    v.warning = false;
+                }
+                CheckResult::passed_with_warnings(self.name(), violations)
+            } else {
+                CheckResult::failed(self.name(), violations)
+            }
         } else if !policy_violations.is_empty() {
             // Only policy violations
             if policy_is_warning {
@@ -394,6 +352,8 @@ impl Check for EscapesCheck {
                 // Error level: fail
                 CheckResult::failed(self.name(), policy_violations)
             }
+        } else if !stubbed.is_empty() {
+            CheckResult::fixed(self.name(), stub_summary(&stubbed, ctx.dry_run))
         } else {
             CheckResult::passed(self.name())
         };
@@ -414,6 +374,439 @@ impl Check for EscapesCheck {
     }
 }
 
+/// Files scanned per worker thread in each batch.
+const SCAN_BATCH_PER_THREAD: usize = 64;
+
+/// Violations and metrics from scanning one file.
+struct FileScan {
+    violations: Vec<Violation>,
+    metrics: EscapesMetrics,
+    /// Relative path and number of justification stubs added by `--fix`.
+    stubbed: Option<(String, usize)>,
+}
+
+/// Fix summary for justification stubs added by `--fix`.
+fn stub_summary(stubbed: &[(String, usize)], dry_run: bool) -> serde_json::Value {
+    let files: Vec<_> = stubbed
+        .iter()
+        .map(|(file, stubs)| serde_json::json!({ "file": file, "stubs": stubs }))
+        .collect();
+    serde_json::json!({
+        "stubs_added": stubbed.iter().map(|(_, n)| n).sum::<usize>(),
+        "dry_run": dry_run,
+        "files_stubbed": files,
+    })
+}
+
+/// Per-file escape scanning, shared across worker threads.
+struct FileScanner<'a> {
+    /// Context without a violation limit (applied by the caller).
+    ctx: &'a CheckContext<'a>,
+    patterns: &'a LanguagePatterns,
+    file_adapter: &'a GenericAdapter,
+    exclude_matcher: &'a ExcludeMatcher,
+    packages: &'a [String],
+    go_modules: &'a [GoModule],
+    /// Compiled `[check.escapes.todo].reference` (None = check off).
+    todo_reference: Option<&'a Regex>,
+    /// Compiled `[golang.secrets].names` (None = check off).
+    secret_names: Option<&'a Regex>,
+    /// Deprecated symbols declared in the tree's modules.
+    deprecations: &'a DeprecationIndex,
+    /// Rules registered with [`rules::register_rule`].
+    custom_rules: &'a [Arc<dyn Rule>],
+    /// `[rules.<rule>].apply_to_tests` settings.
+    test_scopes: &'a [(String, bool)],
+}
+
+impl FileScanner<'_> {
+    /// Scan one file, or None if it is skipped.
+    fn scan(&self, file: &WalkedFile) -> Option<FileScan> {
+        let ctx = self.ctx;
+        let mut scan = FileScan {
+            violations: Vec::new(),
+            metrics: EscapesMetrics::new(),
+            stubbed: None,
+        };
+        // The scan context is unlimited, so this is never set
+        let mut unlimited = false;
+
+        // Skip non-source files (configs, docs, etc.)
+        if !is_source_file(&file.path) {
+            return None;
+        }
+
+        // Skip excluded files
+        if self.exclude_matcher.is_excluded(&file.path, ctx.root) {
+            return None;
+        }
+
+        // Read file content (uses mmap for large files per performance spec),
+        // or the buffer standing in for it
+        let file_content = match ctx.stdin {
+            Some(buffer) if buffer.path == file.path => FileContent::Owned(buffer.content.clone()),
+            _ => match FileContent::read(&file.path) {
+                Ok(c) => c,
+                Err(_) => return None,
+            },
+        };
+        let Some(content) = file_content.as_str() else {
+            return None; // Skip non-UTF-8 files
+        };
+
+        let relative = file.path.strip_prefix(ctx.root).unwrap_or(&file.path);
+
+        // Matches in a Go file that doesn't lex can't be trusted: report the
+        // error in their place and move on to the next file
+        if has_extension(&file.path, &["go"])
+            && let Some(error) = find_syntax_error(content)
+        {
+            let advice = format!(
+                "Go syntax error: {}. The file was not checked; fix it so escapes are matched.",
+                error.message
+            );
+            scan.violations.push(
+                Violation::file(relative, error.line, PARSE_ERROR, advice)
+                    .with_column(error.column),
+            );
+            return Some(scan);
+        }
+
+        // Classify file as source or test
+        let is_test_file = classify_file(self.file_adapter, &file.path, ctx.root) == FileKind::Test;
+        let package = find_package(
+            &file.path,
+            ctx.root,
+            self.packages,
+            &ctx.config.project.package_names,
+        );
+
+        // Parse cfg(test) info for Rust files (reuse for suppress + escape checking)
+        let cfg_info = if has_extension(&file.path, &["rs"]) {
+            Some(CfgTestInfo::parse(content))
+        } else {
+            None
+        };
+
+        // Check for Rust suppress attribute violations
+        if let Some(ref info) = cfg_info {
+            let suppress_violations = check_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.rust.suppress,
+                is_test_file,
+                info,
+                &mut unlimited,
+            );
+            scan.violations.extend(suppress_violations);
+        }
+
+        // Check for Shell shellcheck suppress directive violations
+        if has_extension(&file.path, &["sh", "bash", "bats"]) {
+            let shell_violations = check_shell_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.shell.suppress,
+                is_test_file,
+                &mut unlimited,
+            );
+            scan.violations.extend(shell_violations);
+        }
+
+        // Check for Go nolint directive violations, then run the Go rules
+        if has_extension(&file.path, &["go"]) {
+            let go_violations = check_go_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.golang.suppress,
+                is_test_file,
+                &mut unlimited,
+            );
+            scan.violations.extend(go_violations);
+
+            let go_file = GoFile {
+                path: relative,
+                source: GoSource::new(content),
+                is_test: is_test_file,
+                modules: self.go_modules,
+                deprecations: self.deprecations,
+                secret_names: self.secret_names,
+            };
+            for rule in go_rules(&ctx.config.golang) {
+                scan.violations.extend(rule.check(ctx, &go_file));
+            }
+        }
+
+        // Run custom rules registered by embedding tools
+        if !self.custom_rules.is_empty() {
+            let source = SourceFile {
+                path: relative,
+                content,
+                is_test: is_test_file,
+            };
+            for rule in self.custom_rules {
+                for finding in rule.check(&source) {
+                    if let Some(v) = try_create_violation(
+                        ctx,
+                        relative,
+                        finding.line,
+                        "custom_rule",
+                        &finding.message,
+                        rule.name(),
+                    ) {
+                        scan.violations.push(match finding.column {
+                            Some(column) => v.with_column(column),
+                            None => v,
+                        });
+                    }
+                }
+            }
+        }
+
+        // Check for JavaScript/TypeScript suppress directive violations
+        if has_extension(&file.path, &["js", "jsx", "ts", "tsx", "mjs", "mts"]) {
+            let js_violations = check_javascript_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.javascript.suppress,
+                is_test_file,
+                &mut unlimited,
+            );
+            scan.violations.extend(js_violations);
+        }
+
+        // Check for Ruby RuboCop/Standard suppress directive violations
+        if has_extension(&file.path, &["rb", "rake"]) {
+            let ruby_violations = check_ruby_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.ruby.suppress,
+                is_test_file,
+                &mut unlimited,
+            );
+            scan.violations.extend(ruby_violations);
+        }
+
+        // Check for Python suppress directive violations (noqa, type: ignore, pylint)
+        if has_extension(&file.path, &["py"]) {
+            let python_violations = check_python_suppress_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.python.suppress,
+                is_test_file,
+                &mut unlimited,
+            );
+            scan.violations.extend(python_violations);
+        }
+
+        // Check TODO/FIXME comments for issue references (any language)
+        if let Some(reference) = self.todo_reference {
+            let todo_violations = check_todo_violations(
+                ctx,
+                relative,
+                content,
+                &ctx.config.check.escapes.todo,
+                reference,
+                &mut unlimited,
+            );
+            scan.violations.extend(todo_violations);
+        }
+
+        // Match Go files against canonical names (`u.Slice` -> `unsafe.Slice`)
+        // and Python and JS/TS files against real code (no strings, no `obj.eval()`)
+        let is_python = has_extension(&file.path, &["py"]);
+        let is_go = has_extension(&file.path, &["go"]);
+        let is_js = language_for_file(&file.path) == Some(ProjectLanguage::JavaScript);
+        let normalized = if is_go {
+            normalize_escape_source(content)
+        } else if is_python {
+            python::normalize_escape_source(content)
+        } else if is_js {
+            javascript::normalize_escape_source(content)
+        } else {
+            None
+        };
+        let match_content = normalized.as_deref().unwrap_or(content);
+        // Count patterns still see Python and JS/TS string literals and block
+        // comments (e.g., TODOs in docstrings), as they see line comments
+        let count_content = if is_python || is_js {
+            content
+        } else {
+            match_content
+        };
+        // A cgo preamble is C code, so it never justifies `import "C"`
+        let masked = if is_go {
+            mask_cgo_preambles(content)
+        } else {
+            None
+        };
+        let comment_content = masked.as_deref().unwrap_or(content);
+        // Justification stubs for `--fix`, by line, and the violations they fix
+        let mut stubs = Vec::new();
+        let mut stub_violations = Vec::new();
+
+        // Find matches for each pattern
+        for pattern in self.patterns.for_file(&file.path) {
+            let pattern_content = if pattern.action == EscapeAction::Count {
+                count_content
+            } else {
+                match_content
+            };
+            let matches = pattern.matcher.find_all_with_lines(pattern_content);
+
+            // Each occurrence in code is its own violation (`--dedup line`
+            // collapses them), but metrics count lines so ratchets don't move
+            let mut seen_lines = HashSet::new();
+
+            for m in matches {
+                // Calculate offset of match within the line
+                let line_start = pattern_content[..m.offset]
+                    .rfind('\n')
+                    .map(|i| i + 1)
+                    .unwrap_or(0);
+                let offset_in_line = m.offset - line_start;
+
+                // For comment and forbid actions, skip matches that appear only in comments.
+                // This prevents false positives like "don't use eval" in explanatory comments.
+                // Count action patterns (like TODO/FIXME) are often legitimately in comments.
+                let skip_comment_matches =
+                    matches!(pattern.action, EscapeAction::Comment | EscapeAction::Forbid);
+                if skip_comment_matches && is_match_in_comment(&m.line_content, offset_in_line) {
+                    continue;
+                }
+
+                // Check if line is in test code (file-level OR inline #[cfg(test)])
+                // Note: m.line is 1-indexed, but is_test_line expects 0-indexed
+                let is_test_code = is_test_file
+                    || cfg_info
+                        .as_ref()
+                        .is_some_and(|info| info.is_test_line(m.line.saturating_sub(1) as usize));
+
+                // Always track metrics (both source and test)
+                if seen_lines.insert(m.line) {
+                    scan.metrics.increment(&pattern.name, is_test_code);
+                    if let Some(ref pkg) = package {
+                        scan.metrics
+                            .increment_package(pkg, &pattern.name, is_test_code);
+                    }
+                }
+
+                // Handle test code based on pattern's in_tests setting
+                if is_test_code {
+                    // Determine effective action for test code
+                    let test_action = match pattern.in_tests.as_deref() {
+                        Some("allow") => None, // Skip violations
+                        Some("forbid") => Some(EscapeAction::Forbid),
+                        Some("comment") => Some(EscapeAction::Comment),
+                        // Default: all patterns are allowed in tests (original behavior)
+                        // To make a pattern forbidden in tests, set in_tests = "forbid"
+                        None => None,
+                        _ => None, // Unknown value -> allow
+                    };
+
+                    // `[rules.<rule>].apply_to_tests` overrides `in_tests`
+                    let applies = severity::applies_to_tests(self.test_scopes, &pattern.name)
+                        .unwrap_or(test_action.is_some());
+                    if !applies {
+                        continue;
+                    }
+                }
+
+                // Source code: apply action logic
+                match pattern.action {
+                    EscapeAction::Count => {
+                        // Just count - threshold check happens after all files
+                    }
+                    EscapeAction::Comment => {
+                        // Allowlisted linkname targets are already reviewed
+                        if is_go
+                            && pattern.name == "go_linkname"
+                            && is_allowed_target(&m.line_content, &ctx.config.golang.linkname.allow)
+                        {
+                            continue;
+                        }
+                        let comment_pattern = pattern.comment.as_deref().unwrap_or("// JUSTIFIED:");
+
+                        if has_justification_comment(comment_content, m.line, comment_pattern) {
+                            continue;
+                        }
+                        let advice = format_comment_advice(&pattern.advice, comment_pattern);
+                        if let Some(v) = try_create_violation(
+                            ctx,
+                            relative,
+                            m.line,
+                            "missing_comment",
+                            &advice,
+                            &pattern.name,
+                        ) {
+                            let column =
+                                match_column(content, m.line, &m.line_content, offset_in_line);
+                            let v = v.with_column(column);
+                            // A stubbed match is reported only if the stub can't be written
+                            match fix::stub_comment(comment_pattern).filter(|_| ctx.fix) {
+                                Some(stub) => {
+                                    stubs.push((m.line, stub));
+                                    stub_violations.push(v);
+                                }
+                                None => scan.violations.push(v),
+                            }
+                        }
+                    }
+                    EscapeAction::Forbid => {
+                        if let Some(v) = try_create_violation(
+                            ctx,
+                            relative,
+                            m.line,
+                            "forbidden",
+                            &pattern.advice,
+                            &pattern.name,
+                        ) {
+                            let column =
+                                match_column(content, m.line, &m.line_content, offset_in_line);
+                            scan.violations.push(v.with_column(column));
+                        }
+                    }
+                }
+            }
+        }
+
+        // `apply_to_tests = false` skips test files for rules that check them
+        if is_test_file && self.test_scopes.iter().any(|(_, applies)| !applies) {
+            scan.violations.retain(|v| {
+                severity::applies_to_tests(self.test_scopes, &rule_id(v)) != Some(false)
+            });
+        }
+
+        if !stubs.is_empty() {
+            let (fixed, added) = fix::insert_stubs(content, &stubs);
+            if added > 0 {
+                // Only files that get stubs are rewritten
+                if ctx.dry_run {
+                    ctx.preview_fix(relative, Some(content), &fixed);
+                    scan.stubbed = Some((relative.display().to_string(), added));
+                } else {
+                    drop(file_content);
+                    match std::fs::write(&file.path, fixed) {
+                        Ok(()) => scan.stubbed = Some((relative.display().to_string(), added)),
+                        Err(e) => {
+                            eprintln!("Warning: Failed to write {}: {}", relative.display(), e);
+                            scan.violations.append(&mut stub_violations);
+                        }
+                    }
+                }
+            }
+        }
+
+        Some(scan)
+    }
+}
+
 /// Classify file as source or test using a pre-built adapter.
 fn classify_file(adapter: &GenericAdapter, path: &Path, root: &Path) -> FileKind {
     use crate::adapter::Adapter;
@@ -422,34 +815,117 @@ fn classify_file(adapter: &GenericAdapter, path: &Path, root: &Path) -> FileKind
 }
 
 /// Find which package a file belongs to, if any.
-fn find_package(path: &Path, root: &Path, packages: &[String]) -> Option<String> {
+///
+/// The innermost matching package wins, so a file in a nested Go module
+/// isn't counted against the parent. Packages are named by their last path
+/// component unless another package shares it (e.g., `internal/config` in
+/// two modules); then the configured display name keeps them apart.
+fn find_package(
+    path: &Path,
+    root: &Path,
+    packages: &[String],
+    package_names: &HashMap<String, String>,
+) -> Option<String> {
     let relative = path.strip_prefix(root).ok()?;
     let relative_str = relative.to_string_lossy();
 
-    // Check if file is under any package directory
-    for pkg in packages {
-        // Handle wildcard patterns like "crates/*"
+    // Handle wildcard patterns like "crates/*"
+    for pkg in packages.iter().filter(|pkg| pkg.ends_with("/*")) {
         let prefix = pkg.trim_end_matches("/*");
-        if relative_str.starts_with(prefix) {
-            // Extract package name from path
-            let rest = relative_str.strip_prefix(prefix)?.trim_start_matches('/');
-            let parts: Vec<&str> = rest.split('/').collect();
-
-            if pkg.ends_with("/*") {
-                // Wildcard: first component after prefix is package name
-                if let Some(name) = parts.first()
-                    && !name.is_empty()
-                {
-                    return Some((*name).to_string());
-                }
-            } else {
-                // Exact path: use the last component of the pattern
-                return Some(pkg.split('/').next_back().unwrap_or(pkg).to_string());
+        if let Some(rest) = relative_str.strip_prefix(prefix) {
+            // Wildcard: first component after prefix is package name
+            let rest = rest.trim_start_matches('/');
+            if let Some(name) = rest.split('/').next()
+                && !name.is_empty()
+            {
+                return Some(name.to_string());
             }
         }
     }
 
-    None
+    // Exact path: use the last component of the innermost package
+    let pkg = packages
+        .iter()
+        .filter(|pkg| !pkg.ends_with("/*") && relative.starts_with(pkg.as_str()))
+        .max_by_key(|pkg| pkg.len())?;
+    let short = |p: &str| p.rsplit('/').next().unwrap_or(p).to_string();
+    let name = short(pkg);
+    if packages
+        .iter()
+        .any(|other| other != pkg && short(other) == name)
+    {
+        return Some(
+            package_names
+                .get(pkg)
+                .cloned()
+                .unwrap_or_else(|| pkg.clone()),
+        );
+    }
+    Some(name)
+}
+
+/// Compute the 1-based column of a match in the original file.
+///
+/// Matching may run against normalized content (`u.Slice` -> `unsafe.Slice`),
+/// where offsets no longer line up with the file. Masking (blanked Python
+/// strings) keeps the line's length, so offsets still apply. A normalized
+/// line is aligned with the original to find the match there (so the column
+/// points at the `u` qualifier); if that fails, the column falls back to the
+/// first non-blank character.
+fn match_column(content: &str, line: u32, matched_line: &str, offset_in_line: usize) -> u32 {
+    let original = content
+        .lines()
+        .nth(line.saturating_sub(1) as usize)
+        .unwrap_or(matched_line);
+    let offset =
+        if original.len() == matched_line.len() && original.is_char_boundary(offset_in_line) {
+            offset_in_line
+        } else {
+            original_offset(original, matched_line, offset_in_line)
+                .filter(|&offset| original.is_char_boundary(offset))
+                .unwrap_or(original.len() - original.trim_start().len())
+        };
+    original[..offset].chars().count() as u32 + 1
+}
+
+/// Map a byte offset in a normalized Go line back to the original line.
+///
+/// Normalization rewrites package qualifiers (`u.Pointer` -> `unsafe.Pointer`)
+/// or inserts them for dot imports (`SliceHeader` -> `reflect.SliceHeader`).
+/// Walking both lines together, each mismatch is one such identifier, skipped
+/// on both sides. Returns None if the lines can't be aligned.
+fn original_offset(original: &str, normalized: &str, offset: usize) -> Option<usize> {
+    let ident_len = |bytes: &[u8]| {
+        bytes
+            .iter()
+            .take_while(|b| b.is_ascii_alphanumeric() || **b == b'_')
+            .count()
+    };
+    let orig = original.as_bytes();
+    let norm = normalized.as_bytes();
+    let offset = offset.min(norm.len());
+    let (mut i, mut j) = (0, 0);
+    while j < offset {
+        if orig.get(i) == Some(&norm[j]) {
+            i += 1;
+            j += 1;
+            continue;
+        }
+        let orig_ident = ident_len(&orig[i..]);
+        let norm_ident = ident_len(&norm[j..]);
+        let inserted = orig_ident > 0
+            && norm.get(j + norm_ident) == Some(&b'.')
+            && norm[j + norm_ident + 1..].starts_with(&orig[i..i + orig_ident]);
+        if inserted {
+            j += norm_ident + 1;
+        } else if orig_ident > 0 || norm_ident > 0 {
+            i += orig_ident;
+            j += norm_ident;
+        } else {
+            return None;
+        }
+    }
+    (j == offset).then_some(i)
 }
 
 /// Check if a file is a source code file (for escape pattern checking).
@@ -7,14 +7,17 @@
 use super::*;
 use yare::parameterized;
 
+use crate::config::EscapePattern as ConfigEscapePattern;
 use comment::{is_comment_line, is_match_in_comment, strip_comment_markers};
+use patterns::apply_comment_overrides;
 
 #[parameterized(
     same_line = { "unsafe { code } // SAFETY: reason", 1, true },
     preceding_line = { "// SAFETY: reason\nunsafe { code }", 2, true },
-    through_blank_lines = { "// SAFETY: reason\n\nunsafe { code }", 3, true },
+    stops_at_blank_line = { "// SAFETY: reason\n\nunsafe { code }", 3, false },
     through_other_comments = { "// SAFETY: reason\n// more context\nunsafe { code }", 3, true },
     stops_at_code_line = { "// SAFETY: old\nfn other() {}\nunsafe { code }", 3, false },
+    through_go_directives = { "// SAFETY: reason\n//go:nosplit\nunsafe { code }", 3, true },
     no_comment_returns_false = { "unsafe { code }", 1, false },
 )]
 fn has_justification_comment_cases(content: &str, line: u32, expected: bool) {
@@ -111,6 +114,44 @@ fn comment_boundary_cases(content: &str, line: u32, expected: bool) {
     );
 }
 
+#[parameterized(
+    separate_justifications = {
+        "// NOESCAPE: reason\n// NOSPLIT: reason\n//go:noescape\n//go:nosplit\nfunc f()",
+        4,
+        true
+    },
+    combined_justification = {
+        "// NOESCAPE: NOSPLIT: reason\n//go:noescape\n//go:nosplit\nfunc f()",
+        3,
+        true
+    },
+    combined_justification_reversed = {
+        "// NOSPLIT: NOESCAPE: reason\n//go:noescape\n//go:nosplit\nfunc f()",
+        3,
+        true
+    },
+    other_directive_justified_only = {
+        "// NOESCAPE: reason\n//go:noescape\n//go:nosplit\nfunc f()",
+        3,
+        false
+    },
+    marker_mentioned_in_reason = {
+        "// NOESCAPE: no NOSPLIT: needed\n//go:noescape\n//go:nosplit\nfunc f()",
+        3,
+        false
+    },
+)]
+fn stacked_directive_cases(content: &str, line: u32, expected: bool) {
+    assert_eq!(
+        has_justification_comment(content, line, "// NOSPLIT:"),
+        expected,
+        "content {:?} at line {} should {} have justification",
+        content,
+        line,
+        if expected { "" } else { "not" }
+    );
+}
+
 #[test]
 fn doc_comment_variants() {
     // Triple-slash doc comments should match
@@ -139,6 +180,122 @@ fn strip_comment_markers_cases(input: &str, expected: &str) {
     );
 }
 
+#[parameterized(
+    unchanged_line = { "\tp := unsafe.Pointer(&x)", "\tp := unsafe.Pointer(&x)", 6, 7 },
+    start_of_line = { "unsafe.Pointer(nil)", "unsafe.Pointer(nil)", 0, 1 },
+    multibyte_prefix = { "s := \"é\" + unsafe.String(p, n)", "s := \"é\" + unsafe.String(p, n)", 12, 12 },
+    normalized_line = { "\ts := u.Slice(p, n)", "\ts := unsafe.Slice(p, n)", 6, 7 },
+    normalized_second_match = { "\tp := u.Pointer(u.Pointer(x))", "\tp := unsafe.Pointer(unsafe.Pointer(x))", 21, 17 },
+    normalized_conversion = { "\th := (*T)(ptr.Pointer(&x))", "\th := (*T)(unsafe.Pointer(&x))", 11, 12 },
+    dot_import = { "\t_ = SliceHeader{}; _ = StringHeader{}", "\t_ = reflect.SliceHeader{}; _ = reflect.StringHeader{}", 32, 25 },
+    header_field = { "\thdr.Data = uintptr(p)", "\treflect.SliceHeader.Data = uintptr(p)", 1, 2 },
+    masked_line = { "x = eval(\"é\")", "x = eval(\"  \")", 4, 5 },
+)]
+fn match_column_cases(original: &str, matched: &str, offset: usize, expected: u32) {
+    let content = format!("package p\n{}\n", original);
+    assert_eq!(match_column(&content, 2, matched, offset), expected);
+}
+
+#[parameterized(
+    first_alternative = { "// SAFETY: reason\nunsafe.Pointer(p)", true },
+    second_alternative = { "// JUSTIFY: reason\nunsafe.Pointer(p)", true },
+    neither_alternative = { "// NOTE: reason\nunsafe.Pointer(p)", false },
+    same_line_second = { "unsafe.Pointer(p) // JUSTIFY: reason", true },
+)]
+fn comment_alternatives_cases(content: &str, expected: bool) {
+    let line = content.lines().count() as u32;
+    assert_eq!(
+        has_justification_comment(content, line, "// SAFETY:|// JUSTIFY:"),
+        expected
+    );
+    // Bare marker alternatives behave the same
+    assert_eq!(
+        has_justification_comment(content, line, "SAFETY|JUSTIFY"),
+        expected
+    );
+}
+
+#[test]
+fn display_comment_pattern_joins_alternatives() {
+    use violations::display_comment_pattern;
+    assert_eq!(display_comment_pattern("// SAFETY:"), "// SAFETY:");
+    assert_eq!(
+        display_comment_pattern("// SAFETY:|// JUSTIFY:"),
+        "// SAFETY: or // JUSTIFY:"
+    );
+}
+
+#[test]
+fn comment_override_replaces_marker_and_advice() {
+    let mut patterns = vec![
+        ConfigEscapePattern {
+            name: Some("unsafe_pointer".to_string()),
+            pattern: r"unsafe\.Pointer\(".to_string(),
+            action: EscapeAction::Comment,
+            comment: Some("// SAFETY:".to_string()),
+            threshold: 0,
+            advice: Some("Add a // SAFETY: comment explaining pointer validity.".to_string()),
+            source: Vec::new(),
+            tests: Vec::new(),
+            in_tests: None,
+        },
+        ConfigEscapePattern {
+            name: Some("go_linkname".to_string()),
+            pattern: r"//go:linkname".to_string(),
+            action: EscapeAction::Comment,
+            comment: Some("// LINKNAME:".to_string()),
+            threshold: 0,
+            advice: None,
+            source: Vec::new(),
+            tests: Vec::new(),
+            in_tests: None,
+        },
+    ];
+    let overrides = std::collections::BTreeMap::from([(
+        "unsafe_pointer".to_string(),
+        "// SAFETY:|// JUSTIFY:".to_string(),
+    )]);
+
+    apply_comment_overrides(&mut patterns, &overrides);
+
+    assert_eq!(
+        patterns[0].comment.as_deref(),
+        Some("// SAFETY:|// JUSTIFY:")
+    );
+    assert_eq!(
+        patterns[0].advice.as_deref(),
+        Some("Add a // SAFETY: or // JUSTIFY: comment explaining pointer validity.")
+    );
+    // Patterns without an override keep their marker
+    assert_eq!(patterns[1].comment.as_deref(), Some("// LINKNAME:"));
+}
+
+#[test]
+fn comment_override_rewrites_advice_naming_alternatives() {
+    let mut patterns = vec![ConfigEscapePattern {
+        name: Some("eval".to_string()),
+        pattern: r"\beval\s*\(".to_string(),
+        action: EscapeAction::Comment,
+        comment: Some("# SAFETY:|# EVAL:".to_string()),
+        threshold: 0,
+        advice: Some(
+            "Add a # SAFETY: or # EVAL: comment explaining why eval is necessary.".to_string(),
+        ),
+        source: Vec::new(),
+        tests: Vec::new(),
+        in_tests: None,
+    }];
+    let overrides =
+        std::collections::BTreeMap::from([("eval".to_string(), "# JUSTIFIED:".to_string())]);
+
+    apply_comment_overrides(&mut patterns, &overrides);
+
+    assert_eq!(
+        patterns[0].advice.as_deref(),
+        Some("Add a # JUSTIFIED: comment explaining why eval is necessary.")
+    );
+}
+
 // Performance micro-benchmarks
 // Run with: cargo test --package quench -- bench_ --ignored --nocapture
 mod benchmarks {
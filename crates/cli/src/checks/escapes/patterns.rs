@@ -3,17 +3,16 @@
 
 //! Escape pattern compilation and merging utilities.
 
-use std::collections::HashSet;
+use std::collections::{BTreeMap, HashSet};
 use std::path::Path;
 
 use crate::adapter::{
-    EscapePattern as AdapterEscapePattern, GoAdapter, JavaScriptAdapter, ProjectLanguage,
-    PythonAdapter, RubyAdapter, RustAdapter, ShellAdapter, detect_language,
+    EscapePattern as AdapterEscapePattern, ProjectLanguage, default_escapes, language_for_file,
 };
-use crate::config::{EscapeAction, EscapePattern as ConfigEscapePattern};
+use crate::config::{EscapeAction, EscapePattern as ConfigEscapePattern, EscapesConfig};
 use crate::pattern::{CompiledPattern, PatternError};
 
-use super::violations::default_advice;
+use super::violations::{default_advice, display_comment_pattern};
 
 /// Compiled escape pattern ready for matching.
 pub(super) struct CompiledEscapePattern {
@@ -51,44 +50,71 @@ pub(super) fn default_test_patterns() -> Vec<String> {
     ]
 }
 
-/// Get escape patterns from the adapter for the detected language.
-pub(super) fn get_adapter_escape_patterns(root: &Path) -> Vec<ConfigEscapePattern> {
-    use crate::adapter::Adapter;
-
-    let mut patterns = Vec::new();
+/// Compiled escape patterns for each file language.
+///
+/// Config patterns apply to every file; adapter defaults only to files
+/// their adapter claims by extension ([`language_for_file`]), so a mixed
+/// repository checks each file against its own language's rules.
+pub(super) struct LanguagePatterns {
+    /// Pattern sets by language; `None` is files without an adapter.
+    sets: Vec<(Option<ProjectLanguage>, Vec<CompiledEscapePattern>)>,
+}
 
-    // Check project language and get adapter defaults
-    match detect_language(root) {
-        ProjectLanguage::Rust => {
-            let rust_adapter = RustAdapter::new();
-            patterns.extend(convert_adapter_patterns(rust_adapter.default_escapes()));
-        }
-        ProjectLanguage::Go => {
-            let go_adapter = GoAdapter::new();
-            patterns.extend(convert_adapter_patterns(go_adapter.default_escapes()));
-        }
-        ProjectLanguage::Shell => {
-            let shell_adapter = ShellAdapter::new();
-            patterns.extend(convert_adapter_patterns(shell_adapter.default_escapes()));
-        }
-        ProjectLanguage::JavaScript => {
-            let js_adapter = JavaScriptAdapter::new();
-            patterns.extend(convert_adapter_patterns(js_adapter.default_escapes()));
-        }
-        ProjectLanguage::Python => {
-            let python_adapter = PythonAdapter::new();
-            patterns.extend(convert_adapter_patterns(python_adapter.default_escapes()));
-        }
-        ProjectLanguage::Ruby => {
-            let ruby_adapter = RubyAdapter::new();
-            patterns.extend(convert_adapter_patterns(ruby_adapter.default_escapes()));
-        }
-        ProjectLanguage::Generic => {
-            // No default patterns for generic projects
+impl LanguagePatterns {
+    /// Merge and compile the patterns for each language.
+    ///
+    /// The first language's patterns come first in [`Self::unique`], so the
+    /// project language keeps its metrics order.
+    pub(super) fn compile(
+        config: &EscapesConfig,
+        languages: &[Option<ProjectLanguage>],
+    ) -> Result<Self, PatternError> {
+        let mut sets = Vec::with_capacity(languages.len());
+        for &language in languages {
+            let adapter_patterns = language
+                .map(get_language_escape_patterns)
+                .unwrap_or_default();
+            let mut merged = merge_patterns(&config.patterns, &adapter_patterns);
+            apply_comment_overrides(&mut merged, &config.comments);
+            sets.push((language, compile_merged_patterns(&merged)?));
         }
+        Ok(Self { sets })
     }
 
-    patterns
+    /// Patterns for a file, by its extension.
+    pub(super) fn for_file(&self, path: &Path) -> &[CompiledEscapePattern] {
+        let language = language_for_file(path);
+        self.sets
+            .iter()
+            .find(|(set_language, _)| *set_language == language)
+            .or_else(|| {
+                self.sets
+                    .iter()
+                    .find(|(set_language, _)| set_language.is_none())
+            })
+            .map(|(_, patterns)| patterns.as_slice())
+            .unwrap_or_default()
+    }
+
+    /// Every pattern once by name, for metrics and count thresholds.
+    pub(super) fn unique(&self) -> Vec<&CompiledEscapePattern> {
+        let mut seen = HashSet::new();
+        self.sets
+            .iter()
+            .flat_map(|(_, patterns)| patterns)
+            .filter(|pattern| seen.insert(pattern.name.as_str()))
+            .collect()
+    }
+
+    /// Whether no file has any pattern to check.
+    pub(super) fn is_empty(&self) -> bool {
+        self.sets.iter().all(|(_, patterns)| patterns.is_empty())
+    }
+}
+
+/// Get default escape patterns for a language's adapter.
+fn get_language_escape_patterns(language: ProjectLanguage) -> Vec<ConfigEscapePattern> {
+    convert_adapter_patterns(default_escapes(language))
 }
 
 /// Convert adapter escape patterns to config format.
@@ -120,7 +146,7 @@ fn adapter_action_to_config(action: crate::adapter::EscapeAction) -> EscapeActio
 
 /// Merge user config patterns with adapter defaults.
 /// User patterns override defaults by name.
-pub(super) fn merge_patterns(
+fn merge_patterns(
     config_patterns: &[ConfigEscapePattern],
     adapter_patterns: &[ConfigEscapePattern],
 ) -> Vec<ConfigEscapePattern> {
@@ -140,8 +166,33 @@ pub(super) fn merge_patterns(
     merged
 }
 
+/// Apply `[check.escapes.comments]` marker overrides to merged patterns.
+///
+/// Advice that names the replaced marker is rewritten to name the new one,
+/// so adapter defaults like "Add a // SAFETY: comment ..." stay accurate.
+pub(super) fn apply_comment_overrides(
+    patterns: &mut [ConfigEscapePattern],
+    overrides: &BTreeMap<String, String>,
+) {
+    for pattern in patterns.iter_mut() {
+        let Some(comment) = overrides.get(pattern.effective_name()) else {
+            continue;
+        };
+        if let (Some(old), Some(advice)) = (pattern.comment.as_deref(), pattern.advice.as_mut())
+            && !old.is_empty()
+        {
+            // Advice names alternatives as "# SAFETY: or # EVAL:"
+            let old = display_comment_pattern(old);
+            if advice.contains(&old) {
+                *advice = advice.replace(&old, &display_comment_pattern(comment));
+            }
+        }
+        pattern.comment = Some(comment.clone());
+    }
+}
+
 /// Compile merged patterns into matchers.
-pub(super) fn compile_merged_patterns(
+fn compile_merged_patterns(
     patterns: &[ConfigEscapePattern],
 ) -> Result<Vec<CompiledEscapePattern>, PatternError> {
     patterns
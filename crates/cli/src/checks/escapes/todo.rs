@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! TODO/FIXME issue reference checking for the escapes check.
+//!
+//! Flags `TODO` and `FIXME` in comments that aren't directly followed by a
+//! tracker reference in parentheses or brackets (`TODO(JIRA-123)`,
+//! `FIXME[#42]`). The reference format is `[check.escapes.todo].reference`.
+//! Comments are found with the same language-agnostic heuristics as
+//! justification comments, so every language is checked. Opt-in.
+
+use std::path::Path;
+use std::sync::LazyLock;
+
+use regex::Regex;
+
+use crate::check::{CheckContext, Violation};
+use crate::config::{CheckLevel, TodoConfig};
+
+use super::comment::{find_comment_start, is_comment_line};
+use super::violations::try_create_violation;
+
+/// Violation pattern name for TODOs without an issue reference.
+pub const UNREFERENCED_TODO: &str = "unreferenced_todo";
+
+/// A `TODO` or `FIXME` tag as a whole word (`TODOs` and `TODO_LIST` aren't tags).
+#[allow(clippy::expect_used)]
+static TAG: LazyLock<Regex> =
+    LazyLock::new(|| Regex::new(r"\b(?:TODO|FIXME)\b").expect("valid regex pattern"));
+
+/// Compile the regex matching a reference right after a tag: `reference`,
+/// wrapped in parentheses or brackets.
+pub fn reference_regex(reference: &str) -> Result<Regex, regex::Error> {
+    Regex::new(&format!(r"^(?:\((?:{reference})\)|\[(?:{reference})\])"))
+}
+
+/// A TODO or FIXME without an issue reference.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct UnreferencedTodo {
+    /// The tag as written ("TODO" or "FIXME").
+    pub tag: String,
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column of the tag.
+    pub column: u32,
+}
+
+/// Find tags in comments that `reference` doesn't match right after.
+pub fn find_unreferenced_todos(content: &str, reference: &Regex) -> Vec<UnreferencedTodo> {
+    let mut todos = Vec::new();
+    for (idx, line) in content.lines().enumerate() {
+        let start = if is_comment_line(line) {
+            line.len() - line.trim_start().len()
+        } else {
+            match find_comment_start(line) {
+                Some(start) => start,
+                None => continue,
+            }
+        };
+        let comment = &line[start..];
+        for tag in TAG.find_iter(comment) {
+            if reference.is_match(&comment[tag.end()..]) {
+                continue;
+            }
+            todos.push(UnreferencedTodo {
+                tag: tag.as_str().to_string(),
+                line: idx as u32 + 1,
+                column: line[..start + tag.start()].chars().count() as u32 + 1,
+            });
+        }
+    }
+    todos
+}
+
+/// Check comments for TODOs without an issue reference and return violations.
+///
+/// `reference` is compiled from `config.reference` by the caller, once per run.
+pub fn check_todo_violations(
+    ctx: &CheckContext,
+    path: &Path,
+    content: &str,
+    config: &TodoConfig,
+    reference: &Regex,
+    limit_reached: &mut bool,
+) -> Vec<Violation> {
+    let mut violations = Vec::new();
+    if config.check == CheckLevel::Off || !(content.contains("TODO") || content.contains("FIXME")) {
+        return violations;
+    }
+
+    for todo in find_unreferenced_todos(content, reference) {
+        if *limit_reached {
+            break;
+        }
+
+        let advice = format!(
+            "{} has no issue reference. Add one in parentheses or brackets right after it, matching `{}`, or resolve it.",
+            todo.tag, config.reference
+        );
+        if let Some(v) = try_create_violation(
+            ctx,
+            path,
+            todo.line,
+            "forbidden",
+            &advice,
+            UNREFERENCED_TODO,
+        ) {
+            let mut v = v.with_column(todo.column);
+            v.warning = config.check == CheckLevel::Warn;
+            violations.push(v);
+        } else {
+            *limit_reached = true;
+        }
+    }
+
+    violations
+}
+
+#[cfg(test)]
+#[path = "todo_tests.rs"]
+mod tests;
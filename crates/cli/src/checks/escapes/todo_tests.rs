@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn unreferenced(content: &str, reference: &str) -> Vec<(String, u32, u32)> {
+    let reference = reference_regex(reference).unwrap();
+    find_unreferenced_todos(content, &reference)
+        .into_iter()
+        .map(|t| (t.tag, t.line, t.column))
+        .collect()
+}
+
+fn jira(content: &str) -> Vec<(String, u32, u32)> {
+    unreferenced(content, &TodoConfig::default_reference())
+}
+
+#[parameterized(
+    line_comment = { "// TODO: fix this", "TODO", 4 },
+    trailing_comment = { "x := 1 // FIXME later", "FIXME", 11 },
+    hash_comment = { "# TODO handle errors", "TODO", 3 },
+    block_continuation = { " * TODO: document", "TODO", 4 },
+    name_not_a_reference = { "// TODO(alice): fix", "TODO", 4 },
+    space_before_reference = { "// TODO (JIRA-1): fix", "TODO", 4 },
+    unclosed_reference = { "// TODO(JIRA-1 fix", "TODO", 4 },
+)]
+fn flags_bare_tags(line: &str, tag: &str, column: u32) {
+    assert_eq!(jira(line), vec![(tag.to_string(), 1, column)]);
+}
+
+#[parameterized(
+    parenthesized = { "// TODO(JIRA-123): fix this" },
+    bracketed = { "// FIXME[OPS-9] flaky" },
+    hash_comment = { "# TODO(ABC-1)" },
+    plural = { "// TODOs are tracked elsewhere" },
+    identifier = { "// see TODO_LIST" },
+    code = { "let TODO = 1;" },
+    lowercase = { "// todo: later" },
+)]
+fn accepts_referenced_or_non_tags(line: &str) {
+    assert!(jira(line).is_empty(), "{:?}", jira(line));
+}
+
+#[test]
+fn checks_every_tag_on_a_line() {
+    assert_eq!(
+        jira("package lib\n\n// TODO(A-1) and FIXME\n"),
+        vec![("FIXME".to_string(), 3, 18)]
+    );
+}
+
+#[test]
+fn reference_format_is_configurable() {
+    assert!(unreferenced("// TODO(#123): fix", "#[0-9]+").is_empty());
+    assert_eq!(
+        unreferenced("// TODO(JIRA-123): fix", "#[0-9]+"),
+        vec![("TODO".to_string(), 1, 4)]
+    );
+}
+
+#[test]
+fn rejects_invalid_reference_regex() {
+    assert!(reference_regex("(").is_err());
+}
@@ -19,6 +19,14 @@ pub(super) fn default_advice(action: &EscapeAction) -> String {
     }
 }
 
+/// Count one violation against the limit.
+///
+/// Returns false if the limit was already reached.
+pub(super) fn claim_violation(ctx: &CheckContext) -> bool {
+    let current = ctx.violation_count.fetch_add(1, Ordering::SeqCst);
+    ctx.limit.is_none_or(|limit| current < limit)
+}
+
 /// Try to create a violation, respecting the violation limit.
 ///
 /// Returns `Some(violation)` if under limit, `None` if limit reached.
@@ -30,10 +38,7 @@ pub(super) fn try_create_violation(
     advice: &str,
     pattern_name: &str,
 ) -> Option<Violation> {
-    let current = ctx.violation_count.fetch_add(1, Ordering::SeqCst);
-    if let Some(limit) = ctx.limit
-        && current >= limit
-    {
+    if !claim_violation(ctx) {
         return None;
     }
 
@@ -48,16 +53,14 @@ pub(super) fn create_threshold_violation(
     threshold: usize,
     advice: &str,
 ) -> Option<Violation> {
-    let current = ctx.violation_count.fetch_add(1, Ordering::SeqCst);
-    if let Some(limit) = ctx.limit
-        && current >= limit
-    {
+    if !claim_violation(ctx) {
         return None;
     }
 
     Some(Violation {
         file: None,
         line: None,
+        column: None,
         violation_type: "threshold_exceeded".to_string(),
         advice: advice.to_string(),
         value: Some(count as i64),
@@ -79,6 +82,8 @@ pub(super) fn create_threshold_violation(
         scope: None,
         expected: None,
         found: None,
+        count: None,
+        warning: false,
     })
 }
 
@@ -87,9 +92,20 @@ pub(super) fn format_comment_advice(custom_advice: &str, comment_pattern: &str)
     if custom_advice.is_empty() || custom_advice == default_advice(&EscapeAction::Comment) {
         format!(
             "Add a {} comment explaining why this is necessary.",
-            comment_pattern
+            display_comment_pattern(comment_pattern)
         )
     } else {
         custom_advice.to_string()
     }
 }
+
+/// Render a comment pattern for advice, joining `|` alternatives with "or"
+/// (e.g., "// SAFETY:|// JUSTIFY:" becomes "// SAFETY: or // JUSTIFY:").
+pub(super) fn display_comment_pattern(comment_pattern: &str) -> String {
+    comment_pattern
+        .split('|')
+        .map(str::trim)
+        .filter(|alt| !alt.is_empty())
+        .collect::<Vec<_>>()
+        .join(" or ")
+}
@@ -86,7 +86,7 @@ impl Check for GitCheck {
 
         // Handle --fix for template creation
         let fix_summary = if ctx.fix && config.template {
-            fix_template(ctx.root, config, ctx.dry_run)
+            fix_template(ctx, config)
         } else {
             None
         };
@@ -258,14 +258,14 @@ fn format_type_advice(allowed_types: Option<&[String]>) -> String {
 /// Fix template and git config if needed.
 ///
 /// Returns fix summary if anything was fixed, None otherwise.
-fn fix_template(root: &Path, config: &GitCommitConfig, dry_run: bool) -> Option<serde_json::Value> {
-    let template_path = root.join(TEMPLATE_PATH);
+fn fix_template(ctx: &CheckContext, config: &GitCommitConfig) -> Option<serde_json::Value> {
+    let template_path = ctx.root.join(TEMPLATE_PATH);
     let mut actions = Vec::new();
 
     // Create .gitmessage if missing
     if !template_path.exists() {
         let content = generate_template(config);
-        if !dry_run {
+        if !ctx.dry_run {
             if let Err(e) = std::fs::write(&template_path, &content) {
                 // Log error but continue - this is a best-effort fix
                 eprintln!("Warning: Failed to create {}: {}", TEMPLATE_PATH, e);
@@ -273,14 +273,15 @@ fn fix_template(root: &Path, config: &GitCommitConfig, dry_run: bool) -> Option<
                 actions.push(format!("Created {} (commit template)", TEMPLATE_PATH));
             }
         } else {
+            ctx.preview_fix(&template_path, None, &content);
             actions.push(format!("Would create {} (commit template)", TEMPLATE_PATH));
         }
     }
 
     // Configure git commit.template if not set
-    if !is_template_configured(root) {
-        if !dry_run {
-            if configure_git_template(root) {
+    if !is_template_configured(ctx.root) {
+        if !ctx.dry_run {
+            if configure_git_template(ctx.root) {
                 actions.push("Configured git commit.template".to_string());
             }
         } else {
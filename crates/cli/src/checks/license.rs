@@ -129,7 +129,9 @@ impl Check for LicenseCheck {
                         );
                         let new_content = insert_header_preserving_shebang(content, &header);
 
-                        if !ctx.dry_run {
+                        if ctx.dry_run {
+                            ctx.preview_fix(relative_path, Some(content), &new_content);
+                        } else {
                             let _ = std::fs::write(&file.path, &new_content);
                         }
                         fixes
@@ -174,7 +176,9 @@ impl Check for LicenseCheck {
                             // Update year in content
                             let new_content = update_copyright_year(content, current_year);
 
-                            if !ctx.dry_run {
+                            if ctx.dry_run {
+                                ctx.preview_fix(relative_path, Some(content), &new_content);
+                            } else {
                                 let _ = std::fs::write(&file.path, &new_content);
                             }
                             fixes
@@ -209,12 +213,10 @@ impl Check for LicenseCheck {
         // Check LICENSE and README.md files for copyright year (unless limit reached)
         if ctx.limit.is_none_or(|limit| violations.len() < limit) {
             check_root_file(
+                ctx,
                 &ctx.root.join("LICENSE"),
-                ctx.root,
                 expected_copyright,
                 current_year,
-                ctx.fix,
-                ctx.dry_run,
                 &mut violations,
                 &mut fixes,
                 &mut files_checked,
@@ -224,12 +226,10 @@ impl Check for LicenseCheck {
 
         if ctx.limit.is_none_or(|limit| violations.len() < limit) {
             check_root_file(
+                ctx,
                 &ctx.root.join("README.md"),
-                ctx.root,
                 expected_copyright,
                 current_year,
-                ctx.fix,
-                ctx.dry_run,
                 &mut violations,
                 &mut fixes,
                 &mut files_checked,
@@ -456,12 +456,10 @@ fn update_copyright_year(content: &str, current_year: i32) -> String {
 // TODO(refactor): Extract common parameters into LicenseCheckContext
 #[allow(clippy::too_many_arguments)]
 fn check_root_file(
+    ctx: &CheckContext,
     file_path: &Path,
-    root: &Path,
     expected_copyright: &str,
     current_year: i32,
-    fix: bool,
-    dry_run: bool,
     violations: &mut Vec<Violation>,
     fixes: &mut LicenseFixes,
     files_checked: &mut usize,
@@ -479,7 +477,7 @@ fn check_root_file(
 
     *files_checked += 1;
 
-    let relative_path = file_path.strip_prefix(root).unwrap_or(file_path);
+    let relative_path = file_path.strip_prefix(ctx.root).unwrap_or(file_path);
 
     // Check if copyright line exists and includes current year
     if let Some(caps) = COPYRIGHT_PATTERN.captures(&content) {
@@ -490,11 +488,13 @@ fn check_root_file(
         if !year_includes_current(found_year, current_year) {
             *files_outdated_year += 1;
 
-            if fix {
+            if ctx.fix {
                 // Update year in content
                 let new_content = update_copyright_year(&content, current_year);
 
-                if !dry_run {
+                if ctx.dry_run {
+                    ctx.preview_fix(relative_path, Some(&content), &new_content);
+                } else {
                     let _ = std::fs::write(file_path, &new_content);
                 }
                 fixes
@@ -519,7 +519,7 @@ fn check_root_file(
         // Optionally check copyright holder matches expected
         if found_holder != expected_copyright {
             // Note: Not reporting this as a violation, just updating if in fix mode
-            if fix {
+            if ctx.fix {
                 // Could add logic to update copyright holder, but that's more invasive
                 // For now, we only update the year
             }
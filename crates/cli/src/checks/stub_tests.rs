@@ -45,10 +45,15 @@ fn stub_check_result_marked_as_stub() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     };
 
     let result = check.run(&ctx);
@@ -24,7 +24,7 @@ use std::sync::Arc;
 use serde_json::json;
 
 use crate::adapter::{
-    detect_language, patterns::correlation_exclude_defaults, resolve_project_patterns,
+    patterns::correlation_exclude_defaults, project_language, resolve_project_patterns,
 };
 use crate::check::{Check, CheckContext, CheckResult, Violation};
 
@@ -99,7 +99,7 @@ impl Check for TestsCheck {
 
         // Resolve patterns from project/language config
         let resolved = resolve_project_patterns(ctx.root, ctx.config);
-        let lang = detect_language(ctx.root);
+        let lang = project_language(ctx.root, ctx.config);
 
         let correlation_config = CorrelationConfig {
             source_patterns: if !resolved.source.is_empty() {
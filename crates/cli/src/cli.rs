@@ -3,8 +3,12 @@
 
 //! CLI argument parsing with clap derive.
 
-use std::path::PathBuf;
+use std::path::{Path, PathBuf};
 
+use crate::adapter::ProjectLanguage;
+use crate::build_info;
+use crate::category::Category;
+use crate::error::ExitCode;
 use crate::help;
 use clap::{Parser, Subcommand};
 use clap_complete::Shell;
@@ -12,16 +16,17 @@ use clap_complete::Shell;
 /// A fast linting tool for AI agents that measures quality signals
 #[derive(Parser)]
 #[command(name = "quench")]
-#[command(version, about, long_about = None, disable_version_flag = true)]
+#[command(version = build_info::VERSION_LINE)]
+#[command(about, long_about = None, disable_version_flag = true)]
 #[command(propagate_version = true)]
 #[command(styles = help::styles())]
 pub struct Cli {
     /// Print version
-    #[arg(short = 'v', long = "version", global = true, action = clap::ArgAction::Version)]
+    #[arg(short = 'V', long = "version", global = true, action = clap::ArgAction::Version)]
     version: (),
 
-    /// Hidden alias for backwards compatibility
-    #[arg(short = 'V', global = true, hide = true, action = clap::ArgAction::Version)]
+    /// Hidden alias for backwards compatibility; commands take -v as --verbose
+    #[arg(short = 'v', hide = true, action = clap::ArgAction::Version)]
     version_compat: (),
 
     #[command(subcommand)]
@@ -31,6 +36,7 @@ pub struct Cli {
 #[derive(Subcommand)]
 pub enum Command {
     /// Run quality checks
+    #[command(after_help = EXIT_CODES_HELP)]
     Check(CheckArgs),
     /// Count lines of code by language
     Cloc(ClocArgs),
@@ -40,10 +46,19 @@ pub enum Command {
     Init(InitArgs),
     /// Read configuration reference documentation
     Config(ConfigArgs),
+    /// List rules with their default severity
+    Rules(RulesArgs),
     /// Generate shell completions
     Completions(CompletionsArgs),
 }
 
+/// Exit status table shown after `quench check --help`.
+const EXIT_CODES_HELP: &str = "Exit codes:
+  0  All checks passed
+  1  One or more checks failed (set with --exit-code, 0 with --no-fail)
+  2  Configuration or argument error
+  3  Internal error";
+
 #[derive(clap::Args)]
 pub struct ClocArgs {
     /// Files or directories to count
@@ -61,13 +76,48 @@ pub struct ClocArgs {
 
 #[derive(clap::Args)]
 pub struct CheckArgs {
-    /// Files or directories to check
+    /// Files or directories to check, or Go package patterns like `./...`
     #[arg(value_name = "PATH")]
     pub paths: Vec<PathBuf>,
 
-    /// Output format
-    #[arg(short, long, default_value = "text")]
-    pub output: OutputFormat,
+    /// Check the newline-separated paths listed in FILE (`-` for stdin)
+    /// instead of walking the tree
+    #[arg(long, value_name = "FILE", conflicts_with_all = ["paths", "stdin", "watch"])]
+    pub files_from: Option<PathBuf>,
+
+    /// Check every --files-from path, even ones .gitignore or ignore patterns skip
+    #[arg(long, requires = "files_from")]
+    pub no_ignore_files_from: bool,
+
+    /// Project root that paths are reported relative to (default: nearest
+    /// directory with quench.toml, .quench.yml, go.mod, or .git)
+    #[arg(long, value_name = "DIR")]
+    pub root: Option<PathBuf>,
+
+    /// Output format (default: text), or a file to write the output to
+    /// (e.g., json, report.sarif)
+    #[arg(short, long, value_name = "FORMAT|FILE", value_parser = parse_output_target)]
+    pub output: Option<OutputTarget>,
+
+    /// Emit a flat, sorted violation list instead of the check report
+    #[arg(long, value_name = "FORMAT")]
+    pub format: Option<ViolationFormat>,
+
+    /// Report file paths relative to the scan root or as absolute paths
+    #[arg(long = "paths", value_name = "STYLE", default_value = "relative")]
+    pub path_style: PathStyle,
+
+    /// Collapse repeated violations: off (one per occurrence) or line
+    #[arg(long, value_name = "MODE", default_value = "off")]
+    pub dedup: DedupMode,
+
+    /// Group the check report's violations: under each file, under each rule, or none
+    #[arg(long, value_name = "BY", default_value = "file")]
+    pub group: GroupBy,
+
+    /// Color the check report: auto (on a terminal, unless NO_COLOR), always, or never
+    #[arg(long, value_name = "WHEN", default_value = "auto")]
+    pub color: ColorMode,
 
     /// Maximum violations to display (default: 15)
     #[arg(long, default_value_t = 15, value_name = "N")]
@@ -77,10 +127,80 @@ pub struct CheckArgs {
     #[arg(long)]
     pub no_limit: bool,
 
+    /// Show at most N violations of each rule, noting how many more there are
+    #[arg(long, value_name = "N")]
+    pub limit_per_rule: Option<usize>,
+
     /// Maximum directory depth to traverse
     #[arg(long, default_value_t = 100)]
     pub max_depth: usize,
 
+    /// Skip files larger than SIZE (e.g., 1MB, 500KB); 0 scans every file
+    #[arg(long, value_name = "SIZE", default_value = "10MB", value_parser = parse_file_size)]
+    pub max_file_size: u64,
+
+    /// Worker threads for scanning files (default: number of CPUs)
+    #[arg(short, long, value_name = "N")]
+    pub jobs: Option<std::num::NonZeroUsize>,
+
+    /// Scan files ignored by .gitignore
+    #[arg(long)]
+    pub no_gitignore: bool,
+
+    /// Check generated Go files (`// Code generated ... DO NOT EDIT.`)
+    #[arg(long)]
+    pub include_generated: bool,
+
+    /// Skip Go files whose build constraints exclude these tags (e.g.,
+    /// linux,amd64), like `go build -tags`; by default every file is checked
+    #[arg(long, value_name = "TAGS", value_delimiter = ',')]
+    pub build_tags: Vec<String>,
+
+    /// Fail on files that can't be parsed (default: report them and continue)
+    #[arg(long)]
+    pub strict_parse: bool,
+
+    /// Project language, instead of detecting it from marker files (e.g., go)
+    #[arg(long, value_name = "LANG", value_parser = parse_language)]
+    pub lang: Option<ProjectLanguage>,
+
+    /// Check only files of these languages (e.g., go,ts); other files are skipped
+    #[arg(long, value_name = "LANG", value_delimiter = ',', value_parser = parse_language)]
+    pub only_lang: Vec<ProjectLanguage>,
+
+    /// Skip files matching GLOB (repeatable, e.g., '**/*.pb.go'); replaces
+    /// default ignores like vendor/ unless --keep-defaults
+    #[arg(long, value_name = "GLOB")]
+    pub ignore: Vec<String>,
+
+    /// Keep default ignores (vendor/, target/, ...) alongside configured ones
+    #[arg(long)]
+    pub keep_defaults: bool,
+
+    /// Turn on the rules in a category, e.g., security (repeatable)
+    #[arg(long, value_name = "CATEGORY")]
+    pub enable_category: Vec<Category>,
+
+    /// Turn off the rules in a category, e.g., style (repeatable)
+    #[arg(long, value_name = "CATEGORY")]
+    pub disable_category: Vec<Category>,
+
+    /// Lowest violation severity that fails the check
+    #[arg(long, value_name = "SEVERITY", default_value = "error")]
+    pub fail_on: FailOn,
+
+    /// Exit status when checks fail; 0, 2, and 3 are reserved
+    #[arg(long, value_name = "N", default_value_t = 1, value_parser = parse_exit_code)]
+    pub exit_code: u8,
+
+    /// Fail only when more than N violations would fail the check
+    #[arg(long, value_name = "N")]
+    pub max_violations: Option<usize>,
+
+    /// Exit 0 even when checks fail, for runs that only report
+    #[arg(long)]
+    pub no_fail: bool,
+
     /// Compare against a git base ref (e.g., main, HEAD~1)
     #[arg(long, value_name = "REF")]
     pub base: Option<String>,
@@ -89,6 +209,10 @@ pub struct CheckArgs {
     #[arg(long)]
     pub staged: bool,
 
+    /// Report only violations on lines changed since a git ref (e.g., origin/main)
+    #[arg(long, value_name = "REF")]
+    pub diff: Option<String>,
+
     /// Bypass the cache (force fresh check)
     #[arg(long)]
     pub no_cache: bool,
@@ -105,18 +229,55 @@ pub struct CheckArgs {
     #[arg(long)]
     pub ci: bool,
 
-    /// Show verbose diagnostic output (always enabled in --ci mode)
-    #[arg(long)]
-    pub verbose: bool,
+    /// Show verbose diagnostic output: config files, skipped files and why
+    /// (always enabled in --ci mode); -vv adds the adapter per extension
+    #[arg(short, long, action = clap::ArgAction::Count)]
+    pub verbose: u8,
 
     /// Show timing breakdown (phases, per-check, cache stats)
     #[arg(long)]
     pub timing: bool,
 
+    /// Show a summary footer: violations by severity and rule, files scanned, time
+    #[arg(long)]
+    pub stats: bool,
+
+    /// Hide the scan progress line (shown on stderr when it is a terminal)
+    #[arg(long)]
+    pub no_progress: bool,
+
+    /// Write each file's violations as the scan reaches it (text and github formats)
+    #[arg(long)]
+    pub stream: bool,
+
+    /// Print no report, only set the exit code (--output and --format are ignored)
+    #[arg(short, long, conflicts_with_all = ["verbose", "timing", "stats", "watch"])]
+    pub quiet: bool,
+
     /// Save metrics to file (CI mode)
     #[arg(long, value_name = "FILE")]
     pub save: Option<std::path::PathBuf>,
 
+    /// Report only violations not recorded in this baseline file
+    #[arg(long, value_name = "FILE")]
+    pub baseline: Option<PathBuf>,
+
+    /// Record current violations to the --baseline file
+    #[arg(long, requires = "baseline")]
+    pub write_baseline: bool,
+
+    /// Check source read from stdin as the file at --filename (editor integration)
+    #[arg(long, requires = "filename", conflicts_with_all = ["fix", "watch", "write_baseline"])]
+    pub stdin: bool,
+
+    /// Path the --stdin buffer stands in for (picks the language, named in violations)
+    #[arg(long, value_name = "PATH", requires = "stdin")]
+    pub filename: Option<PathBuf>,
+
+    /// Re-check on file changes and print new and resolved violations
+    #[arg(long, conflicts_with_all = ["fix", "write_baseline"])]
+    pub watch: bool,
+
     // Check enable flags (run only these checks)
     /// Run only the cloc check
     #[arg(long)]
@@ -184,6 +345,95 @@ pub struct CheckArgs {
     pub no_license: bool,
 }
 
+impl CheckArgs {
+    /// Check report format: the `--output` format name, or the one an
+    /// `--output` file's extension implies (.json, .html, .md; text otherwise).
+    pub fn output_format(&self) -> OutputFormat {
+        match &self.output {
+            None => OutputFormat::Text,
+            Some(OutputTarget::Format(format)) => *format,
+            Some(OutputTarget::File(path)) => match extension(path).as_deref() {
+                Some("json") => OutputFormat::Json,
+                Some("html") => OutputFormat::Html,
+                Some("md") => OutputFormat::Markdown,
+                _ => OutputFormat::Text,
+            },
+        }
+    }
+
+    /// File the output is written to instead of stdout, if any.
+    pub fn output_file(&self) -> Option<&Path> {
+        match &self.output {
+            Some(OutputTarget::File(path)) => Some(path),
+            _ => None,
+        }
+    }
+
+    /// `--format`, or `sarif` for an `--output` file ending in `.sarif`.
+    pub fn violation_format(&self) -> Option<ViolationFormat> {
+        match self.format {
+            None if self.output_file().and_then(extension).as_deref() == Some("sarif") => {
+                Some(ViolationFormat::Sarif)
+            }
+            format => format,
+        }
+    }
+
+    /// Error for flag combinations clap can't express, if any.
+    pub fn output_conflict(&self) -> Option<&'static str> {
+        match (&self.output, self.format) {
+            (Some(OutputTarget::Format(_)), Some(_)) => Some(
+                "--format cannot be combined with an --output format; \
+use --output FILE to write it to a file",
+            ),
+            _ => None,
+        }
+    }
+}
+
+fn extension(path: &Path) -> Option<String> {
+    path.extension()
+        .and_then(|e| e.to_str())
+        .map(str::to_lowercase)
+}
+
+/// Parse a `check --output` value: a format name, or otherwise a file path.
+fn parse_output_target(value: &str) -> Result<OutputTarget, String> {
+    use clap::ValueEnum;
+
+    Ok(OutputFormat::from_str(value, true)
+        .map(OutputTarget::Format)
+        .unwrap_or_else(|_| OutputTarget::File(PathBuf::from(value))))
+}
+
+/// Parse a `--lang` or `--only-lang` value.
+fn parse_language(name: &str) -> Result<ProjectLanguage, String> {
+    ProjectLanguage::from_name(name).ok_or_else(|| {
+        format!(
+            "unknown language '{}' (expected rust, go, javascript, python, ruby, shell or generic)",
+            name
+        )
+    })
+}
+
+fn parse_file_size(value: &str) -> Result<u64, String> {
+    crate::tolerance::parse_size(value)
+        .map_err(|_| format!("invalid size '{}' (expected e.g. 10MB, 500KB or 0)", value))
+}
+
+fn parse_exit_code(value: &str) -> Result<u8, String> {
+    let status: u8 = value
+        .parse()
+        .map_err(|_| format!("invalid exit code '{}' (expected 1-255)", value))?;
+    if ExitCode::is_reserved(status) {
+        return Err(format!(
+            "exit code {} is reserved (0 = passed, 2 = configuration error, 3 = internal error)",
+            status
+        ));
+    }
+    Ok(status)
+}
+
 /// Trait for filtering checks/metrics by name.
 ///
 /// Both `CheckArgs` and `ReportArgs` implement this trait to provide
@@ -408,6 +658,23 @@ pub struct ConfigArgs {
     pub feature: Option<String>,
 }
 
+#[derive(clap::Args)]
+pub struct RulesArgs {
+    /// Output format
+    #[arg(long, value_name = "FORMAT", default_value = "text")]
+    pub format: RulesFormat,
+}
+
+/// `quench rules` output format.
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum RulesFormat {
+    /// Table grouped by language
+    #[default]
+    Text,
+    /// JSON array of rule objects
+    Json,
+}
+
 #[derive(clap::Args)]
 pub struct CompletionsArgs {
     /// Shell to generate completions for
@@ -415,7 +682,7 @@ pub struct CompletionsArgs {
     pub shell: Shell,
 }
 
-#[derive(Clone, Copy, Default, clap::ValueEnum)]
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, clap::ValueEnum)]
 pub enum OutputFormat {
     #[default]
     Text,
@@ -424,6 +691,103 @@ pub enum OutputFormat {
     Markdown,
 }
 
+/// Where `check --output` sends the output.
+#[derive(Clone, Debug, PartialEq, Eq)]
+pub enum OutputTarget {
+    /// Write the check report to stdout in this format
+    Format(OutputFormat),
+    /// Write the output to this file
+    File(PathBuf),
+}
+
+/// Flat violation list format (`check --format`).
+#[derive(Clone, Copy, clap::ValueEnum)]
+pub enum ViolationFormat {
+    /// One line per violation: file:line:col: severity: message (rule-id)
+    Human,
+    /// JSON array of violation objects
+    Json,
+    /// SARIF 2.1.0 log (GitHub code scanning)
+    Sarif,
+    /// Checkstyle XML report (Jenkins, GitLab, reviewdog)
+    Checkstyle,
+    /// JUnit XML report, one failing test case per violation (CI test results)
+    Junit,
+    /// GitHub Actions workflow commands (inline annotations)
+    Github,
+    /// `github` when GITHUB_ACTIONS=true, otherwise the check report
+    Auto,
+}
+
+impl ViolationFormat {
+    /// Name of the registered formatter (see [`crate::output::formatter`]).
+    pub fn name(self) -> &'static str {
+        match self {
+            ViolationFormat::Human => "human",
+            ViolationFormat::Json => "json",
+            ViolationFormat::Sarif => "sarif",
+            ViolationFormat::Checkstyle => "checkstyle",
+            ViolationFormat::Junit => "junit",
+            ViolationFormat::Github => "github",
+            ViolationFormat::Auto => "auto",
+        }
+    }
+}
+
+/// How file paths are reported (`check --paths`).
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum PathStyle {
+    /// Relative to the scan root
+    #[default]
+    Relative,
+    /// Absolute
+    Absolute,
+}
+
+/// How repeated violations are collapsed (`check --dedup`).
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum DedupMode {
+    /// Report every occurrence
+    #[default]
+    Off,
+    /// One violation per rule per line, with a count
+    Line,
+}
+
+/// How the check report groups violations (`check --group`).
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum GroupBy {
+    /// Under a header per file, by line
+    #[default]
+    File,
+    /// Under a header per rule, by file and line
+    Rule,
+    /// One flat list, each violation with its full path
+    None,
+}
+
+/// When the check report is colored (`check --color`).
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum ColorMode {
+    /// On a terminal, unless NO_COLOR is set or an agent is detected
+    #[default]
+    Auto,
+    /// Always, even when piped
+    Always,
+    /// Never
+    Never,
+}
+
+/// Lowest severity that fails `check` (`--fail-on`).
+#[derive(Clone, Copy, Default, PartialEq, Eq, Debug, clap::ValueEnum)]
+pub enum FailOn {
+    /// Fail on warnings as well as errors
+    Warning,
+    /// Fail on errors only; warnings are reported but exit 0
+    #[default]
+    Error,
+}
+
 // Re-export profile-related items from the profiles module for backward compatibility
 pub use crate::profiles::{
     ProfileRegistry, agents_detected_section, agents_section, claude_profile_defaults,
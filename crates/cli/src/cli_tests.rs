@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: MIT
 // Copyright (c) 2026 Alfred Jean LLC
 
+use yare::parameterized;
+
 use super::*;
 use crate::init::CursorMarker;
 
@@ -30,10 +32,345 @@ fn parse_check_with_paths() {
 fn parse_check_with_output_format() {
     let cli = Cli::parse_from(["quench", "check", "-o", "json"]);
     if let Some(Command::Check(args)) = cli.command {
-        assert!(matches!(args.output, OutputFormat::Json));
+        assert_eq!(args.output_format(), OutputFormat::Json);
+        assert_eq!(args.output_file(), None);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_violation_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "json"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(matches!(args.format, Some(ViolationFormat::Json)));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_human_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "human"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(matches!(args.format, Some(ViolationFormat::Human)));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_sarif_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "sarif"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(matches!(args.format, Some(ViolationFormat::Sarif)));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_junit_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "junit"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(matches!(args.format, Some(ViolationFormat::Junit)));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_github_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "github"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(matches!(args.format, Some(ViolationFormat::Github)));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn format_conflicts_with_output_format() {
+    let cli = Cli::parse_from(["quench", "check", "--format", "json", "-o", "json"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.output_conflict().is_some());
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[parameterized(
+    sarif_format = { &["--format", "sarif", "--output", "out/report.sarif"], Some("sarif"), OutputFormat::Text },
+    sarif_extension = { &["--output", "report.sarif"], Some("sarif"), OutputFormat::Text },
+    json_extension = { &["--output", "report.json"], None, OutputFormat::Json },
+    text = { &["-o", "report.txt"], None, OutputFormat::Text },
+)]
+fn parse_check_with_output_file(flags: &[&str], format: Option<&str>, report: OutputFormat) {
+    let cli = Cli::parse_from(["quench", "check"].into_iter().chain(flags.iter().copied()));
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.output_file().is_some());
+        assert_eq!(args.violation_format().map(|f| f.name()), format);
+        assert_eq!(args.output_format(), report);
+        assert!(args.output_conflict().is_none());
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_with_jobs() {
+    let cli = Cli::parse_from(["quench", "check", "--jobs", "4"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.jobs.map(|j| j.get()), Some(4));
+    } else {
+        panic!("expected check command");
+    }
+
+    let cli = Cli::parse_from(["quench", "check", "-j", "2"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.jobs.map(|j| j.get()), Some(2));
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_fail_on() {
+    let cli = Cli::parse_from(["quench", "check"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.fail_on, FailOn::Error);
     } else {
         panic!("expected check command");
     }
+
+    let cli = Cli::parse_from(["quench", "check", "--fail-on", "warning"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.fail_on, FailOn::Warning);
+    } else {
+        panic!("expected check command");
+    }
+
+    assert!(Cli::try_parse_from(["quench", "check", "--fail-on", "info"]).is_err());
+}
+
+#[test]
+fn parse_check_exit_code() {
+    let cli = Cli::parse_from(["quench", "check"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.exit_code, 1);
+    } else {
+        panic!("expected check command");
+    }
+
+    let cli = Cli::parse_from(["quench", "check", "--exit-code", "10"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.exit_code, 10);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[parameterized(
+    success = { "0" },
+    config_error = { "2" },
+    internal_error = { "3" },
+    out_of_range = { "256" },
+    not_a_number = { "one" },
+)]
+fn parse_check_rejects_exit_code(value: &str) {
+    assert!(Cli::try_parse_from(["quench", "check", "--exit-code", value]).is_err());
+}
+
+#[test]
+fn parse_check_include_generated() {
+    let cli = Cli::parse_from(["quench", "check", "--include-generated"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.include_generated);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_build_tags() {
+    let cli = Cli::parse_from(["quench", "check", "--build-tags", "linux,amd64"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.build_tags, vec!["linux", "amd64"]);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_strict_parse() {
+    let cli = Cli::parse_from(["quench", "check", "--strict-parse"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.strict_parse);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_root() {
+    let cli = Cli::parse_from(["quench", "check", "--root", "../repo", "pkg"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.root, Some(PathBuf::from("../repo")));
+        assert_eq!(args.paths, vec![PathBuf::from("pkg")]);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_no_progress() {
+    let cli = Cli::parse_from(["quench", "check", "--no-progress"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.no_progress);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_stream() {
+    let cli = Cli::parse_from(["quench", "check", "--stream"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.stream);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_path_style() {
+    let cli = Cli::parse_from(["quench", "check", "src", "--paths", "absolute"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.path_style, PathStyle::Absolute);
+        assert_eq!(args.paths, vec![PathBuf::from("src")]);
+    } else {
+        panic!("expected check command");
+    }
+
+    let cli = Cli::parse_from(["quench", "check"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.path_style, PathStyle::Relative);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_check_dedup() {
+    let cli = Cli::parse_from(["quench", "check", "--dedup", "line"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.dedup, DedupMode::Line);
+    } else {
+        panic!("expected check command");
+    }
+
+    let cli = Cli::parse_from(["quench", "check"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.dedup, DedupMode::Off);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[parameterized(
+    default = { &[], GroupBy::File },
+    file = { &["--group", "file"], GroupBy::File },
+    rule = { &["--group", "rule"], GroupBy::Rule },
+    none = { &["--group", "none"], GroupBy::None },
+)]
+fn parse_check_group(flags: &[&str], expected: GroupBy) {
+    let cli = Cli::parse_from(["quench", "check"].into_iter().chain(flags.iter().copied()));
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.group, expected);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn parse_rules_format() {
+    let cli = Cli::parse_from(["quench", "rules", "--format", "json"]);
+    if let Some(Command::Rules(args)) = cli.command {
+        assert_eq!(args.format, RulesFormat::Json);
+    } else {
+        panic!("expected rules command");
+    }
+
+    let cli = Cli::parse_from(["quench", "rules"]);
+    if let Some(Command::Rules(args)) = cli.command {
+        assert_eq!(args.format, RulesFormat::Text);
+    } else {
+        panic!("expected rules command");
+    }
+}
+
+#[test]
+fn parse_check_lang_and_only_lang() {
+    let cli = Cli::parse_from([
+        "quench",
+        "check",
+        "--lang",
+        "golang",
+        "--only-lang",
+        "ts,py",
+    ]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.lang, Some(ProjectLanguage::Go));
+        assert_eq!(
+            args.only_lang,
+            vec![ProjectLanguage::JavaScript, ProjectLanguage::Python]
+        );
+    } else {
+        panic!("expected check command");
+    }
+
+    assert!(Cli::try_parse_from(["quench", "check", "--lang", "cobol"]).is_err());
+}
+
+#[test]
+fn parse_check_watch() {
+    let cli = Cli::parse_from(["quench", "check", "--watch"]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert!(args.watch);
+    } else {
+        panic!("expected check command");
+    }
+
+    assert!(Cli::try_parse_from(["quench", "check", "--watch", "--fix"]).is_err());
+}
+
+#[test]
+fn jobs_must_be_positive() {
+    let result = Cli::try_parse_from(["quench", "check", "--jobs", "0"]);
+    assert!(result.is_err());
+}
+
+#[test]
+fn parse_check_with_write_baseline() {
+    let cli = Cli::parse_from([
+        "quench",
+        "check",
+        "--baseline",
+        ".quench-baseline.json",
+        "--write-baseline",
+    ]);
+    if let Some(Command::Check(args)) = cli.command {
+        assert_eq!(args.baseline, Some(PathBuf::from(".quench-baseline.json")));
+        assert!(args.write_baseline);
+    } else {
+        panic!("expected check command");
+    }
+}
+
+#[test]
+fn write_baseline_requires_baseline() {
+    let result = Cli::try_parse_from(["quench", "check", "--write-baseline"]);
+    assert!(result.is_err());
 }
 
 #[test]
@@ -3,32 +3,46 @@
 
 //! Check command implementation.
 
+mod stdin;
+mod stream;
 mod verbose;
+mod watch;
 
+use std::io::IsTerminal;
 use std::sync::Arc;
 use std::time::Instant;
 
-use quench::adapter::project::apply_language_defaults;
 use quench::baseline::Baseline;
 use quench::cache::{self, CACHE_FILE_NAME, FileCache};
-use quench::checks;
-use quench::cli::{CheckArgs, CheckFilter, Cli, OutputFormat};
-use quench::color::resolve_color;
+use quench::category;
+use quench::cli::{
+    CheckArgs, CheckFilter, Cli, DedupMode, FailOn, OutputFormat, PathStyle, ViolationFormat,
+};
+use quench::color::color_choice;
 use quench::config::{self, CheckLevel};
+use quench::diff_scope::DiffScope;
 use quench::discovery;
 use quench::error::ExitCode;
+use quench::fix_preview::FixPreview;
 use quench::git::{
     detect_base_branch, find_ratchet_base, get_changed_files, get_staged_files, is_git_repo,
     save_to_git_notes,
 };
+use quench::inline_ignore::InlineIgnores;
 use quench::latest::{LatestMetrics, get_head_commit};
 use quench::output::FormatOptions;
-use quench::output::json::{self, JsonFormatter};
+use quench::output::formatter;
+use quench::output::json::JsonFormatter;
+use quench::output::stats::ScanStats;
 use quench::output::text::TextFormatter;
+use quench::progress::Progress;
 use quench::ratchet::{self, CurrentMetrics};
 use quench::runner::{CheckRunner, RunnerConfig};
+use quench::scan;
+use quench::severity;
 use quench::timing::{PhaseTiming, TimingInfo};
 use quench::verbose::VerboseLogger;
+use quench::violation_baseline::ViolationBaseline;
 use quench::walker::{FileWalker, WalkerConfig};
 
 /// Check if debug files mode is enabled via QUENCH_DEBUG_FILES env var.
@@ -47,72 +61,250 @@ pub fn run(_cli: &Cli, args: &CheckArgs) -> anyhow::Result<ExitCode> {
 
     let verbose = setup_verbose(args);
     let cwd = std::env::current_dir()?;
-    let root = resolve_root(&cwd, args);
+    let project_root = match &args.root {
+        Some(root) if !cwd.join(root).is_dir() => {
+            eprintln!("quench: root not found: {}", root.display());
+            return Ok(ExitCode::ConfigError);
+        }
+        Some(root) => cwd.join(root),
+        None => discovery::find_project_root(&cwd).unwrap_or_else(|| cwd.clone()),
+    };
+    // A --files-from list stands in for the path arguments. Listed paths
+    // that don't exist, like files a change deleted, are skipped
+    let files_from = match &args.files_from {
+        Some(source) => match read_file_list(source) {
+            Ok(paths) => Some(paths),
+            Err(e) => {
+                eprintln!("quench: could not read {}: {}", source.display(), e);
+                return Ok(ExitCode::ConfigError);
+            }
+        },
+        None => None,
+    };
+    // Go package patterns (`./...`) stand for the packages' files
+    let expanded = match scan::expand_package_patterns(&cwd, &args.paths) {
+        Ok(paths) => paths,
+        Err(e) => {
+            eprintln!("quench: {}", e);
+            return Ok(ExitCode::ConfigError);
+        }
+    };
+    let paths = files_from.as_deref().unwrap_or(&expanded);
+    let (root, scope) = scan::resolve_paths(&cwd, &project_root, paths);
+    if files_from.is_none()
+        && let Some(missing) = scope.iter().find(|path| !path.exists())
+    {
+        eprintln!("quench: path not found: {}", missing.display());
+        return Ok(ExitCode::ConfigError);
+    }
+    if args.stdin {
+        return stdin::run(args, &cwd, &root, &verbose);
+    }
+    if args.watch {
+        return watch::run(args, &root, &scope, &verbose);
+    }
 
     // === Configuration Phase ===
-    let (mut config, config_path) = load_config(&root)?;
-    let exclude_patterns = apply_language_defaults(&root, &mut config);
-    verbose::config(&verbose, &root, &config, &config_path, &exclude_patterns);
-
-    let walker_config = WalkerConfig {
-        max_depth: Some(args.max_depth),
-        exclude_patterns,
-        ..Default::default()
-    };
+    tracing::trace!("check command starting");
+    let (mut config, config_path) = scan::load_config(&root)?;
+    if args.lang.is_some() {
+        config.project.language = args.lang;
+    }
+    if args.strict_parse {
+        scan::strict_parse(&mut config);
+    }
+    category::apply(&mut config, &args.enable_category, &args.disable_category);
+    config
+        .project
+        .exclude
+        .add_ignores(&args.ignore, args.keep_defaults);
+    let mut walker_config = scan::walker_config(&root, &mut config, args.max_depth, args.jobs);
+    walker_config.git_ignore = !args.no_gitignore;
+    walker_config.max_file_size = args.max_file_size;
+    walker_config.scope = scope;
+    verbose::config(
+        &verbose,
+        &root,
+        &config,
+        &config_path,
+        &walker_config.exclude_patterns,
+    );
 
     // === Discovery Phase ===
     let discovery_start = Instant::now();
-    let (files, stats) = run_discovery(&root, walker_config, &verbose)?;
-    let Some(files) = files else {
+    let (files, mut stats) = match &files_from {
+        Some(listed) => {
+            verbose.log(&format!("Files from: {} paths listed", listed.len()));
+            let honor_ignores = !args.no_ignore_files_from;
+            let (files, stats) = scan::discover_listed(&root, walker_config, honor_ignores);
+            (Some(files), stats)
+        }
+        None => run_discovery(&root, walker_config, &verbose)?,
+    };
+    let Some(mut files) = files else {
         return Ok(ExitCode::Success); // debug_files mode handled
     };
+    if !args.include_generated {
+        let skipped = scan::skip_generated(&mut files);
+        if !skipped.is_empty() {
+            verbose.log(&format!("Generated: {} files skipped", skipped.len()));
+        }
+        stats.skipped.extend(skipped);
+    }
+    let skipped = scan::skip_build_constrained(&mut files, &args.build_tags);
+    if !skipped.is_empty() {
+        verbose.log(&format!(
+            "Build tags: {} files excluded by build constraints",
+            skipped.len()
+        ));
+    }
+    stats.skipped.extend(skipped);
+    let skipped = scan::only_languages(&mut files, &args.only_lang);
+    if skipped > 0 {
+        verbose.log(&format!(
+            "Languages: {} files of other languages skipped",
+            skipped
+        ));
+    }
     let discovery_ms = discovery_start.elapsed().as_millis() as u64;
 
-    verbose::discovery(&verbose, args, &files, &stats);
+    verbose::discovery(&verbose, &root, args, &files, &stats);
+    let nested = scan::load_directory_configs(&root, &files, &mut config)?;
+    if nested > 0 {
+        verbose.log(&format!("Config: {} nested rule overrides", nested));
+        for directory in &config.directories {
+            verbose.log(&format!(
+                "Config: merged overrides for {}/",
+                directory.dir.display()
+            ));
+        }
+    }
+    verbose::adapters(&verbose, &files);
+    let ignores = InlineIgnores::collect(&root, &files);
 
     // === Setup Phase ===
-    let checks_list = checks::filter_checks(&args.enabled_checks(), &args.disabled_checks());
     let base_branch = resolve_base_branch(args, &root);
     let changed_files = resolve_changed_files(args, &root, &base_branch, &verbose);
 
     verbose::suites(&verbose, &config);
     verbose::commits(&verbose, &root, &base_branch);
 
-    let limit = effective_limit(args);
+    // Baselined, inline-ignored, `off` severity (from `[severity]` or
+    // `[rules]`), and out-of-diff violations are filtered after checking, so
+    // collect them all
+    let diff_scope = resolve_diff_scope(args, &root, &verbose);
+    let filtered = args.baseline.is_some()
+        || !ignores.is_empty()
+        || severity::filters_violations(&config)
+        || diff_scope.is_some();
+    let streaming = stream::enabled(args, violation_format(args), filtered);
+    // --fix also fixes files past the display limit, --stats,
+    // --max-violations, and --limit-per-rule count them, and a stream writes
+    // them before the limit is reached
+    let limit = if filtered
+        || args.fix
+        || args.stats
+        || streaming
+        || args.max_violations.is_some()
+        || args.limit_per_rule.is_some()
+    {
+        None
+    } else {
+        effective_limit(args)
+    };
+    let progress = show_progress(args, &verbose).then(|| Arc::new(Progress::new()));
+    let color_choice = color_choice(args.color);
+    let options = FormatOptions {
+        limit: effective_limit(args),
+        limit_per_rule: args.limit_per_rule,
+        group: args.group,
+    };
+    let stream = streaming.then(|| {
+        let formatter = violation_format(args)
+            .is_none()
+            .then(|| TextFormatter::new(color_choice, options.clone()));
+        stream::Stream::new(args, &root, &config, formatter, progress.clone())
+    });
+    let fix_preview = args.dry_run.then(|| Arc::new(FixPreview::new()));
     let mut runner = CheckRunner::new(RunnerConfig {
         limit,
         changed_files,
         fix: args.fix,
         dry_run: args.dry_run,
+        fix_preview: fix_preview.clone(),
         ci_mode: args.ci,
         base_branch: base_branch.clone(),
         staged: args.staged,
         verbose: verbose.is_enabled(),
+        stdin: None,
+        rules: None,
+        progress: progress.clone(),
+        stream: stream.as_ref().map(|s| Arc::clone(&s.stream)),
     });
 
-    let cache = setup_cache(args, &root, &config)?;
+    let cache = setup_cache(args, &root, &config, streaming)?;
     if let Some(ref cache) = cache {
         runner = runner.with_cache(Arc::clone(cache));
     }
 
     // === Checking Phase ===
     let checking_start = Instant::now();
-    let check_results = runner.run(checks_list, &files, &config, &root);
+    let checked = scan::with_jobs(args.jobs, || {
+        scan::run_checks(
+            &runner,
+            &root,
+            &config,
+            &files,
+            &args.enabled_checks(),
+            &args.disabled_checks(),
+        )
+    });
+    // Clear the progress line before anything else is printed
+    if let Some(ref progress) = progress {
+        progress.finish();
+    }
+    let mut output = checked?;
+    let written = stream.as_ref().map(|s| s.finish());
     let checking_ms = checking_start.elapsed().as_millis() as u64;
 
     let cache_handle = persist_cache_async(args, &cache, &root);
     verbose::cache(&verbose, &cache);
 
-    let output = json::create_output(check_results);
+    // === Suppression Phase ===
+    apply_inline_ignores(args, &ignores, &mut output, &verbose);
+    severity::apply(&config, &mut output);
+    if let Some(ref scope) = diff_scope {
+        let removed = scope.apply(&mut output);
+        if verbose.is_enabled() {
+            verbose.log(&format!(
+                "Diff: {} violations outside changed lines skipped",
+                removed
+            ));
+        }
+    }
+
+    // === Baseline Phase ===
+    if let Some(ref baseline_path) = args.baseline {
+        apply_violation_baseline(args, &root, baseline_path, None, &mut output, &verbose)?;
+    }
 
     // === Ratchet Phase ===
     let use_notes = config.git.uses_notes() && is_git_repo(&root);
-    let (ratchet_result, baseline) =
-        run_ratchet_check(&config, &verbose, &output, use_notes, &root, &base_branch);
+    let (ratchet_result, baseline) = run_ratchet_check(
+        &config,
+        args,
+        &verbose,
+        &output,
+        use_notes,
+        &root,
+        &base_branch,
+    );
 
-    if args.fix {
+    // --dry-run writes nothing, the baseline included
+    if args.fix && !args.dry_run {
         save_baseline(
             &config,
+            args,
             &output,
             &ratchet_result,
             baseline,
@@ -124,10 +316,12 @@ pub fn run(_cli: &Cli, args: &CheckArgs) -> anyhow::Result<ExitCode> {
     save_latest(&root, &output, &verbose);
 
     // === Output Phase ===
-    let color_choice = resolve_color();
-    let options = FormatOptions {
-        limit: effective_limit(args),
-    };
+    if args.dedup == DedupMode::Line {
+        output.dedup_lines();
+    }
+    if args.path_style == PathStyle::Absolute {
+        output.absolutize_paths(&root);
+    }
     let timing_info = build_timing_info(args, &cache, &output, &files, discovery_ms, checking_ms);
 
     let output_start = Instant::now();
@@ -139,8 +333,23 @@ pub fn run(_cli: &Cli, args: &CheckArgs) -> anyhow::Result<ExitCode> {
         color_choice,
         options,
         timing_info.as_ref(),
+        written,
     )?;
 
+    // Human-readable output only, on stdout, which only has the report on it
+    // when there's no --output file
+    let report_is_text =
+        violation_format(args).is_none() && args.output_format() == OutputFormat::Text;
+    let human_stdout = report_is_text || args.output_file().is_some();
+
+    // After the report, which `git apply` skips as leading text
+    if let Some(ref preview) = fix_preview
+        && !args.quiet
+        && human_stdout
+    {
+        print!("{}", preview.diff());
+    }
+
     if let Some(ref save_path) = args.save {
         if let Err(e) = save_metrics_to_file(save_path, &output) {
             eprintln!("quench: warning: failed to save metrics: {}", e);
@@ -149,6 +358,12 @@ pub fn run(_cli: &Cli, args: &CheckArgs) -> anyhow::Result<ExitCode> {
         }
     }
 
+    // Scan time excludes startup and config loading
+    if args.stats && human_stdout {
+        let stats = ScanStats::from_output(&output);
+        println!("{}", stats.format(files.len(), discovery_ms + checking_ms));
+    }
+
     let output_ms = output_start.elapsed().as_millis() as u64;
     let total_ms = total_start.elapsed().as_millis() as u64;
 
@@ -182,43 +397,49 @@ fn validate_flags(args: &CheckArgs) -> Option<ExitCode> {
         eprintln!("--staged and --base cannot be used together");
         return Some(ExitCode::ConfigError);
     }
+    if let Some(conflict) = args.output_conflict() {
+        eprintln!("{}", conflict);
+        return Some(ExitCode::ConfigError);
+    }
     None
 }
 
-fn setup_verbose(args: &CheckArgs) -> VerboseLogger {
-    let verbose_enabled = args.ci || args.verbose || quench::env::quench_debug();
-    VerboseLogger::new(verbose_enabled)
+/// Whether to draw the progress line: only for the text report or an
+/// `--output` file, on an interactive stderr, and never alongside verbose or
+/// quiet output.
+fn show_progress(args: &CheckArgs, verbose: &VerboseLogger) -> bool {
+    let report_is_text =
+        violation_format(args).is_none() && args.output_format() == OutputFormat::Text;
+    !args.no_progress
+        && !args.quiet
+        && !verbose.is_enabled()
+        && (report_is_text || args.output_file().is_some())
+        && std::io::stderr().is_terminal()
 }
 
-fn resolve_root(cwd: &std::path::Path, args: &CheckArgs) -> std::path::PathBuf {
-    if args.paths.is_empty() {
-        cwd.to_path_buf()
-    } else {
-        let path = &args.paths[0];
-        if path.is_absolute() {
-            path.clone()
-        } else {
-            cwd.join(path)
-        }
+fn setup_verbose(args: &CheckArgs) -> VerboseLogger {
+    // --quiet also silences the verbose output --ci and QUENCH_DEBUG turn on
+    if args.quiet {
+        return VerboseLogger::with_level(0);
     }
+    let implied = u8::from(args.ci || quench::env::quench_debug());
+    VerboseLogger::with_level(args.verbose.max(implied))
 }
 
-fn load_config(
-    root: &std::path::Path,
-) -> anyhow::Result<(config::Config, Option<std::path::PathBuf>)> {
-    let config_path = discovery::find_config(root);
-    let config = match &config_path {
-        Some(path) => {
-            tracing::debug!("loading config from {}", path.display());
-            config::load_with_warnings(path)?
-        }
-        None => {
-            tracing::debug!("no config found, using defaults");
-            config::Config::default()
-        }
+/// Read the newline-separated paths of a `--files-from` list (`-` for stdin).
+///
+/// Lines end with `\n` or `\r\n`, and blank lines are skipped.
+fn read_file_list(source: &std::path::Path) -> std::io::Result<Vec<std::path::PathBuf>> {
+    let text = if source == std::path::Path::new("-") {
+        std::io::read_to_string(std::io::stdin())?
+    } else {
+        std::fs::read_to_string(source)?
     };
-    tracing::trace!("check command starting");
-    Ok((config, config_path))
+    Ok(text
+        .lines()
+        .filter(|line| !line.is_empty())
+        .map(std::path::PathBuf::from)
+        .collect())
 }
 
 /// Run file discovery. Returns None for files if debug_files mode handled output.
@@ -230,27 +451,25 @@ fn run_discovery(
     Option<Vec<quench::walker::WalkedFile>>,
     quench::walker::WalkStats,
 )> {
+    if !debug_files() {
+        let (files, stats) = scan::discover_files(root, walker_config);
+        return Ok((Some(files), stats));
+    }
+
     let walker = FileWalker::new(walker_config);
     let (rx, handle) = walker.walk(root);
-
-    if debug_files() {
-        for file in rx {
-            let display_path = file.path.strip_prefix(root).unwrap_or(&file.path);
-            println!("{}", display_path.display());
-        }
-        let stats = handle.join();
-        if verbose.is_enabled() {
-            eprintln!(
-                "Scanned {} files, {} errors, {} symlink loops",
-                stats.files_found, stats.errors, stats.symlink_loops
-            );
-        }
-        return Ok((None, stats));
+    for file in rx {
+        let display_path = file.path.strip_prefix(root).unwrap_or(&file.path);
+        println!("{}", display_path.display());
     }
-
-    let files: Vec<_> = rx.iter().collect();
     let stats = handle.join();
-    Ok((Some(files), stats))
+    if verbose.is_enabled() {
+        eprintln!(
+            "Scanned {} files, {} errors, {} symlink loops",
+            stats.files_found, stats.errors, stats.symlink_loops
+        );
+    }
+    Ok((None, stats))
 }
 
 fn resolve_base_branch(args: &CheckArgs, root: &std::path::Path) -> Option<String> {
@@ -306,6 +525,41 @@ fn resolve_changed_files(
     }
 }
 
+/// Compute the changed lines for `--diff`.
+///
+/// Returns None (report every violation) when `--diff` isn't set, or with a
+/// warning when the project isn't in a git repository or the ref is invalid.
+fn resolve_diff_scope(
+    args: &CheckArgs,
+    root: &std::path::Path,
+    verbose: &VerboseLogger,
+) -> Option<DiffScope> {
+    let base = args.diff.as_ref()?;
+    if !is_git_repo(root) {
+        eprintln!("quench: warning: --diff needs a git repository, reporting all violations");
+        return None;
+    }
+    match DiffScope::from_git(root, base) {
+        Ok(scope) => {
+            if verbose.is_enabled() {
+                verbose.log(&format!(
+                    "Diff against {}: {} files with changed lines",
+                    base,
+                    scope.file_count()
+                ));
+            }
+            Some(scope)
+        }
+        Err(e) => {
+            eprintln!(
+                "quench: warning: could not diff against {}: {}, reporting all violations",
+                base, e
+            );
+            None
+        }
+    }
+}
+
 fn effective_limit(args: &CheckArgs) -> Option<usize> {
     if args.no_limit || args.ci {
         None
@@ -314,12 +568,95 @@ fn effective_limit(args: &CheckArgs) -> Option<usize> {
     }
 }
 
+/// Suppress violations excused by `quench:ignore` directives, and warn about
+/// malformed or unused directives.
+fn apply_inline_ignores(
+    args: &CheckArgs,
+    ignores: &InlineIgnores,
+    output: &mut quench::check::CheckOutput,
+    verbose: &VerboseLogger,
+) {
+    if ignores.is_empty() {
+        return;
+    }
+    // With a subset of checks, directives for the others would look unused
+    let report_unused = args.enabled_checks().is_empty() && args.disabled_checks().is_empty();
+    let (suppressed, warnings) = ignores.apply(output, report_unused);
+    if !args.quiet {
+        for warning in &warnings {
+            eprintln!("quench: warning: {}", warning);
+        }
+    }
+    if verbose.is_enabled() {
+        verbose.log(&format!(
+            "Inline ignores: {} violations suppressed",
+            suppressed
+        ));
+    }
+}
+
+/// Write the `--baseline` file, or suppress the violations it records.
+///
+/// `stdin` is the buffer checked in place of its file, whose lines are
+/// hashed from the buffer rather than from disk.
+fn apply_violation_baseline(
+    args: &CheckArgs,
+    root: &std::path::Path,
+    path: &std::path::Path,
+    stdin: Option<&quench::check::SourceBuffer>,
+    output: &mut quench::check::CheckOutput,
+    verbose: &VerboseLogger,
+) -> anyhow::Result<()> {
+    if args.write_baseline {
+        let baseline = ViolationBaseline::from_output(root, stdin, output);
+        baseline.save(path)?;
+        if !args.quiet {
+            eprintln!(
+                "quench: wrote {} violations to {}",
+                baseline.violations.len(),
+                path.display()
+            );
+        }
+        baseline.apply(root, stdin, output);
+        return Ok(());
+    }
+
+    match ViolationBaseline::load(path)? {
+        Some(baseline) => {
+            let suppressed = baseline.apply(root, stdin, output);
+            if verbose.is_enabled() {
+                verbose.log(&format!(
+                    "Baseline: {} known violations suppressed ({})",
+                    suppressed,
+                    path.display()
+                ));
+            }
+        }
+        None if args.quiet => {}
+        None => eprintln!(
+            "quench: warning: baseline {} not found, reporting all violations",
+            path.display()
+        ),
+    }
+    Ok(())
+}
+
 fn setup_cache(
     args: &CheckArgs,
     root: &std::path::Path,
     config: &config::Config,
+    streaming: bool,
 ) -> anyhow::Result<Option<Arc<FileCache>>> {
-    if args.no_cache {
+    // --fix rescans every file, since cached violations can't be fixed, and a
+    // stream only writes the files it scans. A deprecated_use or
+    // large_struct_return in an unchanged file goes stale when a declaration
+    // in another file changes, so those rules rescan too.
+    if args.no_cache
+        || args.fix
+        || streaming
+        || config.golang.deprecated.check != CheckLevel::Off
+        || config.golang.largestruct.check != CheckLevel::Off
+    {
         return Ok(None);
     }
     let cache_path = root.join(".quench").join(CACHE_FILE_NAME);
@@ -354,6 +691,7 @@ fn persist_cache_async(
 
 fn run_ratchet_check(
     config: &config::Config,
+    args: &CheckArgs,
     verbose: &VerboseLogger,
     output: &quench::check::CheckOutput,
     use_notes: bool,
@@ -380,9 +718,9 @@ fn run_ratchet_check(
     }
 
     if use_notes {
-        ratchet_from_notes(config, verbose, output, root, base_branch)
+        ratchet_from_notes(config, args, verbose, output, root, base_branch)
     } else if let Some(path) = config.git.baseline_path() {
-        ratchet_from_file(config, verbose, output, root, path)
+        ratchet_from_file(config, args, verbose, output, root, path)
     } else {
         if verbose.is_enabled() {
             verbose.log("Ratchet check: off (not in git repo with notes mode)");
@@ -393,6 +731,7 @@ fn run_ratchet_check(
 
 fn ratchet_from_notes(
     config: &config::Config,
+    args: &CheckArgs,
     verbose: &VerboseLogger,
     output: &quench::check::CheckOutput,
     root: &std::path::Path,
@@ -414,7 +753,9 @@ fn ratchet_from_notes(
                             &base_commit[..7.min(base_commit.len())]
                         ));
                     }
-                    warn_stale_baseline(&baseline, config);
+                    if !args.quiet {
+                        warn_stale_baseline(&baseline, config);
+                    }
                     let current = CurrentMetrics::from_output(output);
                     let result = ratchet::compare(&current, &baseline.metrics, &config.ratchet);
                     (Some(result), Some(baseline))
@@ -445,6 +786,7 @@ fn ratchet_from_notes(
 
 fn ratchet_from_file(
     config: &config::Config,
+    args: &CheckArgs,
     verbose: &VerboseLogger,
     output: &quench::check::CheckOutput,
     root: &std::path::Path,
@@ -459,7 +801,9 @@ fn ratchet_from_file(
                     baseline_path.display()
                 ));
             }
-            warn_stale_baseline(&baseline, config);
+            if !args.quiet {
+                warn_stale_baseline(&baseline, config);
+            }
             let current = CurrentMetrics::from_output(output);
             let result = ratchet::compare(&current, &baseline.metrics, &config.ratchet);
             (Some(result), Some(baseline))
@@ -491,6 +835,7 @@ fn warn_stale_baseline(baseline: &Baseline, config: &config::Config) {
 
 fn save_baseline(
     config: &config::Config,
+    args: &CheckArgs,
     output: &quench::check::CheckOutput,
     ratchet_result: &Option<ratchet::RatchetResult>,
     baseline: Option<Baseline>,
@@ -513,6 +858,7 @@ fn save_baseline(
             }
         };
         match save_to_git_notes(root, &json) {
+            Ok(()) if args.quiet => {}
             Ok(()) => report_baseline_update(ratchet_result, "git notes"),
             Err(e) => eprintln!("quench: warning: failed to save to git notes: {}", e),
         }
@@ -523,7 +869,7 @@ fn save_baseline(
         let baseline_existed = baseline_path.exists();
         if let Err(e) = baseline.save(&baseline_path) {
             eprintln!("quench: warning: failed to save baseline: {}", e);
-        } else if !use_notes {
+        } else if !use_notes && !args.quiet {
             report_baseline_update_file(ratchet_result, &baseline_path, baseline_existed);
         }
     }
@@ -576,6 +922,8 @@ fn build_timing_info(
     })
 }
 
+// TODO(refactor): Group the report inputs into an OutputContext struct
+#[allow(clippy::too_many_arguments)]
 fn format_output(
     args: &CheckArgs,
     output: &quench::check::CheckOutput,
@@ -584,13 +932,53 @@ fn format_output(
     color_choice: termcolor::ColorChoice,
     options: FormatOptions,
     timing_info: Option<&TimingInfo>,
+    written: Option<stream::Written>,
 ) -> anyhow::Result<()> {
+    if args.quiet {
+        return Ok(());
+    }
+    // Streamed violations are already written
+    let remaining = written.as_ref().map(|w| stream::remaining(output, w.count));
+    let shown = remaining.as_ref().unwrap_or(output);
+
+    let file = args.output_file().map(create_output_file).transpose()?;
+
+    if let Some(format) = violation_format(args) {
+        let selected = formatter::formatter(format.name())
+            .ok_or_else(|| anyhow::anyhow!("unknown format: {}", format.name()))?;
+        match file {
+            Some(mut file) => selected.format(&mut file, shown)?,
+            None => selected.format(&mut std::io::stdout().lock(), shown)?,
+        }
+        return Ok(());
+    }
+
     let total_violations = output.total_violations();
-    match args.output {
+    match args.output_format() {
         OutputFormat::Text | OutputFormat::Html | OutputFormat::Markdown => {
-            let mut formatter = TextFormatter::new(color_choice, options);
-            for result in &output.checks {
-                formatter.write_check(result)?;
+            let streamed = written.as_ref().is_some_and(|w| w.count > 0);
+            let mut formatter = match file {
+                // Never colored: the file isn't a terminal
+                Some(file) => {
+                    TextFormatter::with_writer(Box::new(termcolor::NoColor::new(file)), options)
+                }
+                None => written
+                    .and_then(|w| w.formatter)
+                    .unwrap_or_else(|| TextFormatter::new(color_choice, options)),
+            };
+            for result in &shown.checks {
+                if streamed && result.name == stream::STREAMED_CHECK && !result.fixed {
+                    // Continue under the streamed header
+                    if !result.violations.is_empty() {
+                        formatter.write_streamed(
+                            &result.name,
+                            &result.violations,
+                            result.passed,
+                        )?;
+                    }
+                } else {
+                    formatter.write_check(result)?;
+                }
             }
             if let Some(result) = ratchet_result {
                 formatter.write_ratchet(result, config.ratchet.check)?;
@@ -601,13 +989,40 @@ fn format_output(
             }
         }
         OutputFormat::Json => {
-            let mut formatter = JsonFormatter::new(std::io::stdout());
+            let writer: Box<dyn std::io::Write> = match file {
+                Some(file) => Box::new(file),
+                None => Box::new(std::io::stdout()),
+            };
+            let mut formatter = JsonFormatter::new(writer);
             formatter.write_with_timing(output, ratchet_result.as_ref(), timing_info)?;
         }
     }
     Ok(())
 }
 
+/// Create the `--output` file, and any missing parent directories.
+fn create_output_file(path: &std::path::Path) -> anyhow::Result<std::fs::File> {
+    if let Some(parent) = path.parent().filter(|p| !p.as_os_str().is_empty()) {
+        std::fs::create_dir_all(parent)
+            .map_err(|e| anyhow::anyhow!("could not create {}: {}", parent.display(), e))?;
+    }
+    std::fs::File::create(path)
+        .map_err(|e| anyhow::anyhow!("could not write {}: {}", path.display(), e))
+}
+
+/// The `--format` to write, or None for the check report.
+///
+/// `auto` picks annotations in GitHub Actions and the check report elsewhere.
+fn violation_format(args: &CheckArgs) -> Option<ViolationFormat> {
+    match args.violation_format() {
+        Some(ViolationFormat::Auto) if quench::env::github_actions() => {
+            Some(ViolationFormat::Github)
+        }
+        Some(ViolationFormat::Auto) => None,
+        format => format,
+    }
+}
+
 fn print_timing(
     args: &CheckArgs,
     timing_info: Option<TimingInfo>,
@@ -619,7 +1034,7 @@ fn print_timing(
     if let Some(mut info) = timing_info {
         info.phases.output_ms = output_ms;
         info.phases.total_ms = total_ms;
-        if !matches!(args.output, OutputFormat::Json) {
+        if args.output_format() != OutputFormat::Json {
             eprintln!("{}", info.phases.format_text());
             for result in &output.checks {
                 if let Some(ms) = result.duration_ms {
@@ -639,18 +1054,64 @@ fn determine_exit_code(
     ratchet_result: &Option<ratchet::RatchetResult>,
     config: &config::Config,
 ) -> ExitCode {
-    let ratchet_failed = ratchet_result
-        .as_ref()
-        .is_some_and(|r| !r.passed && config.ratchet.check == CheckLevel::Error);
+    // Errors that stop the run never get here, so they keep their codes
+    if args.no_fail {
+        return ExitCode::Success;
+    }
+    let fail_on_warning = args.fail_on == FailOn::Warning;
+    let ratchet_failed = ratchet_result.as_ref().is_some_and(|r| {
+        !r.passed
+            && (config.ratchet.check == CheckLevel::Error
+                || (config.ratchet.check == CheckLevel::Warn && fail_on_warning))
+    });
+    // Violations left on a passing output are all warnings
+    let warned = fail_on_warning && output.total_violations() > 0;
+    let violations_failed = match args.max_violations {
+        Some(max) => {
+            failing_violations(output, fail_on_warning) > max || failed_without_violations(output)
+        }
+        None => !output.passed || warned,
+    };
     if args.dry_run {
-        ExitCode::Success
-    } else if !output.passed || ratchet_failed {
+        // Fixes --fix would make fail the run. The baseline isn't updated,
+        // so the ratchet doesn't apply.
+        let fixes_pending = output.checks.iter().any(|check| check.fixed);
+        if fixes_pending || violations_failed {
+            ExitCode::CheckFailed
+        } else {
+            ExitCode::Success
+        }
+    } else if violations_failed || ratchet_failed {
         ExitCode::CheckFailed
     } else {
         ExitCode::Success
     }
 }
 
+/// Violations that fail the run at `--fail-on`: errors in failing checks,
+/// and warnings too with `--fail-on warning`.
+fn failing_violations(output: &quench::check::CheckOutput, fail_on_warning: bool) -> usize {
+    output
+        .checks
+        .iter()
+        .filter(|check| !check.skipped)
+        .flat_map(|check| {
+            check
+                .violations
+                .iter()
+                .filter(move |v| fail_on_warning || (!check.passed && !v.warning))
+        })
+        .count()
+}
+
+/// Whether a check failed with nothing to count against `--max-violations`.
+fn failed_without_violations(output: &quench::check::CheckOutput) -> bool {
+    output
+        .checks
+        .iter()
+        .any(|check| !check.passed && !check.skipped && check.violations.is_empty())
+}
+
 /// Save metrics output to a JSON file.
 fn save_metrics_to_file(
     path: &std::path::Path,
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Stdin mode (`quench check --stdin --filename <PATH>`).
+//!
+//! Checks an editor's unsaved buffer as if it were the file at `--filename`,
+//! so plugins can lint as the user types. Only the escapes check runs; the
+//! other checks look at the project as a whole rather than one file.
+
+use std::io::Read;
+use std::path::Path;
+
+use quench::adapter::go::matches_build_tags;
+use quench::adapter::project::apply_language_defaults;
+use quench::category;
+use quench::check::SourceBuffer;
+use quench::cli::{CheckArgs, DedupMode, PathStyle};
+use quench::color::color_choice;
+use quench::error::ExitCode;
+use quench::file_size::FileSizeClass;
+use quench::inline_ignore::InlineIgnores;
+use quench::output::FormatOptions;
+use quench::runner::{CheckRunner, RunnerConfig};
+use quench::scan;
+use quench::severity;
+use quench::verbose::VerboseLogger;
+use quench::walker::WalkedFile;
+
+/// Check the buffer on stdin and print its violations.
+pub(super) fn run(
+    args: &CheckArgs,
+    cwd: &Path,
+    root: &Path,
+    verbose: &VerboseLogger,
+) -> anyhow::Result<ExitCode> {
+    let Some(filename) = &args.filename else {
+        eprintln!("--stdin requires --filename");
+        return Ok(ExitCode::ConfigError);
+    };
+    let mut content = String::new();
+    std::io::stdin().read_to_string(&mut content)?;
+
+    let path = cwd.join(filename);
+    let relative = path.strip_prefix(root).unwrap_or(&path);
+    let size = content.len() as u64;
+    let mut files = vec![WalkedFile {
+        depth: relative.components().count(),
+        path: path.clone(),
+        size,
+        mtime_secs: 0,
+        mtime_nanos: 0,
+        size_class: FileSizeClass::from_size(size),
+    }];
+
+    if !args.build_tags.is_empty() && !matches_build_tags(relative, &content, &args.build_tags) {
+        files.clear();
+    }
+    scan::only_languages(&mut files, &args.only_lang);
+
+    let (mut config, _) = scan::load_config(root)?;
+    if args.lang.is_some() {
+        config.project.language = args.lang;
+    }
+    if args.strict_parse {
+        scan::strict_parse(&mut config);
+    }
+    category::apply(&mut config, &args.enable_category, &args.disable_category);
+    apply_language_defaults(root, &mut config);
+    let ignores = InlineIgnores::from_source(relative, &content);
+    verbose.log(&format!(
+        "Stdin: checking {} bytes as {}",
+        size,
+        relative.display()
+    ));
+
+    let buffer = SourceBuffer { path, content };
+    let runner = CheckRunner::new(RunnerConfig {
+        // Suppressed violations are filtered after checking
+        limit: None,
+        changed_files: None,
+        fix: false,
+        dry_run: false,
+        fix_preview: None,
+        ci_mode: args.ci,
+        base_branch: None,
+        staged: false,
+        verbose: verbose.is_enabled(),
+        stdin: Some(buffer.clone()),
+        rules: None,
+        progress: None,
+        stream: None,
+    });
+    let mut output = scan::with_jobs(args.jobs, || {
+        scan::run_checks(
+            &runner,
+            root,
+            &config,
+            &files,
+            &["escapes".to_string()],
+            &[],
+        )
+    })?;
+
+    // Only escapes ran, so directives for other checks would look unused
+    let (_, warnings) = ignores.apply(&mut output, false);
+    if !args.quiet {
+        for warning in &warnings {
+            eprintln!("quench: warning: {}", warning);
+        }
+    }
+    severity::apply(&config, &mut output);
+    if let Some(ref baseline_path) = args.baseline {
+        super::apply_violation_baseline(
+            args,
+            root,
+            baseline_path,
+            Some(&buffer),
+            &mut output,
+            verbose,
+        )?;
+    }
+
+    if args.dedup == DedupMode::Line {
+        output.dedup_lines();
+    }
+    if args.path_style == PathStyle::Absolute {
+        output.absolutize_paths(root);
+    }
+    let options = FormatOptions {
+        limit: super::effective_limit(args),
+        limit_per_rule: args.limit_per_rule,
+        group: args.group,
+    };
+    super::format_output(
+        args,
+        &output,
+        &None,
+        &config,
+        color_choice(args.color),
+        options,
+        None,
+        None,
+    )?;
+    Ok(super::determine_exit_code(args, &output, &None, &config))
+}
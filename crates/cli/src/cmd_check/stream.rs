@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! `check --stream`: write escapes violations as each file is scanned.
+//!
+//! Violations get the same severity, rule paths, `--dedup` and `--paths`
+//! treatment as the final report; the report then skips the ones already
+//! written.
+
+use std::path::Path;
+use std::sync::{Arc, Mutex};
+
+use quench::check::{CheckOutput, CheckResult, Violation};
+use quench::cli::{CheckArgs, DedupMode, GroupBy, OutputFormat, PathStyle, ViolationFormat};
+use quench::config::{CheckLevel, Config};
+use quench::output::github;
+use quench::output::text::TextFormatter;
+use quench::progress::Progress;
+use quench::severity;
+use quench::stream::ViolationStream;
+
+/// The check that reports violations per file.
+pub const STREAMED_CHECK: &str = "escapes";
+
+/// Whether to stream: only for the text report (not grouped or limited by
+/// rule, which need every violation first) and GitHub annotations on stdout,
+/// and only when no violation is filtered after checking or fixed.
+pub fn enabled(args: &CheckArgs, format: Option<ViolationFormat>, filtered: bool) -> bool {
+    let streamable = match format {
+        Some(ViolationFormat::Github) => true,
+        Some(_) => false,
+        None => {
+            args.output_format() == OutputFormat::Text
+                && args.group != GroupBy::Rule
+                && args.limit_per_rule.is_none()
+        }
+    };
+    args.stream
+        && streamable
+        && args.output_file().is_none()
+        && !filtered
+        && !args.fix
+        && !args.quiet
+}
+
+/// What the stream has written.
+pub struct Written {
+    /// Text report formatter, to continue the report with (None for GitHub
+    /// annotations).
+    pub formatter: Option<TextFormatter>,
+    /// Violations written.
+    pub count: usize,
+}
+
+/// A stream and the state its writer shares with the report.
+pub struct Stream {
+    pub stream: Arc<ViolationStream>,
+    written: Arc<Mutex<Written>>,
+}
+
+impl Stream {
+    /// Stream to `formatter`, or as GitHub annotations when it's None.
+    pub fn new(
+        args: &CheckArgs,
+        root: &Path,
+        config: &Config,
+        formatter: Option<TextFormatter>,
+        progress: Option<Arc<Progress>>,
+    ) -> Self {
+        let written = Arc::new(Mutex::new(Written {
+            formatter,
+            count: 0,
+        }));
+        let levels = severity::rule_levels(config);
+        let paths = severity::RulePaths::new(config);
+        let warn = config.check.escapes.check == CheckLevel::Warn;
+        let dedup = args.dedup == DedupMode::Line;
+        let root = (args.path_style == PathStyle::Absolute).then(|| root.to_path_buf());
+
+        let state = Arc::clone(&written);
+        let stream = ViolationStream::new(Box::new(move |violations: &[Violation]| {
+            let violations: Vec<Violation> = violations
+                .iter()
+                .filter(|v| paths.keeps(v))
+                .filter_map(|v| {
+                    let default = if warn || v.warning {
+                        CheckLevel::Warn
+                    } else {
+                        CheckLevel::Error
+                    };
+                    let level = severity::violation_level(&levels, v, default);
+                    let mut v = v.clone();
+                    // A warn-level check's violations are already warnings
+                    v.warning = level == CheckLevel::Warn && !warn;
+                    (level != CheckLevel::Off).then_some(v)
+                })
+                .collect();
+            let result = if warn {
+                CheckResult::passed_with_warnings(STREAMED_CHECK, violations)
+            } else {
+                CheckResult::failed(STREAMED_CHECK, violations)
+            };
+            let mut output = CheckOutput::new(String::new(), vec![result]);
+            if dedup {
+                output.dedup_lines();
+            }
+            if let Some(ref root) = root {
+                output.absolutize_paths(root);
+            }
+            let count = output.total_violations();
+            if count == 0 {
+                return;
+            }
+
+            // Clear the progress line; the next file redraws it
+            if let Some(ref progress) = progress {
+                progress.finish();
+            }
+            let Ok(mut state) = state.lock() else {
+                return;
+            };
+            state.count += count;
+            match state.formatter {
+                Some(ref mut formatter) => {
+                    for result in &output.checks {
+                        let warn = warn || result.violations.iter().all(|v| v.warning);
+                        let _ = formatter.write_streamed(&result.name, &result.violations, warn);
+                    }
+                }
+                None => print!("{}", github::render(&output)),
+            }
+        }));
+
+        Self {
+            stream: Arc::new(stream),
+            written,
+        }
+    }
+
+    /// Take what was written, once the scan is done.
+    pub fn finish(&self) -> Written {
+        match self.written.lock() {
+            Ok(mut written) => Written {
+                formatter: written.formatter.take(),
+                count: written.count,
+            },
+            Err(_) => Written {
+                formatter: None,
+                count: 0,
+            },
+        }
+    }
+}
+
+/// `output` without the first `count` violations of the streamed check,
+/// which the stream already wrote.
+pub fn remaining(output: &CheckOutput, count: usize) -> CheckOutput {
+    let mut remaining = output.clone();
+    for result in &mut remaining.checks {
+        if result.name == STREAMED_CHECK {
+            let count = count.min(result.violations.len());
+            result.violations.drain(..count);
+        }
+    }
+    remaining
+}
@@ -3,11 +3,12 @@
 
 //! Verbose logging helpers for the check command.
 
+use std::collections::BTreeMap;
 use std::sync::Arc;
 
 use quench::adapter::{
-    detect_all_languages, detect_language, patterns::correlation_exclude_defaults,
-    resolve_project_patterns,
+    ProjectLanguage, detect_all_languages, language_for_file,
+    patterns::correlation_exclude_defaults, project_language, resolve_project_patterns,
 };
 use quench::cache::FileCache;
 use quench::cli::CheckArgs;
@@ -33,16 +34,21 @@ pub(super) fn config(
         }
         None => verbose.log("Config: (defaults)"),
     }
-    let langs = detect_all_languages(root);
-    let lang_display: Vec<String> = langs.iter().map(|l| l.to_string()).collect();
-    verbose.log(&format!("Language(s): {}", lang_display.join(", ")));
+    match config.project.language {
+        Some(lang) => verbose.log(&format!("Language(s): {} (override)", lang)),
+        None => {
+            let langs = detect_all_languages(root);
+            let lang_display: Vec<String> = langs.iter().map(|l| l.to_string()).collect();
+            verbose.log(&format!("Language(s): {}", lang_display.join(", ")));
+        }
+    }
 
     let resolved = resolve_project_patterns(root, config);
     patterns(verbose, "project.source", &resolved.source);
     patterns(verbose, "project.tests", &resolved.test);
     patterns(verbose, "project.exclude", exclude_patterns);
 
-    let lang = detect_language(root);
+    let lang = project_language(root, config);
     let corr_exclude = if config.check.tests.commit.exclude.is_empty() {
         correlation_exclude_defaults(lang)
     } else {
@@ -53,6 +59,7 @@ pub(super) fn config(
 
 pub(super) fn discovery(
     verbose: &VerboseLogger,
+    root: &std::path::Path,
     args: &CheckArgs,
     files: &[quench::walker::WalkedFile],
     stats: &quench::walker::WalkStats,
@@ -61,14 +68,54 @@ pub(super) fn discovery(
         return;
     }
     verbose.section("Discovery");
+    if !args.paths.is_empty() {
+        let paths: Vec<String> = args.paths.iter().map(|p| p.display().to_string()).collect();
+        verbose.log(&format!("Paths: {}", paths.join(", ")));
+    }
     verbose.log(&format!("Max depth limit: {}", args.max_depth));
+    let size_limit = match args.max_file_size {
+        0 => "none".to_string(),
+        limit => quench::file_size::human_size(limit, false),
+    };
+    verbose.log(&format!("Max file size: {}", size_limit));
     verbose.log(&format!(
-        "Scanned {} files ({} errors, {} symlink loops, {} skipped >10MB)",
+        "Scanned {} files ({} errors, {} symlink loops, {} too large)",
         files.len(),
         stats.errors,
         stats.symlink_loops,
         stats.files_skipped_size,
     ));
+    for skipped in &stats.skipped {
+        let display = skipped.path.strip_prefix(root).unwrap_or(&skipped.path);
+        verbose.log(&format!(
+            "Skipped: {} ({})",
+            display.display(),
+            skipped.reason
+        ));
+    }
+}
+
+/// The adapter each file extension is dispatched to (`-vv`).
+pub(super) fn adapters(verbose: &VerboseLogger, files: &[quench::walker::WalkedFile]) {
+    if !verbose.is_debug() {
+        return;
+    }
+    verbose.section("Adapters");
+    let mut by_extension: BTreeMap<String, (Option<ProjectLanguage>, usize)> = BTreeMap::new();
+    for file in files {
+        let extension = match file.path.extension() {
+            Some(ext) => format!(".{}", ext.to_string_lossy().to_ascii_lowercase()),
+            None => "(no extension)".to_string(),
+        };
+        by_extension
+            .entry(extension)
+            .or_insert((language_for_file(&file.path), 0))
+            .1 += 1;
+    }
+    for (extension, (language, count)) in by_extension {
+        let adapter = language.map_or_else(|| "none".to_string(), |l| l.to_string());
+        verbose.debug(&format!("{}: {} ({} files)", extension, adapter, count));
+    }
 }
 
 pub(super) fn suites(verbose: &VerboseLogger, config: &config::Config) {
@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Watch mode (`quench check --watch`).
+//!
+//! Re-runs the check whenever files under the root change. The file cache
+//! is kept across cycles, so only files whose metadata changed are checked
+//! again. Each cycle prints the violations that appeared or went away.
+
+use std::path::{Path, PathBuf};
+use std::sync::Arc;
+use std::sync::atomic::{AtomicBool, Ordering};
+use std::sync::mpsc::{self, Receiver};
+
+use notify::{Event, EventKind, RecursiveMode, Watcher};
+
+use quench::cache::{self, CACHE_FILE_NAME, FileCache};
+use quench::category;
+use quench::check::CheckOutput;
+use quench::cli::{CheckArgs, DedupMode, PathStyle};
+use quench::error::ExitCode;
+use quench::inline_ignore::InlineIgnores;
+use quench::output::violations::{ViolationRecord, collect_records};
+use quench::runner::{CheckRunner, RunnerConfig};
+use quench::scan;
+use quench::severity;
+use quench::verbose::VerboseLogger;
+use quench::watch::{DEBOUNCE, Delta, is_watched, next_batch};
+
+/// Watch the root and re-check on changes until Ctrl-C.
+///
+/// With path arguments, only files in `scope` are checked.
+pub(super) fn run(
+    args: &CheckArgs,
+    root: &Path,
+    scope: &[PathBuf],
+    verbose: &VerboseLogger,
+) -> anyhow::Result<ExitCode> {
+    let stop = Arc::new(AtomicBool::new(false));
+    let handler_stop = Arc::clone(&stop);
+    ctrlc::set_handler(move || handler_stop.store(true, Ordering::SeqCst))?;
+
+    let (tx, rx) = mpsc::channel();
+    let mut watcher = notify::recommended_watcher(tx)?;
+    watcher.watch(root, RecursiveMode::Recursive)?;
+    // Event paths are canonical; compare against a canonical root
+    let watch_root = root.canonicalize().unwrap_or_else(|_| root.to_path_buf());
+
+    let mut cache = None;
+    let mut previous: Vec<ViolationRecord> = Vec::new();
+    let mut changed: Vec<PathBuf> = Vec::new();
+    loop {
+        match check_once(args, root, scope, &mut cache, verbose) {
+            Ok(output) => {
+                let current = collect_records(&output);
+                let delta = Delta::between(&previous, &current);
+                report_cycle(&watch_root, &changed, &delta, current.len());
+                previous = current;
+            }
+            // A half-written config shouldn't end the session
+            Err(e) => eprintln!("quench: {}", e),
+        }
+        if changed.is_empty() {
+            println!("quench: watching {} (Ctrl-C to stop)", root.display());
+        }
+
+        match wait_for_changes(&rx, &stop, &watch_root) {
+            Some(paths) => changed = paths,
+            None => break,
+        }
+    }
+
+    if let Some((_, cache)) = cache {
+        persist_cache(&cache, root);
+    }
+    println!("quench: stopped watching");
+    Ok(ExitCode::Success)
+}
+
+/// Run one check cycle, applying the same filters as a normal run.
+fn check_once(
+    args: &CheckArgs,
+    root: &Path,
+    scope: &[PathBuf],
+    cache: &mut Option<(u64, Arc<FileCache>)>,
+    verbose: &VerboseLogger,
+) -> anyhow::Result<CheckOutput> {
+    // Reload config each cycle so edits to quench.toml apply
+    let (mut config, _) = scan::load_config(root)?;
+    if args.lang.is_some() {
+        config.project.language = args.lang;
+    }
+    if args.strict_parse {
+        scan::strict_parse(&mut config);
+    }
+    category::apply(&mut config, &args.enable_category, &args.disable_category);
+    config
+        .project
+        .exclude
+        .add_ignores(&args.ignore, args.keep_defaults);
+    let mut walker_config = scan::walker_config(root, &mut config, args.max_depth, args.jobs);
+    walker_config.git_ignore = !args.no_gitignore;
+    walker_config.max_file_size = args.max_file_size;
+    walker_config.scope = scope.to_vec();
+    let (mut files, _) = scan::discover_files(root, walker_config);
+    if !args.include_generated {
+        scan::skip_generated(&mut files);
+    }
+    scan::skip_build_constrained(&mut files, &args.build_tags);
+    scan::only_languages(&mut files, &args.only_lang);
+    scan::load_directory_configs(root, &files, &mut config)?;
+    let ignores = InlineIgnores::collect(root, &files);
+
+    let base_branch = super::resolve_base_branch(args, root);
+    let changed_files = super::resolve_changed_files(args, root, &base_branch, verbose);
+    let diff_scope = super::resolve_diff_scope(args, root, verbose);
+
+    let mut runner = CheckRunner::new(RunnerConfig {
+        limit: None,
+        changed_files,
+        fix: false,
+        dry_run: false,
+        fix_preview: None,
+        ci_mode: args.ci,
+        base_branch,
+        staged: args.staged,
+        verbose: verbose.is_enabled(),
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
+    });
+
+    // A config change invalidates every cached result
+    let config_hash = cache::hash_config(&config);
+    if cache.as_ref().is_none_or(|(hash, _)| *hash != config_hash) {
+        *cache = super::setup_cache(args, root, &config, false)?.map(|c| (config_hash, c));
+    }
+    if let Some((_, file_cache)) = cache {
+        runner = runner.with_cache(Arc::clone(file_cache));
+    }
+
+    let mut output = scan::with_jobs(args.jobs, || {
+        scan::run_checks(
+            &runner,
+            root,
+            &config,
+            &files,
+            &args.enabled_checks(),
+            &args.disabled_checks(),
+        )
+    })?;
+
+    super::apply_inline_ignores(args, &ignores, &mut output, verbose);
+    severity::apply(&config, &mut output);
+    if let Some(scope) = diff_scope {
+        scope.apply(&mut output);
+    }
+    if let Some(ref baseline_path) = args.baseline {
+        super::apply_violation_baseline(args, root, baseline_path, None, &mut output, verbose)?;
+    }
+    if args.dedup == DedupMode::Line {
+        output.dedup_lines();
+    }
+    if args.path_style == PathStyle::Absolute {
+        output.absolutize_paths(root);
+    }
+    Ok(output)
+}
+
+/// Wait for a debounced batch of changes that should trigger a re-run.
+///
+/// Returns None on Ctrl-C.
+fn wait_for_changes(
+    rx: &Receiver<notify::Result<Event>>,
+    stop: &AtomicBool,
+    root: &Path,
+) -> Option<Vec<PathBuf>> {
+    loop {
+        let mut paths: Vec<PathBuf> = Vec::new();
+        for event in next_batch(rx, DEBOUNCE, stop)? {
+            let event = match event {
+                Ok(event) => event,
+                Err(e) => {
+                    tracing::warn!("watch error: {}", e);
+                    continue;
+                }
+            };
+            if matches!(event.kind, EventKind::Access(_)) {
+                continue;
+            }
+            for path in event.paths {
+                if is_watched(root, &path) && !paths.contains(&path) {
+                    paths.push(path);
+                }
+            }
+        }
+        if !paths.is_empty() {
+            return Some(paths);
+        }
+    }
+}
+
+/// Print the cycle's changes.
+fn report_cycle(root: &Path, changed: &[PathBuf], delta: &Delta, total: usize) {
+    let trigger = match changed {
+        [] => {
+            println!("quench: {} violations", total);
+            print!("{}", delta);
+            return;
+        }
+        [path] => path
+            .strip_prefix(root)
+            .unwrap_or(path)
+            .display()
+            .to_string(),
+        paths => format!("{} files", paths.len()),
+    };
+
+    if delta.is_empty() {
+        println!(
+            "quench: {} changed: no new violations ({} total)",
+            trigger, total
+        );
+    } else {
+        println!(
+            "quench: {} changed: {} new, {} resolved ({} total)",
+            trigger,
+            delta.new.len(),
+            delta.resolved.len(),
+            total
+        );
+        print!("{}", delta);
+    }
+}
+
+/// Save the cache on exit so the next run starts warm.
+fn persist_cache(cache: &FileCache, root: &Path) {
+    let cache_dir = root.join(".quench");
+    if let Err(e) = std::fs::create_dir_all(&cache_dir) {
+        tracing::warn!("failed to create cache directory: {}", e);
+        return;
+    }
+    if let Err(e) = cache.persist(&cache_dir.join(CACHE_FILE_NAME)) {
+        tracing::warn!("failed to persist cache: {}", e);
+    }
+}
@@ -520,13 +520,10 @@ fn kind_order(kind: FileKind) -> u8 {
 
 /// Determine which package a file belongs to based on its path prefix.
 fn file_package(path: &Path, packages: &[String]) -> Option<String> {
-    for pkg in packages {
-        if pkg == "." {
-            return Some(pkg.clone());
-        }
-        if path.starts_with(pkg) {
-            return Some(pkg.clone());
-        }
-    }
-    None
+    // Innermost match wins; "." claims whatever is left
+    packages
+        .iter()
+        .filter(|pkg| pkg.as_str() == "." || path.starts_with(pkg.as_str()))
+        .max_by_key(|pkg| if pkg.as_str() == "." { 0 } else { pkg.len() })
+        .cloned()
 }
@@ -99,6 +99,7 @@ fn load_baseline_for_ref(
                 version: quench::baseline::BASELINE_VERSION,
                 updated: latest.updated,
                 commit: latest.commit,
+                quench_version: None,
                 metrics: extract_baseline_metrics(&latest.output),
             }));
         }
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! `quench rules` command implementation.
+//!
+//! Lists the escapes check's rules from the rule catalog, grouped by
+//! language, with their default severity, category, and required
+//! justification marker.
+
+use std::fmt::Write;
+
+use quench::checks::escapes::{RuleInfo, rule_catalog};
+use quench::cli::{RulesArgs, RulesFormat};
+use quench::error::ExitCode;
+
+/// Run the `quench rules` command.
+pub fn run(args: &RulesArgs) -> anyhow::Result<ExitCode> {
+    let rules = rule_catalog();
+    match args.format {
+        RulesFormat::Text => print!("{}", format_text(&rules)),
+        RulesFormat::Json => println!("{}", serde_json::to_string_pretty(&rules)?),
+    }
+    Ok(ExitCode::Success)
+}
+
+/// Aligned table with a heading per language; custom rules come last.
+fn format_text(rules: &[RuleInfo]) -> String {
+    let id_width = rules.iter().map(|r| r.id.len()).max().unwrap_or(0);
+    let marker_width = rules
+        .iter()
+        .map(|r| r.marker.as_deref().map_or(1, str::len))
+        .max()
+        .unwrap_or(0);
+
+    let mut out = String::new();
+    let mut group: Option<Option<&str>> = None;
+    for rule in rules {
+        let language = rule.language.as_deref();
+        if group != Some(language) {
+            if group.is_some() {
+                out.push('\n');
+            }
+            let _ = writeln!(out, "{}:", language.unwrap_or("custom"));
+            group = Some(language);
+        }
+        let _ = writeln!(
+            out,
+            "  {:<id_width$}  {:<7}  {:<11}  {:<marker_width$}  {}",
+            rule.id,
+            rule.severity,
+            rule.category.unwrap_or("-"),
+            rule.marker.as_deref().unwrap_or("-"),
+            rule.description,
+        );
+    }
+    out
+}
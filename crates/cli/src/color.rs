@@ -4,6 +4,7 @@
 //! Color detection and terminal styling.
 //!
 //! Detection logic per docs/specs/03-output.md#colorization:
+//! 0. `--color always|never` → as given (check output only)
 //! 1. NO_COLOR env var → no color
 //! 2. COLOR env var → use color
 //! 3. default:
@@ -19,6 +20,8 @@ use std::io::IsTerminal;
 use std::sync::OnceLock;
 use termcolor::ColorChoice;
 
+use crate::cli::ColorMode;
+
 /// ANSI 256-color codes for terminal styling.
 pub mod codes {
     /// Section headers: pastel cyan/steel blue
@@ -417,6 +420,18 @@ pub fn resolve_color() -> ColorChoice {
     ColorChoice::Auto
 }
 
+/// Resolve the color choice for a `--color` flag.
+///
+/// `always` and `never` override the environment; `auto` defers to
+/// [`resolve_color`].
+pub fn color_choice(mode: ColorMode) -> ColorChoice {
+    match mode {
+        ColorMode::Always => ColorChoice::Always,
+        ColorMode::Never => ColorChoice::Never,
+        ColorMode::Auto => resolve_color(),
+    }
+}
+
 /// Color scheme for output per spec.
 pub mod scheme {
     use termcolor::{Color, ColorSpec};
@@ -463,10 +478,24 @@ pub mod scheme {
         spec
     }
 
-    /// Cyan file path.
+    /// Dimmed file path.
     pub fn path() -> ColorSpec {
         let mut spec = ColorSpec::new();
-        spec.set_fg(Some(Color::Cyan));
+        spec.set_dimmed(true);
+        spec
+    }
+
+    /// Red error violation.
+    pub fn error() -> ColorSpec {
+        let mut spec = ColorSpec::new();
+        spec.set_fg(Some(Color::Red));
+        spec
+    }
+
+    /// Yellow warning violation.
+    pub fn warning() -> ColorSpec {
+        let mut spec = ColorSpec::new();
+        spec.set_fg(Some(Color::Yellow));
         spec
     }
 
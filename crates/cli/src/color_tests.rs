@@ -34,9 +34,25 @@ fn scheme_pass_is_green_bold() {
 }
 
 #[test]
-fn scheme_path_is_cyan() {
+fn scheme_path_is_dimmed() {
     let spec = scheme::path();
-    assert_eq!(spec.fg(), Some(&Color::Cyan));
+    assert!(spec.dimmed());
+    assert!(spec.fg().is_none());
+}
+
+#[test]
+fn scheme_severities_are_red_and_yellow() {
+    assert_eq!(scheme::error().fg(), Some(&Color::Red));
+    assert_eq!(scheme::warning().fg(), Some(&Color::Yellow));
+}
+
+#[test]
+fn color_flag_overrides_detection() {
+    assert!(matches!(
+        color_choice(ColorMode::Always),
+        ColorChoice::Always
+    ));
+    assert!(matches!(color_choice(ColorMode::Never), ColorChoice::Never));
 }
 
 #[test]
@@ -3,10 +3,10 @@
 
 //! Check-specific configuration structures.
 
-use std::collections::HashMap;
+use std::collections::{BTreeMap, HashMap};
 
-use serde::Deserialize;
 use serde::de::{self, Deserializer};
+use serde::{Deserialize, Serialize};
 
 use crate::config::{ContentRule, RequiredSection, deserialize_optional_usize};
 
@@ -283,7 +283,7 @@ pub struct SpecsSectionsConfig {
 }
 
 /// Escapes check configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize, Serialize)]
 #[serde(deny_unknown_fields)]
 pub struct EscapesConfig {
     /// Check level: error, warn, or off.
@@ -297,10 +297,55 @@ pub struct EscapesConfig {
     /// Patterns to detect (overrides defaults).
     #[serde(default)]
     pub patterns: Vec<EscapePattern>,
+
+    /// Required comment markers by pattern name, replacing the pattern's
+    /// `comment`. Alternatives are separated by `|` (e.g., "// SAFETY:|// JUSTIFY:").
+    #[serde(default)]
+    pub comments: BTreeMap<String, String>,
+
+    /// TODO/FIXME issue reference policy.
+    #[serde(default)]
+    pub todo: TodoConfig,
+}
+
+/// TODO/FIXME issue reference policy (off by default).
+///
+/// Flags `TODO` and `FIXME` in comments without a tracker reference in
+/// parentheses or brackets directly after them (`TODO(JIRA-123)`).
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct TodoConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "TodoConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Regex for the reference inside the parentheses or brackets
+    /// (default: Jira-style keys like `JIRA-123`; `#[0-9]+` for GitHub).
+    #[serde(default = "TodoConfig::default_reference")]
+    pub reference: String,
+}
+
+impl Default for TodoConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            reference: Self::default_reference(),
+        }
+    }
+}
+
+impl TodoConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+
+    pub(crate) fn default_reference() -> String {
+        "[A-Z][A-Z0-9]+-[0-9]+".to_string()
+    }
 }
 
 /// A single escape hatch pattern definition.
-#[derive(Debug, Clone, Deserialize)]
+#[derive(Debug, Clone, Deserialize, Serialize)]
 #[serde(deny_unknown_fields)]
 pub struct EscapePattern {
     /// Unique name for this pattern (e.g., "unwrap", "unsafe").
@@ -316,6 +361,7 @@ pub struct EscapePattern {
     pub action: EscapeAction,
 
     /// Required comment pattern for action = "comment".
+    /// Alternatives are separated by `|`; any one is accepted.
     #[serde(default)]
     pub comment: Option<String>,
 
@@ -348,7 +394,7 @@ impl EscapePattern {
 }
 
 /// Action to take when pattern is matched.
-#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, Deserialize)]
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, Deserialize, Serialize)]
 #[serde(rename_all = "lowercase")]
 pub enum EscapeAction {
     #[default]
@@ -369,7 +415,7 @@ pub enum LineMetric {
 }
 
 /// Cloc check configuration.
-#[derive(Debug, Deserialize)]
+#[derive(Debug, Clone, Deserialize)]
 #[serde(deny_unknown_fields)]
 pub struct ClocConfig {
     /// Maximum lines per file (default: 750).
@@ -458,11 +504,12 @@ impl ClocConfig {
 }
 
 /// Check level: error, warn, or off.
-#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, Hash, Deserialize)]
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq, Hash, Deserialize, Serialize)]
 #[serde(rename_all = "lowercase")]
 pub enum CheckLevel {
     #[default]
     Error,
+    #[serde(alias = "warning")]
     Warn,
     Off,
 }
@@ -492,7 +539,7 @@ where
 ///
 /// Allows overriding the global cloc.check level and advice per language.
 /// Unset fields inherit from [check.cloc].
-#[derive(Debug, Clone, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize, Serialize)]
 #[serde(deny_unknown_fields)]
 pub struct LangClocConfig {
     /// Check level: error, warn, or off.
@@ -128,6 +128,19 @@ fn escapes_config_defaults() {
     assert!(config.check.escapes.patterns.is_empty());
 }
 
+#[test]
+fn escapes_todo_defaults_to_off_with_jira_references() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.check.escapes.todo.check, CheckLevel::Off);
+    assert_eq!(config.check.escapes.todo.reference, "[A-Z][A-Z0-9]+-[0-9]+");
+
+    let config = parse_config(
+        "version = 1\n[check.escapes.todo]\ncheck = \"warn\"\nreference = \"#[0-9]+\"\n",
+    );
+    assert_eq!(config.check.escapes.todo.check, CheckLevel::Warn);
+    assert_eq!(config.check.escapes.todo.reference, "#[0-9]+");
+}
+
 #[test]
 fn escapes_config_with_patterns() {
     let config = parse_config(
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Per-directory rule overrides.
+//!
+//! A quench.toml or .quench.yml below the project root that sets only
+//! `[severity]` and `[rules]` overrides rule severity for the files in its
+//! subtree; every other setting comes from the root config. Overrides merge
+//! over the root config and the nested configs between, and the nearest
+//! directory wins. A config that sets anything else belongs to a separate
+//! project (a monorepo member, a test fixture) and is left alone.
+
+use std::collections::HashMap;
+use std::path::{Path, PathBuf};
+
+use serde::Deserialize;
+
+use super::{CheckLevel, RuleConfig, SUPPORTED_VERSION, VersionOnly, is_yaml_config, yaml};
+use crate::error::{Error, Result};
+
+/// Top-level keys a rule overrides file may set.
+const DIRECTORY_KEYS: &[&str] = &["version", "severity", "rules"];
+
+/// Rule overrides from a nested config file.
+#[derive(Debug, Clone, Default, Deserialize)]
+#[serde(deny_unknown_fields)]
+pub struct DirectoryConfig {
+    /// Directory the overrides apply to, relative to the project root.
+    #[serde(skip)]
+    pub dir: PathBuf,
+
+    /// Config file version (must be 1).
+    pub version: i64,
+
+    /// Per-rule severity overrides, keyed like the root `[severity]`.
+    #[serde(default)]
+    pub severity: HashMap<String, CheckLevel>,
+
+    /// Per-rule settings, keyed like the root `[rules.<rule>]`.
+    #[serde(default)]
+    pub rules: HashMap<String, RuleConfig>,
+}
+
+/// A config file below the project root.
+#[derive(Debug)]
+pub enum NestedConfig {
+    /// Rule overrides for its directory.
+    Directory(DirectoryConfig),
+    /// A separate project's config: it sets more than rules, or doesn't parse.
+    Project,
+}
+
+/// Load a nested config whose overrides apply to `dir`.
+pub fn load_directory(path: &Path, dir: PathBuf) -> Result<NestedConfig> {
+    let content = std::fs::read_to_string(path).map_err(|e| Error::Io {
+        path: path.to_path_buf(),
+        source: e,
+    })?;
+    let mut nested = parse_nested(&content, path)?;
+    if let NestedConfig::Directory(ref mut config) = nested {
+        config.dir = dir;
+    }
+    Ok(nested)
+}
+
+/// Parse nested config content, as YAML for `.yml`/`.yaml` paths and TOML
+/// otherwise.
+///
+/// Only files setting nothing but `version`, `severity`, and `rules` are
+/// rule overrides, and those are validated like the root config.
+pub fn parse_nested(content: &str, path: &Path) -> Result<NestedConfig> {
+    let yaml = is_yaml_config(path);
+    let keys: Option<Vec<String>> = if yaml {
+        serde_yaml::from_str::<serde_yaml::Mapping>(content)
+            .ok()
+            .map(|table| {
+                table
+                    .keys()
+                    .filter_map(|key| key.as_str().map(str::to_string))
+                    .collect()
+            })
+    } else {
+        toml::from_str::<toml::Table>(content)
+            .ok()
+            .map(|table| table.keys().cloned().collect())
+    };
+    let overrides = keys.is_some_and(|keys| {
+        keys.iter()
+            .all(|key| DIRECTORY_KEYS.contains(&key.as_str()))
+    });
+    if !overrides {
+        return Ok(NestedConfig::Project);
+    }
+
+    let config_error = |message: String| Error::Config {
+        message,
+        path: Some(path.to_path_buf()),
+    };
+    let version_check: VersionOnly = if yaml {
+        serde_yaml::from_str(content).map_err(|e| yaml::format_yaml_error(content, &e))
+    } else {
+        toml::from_str(content).map_err(|e| e.to_string())
+    }
+    .map_err(config_error)?;
+
+    let version = version_check
+        .version
+        .ok_or_else(|| config_error("missing required field: version".to_string()))?;
+    if version != SUPPORTED_VERSION {
+        return Err(config_error(format!(
+            "unsupported config version {} (supported: {})\n  Upgrade quench to use this config.",
+            version, SUPPORTED_VERSION
+        )));
+    }
+
+    let config = if yaml {
+        serde_yaml::from_str(content).map_err(|e| yaml::format_yaml_error(content, &e))
+    } else {
+        toml::from_str(content).map_err(|e| e.to_string())
+    }
+    .map_err(config_error)?;
+    Ok(NestedConfig::Directory(config))
+}
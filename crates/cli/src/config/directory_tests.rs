@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+#![allow(clippy::unwrap_used, clippy::expect_used, clippy::panic)]
+use super::*;
+use std::path::PathBuf;
+
+fn overrides(content: &str, name: &str) -> DirectoryConfig {
+    match parse_nested(content, &PathBuf::from(name)) {
+        Ok(NestedConfig::Directory(config)) => config,
+        other => panic!("expected rule overrides, got {:?}", other),
+    }
+}
+
+fn nested_error(content: &str, name: &str) -> String {
+    match parse_nested(content, &PathBuf::from(name)) {
+        Err(Error::Config { message, .. }) => message,
+        other => panic!("expected config error, got {:?}", other),
+    }
+}
+
+#[test]
+fn parses_yaml_rule_overrides() {
+    let config = overrides(
+        "version: 1\nseverity:\n  unsafe_pointer: error\nrules:\n  linkname:\n    severity: \"off\"\n",
+        "internal/.quench.yml",
+    );
+    assert_eq!(config.severity["unsafe_pointer"], CheckLevel::Error);
+    assert_eq!(config.rules["linkname"].severity, Some(CheckLevel::Off));
+}
+
+#[test]
+fn parses_toml_rule_overrides() {
+    let config = overrides(
+        "version = 1\n\n[severity]\nunsafe_pointer = \"warn\"\n",
+        "cmd/quench.toml",
+    );
+    assert_eq!(config.severity["unsafe_pointer"], CheckLevel::Warn);
+    assert!(config.rules.is_empty());
+}
+
+#[test]
+fn config_with_other_settings_is_a_project() {
+    let nested = parse_nested(
+        "version: 1\ngolang:\n  sleep:\n    check: error\n",
+        &PathBuf::from("services/api/.quench.yml"),
+    )
+    .unwrap();
+    assert!(matches!(nested, NestedConfig::Project));
+}
+
+#[test]
+fn unparseable_config_is_a_project() {
+    let nested = parse_nested(
+        "version = 1\n[check.cloc\n",
+        &PathBuf::from("fixtures/quench.toml"),
+    )
+    .unwrap();
+    assert!(matches!(nested, NestedConfig::Project));
+}
+
+#[test]
+fn rejects_invalid_severity() {
+    let message = nested_error(
+        "version: 1\nseverity:\n  unsafe_pointer: loud\n",
+        "internal/.quench.yml",
+    );
+    assert!(message.contains("loud"), "{}", message);
+}
+
+#[test]
+fn requires_version() {
+    let message = nested_error("[severity]\nunsafe_pointer = \"warn\"\n", "quench.toml");
+    assert_eq!(message, "missing required field: version");
+}
@@ -3,13 +3,13 @@
 
 //! Go language-specific configuration.
 
-use serde::Deserialize;
+use serde::{Deserialize, Serialize};
 
 use super::lang_common::{LanguageDefaults, define_policy_config};
 use super::{CheckLevel, LangClocConfig, LintChangesPolicy, SuppressLevel, SuppressScopeConfig};
 
 /// Go language-specific configuration.
-#[derive(Debug, Clone, Deserialize)]
+#[derive(Debug, Clone, Deserialize, Serialize)]
 #[serde(deny_unknown_fields)]
 pub struct GoConfig {
     /// Source file patterns.
@@ -32,6 +32,126 @@ pub struct GoConfig {
     #[serde(default)]
     pub policy: GoPolicyConfig,
 
+    /// Direct syscall import policy.
+    #[serde(default)]
+    pub syscall: GoSyscallConfig,
+
+    /// `panic()` justification policy.
+    #[serde(default)]
+    pub panic: GoPanicConfig,
+
+    /// `recover()` placement rule.
+    #[serde(default)]
+    pub recover: GoRecoverConfig,
+
+    /// `//go:embed` asset policy.
+    #[serde(default)]
+    pub embed: GoEmbedConfig,
+
+    /// `unsafe` introspection policy.
+    #[serde(default, rename = "unsafe")]
+    pub unsafe_: GoUnsafeConfig,
+
+    /// `//go:linkname` push policy.
+    #[serde(default)]
+    pub linkname: GoLinknameConfig,
+
+    /// `math/rand` policy for security-sensitive packages.
+    #[serde(default)]
+    pub weakrand: GoWeakRandConfig,
+
+    /// Discarded error results of configured functions.
+    #[serde(default)]
+    pub errcheck: GoErrcheckConfig,
+
+    /// `time.Sleep` justification policy.
+    #[serde(default)]
+    pub sleep: GoSleepConfig,
+
+    /// `init()` justification policy.
+    #[serde(default)]
+    pub init: GoInitConfig,
+
+    /// Fresh root contexts in request-scoped packages.
+    #[serde(default)]
+    pub context: GoContextConfig,
+
+    /// Hardcoded credential detection.
+    #[serde(default)]
+    pub secrets: GoSecretsConfig,
+
+    /// Uses of the module's deprecated symbols.
+    #[serde(default)]
+    pub deprecated: GoDeprecatedConfig,
+
+    /// Builtin print and println calls.
+    #[serde(default)]
+    pub print: GoPrintConfig,
+
+    /// `any` in exported signatures justification policy.
+    #[serde(default)]
+    pub any: GoAnyConfig,
+
+    /// Goroutine recovery justification policy.
+    #[serde(default)]
+    pub goroutine: GoGoroutineConfig,
+
+    /// Large struct returns by value.
+    #[serde(default)]
+    pub largestruct: GoLargeStructConfig,
+
+    /// `fmt.Sprintf` calls that only concatenate.
+    #[serde(default)]
+    pub sprintf: GoSprintfConfig,
+
+    /// Wall clock reads in deterministic packages.
+    #[serde(default)]
+    pub clock: GoClockConfig,
+
+    /// Requests through the default HTTP client.
+    #[serde(default)]
+    pub http: GoHttpConfig,
+
+    /// Slices built from map iteration and returned unsorted.
+    #[serde(default)]
+    pub maporder: GoMapOrderConfig,
+
+    /// Process exits in library packages.
+    #[serde(default)]
+    pub exit: GoExitConfig,
+
+    /// Map fields accessed without the struct's mutex held.
+    #[serde(default)]
+    pub maplock: GoMapLockConfig,
+
+    /// Error strings that are capitalized or end with punctuation.
+    #[serde(default)]
+    pub errstring: GoErrStringConfig,
+
+    /// `[]any` slices passed to fmt print functions without `...`.
+    #[serde(default)]
+    pub variadic: GoVariadicConfig,
+
+    /// Appends that reuse the backing array of a slice used later.
+    #[serde(default)]
+    pub appendalias: GoAppendAliasConfig,
+
+    /// `sync.WaitGroup` Add calls inside the goroutine they count.
+    #[serde(default)]
+    pub waitgroup: GoWaitGroupConfig,
+
+    /// `context.WithValue` keys of built-in types.
+    #[serde(default)]
+    pub ctxkey: GoCtxKeyConfig,
+
+    /// `defer` statements in a loop body.
+    #[serde(default)]
+    pub deferloop: GoDeferLoopConfig,
+
+    /// `regexp.MustCompile` on patterns built at run time.
+    #[serde(default)]
+    pub mustcompile: GoMustCompileConfig,
+
     /// Per-language cloc settings.
     #[serde(default)]
     pub cloc: Option<LangClocConfig>,
@@ -50,6 +170,36 @@ impl Default for GoConfig {
             exclude: GoDefaults::default_exclude(),
             suppress: GoSuppressConfig::default(),
             policy: GoPolicyConfig::default(),
+            syscall: GoSyscallConfig::default(),
+            panic: GoPanicConfig::default(),
+            recover: GoRecoverConfig::default(),
+            embed: GoEmbedConfig::default(),
+            unsafe_: GoUnsafeConfig::default(),
+            linkname: GoLinknameConfig::default(),
+            weakrand: GoWeakRandConfig::default(),
+            errcheck: GoErrcheckConfig::default(),
+            sleep: GoSleepConfig::default(),
+            init: GoInitConfig::default(),
+            context: GoContextConfig::default(),
+            secrets: GoSecretsConfig::default(),
+            deprecated: GoDeprecatedConfig::default(),
+            print: GoPrintConfig::default(),
+            any: GoAnyConfig::default(),
+            goroutine: GoGoroutineConfig::default(),
+            largestruct: GoLargeStructConfig::default(),
+            sprintf: GoSprintfConfig::default(),
+            clock: GoClockConfig::default(),
+            http: GoHttpConfig::default(),
+            maporder: GoMapOrderConfig::default(),
+            exit: GoExitConfig::default(),
+            maplock: GoMapLockConfig::default(),
+            errstring: GoErrStringConfig::default(),
+            variadic: GoVariadicConfig::default(),
+            appendalias: GoAppendAliasConfig::default(),
+            waitgroup: GoWaitGroupConfig::default(),
+            ctxkey: GoCtxKeyConfig::default(),
+            deferloop: GoDeferLoopConfig::default(),
+            mustcompile: GoMustCompileConfig::default(),
             cloc: None,
             cloc_advice: None,
         }
@@ -106,7 +256,7 @@ impl GoConfig {
 }
 
 /// Go suppress configuration (defaults to "comment" like Rust).
-#[derive(Debug, Clone, Deserialize)]
+#[derive(Debug, Clone, Deserialize, Serialize)]
 #[serde(deny_unknown_fields)]
 pub struct GoSuppressConfig {
     /// Check level: forbid, comment, or allow (default: "comment").
@@ -162,6 +312,912 @@ impl GoSuppressConfig {
     }
 }
 
+/// Direct syscall import policy (off by default).
+///
+/// Flags imports of `syscall` and `golang.org/x/sys/...` outside the
+/// allowlisted packages, so low-level calls go through wrappers.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoSyscallConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoSyscallConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Packages allowed to import syscall packages, as directories relative
+    /// to the project root or import paths. A trailing `/...` covers
+    /// subpackages.
+    #[serde(default)]
+    pub allow: Vec<String>,
+}
+
+impl Default for GoSyscallConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            allow: Vec::new(),
+        }
+    }
+}
+
+impl GoSyscallConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `panic()` justification policy (off by default).
+///
+/// Requires a `// PANIC:` comment on each `panic(...)` call, documenting why
+/// the failure is unrecoverable.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoPanicConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoPanicConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Skip `main` packages and `_test.go` files (default: true).
+    #[serde(default = "GoPanicConfig::default_library_only")]
+    pub library_only: bool,
+
+    /// Allow re-panics in a deferred function that calls `recover()` (default: false).
+    #[serde(default)]
+    pub allow_repanic: bool,
+}
+
+impl Default for GoPanicConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            library_only: Self::default_library_only(),
+            allow_repanic: false,
+        }
+    }
+}
+
+impl GoPanicConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+
+    pub(crate) fn default_library_only() -> bool {
+        true
+    }
+}
+
+/// `recover()` placement rule (error by default).
+///
+/// Flags `recover()` calls that can't stop a panic because no deferred
+/// function calls them directly.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoRecoverConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoRecoverConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoRecoverConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoRecoverConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
+/// `//go:embed` asset policy (off by default).
+///
+/// Flags `//go:embed` directives, optionally only those embedding files that
+/// match sensitive globs, so secrets and large assets aren't compiled in.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoEmbedConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoEmbedConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for sensitive embed patterns (e.g., `*.pem`, `.env*`). Empty
+    /// flags every embed.
+    #[serde(default)]
+    pub sensitive: Vec<String>,
+
+    /// Globs for embed patterns that are always allowed.
+    #[serde(default)]
+    pub allow: Vec<String>,
+}
+
+impl Default for GoEmbedConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            sensitive: Vec::new(),
+            allow: Vec::new(),
+        }
+    }
+}
+
+impl GoEmbedConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `unsafe` introspection policy (off by default).
+///
+/// `unsafe.Sizeof`, `unsafe.Alignof`, and `unsafe.Offsetof` only report
+/// layout and can't break memory safety, so they are allowed unless opted in.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoUnsafeConfig {
+    /// Check level for introspection calls: error, warn, or off (default: "off").
+    #[serde(default = "GoUnsafeConfig::default_introspection")]
+    pub introspection: CheckLevel,
+}
+
+impl Default for GoUnsafeConfig {
+    fn default() -> Self {
+        Self {
+            introspection: Self::default_introspection(),
+        }
+    }
+}
+
+impl GoUnsafeConfig {
+    pub(crate) fn default_introspection() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `//go:linkname` push policy (off by default).
+///
+/// A two-argument directive on a function definition pushes it into the
+/// target's package. Go 1.23 rejects pushes the target package doesn't
+/// expect, so pushes outside the current package can be flagged.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoLinknameConfig {
+    /// Check level for pushes into another package: error, warn, or off (default: "off").
+    #[serde(default = "GoLinknameConfig::default_foreign_push")]
+    pub foreign_push: CheckLevel,
+
+    /// Targets (`importpath.name`) whose directives need no `// LINKNAME:`
+    /// comment, e.g. `runtime.nanotime`. Matched exactly.
+    #[serde(default)]
+    pub allow: Vec<String>,
+}
+
+impl Default for GoLinknameConfig {
+    fn default() -> Self {
+        Self {
+            foreign_push: Self::default_foreign_push(),
+            allow: Vec::new(),
+        }
+    }
+}
+
+impl GoLinknameConfig {
+    pub(crate) fn default_foreign_push() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `math/rand` import policy for security-sensitive packages (off by default).
+///
+/// Flags `math/rand` and `math/rand/v2` imports in packages matching
+/// `packages`, so tokens and keys come from `crypto/rand`. A `// WEAKRAND:`
+/// comment on the import allows it.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoWeakRandConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoWeakRandConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for security-sensitive packages, matched against directories
+    /// relative to the project root and import paths. Empty checks nothing.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoWeakRandConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoWeakRandConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Unchecked error policy (off by default).
+///
+/// Flags calls to the listed functions used as bare statements, which
+/// discard the error they return. Assigning the result, even to `_`, counts
+/// as handling it.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoErrcheckConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoErrcheckConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Functions whose last result is an error: package functions as
+    /// `importpath.Func` (e.g., `os.Remove`), methods by name (e.g., `Close`).
+    #[serde(default)]
+    pub functions: Vec<String>,
+}
+
+impl Default for GoErrcheckConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            functions: Vec::new(),
+        }
+    }
+}
+
+impl GoErrcheckConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `time.Sleep` justification policy (off by default).
+///
+/// Requires a `// SLEEP:` comment on each `time.Sleep(...)` call outside
+/// test files, since sleeps often hide races or missing waits.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoSleepConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoSleepConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoSleepConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoSleepConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `init()` justification policy (off by default).
+///
+/// Requires an `// INIT:` comment on each package `init()` function outside
+/// test files, since import-time side effects are hard to trace.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoInitConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoInitConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for packages to check, matched against directories relative to
+    /// the project root and import paths. Empty checks every package.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoInitConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoInitConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Fresh root context policy for request-scoped packages (off by default).
+///
+/// Flags `context.Background()` and `context.TODO()` calls in packages
+/// matching `packages`, where the caller's context should be propagated.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoContextConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoContextConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for request-scoped packages, matched against directories
+    /// relative to the project root and import paths. Empty checks nothing.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoContextConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoContextConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Hardcoded credential policy (off by default).
+///
+/// Flags string literals assigned to names matching `names`, unless they're
+/// empty, placeholders, or below `min_entropy`.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoSecretsConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoSecretsConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Regex for variable, constant, and field names that hold credentials.
+    #[serde(default = "GoSecretsConfig::default_names")]
+    pub names: String,
+
+    /// Minimum Shannon entropy, in bits per character, for a value to count
+    /// as a secret (default: 3.5).
+    #[serde(default = "GoSecretsConfig::default_min_entropy")]
+    pub min_entropy: f64,
+}
+
+impl Default for GoSecretsConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            names: Self::default_names(),
+            min_entropy: Self::default_min_entropy(),
+        }
+    }
+}
+
+impl GoSecretsConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+
+    pub(crate) fn default_names() -> String {
+        r"(?i)passw(?:or)?d|secret|api_?key|token|credential".to_string()
+    }
+
+    pub(crate) fn default_min_entropy() -> f64 {
+        3.5
+    }
+}
+
+/// Deprecated symbol policy (off by default).
+///
+/// Flags references to functions, types, variables, and constants whose doc
+/// comment starts a paragraph with `Deprecated:`, from other packages in the
+/// module.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoDeprecatedConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoDeprecatedConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoDeprecatedConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoDeprecatedConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Builtin print policy (off by default).
+///
+/// Flags calls to the `print` and `println` builtins outside test files,
+/// unless the package shadows them with its own declaration.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoPrintConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoPrintConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoPrintConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoPrintConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// `any` in exported signatures policy (off by default).
+///
+/// Requires an `// ANY:` comment on exported functions and methods whose
+/// parameters or results use `any` or `interface{}`.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoAnyConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoAnyConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for packages to check, matched against directories relative to
+    /// the project root and import paths. Empty checks every package.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoAnyConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoAnyConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Goroutine recovery policy (off by default).
+///
+/// Requires a `// GOROUTINE:` comment on `go` statements whose launched
+/// function doesn't defer a `recover()`.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoGoroutineConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoGoroutineConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for packages to check, matched against directories relative to
+    /// the project root and import paths. Empty checks every package.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoGoroutineConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoGoroutineConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Large struct return policy (off by default).
+///
+/// Flags functions returning a struct larger than `max_bytes` by value.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoLargeStructConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoLargeStructConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Structs larger than this many bytes are flagged (default: 64).
+    #[serde(default = "GoLargeStructConfig::default_max_bytes")]
+    pub max_bytes: u64,
+
+    /// Only check exported functions and methods (default: false).
+    #[serde(default)]
+    pub exported_only: bool,
+}
+
+impl Default for GoLargeStructConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            max_bytes: Self::default_max_bytes(),
+            exported_only: false,
+        }
+    }
+}
+
+impl GoLargeStructConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+
+    pub(crate) fn default_max_bytes() -> u64 {
+        64
+    }
+}
+
+/// `fmt.Sprintf` concatenation policy (off by default).
+///
+/// Flags `fmt.Sprintf` calls whose format string is only `%s` verbs, one
+/// per argument.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoSprintfConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoSprintfConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoSprintfConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoSprintfConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Wall clock policy for deterministic packages (off by default).
+///
+/// Flags `time.Now()` calls in packages matching `packages`, which should
+/// take an injected clock, unless a `// CLOCK:` comment explains them.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoClockConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoClockConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Globs for deterministic packages, matched against directories
+    /// relative to the project root and import paths. Empty checks nothing.
+    #[serde(default)]
+    pub packages: Vec<String>,
+}
+
+impl Default for GoClockConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            packages: Vec::new(),
+        }
+    }
+}
+
+impl GoClockConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Default HTTP client policy (off by default).
+///
+/// Flags `http.Get`, `http.Head`, `http.Post`, `http.PostForm`, and
+/// `http.DefaultClient` outside test files, unless an `// HTTP:` comment
+/// explains them.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoHttpConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoHttpConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoHttpConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoHttpConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Map iteration order policy (off by default).
+///
+/// Flags `range` loops over a map that append to a slice the function
+/// returns without sorting it.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoMapOrderConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoMapOrderConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoMapOrderConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoMapOrderConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Library exit policy (off by default).
+///
+/// Flags `log.Fatal`, `log.Panic`, their `f` and `ln` variants, and
+/// `os.Exit` outside `main` packages and test files, unless an `// EXIT:`
+/// comment explains them.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoExitConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoExitConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoExitConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoExitConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Unlocked map access policy (off by default).
+///
+/// Flags accesses of a struct's map field in a method that hasn't locked the
+/// struct's `sync.Mutex` or `sync.RWMutex`, when another method locks it
+/// around the same map.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoMapLockConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoMapLockConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoMapLockConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoMapLockConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Error string style policy (off by default).
+///
+/// Flags `errors.New` and `fmt.Errorf` strings whose first word is
+/// capitalized, unless it's a common acronym or listed in `allow`, or that
+/// end with `.` or `!`.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoErrStringConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoErrStringConfig::default_check")]
+    pub check: CheckLevel,
+
+    /// Capitalized words error strings may also start with, like product
+    /// names (`Postgres`), on top of the built-in acronyms.
+    #[serde(default)]
+    pub allow: Vec<String>,
+}
+
+impl Default for GoErrStringConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+            allow: Vec::new(),
+        }
+    }
+}
+
+impl GoErrStringConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// Unspread variadic argument rule (error by default).
+///
+/// Flags fmt print calls passed a `[]any` slice without `...`, which prints
+/// the slice as a single value.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoVariadicConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoVariadicConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoVariadicConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoVariadicConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
+/// Aliasing append policy (off by default).
+///
+/// Flags `b := append(a[:0], ...)` when `a` is used again later in the same
+/// function, since `b` and `a` then share their elements.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoAppendAliasConfig {
+    /// Check level: error, warn, or off (default: "off").
+    #[serde(default = "GoAppendAliasConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoAppendAliasConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoAppendAliasConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Off
+    }
+}
+
+/// WaitGroup Add placement rule (error by default).
+///
+/// Flags `wg.Add` inside a goroutine launched on the same WaitGroup, since
+/// `wg.Wait()` can return before the goroutine is counted.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoWaitGroupConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoWaitGroupConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoWaitGroupConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoWaitGroupConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
+/// Built-in context key rule (on by default, as a warning).
+///
+/// Flags `context.WithValue` keys of built-in types like `string`, which can
+/// collide with keys other packages set.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoCtxKeyConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoCtxKeyConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoCtxKeyConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoCtxKeyConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
+/// Defer-in-loop rule (on by default, as a warning).
+///
+/// Requires a `// DEFERLOOP:` comment on `defer` statements in a loop body,
+/// which don't run until the function returns.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoDeferLoopConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoDeferLoopConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoDeferLoopConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoDeferLoopConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
+/// MustCompile pattern rule (on by default).
+///
+/// Flags `regexp.MustCompile` and `MustCompilePOSIX` on patterns that aren't
+/// string literals or consts, which panic on a bad pattern at run time.
+#[derive(Debug, Clone, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct GoMustCompileConfig {
+    /// Check level: error, warn, or off (default: "error").
+    #[serde(default = "GoMustCompileConfig::default_check")]
+    pub check: CheckLevel,
+}
+
+impl Default for GoMustCompileConfig {
+    fn default() -> Self {
+        Self {
+            check: Self::default_check(),
+        }
+    }
+}
+
+impl GoMustCompileConfig {
+    pub(crate) fn default_check() -> CheckLevel {
+        CheckLevel::Error
+    }
+}
+
 define_policy_config!(
     GoPolicyConfig,
     [".golangci.yml", ".golangci.yaml", ".golangci.toml",]
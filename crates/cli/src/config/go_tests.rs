@@ -65,3 +65,374 @@ fn go_cloc_advice_contains_package() {
     assert!(advice.contains("package"));
     assert!(advice.contains("150–250 lines"));
 }
+
+#[test]
+fn go_syscall_config_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.syscall.check, CheckLevel::Off);
+    assert!(config.golang.syscall.allow.is_empty());
+}
+
+#[test]
+fn go_syscall_config_parses_allowlist() {
+    let config = parse_config(
+        r#"
+version = 1
+[golang.syscall]
+check = "error"
+allow = ["internal/sys/...", "example.com/app/cmd/daemon"]
+"#,
+    );
+    assert_eq!(config.golang.syscall.check, CheckLevel::Error);
+    assert_eq!(
+        config.golang.syscall.allow,
+        vec!["internal/sys/...", "example.com/app/cmd/daemon"]
+    );
+}
+
+#[test]
+fn go_panic_config_defaults_to_off_for_libraries() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.panic.check, CheckLevel::Off);
+    assert!(config.golang.panic.library_only);
+    assert!(!config.golang.panic.allow_repanic);
+}
+
+#[test]
+fn go_panic_config_parses_options() {
+    let config = parse_config(
+        r#"
+version = 1
+[golang.panic]
+check = "warn"
+library_only = false
+allow_repanic = true
+"#,
+    );
+    assert_eq!(config.golang.panic.check, CheckLevel::Warn);
+    assert!(!config.golang.panic.library_only);
+    assert!(config.golang.panic.allow_repanic);
+}
+
+#[test]
+fn go_embed_config_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.embed.check, CheckLevel::Off);
+    assert!(config.golang.embed.sensitive.is_empty());
+    assert!(config.golang.embed.allow.is_empty());
+}
+
+#[test]
+fn go_embed_config_parses_globs() {
+    let config = parse_config(
+        r#"
+version = 1
+[golang.embed]
+check = "warn"
+sensitive = ["*.pem", ".env*"]
+allow = ["static/*"]
+"#,
+    );
+    assert_eq!(config.golang.embed.check, CheckLevel::Warn);
+    assert_eq!(config.golang.embed.sensitive, vec!["*.pem", ".env*"]);
+    assert_eq!(config.golang.embed.allow, vec!["static/*"]);
+}
+
+#[test]
+fn go_recover_config_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.recover.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.recover]\ncheck = \"off\"\n");
+    assert_eq!(config.golang.recover.check, CheckLevel::Off);
+}
+
+#[test]
+fn go_unsafe_introspection_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.unsafe_.introspection, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.unsafe]\nintrospection = \"warn\"\n");
+    assert_eq!(config.golang.unsafe_.introspection, CheckLevel::Warn);
+}
+
+#[test]
+fn go_linkname_foreign_push_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.linkname.foreign_push, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.linkname]\nforeign_push = \"error\"\n");
+    assert_eq!(config.golang.linkname.foreign_push, CheckLevel::Error);
+}
+
+#[test]
+fn go_linkname_allow_defaults_to_empty() {
+    let config = parse_config("version = 1\n");
+    assert!(config.golang.linkname.allow.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.linkname]\nallow = [\"runtime.nanotime\", \"runtime.fastrand\"]\n",
+    );
+    assert_eq!(
+        config.golang.linkname.allow,
+        vec!["runtime.nanotime", "runtime.fastrand"]
+    );
+}
+
+#[test]
+fn go_weakrand_defaults_to_off_with_no_packages() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.weakrand.check, CheckLevel::Off);
+    assert!(config.golang.weakrand.packages.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.weakrand]\ncheck = \"error\"\npackages = [\"internal/token/**\"]\n",
+    );
+    assert_eq!(config.golang.weakrand.check, CheckLevel::Error);
+    assert_eq!(config.golang.weakrand.packages, vec!["internal/token/**"]);
+}
+
+#[test]
+fn go_errcheck_defaults_to_off_with_no_functions() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.errcheck.check, CheckLevel::Off);
+    assert!(config.golang.errcheck.functions.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.errcheck]\ncheck = \"warn\"\nfunctions = [\"os.Remove\", \"Close\"]\n",
+    );
+    assert_eq!(config.golang.errcheck.check, CheckLevel::Warn);
+    assert_eq!(config.golang.errcheck.functions, vec!["os.Remove", "Close"]);
+}
+
+#[test]
+fn go_sleep_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.sleep.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.sleep]\ncheck = \"error\"\n");
+    assert_eq!(config.golang.sleep.check, CheckLevel::Error);
+}
+
+#[test]
+fn go_init_defaults_to_off_for_every_package() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.init.check, CheckLevel::Off);
+    assert!(config.golang.init.packages.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.init]\ncheck = \"warn\"\npackages = [\"internal/**\"]\n",
+    );
+    assert_eq!(config.golang.init.check, CheckLevel::Warn);
+    assert_eq!(config.golang.init.packages, vec!["internal/**"]);
+}
+
+#[test]
+fn go_context_defaults_to_off_with_no_packages() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.context.check, CheckLevel::Off);
+    assert!(config.golang.context.packages.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.context]\ncheck = \"error\"\npackages = [\"internal/handler/**\"]\n",
+    );
+    assert_eq!(config.golang.context.check, CheckLevel::Error);
+    assert_eq!(config.golang.context.packages, vec!["internal/handler/**"]);
+}
+
+#[test]
+fn go_secrets_defaults_to_off_with_credential_names() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.secrets.check, CheckLevel::Off);
+    assert_eq!(config.golang.secrets.min_entropy, 3.5);
+    assert!(config.golang.secrets.names.contains("api_?key"));
+
+    let config = parse_config(
+        "version = 1\n[golang.secrets]\ncheck = \"error\"\nnames = \"(?i)dsn\"\nmin_entropy = 4.0\n",
+    );
+    assert_eq!(config.golang.secrets.check, CheckLevel::Error);
+    assert_eq!(config.golang.secrets.names, "(?i)dsn");
+    assert_eq!(config.golang.secrets.min_entropy, 4.0);
+}
+
+#[test]
+fn go_deprecated_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.deprecated.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.deprecated]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.deprecated.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_print_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.print.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.print]\ncheck = \"error\"\n");
+    assert_eq!(config.golang.print.check, CheckLevel::Error);
+}
+
+#[test]
+fn go_any_defaults_to_off_for_every_package() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.any.check, CheckLevel::Off);
+    assert!(config.golang.any.packages.is_empty());
+
+    let config =
+        parse_config("version = 1\n[golang.any]\ncheck = \"error\"\npackages = [\"pkg/api/**\"]\n");
+    assert_eq!(config.golang.any.check, CheckLevel::Error);
+    assert_eq!(config.golang.any.packages, vec!["pkg/api/**"]);
+}
+
+#[test]
+fn go_goroutine_defaults_to_off_for_every_package() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.goroutine.check, CheckLevel::Off);
+    assert!(config.golang.goroutine.packages.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.goroutine]\ncheck = \"warn\"\npackages = [\"internal/server/**\"]\n",
+    );
+    assert_eq!(config.golang.goroutine.check, CheckLevel::Warn);
+    assert_eq!(config.golang.goroutine.packages, vec!["internal/server/**"]);
+}
+
+#[test]
+fn go_largestruct_defaults_to_off_at_64_bytes() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.largestruct.check, CheckLevel::Off);
+    assert_eq!(config.golang.largestruct.max_bytes, 64);
+    assert!(!config.golang.largestruct.exported_only);
+
+    let config = parse_config(
+        "version = 1\n[golang.largestruct]\ncheck = \"warn\"\nmax_bytes = 128\nexported_only = true\n",
+    );
+    assert_eq!(config.golang.largestruct.check, CheckLevel::Warn);
+    assert_eq!(config.golang.largestruct.max_bytes, 128);
+    assert!(config.golang.largestruct.exported_only);
+}
+
+#[test]
+fn go_sprintf_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.sprintf.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.sprintf]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.sprintf.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_clock_defaults_to_off_for_every_package() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.clock.check, CheckLevel::Off);
+    assert!(config.golang.clock.packages.is_empty());
+
+    let config = parse_config(
+        "version = 1\n[golang.clock]\ncheck = \"error\"\npackages = [\"internal/ledger/**\"]\n",
+    );
+    assert_eq!(config.golang.clock.check, CheckLevel::Error);
+    assert_eq!(config.golang.clock.packages, vec!["internal/ledger/**"]);
+}
+
+#[test]
+fn go_http_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.http.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.http]\ncheck = \"error\"\n");
+    assert_eq!(config.golang.http.check, CheckLevel::Error);
+}
+
+#[test]
+fn go_maporder_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.maporder.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.maporder]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.maporder.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_exit_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.exit.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.exit]\ncheck = \"error\"\n");
+    assert_eq!(config.golang.exit.check, CheckLevel::Error);
+}
+
+#[test]
+fn go_maplock_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.maplock.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.maplock]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.maplock.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_errstring_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.errstring.check, CheckLevel::Off);
+    assert!(config.golang.errstring.allow.is_empty());
+
+    let config =
+        parse_config("version = 1\n[golang.errstring]\ncheck = \"warn\"\nallow = [\"Postgres\"]\n");
+    assert_eq!(config.golang.errstring.check, CheckLevel::Warn);
+    assert_eq!(config.golang.errstring.allow, vec!["Postgres"]);
+}
+
+#[test]
+fn go_variadic_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.variadic.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.variadic]\ncheck = \"off\"\n");
+    assert_eq!(config.golang.variadic.check, CheckLevel::Off);
+}
+
+#[test]
+fn go_appendalias_defaults_to_off() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.appendalias.check, CheckLevel::Off);
+
+    let config = parse_config("version = 1\n[golang.appendalias]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.appendalias.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_waitgroup_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.waitgroup.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.waitgroup]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.waitgroup.check, CheckLevel::Warn);
+}
+
+#[test]
+fn go_ctxkey_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.ctxkey.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.ctxkey]\ncheck = \"off\"\n");
+    assert_eq!(config.golang.ctxkey.check, CheckLevel::Off);
+}
+
+#[test]
+fn go_deferloop_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.deferloop.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.deferloop]\ncheck = \"off\"\n");
+    assert_eq!(config.golang.deferloop.check, CheckLevel::Off);
+}
+
+#[test]
+fn go_mustcompile_defaults_to_error() {
+    let config = parse_config("version = 1\n");
+    assert_eq!(config.golang.mustcompile.check, CheckLevel::Error);
+
+    let config = parse_config("version = 1\n[golang.mustcompile]\ncheck = \"warn\"\n");
+    assert_eq!(config.golang.mustcompile.check, CheckLevel::Warn);
+}
@@ -22,7 +22,7 @@
 macro_rules! define_policy_config {
     ($name:ident, [$($config_file:expr),* $(,)?]) => {
         /// Lint policy configuration.
-        #[derive(Debug, Clone, Deserialize)]
+        #[derive(Debug, Clone, Deserialize, serde::Serialize)]
         #[serde(default, deny_unknown_fields)]
         pub struct $name {
             /// Check level: "error" | "warn" | "off" (default: inherits from global).
@@ -3,10 +3,12 @@
 
 //! Configuration parsing and validation.
 //!
-//! Handles quench.toml parsing with version validation and unknown key warnings.
+//! Handles quench.toml (or .quench.yml) parsing with version validation and
+//! unknown key warnings.
 
 mod checks;
 pub mod defaults;
+mod directory;
 pub mod duration;
 mod go;
 mod javascript;
@@ -17,20 +19,33 @@ mod ruby;
 mod shell;
 mod suppress;
 mod test_config;
+mod yaml;
 
+use std::collections::HashMap;
 use std::path::Path;
 
-use serde::Deserialize;
+use serde::{Deserialize, Serialize};
 
 pub use checks::CheckLevel;
+pub use directory::{DirectoryConfig, NestedConfig, load_directory, parse_nested};
+pub use yaml::{YAML_CONFIG_NAME, is_yaml_config};
 
+use crate::category::Category;
 use crate::error::{Error, Result};
 
 pub(crate) use checks::{
     ClocConfig, DocsAreaConfig, DocsCommitConfig, DocsConfig, EscapeAction, EscapePattern,
-    EscapesConfig, LangClocConfig, LineMetric, SpecsConfig, SpecsSectionsConfig,
+    EscapesConfig, LangClocConfig, LineMetric, SpecsConfig, SpecsSectionsConfig, TodoConfig,
+};
+pub(crate) use go::{
+    GoAnyConfig, GoAppendAliasConfig, GoClockConfig, GoConfig, GoContextConfig, GoCtxKeyConfig,
+    GoDeferLoopConfig, GoDeprecatedConfig, GoEmbedConfig, GoErrStringConfig, GoErrcheckConfig,
+    GoExitConfig, GoGoroutineConfig, GoHttpConfig, GoInitConfig, GoLargeStructConfig,
+    GoLinknameConfig, GoMapLockConfig, GoMapOrderConfig, GoMustCompileConfig, GoPanicConfig,
+    GoPolicyConfig, GoPrintConfig, GoRecoverConfig, GoSecretsConfig, GoSleepConfig,
+    GoSprintfConfig, GoSuppressConfig, GoSyscallConfig, GoUnsafeConfig, GoVariadicConfig,
+    GoWaitGroupConfig, GoWeakRandConfig,
 };
-pub(crate) use go::{GoConfig, GoPolicyConfig, GoSuppressConfig};
 pub(crate) use javascript::{JavaScriptConfig, JavaScriptPolicyConfig, JavaScriptSuppressConfig};
 pub(crate) use python::{PythonConfig, PythonPolicyConfig, PythonSuppressConfig};
 pub(crate) use ratchet::RatchetConfig;
@@ -54,7 +69,7 @@ struct VersionOnly {
 }
 
 /// Full configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(deny_unknown_fields)]
 pub struct Config {
     /// Config file version (must be 1).
@@ -76,6 +91,18 @@ pub struct Config {
     #[serde(default)]
     pub ratchet: RatchetConfig,
 
+    /// Per-rule severity overrides, keyed by escape pattern name or violation type.
+    #[serde(default)]
+    pub severity: HashMap<String, CheckLevel>,
+
+    /// Per-rule settings (`[rules.<rule>]`), keyed like `[severity]`.
+    #[serde(default)]
+    pub rules: HashMap<String, RuleConfig>,
+
+    /// Rule categories to turn on or off (`[categories]`).
+    #[serde(default)]
+    pub categories: CategoriesConfig,
+
     /// Rust-specific configuration.
     #[serde(default)]
     pub rust: RustConfig,
@@ -99,6 +126,46 @@ pub struct Config {
     /// Shell-specific configuration.
     #[serde(default)]
     pub shell: ShellConfig,
+
+    /// Rule overrides from nested config files, loaded after discovery.
+    #[serde(skip)]
+    pub directories: Vec<DirectoryConfig>,
+}
+
+/// Settings for one rule (`[rules.<rule>]`).
+#[derive(Debug, Clone, Default, Deserialize, Serialize)]
+#[serde(deny_unknown_fields)]
+pub struct RuleConfig {
+    /// Severity override: error, warning, or off (takes precedence over `[severity]`).
+    #[serde(default)]
+    pub severity: Option<CheckLevel>,
+
+    /// Whether the rule checks test code (None = the rule's default: escape
+    /// patterns follow their `in_tests`, other rules their own test policy).
+    #[serde(default)]
+    pub apply_to_tests: Option<bool>,
+
+    /// Paths the rule checks (globs relative to the config's directory;
+    /// empty = all files).
+    #[serde(default)]
+    pub include: Vec<String>,
+
+    /// Paths the rule skips, even when they match `include`.
+    #[serde(default)]
+    pub exclude: Vec<String>,
+}
+
+/// Rule categories to turn on or off (`[categories]`).
+///
+/// Per-rule `[severity]` and `[rules.<rule>]` settings take precedence.
+#[derive(Debug, Clone, Default, Deserialize)]
+#[serde(default, deny_unknown_fields)]
+pub struct CategoriesConfig {
+    /// Categories whose opt-in rules are turned on.
+    pub enable: Vec<Category>,
+
+    /// Categories whose rules are all turned off.
+    pub disable: Vec<Category>,
 }
 
 /// Git configuration.
@@ -449,7 +516,7 @@ define_policy_config!(
 );
 
 /// Lint changes policy.
-#[derive(Debug, Clone, Copy, PartialEq, Eq, Default, Deserialize)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Default, Deserialize, Serialize)]
 #[serde(rename_all = "lowercase")]
 pub enum LintChangesPolicy {
     /// No policy - mixed changes allowed.
@@ -460,7 +527,7 @@ pub enum LintChangesPolicy {
 }
 
 /// Check-specific configurations.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(deny_unknown_fields)]
 pub struct CheckConfig {
     /// Cloc (count lines of code) check configuration.
@@ -493,7 +560,7 @@ pub struct CheckConfig {
 }
 
 /// License check configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct LicenseConfig {
     /// Check level: "error" | "warn" | "off"
@@ -516,7 +583,7 @@ pub struct LicenseConfig {
 }
 
 /// Build check configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct BuildConfig {
     /// Check level: "error" | "warn" | "off"
@@ -541,7 +608,7 @@ pub struct BuildConfig {
 }
 
 /// Per-target build configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct BuildTargetConfig {
     /// Maximum binary size for this target.
@@ -549,12 +616,17 @@ pub struct BuildTargetConfig {
 }
 
 /// Project-level configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(deny_unknown_fields)]
 pub struct ProjectConfig {
     /// Project name.
     pub name: Option<String>,
 
+    /// Project language, overriding detection from marker files
+    /// (`--lang` sets it from the command line).
+    #[serde(default)]
+    pub language: Option<crate::adapter::ProjectLanguage>,
+
     /// Source file patterns (default: empty = all non-test files are source).
     #[serde(default)]
     pub source: Vec<String>,
@@ -568,6 +640,7 @@ pub struct ProjectConfig {
     pub packages: Vec<String>,
 
     /// Custom exclude patterns (walker-level: prevents I/O on subtrees).
+    /// Replaces the language defaults unless `keep_defaults` is set.
     #[serde(default, alias = "ignore")]
     pub exclude: ExcludeConfig,
 
@@ -597,17 +670,46 @@ impl ProjectConfig {
 ///
 /// Accepts either shorthand or full form:
 /// - `exclude = ["pattern1", "pattern2"]`
-/// - `exclude = { patterns = ["pattern1", "pattern2"] }`
+/// - `exclude = { patterns = ["pattern1", "pattern2"], keep_defaults = true }`
+///
+/// A list set in config (or with `--ignore`) replaces the language default
+/// excludes (`vendor/`, `target/`, ...) unless `keep_defaults` is set.
 #[derive(Debug, Default, Clone)]
 pub struct ExcludeConfig {
     pub patterns: Vec<String>,
+
+    /// Whether the patterns were set explicitly rather than defaulted.
+    pub explicit: bool,
+
+    /// Merge the patterns with the language defaults instead of replacing them.
+    pub keep_defaults: bool,
+}
+
+impl ExcludeConfig {
+    /// Add patterns from `--ignore`, and `--keep-defaults`.
+    pub fn add_ignores(&mut self, patterns: &[String], keep_defaults: bool) {
+        if !patterns.is_empty() {
+            self.patterns.extend_from_slice(patterns);
+            self.explicit = true;
+        }
+        self.keep_defaults |= keep_defaults;
+    }
+
+    /// Whether language default excludes apply alongside the patterns.
+    pub fn uses_defaults(&self) -> bool {
+        !self.explicit || self.keep_defaults
+    }
 }
 
 #[derive(Deserialize)]
 #[serde(untagged)]
 enum ExcludeConfigHelper {
     Short(Vec<String>),
-    Full { patterns: Vec<String> },
+    Full {
+        patterns: Vec<String>,
+        #[serde(default)]
+        keep_defaults: bool,
+    },
 }
 
 impl<'de> serde::Deserialize<'de> for ExcludeConfig {
@@ -615,10 +717,17 @@ impl<'de> serde::Deserialize<'de> for ExcludeConfig {
     where
         D: serde::Deserializer<'de>,
     {
-        Ok(match ExcludeConfigHelper::deserialize(deserializer)? {
-            ExcludeConfigHelper::Short(patterns) | ExcludeConfigHelper::Full { patterns } => {
-                Self { patterns }
-            }
+        let (patterns, keep_defaults) = match ExcludeConfigHelper::deserialize(deserializer)? {
+            ExcludeConfigHelper::Short(patterns) => (patterns, false),
+            ExcludeConfigHelper::Full {
+                patterns,
+                keep_defaults,
+            } => (patterns, keep_defaults),
+        };
+        Ok(Self {
+            patterns,
+            explicit: true,
+            keep_defaults,
         })
     }
 }
@@ -627,13 +736,19 @@ impl<'de> serde::Deserialize<'de> for ExcludeConfig {
 pub const SUPPORTED_VERSION: i64 = 1;
 
 /// Load and validate config from a file path.
+///
+/// `.yml`/`.yaml` files are parsed as YAML; anything else as TOML.
 pub fn load(path: &Path) -> Result<Config> {
     let content = std::fs::read_to_string(path).map_err(|e| Error::Io {
         path: path.to_path_buf(),
         source: e,
     })?;
 
-    parse(&content, path)
+    if is_yaml_config(path) {
+        yaml::parse(&content, path)
+    } else {
+        parse(&content, path)
+    }
 }
 
 /// Load config with warnings for unknown keys.
@@ -706,6 +821,10 @@ mod defaults_tests;
 #[path = "ratchet_tests.rs"]
 mod ratchet_tests;
 
+#[cfg(test)]
+#[path = "directory_tests.rs"]
+mod directory_tests;
+
 #[cfg(test)]
 #[path = "go_tests.rs"]
 mod go_tests;
@@ -729,3 +848,7 @@ mod shell_tests;
 #[cfg(test)]
 #[path = "test_config_tests.rs"]
 mod test_config_tests;
+
+#[cfg(test)]
+#[path = "yaml_tests.rs"]
+mod yaml_tests;
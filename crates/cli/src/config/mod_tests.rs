@@ -71,6 +71,61 @@ fn load_fails_on_missing_file() {
     assert!(result.is_err());
 }
 
+#[test]
+fn parses_severity_overrides() {
+    let path = PathBuf::from("quench.toml");
+    let content = r#"
+version = 1
+
+[severity]
+subprocess_shell = "warn"
+unsafe-pointer = "error"
+"#;
+    let config = parse(content, &path).unwrap();
+    assert_eq!(
+        config.severity.get("subprocess_shell"),
+        Some(&CheckLevel::Warn)
+    );
+    assert_eq!(
+        config.severity.get("unsafe-pointer"),
+        Some(&CheckLevel::Error)
+    );
+}
+
+#[test]
+fn parses_rule_severity() {
+    let path = PathBuf::from("quench.toml");
+    let content = r#"
+version = 1
+
+[rules.noescape]
+severity = "warning"
+
+[rules.unsafe_pointer]
+severity = "error"
+"#;
+    let config = parse(content, &path).unwrap();
+    assert_eq!(config.rules["noescape"].severity, Some(CheckLevel::Warn));
+    assert_eq!(
+        config.rules["unsafe_pointer"].severity,
+        Some(CheckLevel::Error)
+    );
+}
+
+#[test]
+fn rejects_unknown_rule_setting() {
+    let path = PathBuf::from("quench.toml");
+    let result = parse("version = 1\n\n[rules.eval]\nlevel = \"warn\"\n", &path);
+    assert!(result.is_err());
+}
+
+#[test]
+fn rejects_invalid_severity_level() {
+    let path = PathBuf::from("quench.toml");
+    let result = parse("version = 1\n\n[severity]\neval = \"loud\"\n", &path);
+    assert!(result.is_err());
+}
+
 // Unknown key validation tests
 
 #[test]
@@ -561,6 +616,51 @@ patterns = ["*.snapshot"]
     assert_eq!(config.project.exclude.patterns, vec!["*.snapshot"]);
 }
 
+#[test]
+fn exclude_replaces_defaults_unless_kept() {
+    let path = PathBuf::from("quench.toml");
+    let config = parse(
+        "version = 1
+",
+        &path,
+    )
+    .unwrap();
+    assert!(config.project.exclude.uses_defaults());
+
+    let content = r#"
+version = 1
+
+[project]
+ignore = ["**/*.pb.go"]
+"#;
+    let config = parse(content, &path).unwrap();
+    assert!(!config.project.exclude.uses_defaults());
+
+    let content = r#"
+version = 1
+
+[project]
+ignore = { patterns = ["**/*.pb.go"], keep_defaults = true }
+"#;
+    let config = parse(content, &path).unwrap();
+    assert_eq!(config.project.exclude.patterns, vec!["**/*.pb.go"]);
+    assert!(config.project.exclude.uses_defaults());
+}
+
+#[test]
+fn exclude_add_ignores_from_command_line() {
+    let mut exclude = ExcludeConfig::default();
+    exclude.add_ignores(&[], false);
+    assert!(exclude.uses_defaults());
+
+    exclude.add_ignores(&["third_party/".to_string()], false);
+    assert_eq!(exclude.patterns, vec!["third_party/"]);
+    assert!(!exclude.uses_defaults());
+
+    exclude.add_ignores(&[], true);
+    assert!(exclude.uses_defaults());
+}
+
 // Specs content validation config tests
 
 #[test]
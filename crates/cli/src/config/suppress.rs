@@ -6,7 +6,7 @@
 //! Used by Rust, Go, and Shell language adapters.
 
 use serde::de::{self, MapAccess, Visitor};
-use serde::{Deserialize, Deserializer};
+use serde::{Deserialize, Deserializer, Serialize};
 
 /// Lint suppression configuration for #[allow(...)] and #[expect(...)].
 #[derive(Debug, Clone, Deserialize)]
@@ -104,7 +104,7 @@ fn merge_with_defaults(
 ///
 /// NOTE: Uses custom deserializer to accept arbitrary lint codes as fields
 /// (e.g., `dead_code = "// REASON:"`), which are parsed into the `patterns` map.
-#[derive(Debug, Clone)]
+#[derive(Debug, Clone, Serialize)]
 pub struct SuppressScopeConfig {
     /// Override check level for this scope.
     pub check: Option<SuppressLevel>,
@@ -275,7 +275,7 @@ impl SuppressScopeConfig {
 }
 
 /// Suppress check level.
-#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default, Deserialize)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq, Hash, Default, Deserialize, Serialize)]
 #[serde(rename_all = "lowercase")]
 pub enum SuppressLevel {
     /// Never allowed - any suppression fails.
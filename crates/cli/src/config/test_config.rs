@@ -10,7 +10,7 @@ use serde::Deserialize;
 use super::duration;
 
 /// Tests check configuration.
-#[derive(Debug, Default, Deserialize)]
+#[derive(Debug, Clone, Default, Deserialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct TestsConfig {
     /// Check level: "error" | "warn" | "off"
@@ -150,7 +150,7 @@ impl TestsCoverageConfig {
 }
 
 /// Tests commit check configuration.
-#[derive(Debug, Deserialize)]
+#[derive(Debug, Clone, Deserialize)]
 #[serde(default, deny_unknown_fields)]
 pub struct TestsCommitConfig {
     /// Check level: "error" | "warn" | "off"
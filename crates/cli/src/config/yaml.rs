@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! `.quench.yml` parsing.
+//!
+//! YAML configs share the quench.toml schema; only the syntax differs.
+//! Parse errors are rendered with the offending source line, matching the
+//! context the TOML parser provides.
+
+use std::path::Path;
+
+use super::{Config, SUPPORTED_VERSION, VersionOnly};
+use crate::error::{Error, Result};
+
+/// YAML config file name.
+pub const YAML_CONFIG_NAME: &str = ".quench.yml";
+
+/// Check whether a config path should be parsed as YAML.
+pub fn is_yaml_config(path: &Path) -> bool {
+    matches!(
+        path.extension().and_then(|e| e.to_str()),
+        Some("yml" | "yaml")
+    )
+}
+
+/// Parse YAML config content (strict mode).
+pub fn parse(content: &str, path: &Path) -> Result<Config> {
+    let version_check: VersionOnly =
+        serde_yaml::from_str(content).map_err(|e| config_error(content, &e, path))?;
+
+    let version = version_check.version.ok_or_else(|| Error::Config {
+        message: "missing required field: version".to_string(),
+        path: Some(path.to_path_buf()),
+    })?;
+
+    if version != SUPPORTED_VERSION {
+        return Err(Error::Config {
+            message: format!(
+                "unsupported config version {} (supported: {})\n  Upgrade quench to use this config.",
+                version, SUPPORTED_VERSION
+            ),
+            path: Some(path.to_path_buf()),
+        });
+    }
+
+    serde_yaml::from_str(content).map_err(|e| config_error(content, &e, path))
+}
+
+fn config_error(content: &str, error: &serde_yaml::Error, path: &Path) -> Error {
+    Error::Config {
+        message: format_yaml_error(content, error),
+        path: Some(path.to_path_buf()),
+    }
+}
+
+/// Render a YAML error with the source line and a caret under the column.
+///
+/// ```text
+/// YAML parse error at line 3, column 10
+///   |
+/// 3 |     check: [warn
+///   |          ^
+/// did not find expected ',' or ']'
+/// ```
+pub(super) fn format_yaml_error(content: &str, error: &serde_yaml::Error) -> String {
+    let message = error.to_string();
+    // serde_yaml appends the location (and parser context) to the message;
+    // the location is shown above instead
+    let message = message
+        .split_once(" at line ")
+        .map_or(message.as_str(), |(head, _)| head);
+
+    let Some(location) = error.location() else {
+        return format!("YAML parse error\n{}", message);
+    };
+    let line = location.line();
+    let column = location.column();
+    let source = content.lines().nth(line.saturating_sub(1)).unwrap_or("");
+    let gutter = " ".repeat(line.to_string().len());
+
+    format!(
+        "YAML parse error at line {line}, column {column}\n\
+         {gutter} |\n\
+         {line} | {source}\n\
+         {gutter} | {caret:>column$}\n\
+         {message}",
+        caret = "^",
+    )
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+#![allow(clippy::unwrap_used, clippy::expect_used, clippy::panic)]
+use super::*;
+use std::path::PathBuf;
+
+fn parse_yaml(content: &str) -> Result<Config> {
+    yaml::parse(content, &PathBuf::from(".quench.yml"))
+}
+
+fn yaml_error(content: &str) -> String {
+    match parse_yaml(content) {
+        Err(Error::Config { message, .. }) => message,
+        other => panic!("expected config error, got {:?}", other),
+    }
+}
+
+#[test]
+fn yaml_config_matches_toml_schema() {
+    let config = parse_yaml(
+        r#"
+version: 1
+project:
+  ignore:
+    - "vendor/**"
+check:
+  escapes:
+    check: warn
+  cloc:
+    check: "off"
+"#,
+    )
+    .unwrap();
+
+    assert_eq!(config.project.exclude.patterns, vec!["vendor/**"]);
+    assert_eq!(config.check.escapes.check, CheckLevel::Warn);
+    assert_eq!(config.check.cloc.check, CheckLevel::Off);
+}
+
+#[test]
+fn yaml_config_requires_version() {
+    assert_eq!(
+        yaml_error("project:\n  name: demo\n"),
+        "missing required field: version"
+    );
+}
+
+#[test]
+fn yaml_config_rejects_unsupported_version() {
+    assert!(yaml_error("version: 2\n").starts_with("unsupported config version 2"));
+}
+
+#[test]
+fn yaml_config_rejects_unknown_keys() {
+    let message = yaml_error("version: 1\ncheck:\n  unknown:\n    field: 1\n");
+    assert!(message.contains("unknown field `unknown`"), "{}", message);
+}
+
+#[test]
+fn yaml_syntax_error_shows_line_context() {
+    let message = yaml_error("version: 1\ncheck:\n  escapes: [warn\n");
+
+    let mut lines = message.lines();
+    let header = lines.next().unwrap();
+    assert!(
+        header.starts_with("YAML parse error at line "),
+        "{}",
+        message
+    );
+    assert!(
+        message.contains(" | "),
+        "should include the source line: {}",
+        message
+    );
+    assert!(
+        message.contains('^'),
+        "should point at the column: {}",
+        message
+    );
+}
+
+#[test]
+fn yaml_error_caret_under_column() {
+    let content = "version: 1\ncheck:\n  escapes:\n    check: loud\n";
+    let message = yaml_error(content);
+
+    let lines: Vec<_> = message.lines().collect();
+    assert_eq!(lines[0], "YAML parse error at line 4, column 12");
+    assert_eq!(lines[2], "4 |     check: loud");
+    assert_eq!(lines[3], "  |            ^");
+}
+
+#[test]
+fn yaml_extension_detection() {
+    assert!(is_yaml_config(&PathBuf::from(".quench.yml")));
+    assert!(is_yaml_config(&PathBuf::from("quench.yaml")));
+    assert!(!is_yaml_config(&PathBuf::from("quench.toml")));
+}
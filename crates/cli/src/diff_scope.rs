@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Changed-line scope (`--diff`).
+//!
+//! Files are still checked in full, but only violations on lines added or
+//! modified since the base ref are reported. This lets a new rule be
+//! enforced on new code without fixing the legacy backlog first.
+
+use std::collections::HashMap;
+use std::path::{Path, PathBuf};
+
+use crate::check::{CheckOutput, Violation};
+use crate::git::get_changed_lines;
+
+/// Lines changed since a base ref, per file.
+#[derive(Debug, Default)]
+pub struct DiffScope {
+    /// Inclusive 1-based line ranges, keyed by path relative to the root.
+    ranges: HashMap<PathBuf, Vec<(u32, u32)>>,
+}
+
+impl DiffScope {
+    /// Compute the scope from git.
+    pub fn from_git(root: &Path, base: &str) -> anyhow::Result<Self> {
+        Ok(Self::new(get_changed_lines(root, base)?))
+    }
+
+    /// Build a scope from changed line ranges.
+    pub fn new(ranges: HashMap<PathBuf, Vec<(u32, u32)>>) -> Self {
+        Self { ranges }
+    }
+
+    /// Number of files with changed lines.
+    pub fn file_count(&self) -> usize {
+        self.ranges.len()
+    }
+
+    /// Whether a violation falls on a changed line.
+    ///
+    /// File-level violations are in scope when the file has any changed
+    /// line; violations without a file (e.g., commit messages) always are.
+    pub fn contains(&self, violation: &Violation) -> bool {
+        let Some(file) = &violation.file else {
+            return true;
+        };
+        let Some(ranges) = self.ranges.get(file) else {
+            return false;
+        };
+        match violation.line {
+            Some(line) => ranges
+                .iter()
+                .any(|&(start, end)| (start..=end).contains(&line)),
+            None => true,
+        }
+    }
+
+    /// Remove violations outside the changed lines.
+    ///
+    /// A failing check whose violations are all out of scope passes. Returns
+    /// the number of removed violations.
+    pub fn apply(&self, output: &mut CheckOutput) -> usize {
+        let mut removed = 0;
+        for result in &mut output.checks {
+            if result.violations.is_empty() {
+                continue;
+            }
+            let before = result.violations.len();
+            result.violations.retain(|v| self.contains(v));
+            removed += before - result.violations.len();
+            if result.violations.is_empty() && !result.skipped {
+                result.passed = true;
+            }
+        }
+        output.passed = output.checks.iter().all(|c| c.passed || c.skipped);
+        removed
+    }
+}
+
+#[cfg(test)]
+#[path = "diff_scope_tests.rs"]
+mod tests;
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::CheckResult;
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+fn scope(ranges: &[(&str, &[(u32, u32)])]) -> DiffScope {
+    DiffScope::new(
+        ranges
+            .iter()
+            .map(|(file, lines)| (PathBuf::from(file), lines.to_vec()))
+            .collect(),
+    )
+}
+
+#[test]
+fn contains_lines_inside_changed_ranges() {
+    let scope = scope(&[("main.go", &[(3, 5), (10, 10)])]);
+    assert!(scope.contains(&escape_violation("main.go", 3, "unsafe_pointer")));
+    assert!(scope.contains(&escape_violation("main.go", 5, "unsafe_pointer")));
+    assert!(scope.contains(&escape_violation("main.go", 10, "unsafe_pointer")));
+    assert!(!scope.contains(&escape_violation("main.go", 2, "unsafe_pointer")));
+    assert!(!scope.contains(&escape_violation("main.go", 6, "unsafe_pointer")));
+}
+
+#[test]
+fn unchanged_file_is_out_of_scope() {
+    let scope = scope(&[("main.go", &[(1, 20)])]);
+    assert!(!scope.contains(&escape_violation("legacy.go", 1, "unsafe_pointer")));
+}
+
+#[test]
+fn file_level_violation_in_scope_when_file_changed() {
+    let scope = scope(&[("main.go", &[(4, 4)])]);
+    let cloc = |file: &str| Violation::file_only(file, "file_too_large", "Split the file.");
+    assert!(scope.contains(&cloc("main.go")));
+    assert!(!scope.contains(&cloc("legacy.go")));
+}
+
+#[test]
+fn violation_without_file_always_in_scope() {
+    let scope = scope(&[]);
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    assert!(scope.contains(&violation));
+}
+
+#[test]
+fn apply_removes_out_of_scope_violations() {
+    let scope = scope(&[("main.go", &[(8, 9)])]);
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 8, "unsafe_pointer"),
+            escape_violation("legacy.go", 8, "unsafe_pointer"),
+        ],
+    )]);
+
+    assert_eq!(scope.apply(&mut output), 2);
+    assert!(!output.passed);
+    assert_eq!(output.checks[0].violations.len(), 1);
+    assert_eq!(output.checks[0].violations[0].line, Some(8));
+}
+
+#[test]
+fn apply_passes_check_with_only_legacy_violations() {
+    let scope = scope(&[("main.go", &[(8, 9)])]);
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("legacy.go", 3, "unsafe_pointer")],
+    )]);
+
+    assert_eq!(scope.apply(&mut output), 1);
+    assert!(output.passed);
+    assert!(output.checks[0].passed);
+}
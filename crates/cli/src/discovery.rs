@@ -1,20 +1,37 @@
 // SPDX-License-Identifier: MIT
 // Copyright (c) 2026 Alfred Jean LLC
 
-//! Config file discovery.
+//! Config file and project root discovery.
 //!
-//! Walks from the current directory up to the git root looking for quench.toml.
+//! Walks from the current directory up to the git root looking for quench.toml
+//! or .quench.yml, and up to the nearest project marker for the root that
+//! reported paths are relative to. Config files below the project root hold
+//! per-directory rule overrides.
 
+use std::collections::BTreeSet;
 use std::path::{Path, PathBuf};
 
-/// Find quench.toml starting from `start_dir` and walking up to git root.
+use crate::config::YAML_CONFIG_NAME;
+
+/// Config file names in order of preference within a directory.
+const CONFIG_NAMES: &[&str] = &["quench.toml", YAML_CONFIG_NAME];
+
+/// Files and directories that mark a project root.
+const ROOT_MARKERS: &[&str] = &["quench.toml", YAML_CONFIG_NAME, "go.mod", ".git"];
+
+/// Find quench.toml or .quench.yml starting from `start_dir` and walking up to git root.
+///
+/// The nearest directory with either file wins; quench.toml is preferred
+/// when both exist side by side.
 pub fn find_config(start_dir: &Path) -> Option<PathBuf> {
     let mut current = start_dir.to_path_buf();
 
     loop {
-        let config_path = current.join("quench.toml");
-        if config_path.exists() {
-            return Some(config_path);
+        for name in CONFIG_NAMES {
+            let config_path = current.join(name);
+            if config_path.exists() {
+                return Some(config_path);
+            }
         }
 
         // Stop at git root
@@ -30,6 +47,44 @@ pub fn find_config(start_dir: &Path) -> Option<PathBuf> {
     }
 }
 
+/// Find config files below `root` that apply to `files`: in the directory of
+/// each file or an ancestor, short of `root` itself. Sorted by path.
+///
+/// As in [`find_config`], quench.toml is preferred over .quench.yml in one
+/// directory.
+pub fn find_nested_configs<'a>(
+    root: &Path,
+    files: impl IntoIterator<Item = &'a Path>,
+) -> Vec<PathBuf> {
+    let mut dirs = BTreeSet::new();
+    for file in files {
+        for dir in file.ancestors().skip(1) {
+            if dir == root || !dir.starts_with(root) || !dirs.insert(dir.to_path_buf()) {
+                break;
+            }
+        }
+    }
+    dirs.into_iter()
+        .filter_map(|dir| {
+            CONFIG_NAMES
+                .iter()
+                .map(|name| dir.join(name))
+                .find(|path| path.exists())
+        })
+        .collect()
+}
+
+/// Find the project root for `start_dir`: the nearest directory, itself or
+/// an ancestor, containing quench.toml, .quench.yml, go.mod, or .git.
+///
+/// Returns None when no ancestor has a marker.
+pub fn find_project_root(start_dir: &Path) -> Option<PathBuf> {
+    start_dir
+        .ancestors()
+        .find(|dir| ROOT_MARKERS.iter().any(|marker| dir.join(marker).exists()))
+        .map(Path::to_path_buf)
+}
+
 #[cfg(test)]
 #[path = "discovery_tests.rs"]
 mod tests;
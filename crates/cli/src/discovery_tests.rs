@@ -76,3 +76,99 @@ fn returns_none_when_no_config() {
     let found = find_config(dir.path());
     assert_eq!(found, None);
 }
+
+#[test]
+fn finds_yaml_config() {
+    let dir = tempdir().unwrap();
+    let config_path = dir.path().join(".quench.yml");
+    fs::write(&config_path, "version: 1\n").unwrap();
+
+    let subdir = dir.path().join("subdir");
+    fs::create_dir(&subdir).unwrap();
+
+    let found = find_config(&subdir);
+    assert_eq!(found, Some(config_path));
+}
+
+#[test]
+fn prefers_toml_over_yaml_in_same_dir() {
+    let dir = tempdir().unwrap();
+    let toml_path = dir.path().join("quench.toml");
+    fs::write(&toml_path, "version = 1\n").unwrap();
+    fs::write(dir.path().join(".quench.yml"), "version: 1\n").unwrap();
+
+    let found = find_config(dir.path());
+    assert_eq!(found, Some(toml_path));
+}
+
+#[test]
+fn nearer_yaml_wins_over_parent_toml() {
+    let dir = tempdir().unwrap();
+    fs::write(dir.path().join("quench.toml"), "version = 1\n").unwrap();
+
+    let subdir = dir.path().join("subdir");
+    fs::create_dir(&subdir).unwrap();
+    let yaml_path = subdir.join(".quench.yml");
+    fs::write(&yaml_path, "version: 1\n").unwrap();
+
+    let found = find_config(&subdir);
+    assert_eq!(found, Some(yaml_path));
+}
+
+#[test]
+fn project_root_is_nearest_marker_dir() {
+    let dir = tempdir().unwrap();
+    fs::create_dir(dir.path().join(".git")).unwrap();
+    let module = dir.path().join("svc");
+    fs::create_dir_all(module.join("internal/store")).unwrap();
+    fs::write(module.join("go.mod"), "module example.com/svc\n").unwrap();
+
+    assert_eq!(
+        find_project_root(&module.join("internal/store")),
+        Some(module.clone())
+    );
+    assert_eq!(find_project_root(&module), Some(module));
+    assert_eq!(
+        find_project_root(dir.path()),
+        Some(dir.path().to_path_buf())
+    );
+}
+
+#[test]
+fn project_root_found_by_config_file() {
+    let dir = tempdir().unwrap();
+    fs::write(dir.path().join(".quench.yml"), "version: 1\n").unwrap();
+    let subdir = dir.path().join("a/b");
+    fs::create_dir_all(&subdir).unwrap();
+
+    assert_eq!(find_project_root(&subdir), Some(dir.path().to_path_buf()));
+}
+
+#[test]
+fn finds_nested_configs_above_files() {
+    let dir = tempdir().unwrap();
+    let root = dir.path();
+    fs::write(root.join("quench.toml"), "version = 1\n").unwrap();
+    fs::create_dir_all(root.join("internal/store")).unwrap();
+    fs::create_dir_all(root.join("cmd/app")).unwrap();
+    fs::create_dir_all(root.join("docs")).unwrap();
+    fs::write(root.join("internal/.quench.yml"), "version: 1\n").unwrap();
+    fs::write(root.join("internal/store/quench.toml"), "version = 1\n").unwrap();
+    fs::write(root.join("internal/store/.quench.yml"), "version: 1\n").unwrap();
+    // No scanned files below docs/
+    fs::write(root.join("docs/.quench.yml"), "version: 1\n").unwrap();
+
+    let files = [
+        root.join("internal/store/ptr.go"),
+        root.join("internal/store/map.go"),
+        root.join("cmd/app/main.go"),
+        root.join("main.go"),
+    ];
+    assert_eq!(
+        find_nested_configs(root, files.iter().map(PathBuf::as_path)),
+        vec![
+            root.join("internal/.quench.yml"),
+            root.join("internal/store/quench.toml"),
+        ]
+    );
+}
@@ -52,6 +52,11 @@ pub fn is_non_interactive() -> bool {
         || std::env::var_os(names::CI).is_some()
 }
 
+/// Returns `true` if `GITHUB_ACTIONS` is `"true"` (set by GitHub Actions runners).
+pub fn github_actions() -> bool {
+    std::env::var(names::GITHUB_ACTIONS).is_ok_and(|v| v == "true")
+}
+
 /// Returns `true` if `QUENCH_DEBUG` is `"1"` or `"true"` (case-insensitive).
 pub fn quench_debug() -> bool {
     std::env::var(names::QUENCH_DEBUG).is_ok_and(|v| v == "1" || v.eq_ignore_ascii_case("true"))
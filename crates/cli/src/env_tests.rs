@@ -35,6 +35,11 @@ fn names_ci_is_correct() {
     assert_eq!(names::CI, "CI");
 }
 
+#[test]
+fn names_github_actions_is_correct() {
+    assert_eq!(names::GITHUB_ACTIONS, "GITHUB_ACTIONS");
+}
+
 #[test]
 fn names_quench_debug_files_is_correct() {
     assert_eq!(names::QUENCH_DEBUG_FILES, "QUENCH_DEBUG_FILES");
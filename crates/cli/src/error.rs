@@ -59,6 +59,28 @@ pub enum ExitCode {
     InternalError = 3,
 }
 
+impl ExitCode {
+    /// Process exit status, with `check_failed` (`--exit-code`) in place of
+    /// [`ExitCode::CheckFailed`].
+    pub fn status(self, check_failed: u8) -> i32 {
+        match self {
+            ExitCode::CheckFailed => i32::from(check_failed),
+            code => code as i32,
+        }
+    }
+
+    /// Whether a status is taken by a code other than [`ExitCode::CheckFailed`].
+    pub fn is_reserved(status: u8) -> bool {
+        [
+            ExitCode::Success,
+            ExitCode::ConfigError,
+            ExitCode::InternalError,
+        ]
+        .iter()
+        .any(|code| *code as u8 == status)
+    }
+}
+
 impl From<&Error> for ExitCode {
     fn from(err: &Error) -> Self {
         match err {
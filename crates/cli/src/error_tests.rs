@@ -42,3 +42,24 @@ fn exit_code_from_internal_error() {
 fn exit_code_mapping(err: Error, expected: ExitCode) {
     assert_eq!(ExitCode::from(&err), expected);
 }
+
+#[parameterized(
+    success = { ExitCode::Success, 0 },
+    check_failed = { ExitCode::CheckFailed, 7 },
+    config_error = { ExitCode::ConfigError, 2 },
+    internal_error = { ExitCode::InternalError, 3 },
+)]
+fn exit_status_replaces_only_check_failed(code: ExitCode, expected: i32) {
+    assert_eq!(code.status(7), expected);
+}
+
+#[parameterized(
+    success = { 0, true },
+    check_failed = { 1, false },
+    config_error = { 2, true },
+    internal_error = { 3, true },
+    custom = { 10, false },
+)]
+fn reserved_statuses(status: u8, reserved: bool) {
+    assert_eq!(ExitCode::is_reserved(status), reserved);
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Pending `--fix` edits, collected under `--dry-run`.
+//!
+//! Fixers record each file's content before and after the edit instead of
+//! writing it, and the run prints a unified diff of every edit, in file path
+//! order, that `git apply` accepts.
+
+use std::path::{Path, PathBuf};
+use std::sync::Mutex;
+
+/// Unchanged lines shown around each change.
+const CONTEXT: usize = 3;
+
+/// An edit `--fix` would make to one file.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct PendingFix {
+    /// Path relative to the project root.
+    pub path: PathBuf,
+    /// Content before the fix (None = the fix creates the file).
+    pub before: Option<String>,
+    /// Content after the fix.
+    pub after: String,
+}
+
+/// Edits recorded by checks running in parallel.
+#[derive(Debug, Default)]
+pub struct FixPreview {
+    fixes: Mutex<Vec<PendingFix>>,
+}
+
+impl FixPreview {
+    pub fn new() -> Self {
+        Self::default()
+    }
+
+    /// Record an edit to `path`, relative to the project root. Edits that
+    /// leave the content as it was are skipped.
+    pub fn record(&self, path: &Path, before: Option<&str>, after: &str) {
+        if before == Some(after) {
+            return;
+        }
+        if let Ok(mut fixes) = self.fixes.lock() {
+            fixes.push(PendingFix {
+                path: path.to_path_buf(),
+                before: before.map(str::to_string),
+                after: after.to_string(),
+            });
+        }
+    }
+
+    /// Whether no edit is pending.
+    pub fn is_empty(&self) -> bool {
+        self.fixes.lock().map_or(true, |fixes| fixes.is_empty())
+    }
+
+    /// Unified diff of every pending edit, in file path order.
+    pub fn diff(&self) -> String {
+        let Ok(fixes) = self.fixes.lock() else {
+            return String::new();
+        };
+        let mut sorted: Vec<&PendingFix> = fixes.iter().collect();
+        sorted.sort_by(|a, b| a.path.cmp(&b.path));
+        sorted.into_iter().map(unified_diff).collect()
+    }
+}
+
+/// One line of an edit script.
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+enum Edit<'a> {
+    Keep(&'a str),
+    Remove(&'a str),
+    Add(&'a str),
+}
+
+/// Unified diff of one edit, with `a/` and `b/` path prefixes.
+pub fn unified_diff(fix: &PendingFix) -> String {
+    let path = fix.path.to_string_lossy().replace('\\', "/");
+    let old: Vec<&str> = fix
+        .before
+        .as_deref()
+        .map(|before| before.split_inclusive('\n').collect())
+        .unwrap_or_default();
+    let new: Vec<&str> = fix.after.split_inclusive('\n').collect();
+    let edits = edits(&old, &new);
+
+    let mut out = match fix.before {
+        Some(_) => format!("--- a/{}\n", path),
+        None => "--- /dev/null\n".to_string(),
+    };
+    out.push_str(&format!("+++ b/{}\n", path));
+    for (start, end) in hunk_ranges(&edits) {
+        let before = &edits[..start];
+        let hunk = &edits[start..end];
+        let old_count =
+            |edits: &[Edit]| edits.iter().filter(|e| !matches!(e, Edit::Add(_))).count();
+        let new_count = |edits: &[Edit]| {
+            edits
+                .iter()
+                .filter(|e| !matches!(e, Edit::Remove(_)))
+                .count()
+        };
+        out.push_str(&format!(
+            "@@ -{} +{} @@\n",
+            hunk_range(old_count(before), old_count(hunk)),
+            hunk_range(new_count(before), new_count(hunk)),
+        ));
+        for edit in hunk {
+            let (prefix, line) = match edit {
+                Edit::Keep(line) => (' ', line),
+                Edit::Remove(line) => ('-', line),
+                Edit::Add(line) => ('+', line),
+            };
+            out.push(prefix);
+            out.push_str(line);
+            if !line.ends_with('\n') {
+                out.push_str("\n\\ No newline at end of file\n");
+            }
+        }
+    }
+    out
+}
+
+/// A hunk header range: the first line and line count, with the count left
+/// out when it's 1, and the line before the hunk when it's 0.
+fn hunk_range(lines_before: usize, count: usize) -> String {
+    match count {
+        0 => format!("{},0", lines_before),
+        1 => format!("{}", lines_before + 1),
+        _ => format!("{},{}", lines_before + 1, count),
+    }
+}
+
+/// Ranges of `edits` to show: each change with `CONTEXT` lines around it,
+/// merged when their context overlaps or touches.
+fn hunk_ranges(edits: &[Edit]) -> Vec<(usize, usize)> {
+    let mut ranges: Vec<(usize, usize)> = Vec::new();
+    for (idx, edit) in edits.iter().enumerate() {
+        if matches!(edit, Edit::Keep(_)) {
+            continue;
+        }
+        let start = idx.saturating_sub(CONTEXT);
+        let end = (idx + 1 + CONTEXT).min(edits.len());
+        match ranges.last_mut() {
+            Some(last) if start <= last.1 => last.1 = end,
+            _ => ranges.push((start, end)),
+        }
+    }
+    ranges
+}
+
+/// Line edit script from `old` to `new`.
+///
+/// The common prefix and suffix are kept as is, so the search only covers
+/// the lines in between: fixes usually touch a few lines of a large file.
+fn edits<'a>(old: &[&'a str], new: &[&'a str]) -> Vec<Edit<'a>> {
+    let prefix = old.iter().zip(new).take_while(|(a, b)| a == b).count();
+    let suffix = old[prefix..]
+        .iter()
+        .rev()
+        .zip(new[prefix..].iter().rev())
+        .take_while(|(a, b)| a == b)
+        .count();
+
+    let mut script: Vec<Edit> = old[..prefix].iter().map(|&line| Edit::Keep(line)).collect();
+    script.extend(shortest_edit(
+        &old[prefix..old.len() - suffix],
+        &new[prefix..new.len() - suffix],
+    ));
+    script.extend(
+        old[old.len() - suffix..]
+            .iter()
+            .map(|&line| Edit::Keep(line)),
+    );
+    script
+}
+
+/// Shortest edit script from `a` to `b`, by Myers' O(ND) algorithm.
+fn shortest_edit<'a>(a: &[&'a str], b: &[&'a str]) -> Vec<Edit<'a>> {
+    let (n, m) = (a.len() as isize, b.len() as isize);
+    let max = n + m;
+    if max == 0 {
+        return Vec::new();
+    }
+    // Furthest x reached on each diagonal k = x - y, indexed from -max
+    let at = |k: isize| (k + max) as usize;
+    let mut v = vec![0isize; 2 * max as usize + 2];
+    let mut trace = Vec::new();
+    'search: for d in 0..=max {
+        trace.push(v.clone());
+        for k in (-d..=d).step_by(2) {
+            let mut x = if k == -d || (k != d && v[at(k - 1)] < v[at(k + 1)]) {
+                v[at(k + 1)]
+            } else {
+                v[at(k - 1)] + 1
+            };
+            let mut y = x - k;
+            while x < n && y < m && a[x as usize] == b[y as usize] {
+                x += 1;
+                y += 1;
+            }
+            v[at(k)] = x;
+            if x >= n && y >= m {
+                break 'search;
+            }
+        }
+    }
+
+    // Walk back from the end through each round's furthest points
+    let mut script = Vec::new();
+    let (mut x, mut y) = (n, m);
+    for (d, v) in trace.iter().enumerate().rev() {
+        let d = d as isize;
+        let k = x - y;
+        let prev_k = if k == -d || (k != d && v[at(k - 1)] < v[at(k + 1)]) {
+            k + 1
+        } else {
+            k - 1
+        };
+        let prev_x = v[at(prev_k)];
+        let prev_y = prev_x - prev_k;
+        while x > prev_x && y > prev_y {
+            script.push(Edit::Keep(a[x as usize - 1]));
+            x -= 1;
+            y -= 1;
+        }
+        if d > 0 {
+            if x == prev_x {
+                script.push(Edit::Add(b[y as usize - 1]));
+            } else {
+                script.push(Edit::Remove(a[x as usize - 1]));
+            }
+        }
+        x = prev_x;
+        y = prev_y;
+    }
+    script.reverse();
+    script
+}
+
+#[cfg(test)]
+#[path = "fix_preview_tests.rs"]
+mod tests;
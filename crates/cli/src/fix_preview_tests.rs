@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+fn diff(before: Option<&str>, after: &str) -> String {
+    unified_diff(&PendingFix {
+        path: PathBuf::from("src/lib.rs"),
+        before: before.map(str::to_string),
+        after: after.to_string(),
+    })
+}
+
+#[test]
+fn inserted_line_has_context() {
+    let before = "a\nb\nc\nd\ne\nf\ng\nh\n";
+    let after = "a\nb\nc\nd\nnew\ne\nf\ng\nh\n";
+    assert_eq!(
+        diff(Some(before), after),
+        "--- a/src/lib.rs\n+++ b/src/lib.rs\n@@ -2,6 +2,7 @@\n b\n c\n d\n+new\n e\n f\n g\n"
+    );
+}
+
+#[test]
+fn distant_changes_get_separate_hunks() {
+    let before = "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n";
+    let after = "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n";
+    assert_eq!(
+        diff(Some(before), after),
+        "--- a/src/lib.rs\n+++ b/src/lib.rs\n\
+@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n\
+@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n"
+    );
+}
+
+#[test]
+fn nearby_changes_share_a_hunk() {
+    let before = "1\n2\n3\n4\n5\n6\n7\n8\n";
+    let after = "one\n2\n3\n4\n5\n6\n7\neight\n";
+    assert_eq!(
+        diff(Some(before), after),
+        "--- a/src/lib.rs\n+++ b/src/lib.rs\n\
+@@ -1,8 +1,8 @@\n-1\n+one\n 2\n 3\n 4\n 5\n 6\n 7\n-8\n+eight\n"
+    );
+}
+
+#[test]
+fn new_file_diffs_from_dev_null() {
+    assert_eq!(
+        diff(None, "x\ny\n"),
+        "--- /dev/null\n+++ b/src/lib.rs\n@@ -0,0 +1,2 @@\n+x\n+y\n"
+    );
+}
+
+#[test]
+fn missing_final_newline_is_marked() {
+    assert_eq!(
+        diff(Some("a\nb"), "a\nb\n"),
+        "--- a/src/lib.rs\n+++ b/src/lib.rs\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"
+    );
+}
+
+#[parameterized(
+    insert_only = { "a\nb\n", "x\na\nb\ny\n" },
+    remove_only = { "a\nb\nc\n", "b\n" },
+    replace_all = { "a\nb\n", "c\nd\ne\n" },
+    interleaved = { "a\nb\nc\nd\n", "b\na\nd\nc\n" },
+    from_empty = { "", "a\n" },
+    to_empty = { "a\nb\n", "" },
+)]
+fn edit_script_rebuilds_both_sides(before: &str, after: &str) {
+    let old: Vec<&str> = before.split_inclusive('\n').collect();
+    let new: Vec<&str> = after.split_inclusive('\n').collect();
+    let script = edits(&old, &new);
+
+    let rebuilt_old: String = script
+        .iter()
+        .filter_map(|e| match e {
+            Edit::Keep(l) | Edit::Remove(l) => Some(*l),
+            Edit::Add(_) => None,
+        })
+        .collect();
+    let rebuilt_new: String = script
+        .iter()
+        .filter_map(|e| match e {
+            Edit::Keep(l) | Edit::Add(l) => Some(*l),
+            Edit::Remove(_) => None,
+        })
+        .collect();
+    assert_eq!(rebuilt_old, before);
+    assert_eq!(rebuilt_new, after);
+}
+
+#[test]
+fn preview_sorts_by_path_and_skips_unchanged() {
+    let preview = FixPreview::new();
+    preview.record(Path::new("b.txt"), Some("1\n"), "2\n");
+    preview.record(Path::new("same.txt"), Some("1\n"), "1\n");
+    preview.record(Path::new("a.txt"), None, "new\n");
+    assert!(!preview.is_empty());
+    assert_eq!(
+        preview.diff(),
+        "--- /dev/null\n+++ b/a.txt\n@@ -0,0 +1 @@\n+new\n\
+--- a/b.txt\n+++ b/b.txt\n@@ -1 +1 @@\n-1\n+2\n"
+    );
+}
+
+#[test]
+fn empty_preview_has_no_diff() {
+    let preview = FixPreview::new();
+    preview.record(Path::new("same.txt"), Some("1\n"), "1\n");
+    assert!(preview.is_empty());
+    assert_eq!(preview.diff(), "");
+}
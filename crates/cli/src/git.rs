@@ -12,7 +12,14 @@
 //! - Modified files: path from `new_file()` (same as old)
 //! - Renamed files: path from `new_file()` (the new location)
 //! - Deleted files: path from `old_file()` (since `new_file()` is empty)
+//!
+//! ## Line Detection
+//!
+//! When detecting changed lines (`--diff`), renames are paired with their
+//! source so only edited lines count, and new or untracked files count in
+//! full. Deleted lines have no position in the new file and are not reported.
 
+use std::collections::HashMap;
 use std::path::{Path, PathBuf};
 
 use anyhow::Context;
@@ -202,6 +209,72 @@ pub fn get_staged_files(root: &Path) -> anyhow::Result<Vec<PathBuf>> {
     Ok(files)
 }
 
+/// Get lines added or modified since a git base ref (for --diff).
+///
+/// Compares the base tree to the working tree, including staged, unstaged,
+/// and untracked changes. Keys are file paths relative to `root`; values are
+/// inclusive 1-based line ranges in the current file.
+pub fn get_changed_lines(
+    root: &Path,
+    base: &str,
+) -> anyhow::Result<HashMap<PathBuf, Vec<(u32, u32)>>> {
+    let repo = Repository::discover(root).context("Failed to open repository")?;
+    let workdir = repo
+        .workdir()
+        .context("Repository has no working directory")?;
+
+    let base_tree = repo
+        .revparse_single(base)
+        .with_context(|| format!("Failed to resolve base ref: {}", base))?
+        .peel_to_tree()
+        .context("Failed to get tree for base ref")?;
+
+    let mut opts = git2::DiffOptions::new();
+    opts.context_lines(0)
+        .include_untracked(true)
+        .recurse_untracked_dirs(true)
+        .show_untracked_content(true);
+    let mut diff = repo
+        .diff_tree_to_workdir_with_index(Some(&base_tree), Some(&mut opts))
+        .context("Failed to compute diff")?;
+
+    // Pair renames (including untracked copies of deleted files) with their
+    // source, so a moved file only reports the lines that were edited
+    let mut find = git2::DiffFindOptions::new();
+    find.renames(true).for_untracked(true);
+    diff.find_similar(Some(&mut find))
+        .context("Failed to detect renames")?;
+
+    // Violation paths are relative to root, which may be below the workdir
+    let workdir = workdir
+        .canonicalize()
+        .unwrap_or_else(|_| workdir.to_path_buf());
+    let root = root.canonicalize().unwrap_or_else(|_| root.to_path_buf());
+
+    let mut lines: HashMap<PathBuf, Vec<(u32, u32)>> = HashMap::new();
+    diff.foreach(
+        &mut |_, _| true,
+        None,
+        Some(&mut |delta, hunk| {
+            if hunk.new_lines() > 0
+                && let Some(path) = delta.new_file().path()
+                && let Ok(relative) = workdir.join(path).strip_prefix(&root)
+            {
+                let start = hunk.new_start();
+                lines
+                    .entry(relative.to_path_buf())
+                    .or_default()
+                    .push((start, start + hunk.new_lines() - 1));
+            }
+            true
+        }),
+        None,
+    )
+    .context("Failed to read diff hunks")?;
+
+    Ok(lines)
+}
+
 /// Save content to git notes for HEAD commit.
 ///
 /// Uses `refs/notes/quench` namespace to avoid conflicts with other tools.
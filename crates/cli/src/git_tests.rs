@@ -496,3 +496,96 @@ fn find_ratchet_base_errors_for_unborn_branch() {
     let result = find_ratchet_base(temp.path(), None);
     assert!(result.is_err());
 }
+
+// =============================================================================
+// GET_CHANGED_LINES TESTS
+// =============================================================================
+
+#[test]
+fn get_changed_lines_reports_modified_lines() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    create_and_stage(&temp, "main.go", "a\nb\nc\nd\n");
+    git_commit(&temp, "feat: add main");
+
+    // Modify line 2 and append line 5 without committing
+    std::fs::write(temp.path().join("main.go"), "a\nB\nc\nd\ne\n").unwrap();
+
+    let lines = get_changed_lines(temp.path(), "HEAD").unwrap();
+    assert_eq!(lines.get(Path::new("main.go")), Some(&vec![(2, 2), (5, 5)]));
+}
+
+#[test]
+fn get_changed_lines_covers_new_files() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    create_initial_commit(&temp);
+
+    // One committed on the branch, one untracked
+    create_and_stage(&temp, "added.go", "a\nb\n");
+    git_commit(&temp, "feat: add file");
+    std::fs::write(temp.path().join("untracked.go"), "a\nb\nc\n").unwrap();
+
+    let lines = get_changed_lines(temp.path(), "HEAD~1").unwrap();
+    assert_eq!(lines.get(Path::new("added.go")), Some(&vec![(1, 2)]));
+    assert_eq!(lines.get(Path::new("untracked.go")), Some(&vec![(1, 3)]));
+    assert!(!lines.contains_key(Path::new("README.md")));
+}
+
+#[test]
+fn get_changed_lines_renamed_file_reports_only_edits() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    let content: String = (1..=20).map(|i| format!("line {}\n", i)).collect();
+    create_and_stage(&temp, "old.go", &content);
+    git_commit(&temp, "feat: add file");
+
+    git_mv(&temp, "old.go", "new.go");
+    std::fs::write(
+        temp.path().join("new.go"),
+        content.replace("line 7\n", "line seven\n"),
+    )
+    .unwrap();
+
+    let lines = get_changed_lines(temp.path(), "HEAD").unwrap();
+    assert_eq!(lines.get(Path::new("new.go")), Some(&vec![(7, 7)]));
+    assert!(!lines.contains_key(Path::new("old.go")));
+}
+
+#[test]
+fn get_changed_lines_ignores_deletions() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    create_and_stage(&temp, "main.go", "a\nb\nc\n");
+    create_and_stage(&temp, "gone.go", "a\n");
+    git_commit(&temp, "feat: add files");
+
+    std::fs::write(temp.path().join("main.go"), "a\nc\n").unwrap();
+    std::fs::remove_file(temp.path().join("gone.go")).unwrap();
+
+    let lines = get_changed_lines(temp.path(), "HEAD").unwrap();
+    assert!(lines.is_empty());
+}
+
+#[test]
+fn get_changed_lines_relative_to_subdirectory_root() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    create_initial_commit(&temp);
+    std::fs::create_dir_all(temp.path().join("app")).unwrap();
+    std::fs::write(temp.path().join("app/main.go"), "a\n").unwrap();
+    std::fs::write(temp.path().join("other.go"), "a\n").unwrap();
+
+    let lines = get_changed_lines(&temp.path().join("app"), "HEAD").unwrap();
+    assert_eq!(lines.get(Path::new("main.go")), Some(&vec![(1, 1)]));
+    assert_eq!(lines.len(), 1);
+}
+
+#[test]
+fn get_changed_lines_invalid_base_ref() {
+    let temp = TempDir::new().unwrap();
+    init_git_repo(&temp);
+    create_initial_commit(&temp);
+
+    assert!(get_changed_lines(temp.path(), "nonexistent").is_err());
+}
@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Inline suppression directives (`quench:ignore`).
+//!
+//! A comment like `// quench:ignore unsafe-pointer reason: audited 2024`
+//! suppresses violations of that rule on its own line, or on the next line
+//! when the comment stands alone. Rules are matched like `[severity]` keys:
+//! `-` and `_` are treated alike, and a violation's stable rule id
+//! (`linkname` for `go_linkname`) works too. The reason is required: a
+//! directive without one suppresses nothing and is reported. Directives that
+//! match no violation are reported as unused, so reviewed exceptions don't
+//! outlive the code they excused.
+
+use std::collections::HashMap;
+use std::fmt;
+use std::path::{Path, PathBuf};
+
+use rayon::prelude::*;
+
+use crate::check::CheckOutput;
+use crate::file_reader::FileContent;
+use crate::output::violations::rule_id;
+use crate::severity::rule_matches;
+use crate::walker::WalkedFile;
+
+/// Directive marker searched for in comments.
+pub const DIRECTIVE: &str = "quench:ignore";
+
+/// Comment markers a directive may follow.
+const COMMENT_MARKERS: &[&str] = &["<!--", "//", "/*", "#", "--", ";;"];
+
+/// A parsed `quench:ignore` directive.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct Directive {
+    /// File path relative to the project root.
+    pub file: PathBuf,
+    /// Line the directive is written on, 1-based.
+    pub line: u32,
+    /// Line the directive applies to, 1-based.
+    pub target: u32,
+    /// Rules to suppress, as written.
+    pub rules: Vec<String>,
+    /// Justification after `reason:`.
+    pub reason: String,
+}
+
+/// A problem with a directive, reported as a warning.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum IgnoreWarning {
+    /// Directive missing its rule or reason; it suppresses nothing.
+    Malformed {
+        file: PathBuf,
+        line: u32,
+        problem: &'static str,
+    },
+    /// Directive rule with no matching violation on its target line.
+    Unused {
+        file: PathBuf,
+        line: u32,
+        rule: String,
+    },
+}
+
+impl IgnoreWarning {
+    /// File and line of the directive.
+    pub fn location(&self) -> (&Path, u32) {
+        match self {
+            IgnoreWarning::Malformed { file, line, .. }
+            | IgnoreWarning::Unused { file, line, .. } => (file, *line),
+        }
+    }
+}
+
+impl fmt::Display for IgnoreWarning {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        match self {
+            IgnoreWarning::Malformed {
+                file,
+                line,
+                problem,
+            } => write!(
+                f,
+                "{}:{}: {} {}, directive ignored",
+                file.display(),
+                line,
+                DIRECTIVE,
+                problem
+            ),
+            IgnoreWarning::Unused { file, line, rule } => write!(
+                f,
+                "{}:{}: unused {} {}: no matching violation",
+                file.display(),
+                line,
+                DIRECTIVE,
+                rule
+            ),
+        }
+    }
+}
+
+/// Directives collected from the project's files.
+#[derive(Debug, Default)]
+pub struct InlineIgnores {
+    directives: Vec<Directive>,
+    malformed: Vec<IgnoreWarning>,
+}
+
+impl InlineIgnores {
+    /// Collect directives from every walked file.
+    ///
+    /// Files that can't be read as UTF-8 are skipped.
+    pub fn collect(root: &Path, files: &[WalkedFile]) -> Self {
+        let parsed: Vec<_> = files
+            .par_iter()
+            .filter_map(|file| {
+                let content = FileContent::read(&file.path).ok()?;
+                let text = content.as_str()?;
+                if !text.contains(DIRECTIVE) {
+                    return None;
+                }
+                let relative = file.path.strip_prefix(root).unwrap_or(&file.path);
+                Some(parse(relative, text))
+            })
+            .collect();
+
+        let mut ignores = Self::default();
+        for (directives, malformed) in parsed {
+            ignores.directives.extend(directives);
+            ignores.malformed.extend(malformed);
+        }
+        ignores
+    }
+
+    /// Collect directives from one file's content, e.g., an editor buffer.
+    pub fn from_source(file: &Path, content: &str) -> Self {
+        let (directives, malformed) = parse(file, content);
+        Self {
+            directives,
+            malformed,
+        }
+    }
+
+    /// Whether no directives (valid or not) were found.
+    pub fn is_empty(&self) -> bool {
+        self.directives.is_empty() && self.malformed.is_empty()
+    }
+
+    /// Remove suppressed violations from the output.
+    ///
+    /// A failing check whose violations are all suppressed passes. Returns
+    /// the number of suppressed violations and the warnings to report:
+    /// malformed directives always, unused ones only when `report_unused`
+    /// (a directive for a check that didn't run would look unused).
+    pub fn apply(
+        &self,
+        output: &mut CheckOutput,
+        report_unused: bool,
+    ) -> (usize, Vec<IgnoreWarning>) {
+        let mut by_target: HashMap<(&Path, u32), Vec<(usize, usize)>> = HashMap::new();
+        for (d, directive) in self.directives.iter().enumerate() {
+            for r in 0..directive.rules.len() {
+                by_target
+                    .entry((directive.file.as_path(), directive.target))
+                    .or_default()
+                    .push((d, r));
+            }
+        }
+
+        let mut used: Vec<Vec<bool>> = self
+            .directives
+            .iter()
+            .map(|d| vec![false; d.rules.len()])
+            .collect();
+        let mut suppressed = 0;
+        for result in &mut output.checks {
+            if result.violations.is_empty() {
+                continue;
+            }
+            result.violations.retain(|v| {
+                let (Some(file), Some(line)) = (&v.file, v.line) else {
+                    return true;
+                };
+                let Some(candidates) = by_target.get(&(file.as_path(), line)) else {
+                    return true;
+                };
+                let rule = rule_id(v);
+                let mut matched = false;
+                for &(d, r) in candidates {
+                    if rule_matches(&self.directives[d].rules[r], &rule) {
+                        used[d][r] = true;
+                        matched = true;
+                    }
+                }
+                if matched {
+                    suppressed += 1;
+                }
+                !matched
+            });
+            if result.violations.is_empty() && !result.skipped {
+                result.passed = true;
+            }
+        }
+        output.passed = output.checks.iter().all(|c| c.passed || c.skipped);
+
+        let mut warnings = self.malformed.clone();
+        if report_unused {
+            for (directive, used) in self.directives.iter().zip(&used) {
+                for (rule, _) in directive.rules.iter().zip(used).filter(|(_, u)| !**u) {
+                    warnings.push(IgnoreWarning::Unused {
+                        file: directive.file.clone(),
+                        line: directive.line,
+                        rule: rule.clone(),
+                    });
+                }
+            }
+        }
+        warnings.sort_by(|a, b| a.location().cmp(&b.location()));
+        (suppressed, warnings)
+    }
+}
+
+/// Parse all directives in a file's content.
+pub fn parse(file: &Path, content: &str) -> (Vec<Directive>, Vec<IgnoreWarning>) {
+    let mut directives = Vec::new();
+    let mut malformed = Vec::new();
+    for (idx, text) in content.lines().enumerate() {
+        let line = idx as u32 + 1;
+        match parse_line(text) {
+            None => {}
+            Some(Ok(parsed)) => directives.push(Directive {
+                file: file.to_path_buf(),
+                line,
+                target: if parsed.standalone { line + 1 } else { line },
+                rules: parsed.rules,
+                reason: parsed.reason,
+            }),
+            Some(Err(problem)) => malformed.push(IgnoreWarning::Malformed {
+                file: file.to_path_buf(),
+                line,
+                problem,
+            }),
+        }
+    }
+    (directives, malformed)
+}
+
+/// A directive parsed from a single line.
+#[derive(Debug, PartialEq, Eq)]
+struct ParsedLine {
+    rules: Vec<String>,
+    reason: String,
+    /// Nothing but the comment is on the line.
+    standalone: bool,
+}
+
+/// Parse a directive from one line.
+///
+/// Returns None when the line has no directive in a comment, or the problem
+/// when the directive is missing its rule or reason.
+fn parse_line(text: &str) -> Option<Result<ParsedLine, &'static str>> {
+    let start = text.find(DIRECTIVE)?;
+    let before = text[..start].trim_end();
+    let marker = COMMENT_MARKERS.iter().find(|m| before.ends_with(*m))?;
+    let code = &before[..before.len() - marker.len()];
+
+    let rest = &text[start + DIRECTIVE.len()..];
+    if !rest.is_empty() && !rest.starts_with(char::is_whitespace) {
+        return None; // e.g. `quench:ignored`
+    }
+    let rest = rest.trim_end();
+    let rest = rest
+        .strip_suffix("*/")
+        .or_else(|| rest.strip_suffix("-->"))
+        .unwrap_or(rest);
+
+    let (rules, reason) = rest.split_once("reason:").unwrap_or((rest, ""));
+    let rules: Vec<String> = rules
+        .split(|c: char| c == ',' || c.is_whitespace())
+        .filter(|r| !r.is_empty())
+        .map(String::from)
+        .collect();
+    let reason = reason.trim();
+
+    if rules.is_empty() {
+        return Some(Err("needs a rule"));
+    }
+    if reason.is_empty() {
+        return Some(Err("needs a reason (`reason: <why>`)"));
+    }
+    Some(Ok(ParsedLine {
+        rules,
+        reason: reason.to_string(),
+        standalone: code.trim().is_empty(),
+    }))
+}
+
+#[cfg(test)]
+#[path = "inline_ignore_tests.rs"]
+mod tests;
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+fn ignores(file: &str, content: &str) -> InlineIgnores {
+    InlineIgnores::from_source(Path::new(file), content)
+}
+
+#[parameterized(
+    go_line_above = { "//quench:ignore unsafe-pointer reason: audited 2024", true },
+    spaced = { "\t// quench:ignore unsafe-pointer reason: audited 2024", true },
+    hash_comment = { "# quench:ignore eval reason: sandboxed", true },
+    block_comment = { "/* quench:ignore unsafe-pointer reason: audited */", true },
+    html_comment = { "<!-- quench:ignore todo reason: tracked -->", true },
+    trailing = { "p := unsafe.Pointer(&x) // quench:ignore unsafe-pointer reason: audited", false },
+)]
+fn parses_directive(line: &str, standalone: bool) {
+    let parsed = parse_line(line).unwrap().unwrap();
+    assert_eq!(parsed.rules.len(), 1);
+    assert!(!parsed.reason.is_empty());
+    assert!(!parsed.reason.ends_with("*/") && !parsed.reason.ends_with("-->"));
+    assert_eq!(parsed.standalone, standalone);
+}
+
+#[parameterized(
+    no_directive = { "p := unsafe.Pointer(&x)" },
+    in_string = { "msg := \"quench:ignore unsafe-pointer reason: x\"" },
+    longer_word = { "// quench:ignored unsafe-pointer reason: x" },
+)]
+fn ignores_non_directives(line: &str) {
+    assert_eq!(parse_line(line), None);
+}
+
+#[test]
+fn parses_multiple_rules() {
+    let parsed = parse_line("// quench:ignore unsafe-pointer, forbidden reason: FFI shim")
+        .unwrap()
+        .unwrap();
+    assert_eq!(parsed.rules, vec!["unsafe-pointer", "forbidden"]);
+    assert_eq!(parsed.reason, "FFI shim");
+}
+
+#[parameterized(
+    missing_reason = { "// quench:ignore unsafe-pointer" },
+    empty_reason = { "// quench:ignore unsafe-pointer reason:   " },
+    missing_rule = { "// quench:ignore reason: audited" },
+)]
+fn rejects_malformed_directive(line: &str) {
+    assert!(parse_line(line).unwrap().is_err());
+}
+
+#[test]
+fn standalone_directive_targets_next_line() {
+    let (directives, _) = parse(
+        Path::new("main.go"),
+        "package main\n// quench:ignore unsafe-pointer reason: audited\np := unsafe.Pointer(&x)\n",
+    );
+    assert_eq!(directives.len(), 1);
+    assert_eq!(directives[0].line, 2);
+    assert_eq!(directives[0].target, 3);
+}
+
+#[test]
+fn apply_suppresses_matching_violation() {
+    let ignores = ignores(
+        "main.go",
+        "package main\n// quench:ignore unsafe-pointer reason: audited\np := unsafe.Pointer(&x)\n",
+    );
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 3, "unsafe_pointer")],
+    )]);
+
+    let (suppressed, warnings) = ignores.apply(&mut output, true);
+    assert_eq!(suppressed, 1);
+    assert!(warnings.is_empty());
+    assert!(output.passed);
+    assert!(output.checks[0].violations.is_empty());
+}
+
+#[test]
+fn apply_matches_stable_rule_id() {
+    let ignores = ignores(
+        "main.go",
+        "package main\n// quench:ignore linkname reason: vetted runtime hook\n//go:linkname now runtime.nanotime\n",
+    );
+    let violation = Violation::file(
+        "main.go",
+        3,
+        "missing_comment",
+        "Add a // LINKNAME: comment.",
+    )
+    .with_pattern("go_linkname");
+    let mut output = create_output(vec![CheckResult::failed("escapes", vec![violation])]);
+
+    let (suppressed, warnings) = ignores.apply(&mut output, true);
+    assert_eq!(suppressed, 1);
+    assert!(warnings.is_empty());
+}
+
+#[test]
+fn apply_keeps_other_rules_and_lines() {
+    let ignores = ignores(
+        "main.go",
+        "p := unsafe.Pointer(&x) // quench:ignore forbidden reason: audited\nq := unsafe.Pointer(&y)\n",
+    );
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 1, "unsafe_pointer"),
+            escape_violation("main.go", 2, "unsafe_pointer"),
+        ],
+    )]);
+
+    let (suppressed, warnings) = ignores.apply(&mut output, true);
+    assert_eq!(suppressed, 0);
+    assert!(!output.passed);
+    assert_eq!(output.checks[0].violations.len(), 2);
+    assert_eq!(
+        warnings,
+        vec![IgnoreWarning::Unused {
+            file: PathBuf::from("main.go"),
+            line: 1,
+            rule: "forbidden".to_string(),
+        }]
+    );
+}
+
+#[test]
+fn apply_reports_malformed_and_does_not_suppress() {
+    let ignores = ignores(
+        "main.go",
+        "// quench:ignore unsafe-pointer\np := unsafe.Pointer(&x)\n",
+    );
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+
+    let (suppressed, warnings) = ignores.apply(&mut output, false);
+    assert_eq!(suppressed, 0);
+    assert!(!output.passed);
+    assert!(matches!(
+        warnings.as_slice(),
+        [IgnoreWarning::Malformed { line: 1, .. }]
+    ));
+}
+
+#[test]
+fn apply_skips_unused_when_not_reporting() {
+    let ignores = ignores(
+        "main.go",
+        "// quench:ignore unsafe-pointer reason: audited\nfunc main() {}\n",
+    );
+    let mut output = create_output(vec![CheckResult::passed("escapes")]);
+
+    let (_, warnings) = ignores.apply(&mut output, false);
+    assert!(warnings.is_empty());
+}
+
+#[test]
+fn warning_display_includes_location() {
+    let warning = IgnoreWarning::Unused {
+        file: PathBuf::from("main.go"),
+        line: 4,
+        rule: "unsafe-pointer".to_string(),
+    };
+    assert_eq!(
+        warning.to_string(),
+        "main.go:4: unused quench:ignore unsafe-pointer: no matching violation"
+    );
+}
@@ -3,7 +3,9 @@
 
 pub mod adapter;
 pub mod baseline;
+pub mod build_info;
 pub mod cache;
+pub mod category;
 pub mod check;
 pub mod checks;
 pub mod cli;
@@ -12,29 +14,42 @@ pub mod cmd_init;
 pub mod color;
 pub mod completions;
 pub mod config;
+pub mod diff_scope;
 pub mod discovery;
 pub mod env;
 pub mod error;
 pub mod file_reader;
 pub mod file_size;
+pub mod fix_preview;
 pub mod git;
 pub mod help;
 pub mod init;
+pub mod inline_ignore;
 pub mod latest;
 pub mod output;
 pub mod pattern;
 pub mod profiles;
+pub mod progress;
 pub mod ratchet;
 pub mod report;
+pub mod rules;
 pub mod runner;
+pub mod scan;
+pub mod severity;
+pub mod stream;
 pub mod timing;
 pub mod tolerance;
 pub mod verbose;
+pub mod violation_baseline;
 pub mod walker;
+pub mod watch;
 
 pub use baseline::Baseline;
 pub use cli::{Cli, Command};
+pub use config::Config;
 pub use error::{Error, ExitCode};
+pub use output::violations::{Severity, ViolationRecord};
+pub use scan::{Engine, ScanOptions, scan};
 
 #[cfg(test)]
 pub mod test_utils;
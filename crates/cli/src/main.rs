@@ -17,6 +17,7 @@ mod cmd_check;
 mod cmd_cloc;
 mod cmd_config;
 mod cmd_report;
+mod cmd_rules;
 
 fn init_logging() {
     let filter = EnvFilter::try_from_env(quench::env::quench_log_var())
@@ -32,21 +33,23 @@ fn init_logging() {
 fn main() {
     init_logging();
 
-    let exit_code = match run() {
-        Ok(code) => code,
+    let status = match run() {
+        Ok(status) => status,
         Err(e) => {
             eprintln!("quench: {}", e);
-            match e.downcast_ref::<quench::Error>() {
+            let code = match e.downcast_ref::<quench::Error>() {
                 Some(err) => ExitCode::from(err),
                 None => ExitCode::InternalError,
-            }
+            };
+            code as i32
         }
     };
 
-    std::process::exit(exit_code as i32);
+    std::process::exit(status);
 }
 
-fn run() -> anyhow::Result<ExitCode> {
+/// Run the command and return the process exit status.
+fn run() -> anyhow::Result<i32> {
     // Use try_parse to intercept help display
     let cli = match Cli::try_parse() {
         Ok(cli) => cli,
@@ -55,12 +58,12 @@ fn run() -> anyhow::Result<ExitCode> {
                 ErrorKind::DisplayHelp => {
                     // Use custom help formatter
                     print_custom_help(&std::env::args().collect::<Vec<_>>());
-                    Ok(ExitCode::Success)
+                    Ok(ExitCode::Success as i32)
                 }
                 ErrorKind::DisplayVersion => {
                     // Let clap handle version display
                     e.print()?;
-                    Ok(ExitCode::Success)
+                    Ok(ExitCode::Success as i32)
                 }
                 _ => {
                     // Let clap handle other errors (including DisplayHelpOnMissingArgumentOrSubcommand)
@@ -70,6 +73,17 @@ fn run() -> anyhow::Result<ExitCode> {
         }
     };
 
+    let code = dispatch(&cli)?;
+    // Only check runs take a custom failure status
+    let check_failed = match &cli.command {
+        Some(Command::Check(args)) => args.exit_code,
+        _ => ExitCode::CheckFailed as u8,
+    };
+    Ok(code.status(check_failed))
+}
+
+/// Run the parsed subcommand.
+fn dispatch(cli: &Cli) -> anyhow::Result<ExitCode> {
     match &cli.command {
         None => {
             // Show help for bare invocation
@@ -77,14 +91,15 @@ fn run() -> anyhow::Result<ExitCode> {
             println!();
             Ok(ExitCode::Success)
         }
-        Some(Command::Check(args)) => cmd_check::run(&cli, args),
+        Some(Command::Check(args)) => cmd_check::run(cli, args),
         Some(Command::Cloc(args)) => cmd_cloc::run(args),
         Some(Command::Report(args)) => {
-            cmd_report::run(&cli, args)?;
+            cmd_report::run(cli, args)?;
             Ok(ExitCode::Success)
         }
         Some(Command::Init(args)) => quench::cmd_init::run(args),
         Some(Command::Config(args)) => cmd_config::run(args),
+        Some(Command::Rules(args)) => cmd_rules::run(args),
         Some(Command::Completions(args)) => {
             let mut cmd = Cli::command();
             generate(args.shell, &mut cmd, "quench", &mut io::stdout());
@@ -126,6 +141,11 @@ fn print_custom_help(args: &[String]) {
                 print!("{}", format_help(subcmd));
             }
         }
+        Some("rules") => {
+            if let Some(subcmd) = cmd.find_subcommand_mut("rules") {
+                print!("{}", format_help(subcmd));
+            }
+        }
         Some("completions") => {
             if let Some(subcmd) = cmd.find_subcommand_mut("completions") {
                 print!("{}", format_help(subcmd));
@@ -155,6 +175,11 @@ fn print_custom_help(args: &[String]) {
                         print!("{}", format_help(subcmd));
                     }
                 }
+                Some("rules") => {
+                    if let Some(subcmd) = cmd.find_subcommand_mut("rules") {
+                        print!("{}", format_help(subcmd));
+                    }
+                }
                 Some("completions") => {
                     if let Some(subcmd) = cmd.find_subcommand_mut("completions") {
                         print!("{}", format_help(subcmd));
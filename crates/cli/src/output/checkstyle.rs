@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Checkstyle XML formatter (`--format checkstyle`).
+//!
+//! Emits the checkstyle report format that CI servers (Jenkins, GitLab,
+//! reviewdog) already parse for other linters. Built from the same sorted
+//! records as the flat violation list, grouped into one `<file>` per path.
+//! See docs/specs/03-output.md#checkstyle-format-checkstyle.
+
+use std::fmt::Write as _;
+use std::io::Write;
+
+use super::violations::{Severity, ViolationRecord, collect_records};
+use crate::check::CheckOutput;
+
+/// Report format version written to `<checkstyle version=...>`.
+///
+/// The de facto version other linters emit; consumers ignore it.
+pub const CHECKSTYLE_VERSION: &str = "4.3";
+
+fn severity(severity: Severity) -> &'static str {
+    match severity {
+        Severity::Error => "error",
+        Severity::Warning => "warning",
+    }
+}
+
+/// Rule identifier for the `source` attribute, e.g. `quench.escapes.unsafe-pointer`.
+fn source(record: &ViolationRecord) -> String {
+    format!("quench.{}.{}", record.check, record.rule_id)
+}
+
+/// Escape text for use inside a double-quoted XML attribute.
+///
+/// Newlines and tabs become character references so attribute-value
+/// normalization doesn't fold them into spaces. Other control characters
+/// aren't allowed in XML 1.0 and are dropped.
+pub fn escape_attr(text: &str) -> String {
+    let mut escaped = String::with_capacity(text.len());
+    for c in text.chars() {
+        match c {
+            '&' => escaped.push_str("&amp;"),
+            '<' => escaped.push_str("&lt;"),
+            '>' => escaped.push_str("&gt;"),
+            '"' => escaped.push_str("&quot;"),
+            '\'' => escaped.push_str("&apos;"),
+            '\n' => escaped.push_str("&#10;"),
+            '\r' => escaped.push_str("&#13;"),
+            '\t' => escaped.push_str("&#9;"),
+            c if c.is_control() => {}
+            c => escaped.push(c),
+        }
+    }
+    escaped
+}
+
+/// Render the checkstyle report.
+///
+/// Violations without a file (e.g., commit messages) are grouped under
+/// `<file name="">`.
+pub fn render(output: &CheckOutput) -> String {
+    let mut xml = String::new();
+    xml.push_str("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n");
+    let _ = writeln!(xml, "<checkstyle version=\"{}\">", CHECKSTYLE_VERSION);
+
+    let records = collect_records(output);
+    let mut current: Option<&str> = None;
+    for record in &records {
+        let file = record.file.as_deref().unwrap_or("");
+        if current != Some(file) {
+            if current.is_some() {
+                xml.push_str("  </file>\n");
+            }
+            let _ = writeln!(xml, "  <file name=\"{}\">", escape_attr(file));
+            current = Some(file);
+        }
+
+        xml.push_str("    <error");
+        if let Some(line) = record.line {
+            let _ = write!(xml, " line=\"{}\"", line);
+        }
+        if let Some(column) = record.column {
+            let _ = write!(xml, " column=\"{}\"", column);
+        }
+        let _ = writeln!(
+            xml,
+            " severity=\"{}\" message=\"{}\" source=\"{}\"/>",
+            severity(record.severity),
+            escape_attr(&record.message),
+            escape_attr(&source(record)),
+        );
+    }
+    if current.is_some() {
+        xml.push_str("  </file>\n");
+    }
+
+    xml.push_str("</checkstyle>\n");
+    xml
+}
+
+/// Checkstyle output formatter.
+pub struct CheckstyleFormatter<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> CheckstyleFormatter<W> {
+    /// Create a new checkstyle formatter.
+    pub fn new(writer: W) -> Self {
+        Self { writer }
+    }
+
+    /// Write the complete report.
+    pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
+        self.writer.write_all(render(output).as_bytes())
+    }
+}
+
+#[cfg(test)]
+#[path = "checkstyle_tests.rs"]
+mod tests;
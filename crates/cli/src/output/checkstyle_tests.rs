@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+#[test]
+fn empty_output_is_empty_report() {
+    let xml = render(&create_output(vec![CheckResult::passed("escapes")]));
+    assert_eq!(
+        xml,
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<checkstyle version=\"4.3\">\n</checkstyle>\n"
+    );
+}
+
+#[test]
+fn violations_grouped_by_file() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("b.go", 3, "go_linkname"),
+            escape_violation("a.go", 5, "unsafe_pointer"),
+            escape_violation("a.go", 1, "go_nosplit"),
+        ],
+    )]);
+
+    assert_eq!(
+        render(&output),
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n\
+<checkstyle version=\"4.3\">\n\
+  <file name=\"a.go\">\n\
+    <error line=\"1\" column=\"2\" severity=\"error\" message=\"Justify go_nosplit.\" source=\"quench.escapes.nosplit\"/>\n\
+    <error line=\"5\" column=\"2\" severity=\"error\" message=\"Justify unsafe_pointer.\" source=\"quench.escapes.unsafe-pointer\"/>\n\
+  </file>\n\
+  <file name=\"b.go\">\n\
+    <error line=\"3\" column=\"2\" severity=\"error\" message=\"Justify go_linkname.\" source=\"quench.escapes.linkname\"/>\n\
+  </file>\n\
+</checkstyle>\n"
+    );
+}
+
+#[test]
+fn warnings_map_to_warning_severity() {
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![CheckResult::passed_with_warnings(
+        "docs",
+        vec![violation],
+    )]);
+
+    let xml = render(&output);
+    assert!(xml.contains(
+        "<error severity=\"warning\" message=\"Add a section.\" source=\"quench.docs.missing-section\"/>"
+    ));
+}
+
+#[test]
+fn violation_without_file_uses_empty_name() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+
+    let xml = render(&output);
+    assert!(xml.contains("  <file name=\"\">\n    <error severity=\"error\""));
+}
+
+#[test]
+fn escapes_xml_special_characters() {
+    assert_eq!(
+        escape_attr(r#"Use <T> & "quotes" or 'ticks'"#),
+        "Use &lt;T&gt; &amp; &quot;quotes&quot; or &apos;ticks&apos;"
+    );
+}
+
+#[test]
+fn escapes_whitespace_and_drops_control_characters() {
+    assert_eq!(
+        escape_attr("line one\nline\ttwo\u{1b}"),
+        "line one&#10;line&#9;two"
+    );
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Violation list formatters, selected by name (`--format <name>`).
+//!
+//! Every built-in format implements [`Formatter`], and `quench check` looks
+//! the `--format` name up in the registry. Embedding tools register their
+//! own formats the same way, for an in-house dashboard or review bot:
+//!
+//! ```ignore
+//! struct Count;
+//!
+//! impl quench::output::formatter::Formatter for Count {
+//!     fn name(&self) -> &str {
+//!         "count"
+//!     }
+//!
+//!     fn format(&self, writer: &mut dyn std::io::Write, output: &quench::check::CheckOutput)
+//!         -> std::io::Result<()> {
+//!         writeln!(writer, "{}", output.total_violations())
+//!     }
+//! }
+//!
+//! quench::output::formatter::register_formatter(Count);
+//! ```
+//!
+//! See docs/specs/03-output.md#custom-formatters.
+
+use std::io::Write;
+use std::sync::{Arc, LazyLock, RwLock};
+
+use super::checkstyle::CheckstyleFormatter;
+use super::github::GithubFormatter;
+use super::junit::JunitFormatter;
+use super::sarif::SarifFormatter;
+use super::violations::{Severity, ViolationsFormatter, collect_records};
+use crate::check::CheckOutput;
+
+/// A named violation list format.
+pub trait Formatter: Send + Sync {
+    /// Name selected with `--format`, e.g. `sarif`.
+    fn name(&self) -> &str;
+
+    /// Write the violations of a check run.
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()>;
+}
+
+/// One line per violation: `file:line:col: severity: message (rule-id)`.
+struct Human;
+
+impl Formatter for Human {
+    fn name(&self) -> &str {
+        "human"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        for record in collect_records(output) {
+            let mut location = record.file.clone().unwrap_or_else(|| record.check.clone());
+            if let Some(line) = record.line {
+                location.push_str(&format!(":{}", line));
+                if let Some(column) = record.column {
+                    location.push_str(&format!(":{}", column));
+                }
+            }
+            let severity = match record.severity {
+                Severity::Error => "error",
+                Severity::Warning => "warning",
+            };
+            writeln!(
+                writer,
+                "{}: {}: {} ({})",
+                location, severity, record.message, record.rule_id
+            )?;
+        }
+        Ok(())
+    }
+}
+
+/// Flat JSON array (`--format json`).
+struct Json;
+
+impl Formatter for Json {
+    fn name(&self) -> &str {
+        "json"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        ViolationsFormatter::new(writer).write(output)
+    }
+}
+
+/// SARIF 2.1.0 log (`--format sarif`).
+struct Sarif;
+
+impl Formatter for Sarif {
+    fn name(&self) -> &str {
+        "sarif"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        SarifFormatter::new(writer).write(output)
+    }
+}
+
+/// Checkstyle XML report (`--format checkstyle`).
+struct Checkstyle;
+
+impl Formatter for Checkstyle {
+    fn name(&self) -> &str {
+        "checkstyle"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        CheckstyleFormatter::new(writer).write(output)
+    }
+}
+
+/// JUnit XML report (`--format junit`).
+struct Junit;
+
+impl Formatter for Junit {
+    fn name(&self) -> &str {
+        "junit"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        JunitFormatter::new(writer).write(output)
+    }
+}
+
+/// GitHub Actions workflow commands (`--format github`).
+struct Github;
+
+impl Formatter for Github {
+    fn name(&self) -> &str {
+        "github"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        GithubFormatter::new(writer).write(output)
+    }
+}
+
+static REGISTRY: LazyLock<RwLock<Vec<Arc<dyn Formatter>>>> = LazyLock::new(|| {
+    RwLock::new(vec![
+        Arc::new(Human),
+        Arc::new(Json),
+        Arc::new(Sarif),
+        Arc::new(Checkstyle),
+        Arc::new(Junit),
+        Arc::new(Github),
+    ])
+});
+
+/// Register a formatter for all subsequent lookups in this process.
+///
+/// Formatters registered under the same name as an existing one, built-in
+/// or not, replace it.
+pub fn register_formatter(formatter: impl Formatter + 'static) {
+    let formatter: Arc<dyn Formatter> = Arc::new(formatter);
+    let mut formatters = REGISTRY.write().unwrap_or_else(|e| e.into_inner());
+    formatters.retain(|f| f.name() != formatter.name());
+    formatters.push(formatter);
+}
+
+/// The formatter registered under `name`, if any.
+pub fn formatter(name: &str) -> Option<Arc<dyn Formatter>> {
+    REGISTRY
+        .read()
+        .unwrap_or_else(|e| e.into_inner())
+        .iter()
+        .find(|f| f.name() == name)
+        .cloned()
+}
+
+/// Names of the registered formatters, built-ins first.
+pub fn formatter_names() -> Vec<String> {
+    REGISTRY
+        .read()
+        .unwrap_or_else(|e| e.into_inner())
+        .iter()
+        .map(|f| f.name().to_string())
+        .collect()
+}
+
+#[cfg(test)]
+#[path = "formatter_tests.rs"]
+mod tests;
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+
+fn render(name: &str, output: &CheckOutput) -> String {
+    let mut buf = Vec::new();
+    formatter(name)
+        .expect("registered formatter")
+        .format(&mut buf, output)
+        .expect("format succeeds");
+    String::from_utf8(buf).expect("utf-8 output")
+}
+
+#[parameterized(
+    human = { "human" },
+    json = { "json" },
+    sarif = { "sarif" },
+    checkstyle = { "checkstyle" },
+    junit = { "junit" },
+    github = { "github" },
+)]
+fn built_in_formats_are_registered(name: &str) {
+    assert_eq!(
+        formatter(name).map(|f| f.name().to_string()),
+        Some(name.to_string())
+    );
+}
+
+#[test]
+fn unknown_format_is_not_registered() {
+    assert!(formatter("yaml").is_none());
+}
+
+#[test]
+fn built_ins_match_their_formatters() {
+    let violation = Violation::file("main.go", 4, "missing_comment", "Add a comment.")
+        .with_pattern("go_nosplit")
+        .with_column(1);
+    let output = create_output(vec![CheckResult::failed("escapes", vec![violation])]);
+
+    assert_eq!(
+        render("github", &output),
+        crate::output::github::render(&output)
+    );
+    assert_eq!(
+        render("checkstyle", &output),
+        crate::output::checkstyle::render(&output)
+    );
+}
+
+#[test]
+fn human_writes_one_line_per_violation() {
+    let error = Violation::file("main.go", 4, "missing_comment", "Add a comment.").with_column(1);
+    let warning = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![
+        CheckResult::failed("escapes", vec![error]),
+        CheckResult::passed_with_warnings("docs", vec![warning]),
+    ]);
+
+    assert_eq!(
+        render("human", &output),
+        "README.md: warning: Add a section. (missing-section)\n\
+main.go:4:1: error: Add a comment. (missing-comment)\n"
+    );
+}
+
+struct Count;
+
+impl Formatter for Count {
+    fn name(&self) -> &str {
+        "test-count"
+    }
+
+    fn format(&self, writer: &mut dyn Write, output: &CheckOutput) -> std::io::Result<()> {
+        writeln!(writer, "{} violations", output.total_violations())
+    }
+}
+
+#[test]
+fn custom_formatter_is_selected_by_name() {
+    register_formatter(Count);
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![CheckResult::failed("docs", vec![violation])]);
+
+    assert_eq!(render("test-count", &output), "1 violations\n");
+    assert!(formatter_names().contains(&"test-count".to_string()));
+}
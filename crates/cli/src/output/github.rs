@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! GitHub Actions annotation formatter (`--format github`).
+//!
+//! Emits one workflow command per violation, which the Actions runner turns
+//! into inline annotations on the pull request diff without a SARIF upload:
+//!
+//! ```text
+//! ::error file=main.go,line=4,col=1,title=nosplit::Add a // NOSPLIT: comment ...
+//! ```
+//!
+//! See docs/specs/03-output.md#github-actions-format-github.
+
+use std::fmt::Write as _;
+use std::io::Write;
+
+use super::violations::{Severity, ViolationRecord, collect_records};
+use crate::check::CheckOutput;
+
+/// Escape a workflow command message.
+///
+/// The runner splits commands on newlines and decodes `%XX`, so `%`, CR,
+/// and LF must be percent-encoded.
+pub fn escape_data(text: &str) -> String {
+    text.replace('%', "%25")
+        .replace('\r', "%0D")
+        .replace('\n', "%0A")
+}
+
+/// Escape a workflow command property value.
+///
+/// Like [`escape_data`], plus `:` and `,`, which delimit properties.
+pub fn escape_property(text: &str) -> String {
+    escape_data(text).replace(':', "%3A").replace(',', "%2C")
+}
+
+/// Format one violation as a workflow command.
+///
+/// The title is the stable rule id. Properties without a value (e.g., no file
+/// for commit violations) are omitted.
+pub fn annotation(record: &ViolationRecord) -> String {
+    let command = match record.severity {
+        Severity::Error => "error",
+        Severity::Warning => "warning",
+    };
+
+    let mut properties = Vec::new();
+    if let Some(file) = &record.file {
+        properties.push(format!("file={}", escape_property(file)));
+    }
+    if let Some(line) = record.line {
+        properties.push(format!("line={}", line));
+    }
+    if let Some(column) = record.column {
+        properties.push(format!("col={}", column));
+    }
+    properties.push(format!("title={}", escape_property(&record.rule_id)));
+
+    let mut out = format!("::{}", command);
+    if !properties.is_empty() {
+        let _ = write!(out, " {}", properties.join(","));
+    }
+    let _ = write!(out, "::{}", escape_data(&record.message));
+    out
+}
+
+/// Render all annotations, one per line (empty when there are no violations).
+pub fn render(output: &CheckOutput) -> String {
+    let mut out = String::new();
+    for record in collect_records(output) {
+        out.push_str(&annotation(&record));
+        out.push('\n');
+    }
+    out
+}
+
+/// GitHub Actions annotation formatter.
+pub struct GithubFormatter<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> GithubFormatter<W> {
+    /// Create a new GitHub Actions formatter.
+    pub fn new(writer: W) -> Self {
+        Self { writer }
+    }
+
+    /// Write all annotations.
+    pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
+        self.writer.write_all(render(output).as_bytes())
+    }
+}
+
+#[cfg(test)]
+#[path = "github_tests.rs"]
+mod tests;
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+
+#[test]
+fn empty_output_is_empty() {
+    assert_eq!(
+        render(&create_output(vec![CheckResult::passed("escapes")])),
+        ""
+    );
+}
+
+#[test]
+fn errors_include_file_line_and_column() {
+    let violation = Violation::file(
+        "main.go",
+        4,
+        "missing_comment",
+        "Add a // NOSPLIT: comment.",
+    )
+    .with_pattern("go_nosplit")
+    .with_column(1);
+    let output = create_output(vec![CheckResult::failed("escapes", vec![violation])]);
+
+    assert_eq!(
+        render(&output),
+        "::error file=main.go,line=4,col=1,title=nosplit::Add a // NOSPLIT: comment.\n"
+    );
+}
+
+#[test]
+fn warnings_use_warning_command() {
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![CheckResult::passed_with_warnings(
+        "docs",
+        vec![violation],
+    )]);
+
+    assert_eq!(
+        render(&output),
+        "::warning file=README.md,title=missing-section::Add a section.\n"
+    );
+}
+
+#[test]
+fn violation_without_file_has_only_title() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+
+    assert_eq!(render(&output), "::error title=invalid-format::Use type:\n");
+}
+
+#[test]
+fn message_escapes_newlines_and_percent() {
+    assert_eq!(
+        escape_data("100% sure\r\nsecond line\n"),
+        "100%25 sure%0D%0Asecond line%0A"
+    );
+}
+
+#[test]
+fn property_escapes_delimiters() {
+    assert_eq!(escape_property("a:b,c%d"), "a%3Ab%2Cc%25d");
+}
@@ -11,6 +11,7 @@ use std::io::Write;
 use chrono::Utc;
 use serde::Serialize;
 
+use crate::build_info;
 use crate::check::{CheckOutput, CheckResult};
 use crate::ratchet::{MetricComparison, MetricImprovement, RatchetResult};
 use crate::timing::TimingInfo;
@@ -110,6 +111,8 @@ pub struct JsonFormatter<W: Write> {
 #[derive(Debug, Serialize)]
 struct CombinedOutput<'a> {
     timestamp: &'a str,
+    quench_version: &'static str,
+    quench_commit: &'static str,
     passed: bool,
     checks: &'a [CheckResult],
     #[serde(skip_serializing_if = "Option::is_none")]
@@ -126,8 +129,7 @@ impl<W: Write> JsonFormatter<W> {
 
     /// Write the complete JSON output.
     pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
-        let json = serde_json::to_string_pretty(output).map_err(std::io::Error::other)?;
-        writeln!(self.writer, "{}", json)
+        self.write_with_timing(output, None, None)
     }
 
     /// Write JSON output with optional ratchet results (no timing).
@@ -148,6 +150,8 @@ impl<W: Write> JsonFormatter<W> {
     ) -> std::io::Result<()> {
         let combined = CombinedOutput {
             timestamp: &output.timestamp,
+            quench_version: build_info::VERSION,
+            quench_commit: build_info::COMMIT,
             passed: output.passed && ratchet.as_ref().is_none_or(|r| r.passed),
             checks: &output.checks,
             ratchet: ratchet.map(Into::into),
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! JUnit XML formatter (`--format junit`).
+//!
+//! Emits violations as failing test cases so CI servers that render JUnit
+//! reports (GitLab, Jenkins, CircleCI) list them in their test results. Each
+//! rule is a `<testsuite>` and each violation a `<testcase>`; files without
+//! violations don't appear. See docs/specs/03-output.md#junit-format-junit.
+
+use std::collections::BTreeMap;
+use std::fmt::Write as _;
+use std::io::Write;
+
+use super::checkstyle::escape_attr;
+use super::violations::{Severity, ViolationRecord, collect_records};
+use crate::check::CheckOutput;
+
+fn severity(severity: Severity) -> &'static str {
+    match severity {
+        Severity::Error => "error",
+        Severity::Warning => "warning",
+    }
+}
+
+/// Suite name for a rule, e.g. `quench.escapes.unsafe-pointer`.
+fn suite_name(record: &ViolationRecord) -> String {
+    format!("quench.{}.{}", record.check, record.rule_id)
+}
+
+/// Test case name: `file:line`, or the rule id for violations without a file.
+fn case_name(record: &ViolationRecord) -> String {
+    match (&record.file, record.line) {
+        (Some(file), Some(line)) => format!("{}:{}", file, line),
+        (Some(file), None) => file.clone(),
+        (None, _) => record.rule_id.clone(),
+    }
+}
+
+/// Render the JUnit report.
+///
+/// Suites are sorted by name and test cases keep the record order (file,
+/// then line). The report timestamp is the check run's.
+pub fn render(output: &CheckOutput) -> String {
+    let records = collect_records(output);
+    let mut suites: BTreeMap<String, Vec<&ViolationRecord>> = BTreeMap::new();
+    for record in &records {
+        suites.entry(suite_name(record)).or_default().push(record);
+    }
+    let timestamp = escape_attr(&output.timestamp);
+
+    let mut xml = String::new();
+    xml.push_str("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n");
+    let _ = writeln!(
+        xml,
+        "<testsuites name=\"quench\" tests=\"{0}\" failures=\"{0}\" timestamp=\"{1}\">",
+        records.len(),
+        timestamp
+    );
+    for (name, cases) in &suites {
+        let _ = writeln!(
+            xml,
+            "  <testsuite name=\"{0}\" tests=\"{1}\" failures=\"{1}\" timestamp=\"{2}\">",
+            escape_attr(name),
+            cases.len(),
+            timestamp
+        );
+        for record in cases {
+            let case = escape_attr(&case_name(record));
+            let message = escape_attr(&record.message);
+            let _ = write!(
+                xml,
+                "    <testcase name=\"{}\" classname=\"{}\"",
+                case,
+                escape_attr(name)
+            );
+            if let Some(file) = &record.file {
+                let _ = write!(xml, " file=\"{}\"", escape_attr(file));
+            }
+            if let Some(line) = record.line {
+                let _ = write!(xml, " line=\"{}\"", line);
+            }
+            xml.push_str(">\n");
+            let _ = writeln!(
+                xml,
+                "      <failure message=\"{}\" type=\"{}\">{}: {}</failure>",
+                message,
+                severity(record.severity),
+                case,
+                message
+            );
+            xml.push_str("    </testcase>\n");
+        }
+        xml.push_str("  </testsuite>\n");
+    }
+    xml.push_str("</testsuites>\n");
+    xml
+}
+
+/// JUnit output formatter.
+pub struct JunitFormatter<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> JunitFormatter<W> {
+    /// Create a new JUnit formatter.
+    pub fn new(writer: W) -> Self {
+        Self { writer }
+    }
+
+    /// Write the complete report.
+    pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
+        self.writer.write_all(render(output).as_bytes())
+    }
+}
+
+#[cfg(test)]
+#[path = "junit_tests.rs"]
+mod tests;
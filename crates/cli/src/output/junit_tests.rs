@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::test_utils::escape_violation;
+
+const TIMESTAMP: &str = "2026-01-21T10:30:00Z";
+
+fn output(checks: Vec<CheckResult>) -> CheckOutput {
+    CheckOutput::new(TIMESTAMP.to_string(), checks)
+}
+
+#[test]
+fn empty_output_is_empty_report() {
+    assert_eq!(
+        render(&output(vec![CheckResult::passed("escapes")])),
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n\
+<testsuites name=\"quench\" tests=\"0\" failures=\"0\" timestamp=\"2026-01-21T10:30:00Z\">\n\
+</testsuites>\n"
+    );
+}
+
+#[test]
+fn one_suite_per_rule() {
+    let output = output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("b.go", 3, "unsafe_pointer"),
+            escape_violation("a.go", 5, "unsafe_pointer"),
+            escape_violation("a.go", 1, "go_nosplit"),
+        ],
+    )]);
+
+    assert_eq!(
+        render(&output),
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n\
+<testsuites name=\"quench\" tests=\"3\" failures=\"3\" timestamp=\"2026-01-21T10:30:00Z\">\n\
+  <testsuite name=\"quench.escapes.nosplit\" tests=\"1\" failures=\"1\" timestamp=\"2026-01-21T10:30:00Z\">\n\
+    <testcase name=\"a.go:1\" classname=\"quench.escapes.nosplit\" file=\"a.go\" line=\"1\">\n\
+      <failure message=\"Justify go_nosplit.\" type=\"error\">a.go:1: Justify go_nosplit.</failure>\n\
+    </testcase>\n\
+  </testsuite>\n\
+  <testsuite name=\"quench.escapes.unsafe-pointer\" tests=\"2\" failures=\"2\" timestamp=\"2026-01-21T10:30:00Z\">\n\
+    <testcase name=\"a.go:5\" classname=\"quench.escapes.unsafe-pointer\" file=\"a.go\" line=\"5\">\n\
+      <failure message=\"Justify unsafe_pointer.\" type=\"error\">a.go:5: Justify unsafe_pointer.</failure>\n\
+    </testcase>\n\
+    <testcase name=\"b.go:3\" classname=\"quench.escapes.unsafe-pointer\" file=\"b.go\" line=\"3\">\n\
+      <failure message=\"Justify unsafe_pointer.\" type=\"error\">b.go:3: Justify unsafe_pointer.</failure>\n\
+    </testcase>\n\
+  </testsuite>\n\
+</testsuites>\n"
+    );
+}
+
+#[test]
+fn warnings_have_warning_type() {
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = output(vec![CheckResult::passed_with_warnings(
+        "docs",
+        vec![violation],
+    )]);
+
+    let xml = render(&output);
+    assert!(xml.contains(
+        "    <testcase name=\"README.md\" classname=\"quench.docs.missing-section\" file=\"README.md\">\n\
+      <failure message=\"Add a section.\" type=\"warning\">README.md: Add a section.</failure>\n"
+    ));
+}
+
+#[test]
+fn violation_without_file_is_named_by_rule() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let xml = render(&output(vec![CheckResult::failed("git", vec![violation])]));
+    assert!(xml.contains(
+        "    <testcase name=\"invalid-format\" classname=\"quench.git.invalid-format\">\n"
+    ));
+}
+
+#[test]
+fn escapes_messages() {
+    let violation = Violation::file("a.go", 1, "forbidden", "Use <T> & \"quotes\"\nnot this");
+    let xml = render(&output(vec![CheckResult::failed(
+        "escapes",
+        vec![violation],
+    )]));
+    assert!(xml.contains(
+        "<failure message=\"Use &lt;T&gt; &amp; &quot;quotes&quot;&#10;not this\" type=\"error\">\
+a.go:1: Use &lt;T&gt; &amp; &quot;quotes&quot;&#10;not this</failure>"
+    ));
+    assert!(!xml.contains("<T>"));
+}
@@ -3,20 +3,35 @@
 
 //! Output formatting for check results.
 
+pub mod checkstyle;
+pub mod formatter;
+pub mod github;
 pub mod json;
+pub mod junit;
+pub mod sarif;
+pub mod stats;
 pub mod text;
+pub mod violations;
+
+use crate::cli::GroupBy;
 
 /// Output formatting options.
 #[derive(Debug, Clone)]
 pub struct FormatOptions {
     /// Maximum violations to show (None = unlimited).
     pub limit: Option<usize>,
+    /// Maximum violations to show of each rule (None = unlimited).
+    pub limit_per_rule: Option<usize>,
+    /// How the text report groups violations.
+    pub group: GroupBy,
 }
 
 impl Default for FormatOptions {
     fn default() -> Self {
         Self {
             limit: Some(15), // Default per spec
+            limit_per_rule: None,
+            group: GroupBy::default(),
         }
     }
 }
@@ -24,11 +39,17 @@ impl Default for FormatOptions {
 impl FormatOptions {
     /// Create options with no limit.
     pub fn no_limit() -> Self {
-        Self { limit: None }
+        Self {
+            limit: None,
+            ..Self::default()
+        }
     }
 
     /// Create options with a specific limit.
     pub fn with_limit(limit: usize) -> Self {
-        Self { limit: Some(limit) }
+        Self {
+            limit: Some(limit),
+            ..Self::default()
+        }
     }
 }
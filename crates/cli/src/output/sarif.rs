@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! SARIF 2.1.0 formatter (`--format sarif`).
+//!
+//! Produces a single-run SARIF log for GitHub code scanning, built from the
+//! same sorted records as the flat violation list.
+//! See docs/specs/03-output.md#sarif-format-sarif.
+
+use std::collections::{BTreeMap, HashMap};
+use std::io::Write;
+use std::path::Path;
+
+use serde::Serialize;
+
+use super::violations::{Severity, ViolationRecord, collect_records};
+use crate::build_info;
+use crate::check::CheckOutput;
+use crate::config::CheckLevel;
+use crate::severity::default_level;
+
+/// SARIF schema URI written to `$schema`.
+pub const SARIF_SCHEMA: &str = "https://json.schemastore.org/sarif-2.1.0.json";
+
+/// SARIF specification version.
+pub const SARIF_VERSION: &str = "2.1.0";
+
+/// Base URI id for artifact locations (resolved by the consumer to the repo root).
+const SRCROOT: &str = "%SRCROOT%";
+
+/// Top-level SARIF log.
+#[derive(Debug, Serialize)]
+pub struct SarifLog {
+    #[serde(rename = "$schema")]
+    pub schema: &'static str,
+    pub version: &'static str,
+    pub runs: Vec<SarifRun>,
+}
+
+/// A single analysis run.
+#[derive(Debug, Serialize)]
+pub struct SarifRun {
+    pub tool: SarifTool,
+    pub results: Vec<SarifResult>,
+}
+
+/// Tool that produced the run.
+#[derive(Debug, Serialize)]
+pub struct SarifTool {
+    pub driver: SarifDriver,
+}
+
+/// Tool component with rule metadata.
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifDriver {
+    pub name: &'static str,
+    pub version: &'static str,
+    pub information_uri: &'static str,
+    pub rules: Vec<SarifRule>,
+}
+
+/// Rule metadata (`reportingDescriptor`).
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifRule {
+    pub id: String,
+    pub name: String,
+    pub short_description: SarifMessage,
+    pub full_description: SarifMessage,
+    pub default_configuration: SarifConfiguration,
+}
+
+/// Default rule configuration.
+#[derive(Debug, Serialize)]
+pub struct SarifConfiguration {
+    pub level: &'static str,
+}
+
+/// Plain-text message.
+#[derive(Debug, Serialize)]
+pub struct SarifMessage {
+    pub text: String,
+}
+
+/// A single violation.
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifResult {
+    pub rule_id: String,
+    pub rule_index: usize,
+    pub level: &'static str,
+    pub message: SarifMessage,
+    #[serde(skip_serializing_if = "Vec::is_empty")]
+    pub locations: Vec<SarifLocation>,
+}
+
+/// Result location.
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifLocation {
+    pub physical_location: SarifPhysicalLocation,
+}
+
+/// File and region of a result.
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifPhysicalLocation {
+    pub artifact_location: SarifArtifactLocation,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub region: Option<SarifRegion>,
+}
+
+/// File path relative to the repository root, or an absolute `file://` URI.
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifArtifactLocation {
+    pub uri: String,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub uri_base_id: Option<&'static str>,
+}
+
+/// Line and column of a result (1-based).
+#[derive(Debug, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct SarifRegion {
+    pub start_line: u32,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub start_column: Option<u32>,
+}
+
+fn level(severity: Severity) -> &'static str {
+    match severity {
+        Severity::Error => "error",
+        Severity::Warning => "warning",
+    }
+}
+
+/// A rule's level before `[severity]` or `[rules]` overrides, e.g. warning
+/// for `context_todo`.
+fn default_rule_level(rule: &str) -> &'static str {
+    match default_level(rule) {
+        CheckLevel::Error => "error",
+        CheckLevel::Warn => "warning",
+        CheckLevel::Off => "none",
+    }
+}
+
+/// Short rule description, e.g. "escapes: unsafe pointer".
+fn short_description(record: &ViolationRecord) -> String {
+    format!("{}: {}", record.check, record.rule.replace('_', " "))
+}
+
+/// A `file://` URI for an absolute path, percent-encoding reserved bytes.
+fn file_uri(path: &str) -> String {
+    let path = path.replace('\\', "/");
+    let mut uri = String::from(if path.starts_with('/') {
+        "file://"
+    } else {
+        "file:///"
+    });
+    for byte in path.bytes() {
+        match byte {
+            b'A'..=b'Z' | b'a'..=b'z' | b'0'..=b'9' | b'-' | b'.' | b'_' | b'~' | b'/' | b':' => {
+                uri.push(byte as char)
+            }
+            _ => uri.push_str(&format!("%{:02X}", byte)),
+        }
+    }
+    uri
+}
+
+fn location(record: &ViolationRecord) -> Option<SarifLocation> {
+    let file = record.file.as_deref()?;
+    let artifact_location = if Path::new(file).is_absolute() {
+        SarifArtifactLocation {
+            uri: file_uri(file),
+            uri_base_id: None,
+        }
+    } else {
+        SarifArtifactLocation {
+            uri: file.to_string(),
+            uri_base_id: Some(SRCROOT),
+        }
+    };
+    Some(SarifLocation {
+        physical_location: SarifPhysicalLocation {
+            artifact_location,
+            region: record.line.map(|start_line| SarifRegion {
+                start_line,
+                start_column: record.column,
+            }),
+        },
+    })
+}
+
+/// Build a SARIF log from check output.
+///
+/// The log is deterministic, so a committed report only changes when the
+/// violations do: results are sorted by file, line, column, then rule id,
+/// and rules by id. The first result of a rule supplies its full
+/// description, while its default level is the built-in one, so each
+/// result's own level shows any override. Keys are written in declaration
+/// order.
+pub fn build_log(output: &CheckOutput) -> SarifLog {
+    let mut records = collect_records(output);
+    records.sort_by(|a, b| {
+        (&a.file, a.line, a.column, &a.rule_id, &a.message)
+            .cmp(&(&b.file, b.line, b.column, &b.rule_id, &b.message))
+    });
+
+    let mut rules: BTreeMap<String, SarifRule> = BTreeMap::new();
+    for record in &records {
+        rules
+            .entry(record.rule_id.clone())
+            .or_insert_with(|| SarifRule {
+                id: record.rule_id.clone(),
+                name: record.rule.clone(),
+                short_description: SarifMessage {
+                    text: short_description(record),
+                },
+                full_description: SarifMessage {
+                    text: record.message.clone(),
+                },
+                default_configuration: SarifConfiguration {
+                    level: default_rule_level(&record.rule),
+                },
+            });
+    }
+    let rule_indices: HashMap<&str, usize> = rules
+        .keys()
+        .enumerate()
+        .map(|(index, id)| (id.as_str(), index))
+        .collect();
+
+    let results: Vec<SarifResult> = records
+        .iter()
+        .map(|record| SarifResult {
+            rule_id: record.rule_id.clone(),
+            rule_index: rule_indices
+                .get(record.rule_id.as_str())
+                .copied()
+                .unwrap_or_default(),
+            level: level(record.severity),
+            message: SarifMessage {
+                text: record.message.clone(),
+            },
+            locations: location(record).into_iter().collect(),
+        })
+        .collect();
+    let rules: Vec<SarifRule> = rules.into_values().collect();
+
+    SarifLog {
+        schema: SARIF_SCHEMA,
+        version: SARIF_VERSION,
+        runs: vec![SarifRun {
+            tool: SarifTool {
+                driver: SarifDriver {
+                    name: "quench",
+                    version: build_info::VERSION,
+                    information_uri: env!("CARGO_PKG_REPOSITORY"),
+                    rules,
+                },
+            },
+            results,
+        }],
+    }
+}
+
+/// SARIF output formatter.
+pub struct SarifFormatter<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> SarifFormatter<W> {
+    /// Create a new SARIF formatter.
+    pub fn new(writer: W) -> Self {
+        Self { writer }
+    }
+
+    /// Write the complete SARIF log.
+    pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
+        let log = build_log(output);
+        let json = serde_json::to_string_pretty(&log).map_err(std::io::Error::other)?;
+        writeln!(self.writer, "{}", json)
+    }
+}
+
+#[cfg(test)]
+#[path = "sarif_tests.rs"]
+mod tests;
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+fn to_json(output: &CheckOutput) -> serde_json::Value {
+    let mut buffer = Vec::new();
+    SarifFormatter::new(&mut buffer).write(output).unwrap();
+    serde_json::from_slice(&buffer).unwrap()
+}
+
+#[test]
+fn empty_output_has_one_run_without_results() {
+    let json = to_json(&create_output(vec![CheckResult::passed("escapes")]));
+    assert_eq!(json["version"], "2.1.0");
+    assert_eq!(json["runs"].as_array().unwrap().len(), 1);
+    assert_eq!(json["runs"][0]["tool"]["driver"]["name"], "quench");
+    assert_eq!(json["runs"][0]["results"], serde_json::json!([]));
+    assert_eq!(
+        json["runs"][0]["tool"]["driver"]["rules"],
+        serde_json::json!([])
+    );
+}
+
+#[test]
+fn rules_are_deduplicated_and_indexed() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("a.go", 1, "go_linkname"),
+            escape_violation("a.go", 5, "unsafe_pointer"),
+            escape_violation("b.go", 3, "go_linkname"),
+        ],
+    )]);
+    let json = to_json(&output);
+    let run = &json["runs"][0];
+
+    let rule_ids: Vec<_> = run["tool"]["driver"]["rules"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .map(|r| r["id"].as_str().unwrap())
+        .collect();
+    assert_eq!(rule_ids, vec!["linkname", "unsafe-pointer"]);
+
+    let indices: Vec<_> = run["results"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .map(|r| r["ruleIndex"].as_u64().unwrap())
+        .collect();
+    assert_eq!(indices, vec![0, 1, 0]);
+}
+
+#[test]
+fn rule_has_descriptions() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("a.go", 1, "unsafe_pointer")],
+    )]);
+    let rule = &to_json(&output)["runs"][0]["tool"]["driver"]["rules"][0];
+    assert_eq!(rule["shortDescription"]["text"], "escapes: unsafe pointer");
+    assert_eq!(rule["fullDescription"]["text"], "Justify unsafe_pointer.");
+    assert_eq!(rule["defaultConfiguration"]["level"], "error");
+}
+
+#[test]
+fn result_has_physical_location() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("pkg/a.go", 7, "unsafe_pointer")],
+    )]);
+    let result = &to_json(&output)["runs"][0]["results"][0];
+    assert_eq!(result["ruleId"], "unsafe-pointer");
+    assert_eq!(result["level"], "error");
+    assert_eq!(
+        result["locations"][0]["physicalLocation"],
+        serde_json::json!({
+            "artifactLocation": { "uri": "pkg/a.go", "uriBaseId": "%SRCROOT%" },
+            "region": { "startLine": 7, "startColumn": 2 },
+        })
+    );
+}
+
+#[test]
+fn absolute_paths_use_file_uris_without_base() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation(
+            "/home/ci/my repo/pkg/a.go",
+            7,
+            "unsafe_pointer",
+        )],
+    )]);
+    let result = &to_json(&output)["runs"][0]["results"][0];
+    assert_eq!(
+        result["locations"][0]["physicalLocation"]["artifactLocation"],
+        serde_json::json!({ "uri": "file:///home/ci/my%20repo/pkg/a.go" })
+    );
+}
+
+#[parameterized(
+    unix = { "/repo/a.go", "file:///repo/a.go" },
+    windows = { "C:\\repo\\a.go", "file:///C:/repo/a.go" },
+    reserved = { "/repo/a#b%.go", "file:///repo/a%23b%25.go" },
+)]
+fn encodes_file_uris(path: &str, expected: &str) {
+    assert_eq!(file_uri(path), expected);
+}
+
+#[test]
+fn non_file_result_has_no_locations() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+    let result = &to_json(&output)["runs"][0]["results"][0];
+    assert!(result.get("locations").is_none());
+}
+
+#[test]
+fn warnings_use_warning_level() {
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![CheckResult::passed_with_warnings(
+        "docs",
+        vec![violation],
+    )]);
+    assert_eq!(
+        to_json(&output)["runs"][0]["results"][0]["level"],
+        "warning"
+    );
+}
+
+#[test]
+fn rule_default_level_ignores_overrides() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("a.go", 1, "context_todo")],
+    )]);
+    let run = &to_json(&output)["runs"][0];
+    assert_eq!(
+        run["tool"]["driver"]["rules"][0]["defaultConfiguration"]["level"],
+        "warning"
+    );
+    assert_eq!(run["results"][0]["level"], "error");
+}
+
+#[test]
+fn rules_are_sorted_by_id() {
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("a.go", 1, "unsafe_pointer"),
+            escape_violation("b.go", 3, "go_linkname"),
+        ],
+    )]);
+    let json = to_json(&output);
+    let run = &json["runs"][0];
+
+    let rule_ids: Vec<_> = run["tool"]["driver"]["rules"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .map(|r| r["id"].as_str().unwrap())
+        .collect();
+    assert_eq!(rule_ids, vec!["linkname", "unsafe-pointer"]);
+
+    let indices: Vec<_> = run["results"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .map(|r| r["ruleIndex"].as_u64().unwrap())
+        .collect();
+    assert_eq!(indices, vec![1, 0]);
+}
+
+#[test]
+fn output_does_not_depend_on_check_or_violation_order() {
+    let render = |checks: Vec<CheckResult>| {
+        let mut buffer = Vec::new();
+        SarifFormatter::new(&mut buffer)
+            .write(&create_output(checks))
+            .unwrap();
+        buffer
+    };
+    let docs = || Violation::file_only("README.md", "missing_section", "Add a section.");
+
+    let forward = render(vec![
+        CheckResult::failed(
+            "escapes",
+            vec![
+                escape_violation("a.go", 5, "unsafe_pointer"),
+                escape_violation("a.go", 5, "go_linkname"),
+                escape_violation("b.go", 1, "go_linkname"),
+            ],
+        ),
+        CheckResult::failed("docs", vec![docs()]),
+    ]);
+    let reversed = render(vec![
+        CheckResult::failed("docs", vec![docs()]),
+        CheckResult::failed(
+            "escapes",
+            vec![
+                escape_violation("b.go", 1, "go_linkname"),
+                escape_violation("a.go", 5, "go_linkname"),
+                escape_violation("a.go", 5, "unsafe_pointer"),
+            ],
+        ),
+    ]);
+    assert_eq!(
+        String::from_utf8(forward).unwrap(),
+        String::from_utf8(reversed).unwrap()
+    );
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Summary statistics footer (`check --stats`).
+//!
+//! One line gauging the scope of a run, for rollouts:
+//!
+//! ```text
+//! quench: 12 violations (8 error, 4 warning) across 5 files; 1340 files scanned in 2.3s
+//!   by rule: unsafe_pointer 7, go_linkname 3, missing_comment 2
+//! ```
+//!
+//! Only written with the human-readable text output.
+
+use std::collections::{BTreeMap, BTreeSet};
+
+use super::violations::{Severity, collect_records};
+use crate::check::CheckOutput;
+
+/// Violation counts for a run.
+#[derive(Debug, Clone, Default, PartialEq, Eq)]
+pub struct ScanStats {
+    /// Errors (violations that fail their check).
+    pub errors: usize,
+    /// Warnings.
+    pub warnings: usize,
+    /// Distinct files with at least one violation.
+    pub files_with_violations: usize,
+    /// Violations per rule, most frequent first (ties by name).
+    pub by_rule: Vec<(String, usize)>,
+}
+
+impl ScanStats {
+    /// Count the violations in a check output.
+    pub fn from_output(output: &CheckOutput) -> Self {
+        let mut stats = Self::default();
+        let mut files = BTreeSet::new();
+        let mut by_rule: BTreeMap<String, usize> = BTreeMap::new();
+        for record in collect_records(output) {
+            match record.severity {
+                Severity::Error => stats.errors += 1,
+                Severity::Warning => stats.warnings += 1,
+            }
+            if let Some(file) = record.file {
+                files.insert(file);
+            }
+            *by_rule.entry(record.rule).or_default() += 1;
+        }
+        stats.files_with_violations = files.len();
+        stats.by_rule = by_rule.into_iter().collect();
+        stats
+            .by_rule
+            .sort_by(|a, b| b.1.cmp(&a.1).then_with(|| a.0.cmp(&b.0)));
+        stats
+    }
+
+    /// Total violations.
+    pub fn violations(&self) -> usize {
+        self.errors + self.warnings
+    }
+
+    /// Format the footer for `files_scanned` files scanned in `scan_ms`.
+    pub fn format(&self, files_scanned: usize, scan_ms: u64) -> String {
+        let scanned = format!(
+            "{} scanned in {}",
+            plural(files_scanned, "file"),
+            format_duration(scan_ms)
+        );
+        if self.violations() == 0 {
+            return format!("quench: no violations; {}", scanned);
+        }
+
+        let mut out = format!(
+            "quench: {} ({} error, {} warning) across {}; {}",
+            plural(self.violations(), "violation"),
+            self.errors,
+            self.warnings,
+            plural(self.files_with_violations, "file"),
+            scanned
+        );
+        let rules: Vec<String> = self
+            .by_rule
+            .iter()
+            .map(|(rule, count)| format!("{} {}", rule, count))
+            .collect();
+        out.push_str("\n  by rule: ");
+        out.push_str(&rules.join(", "));
+        out
+    }
+}
+
+fn plural(count: usize, noun: &str) -> String {
+    if count == 1 {
+        format!("1 {}", noun)
+    } else {
+        format!("{} {}s", count, noun)
+    }
+}
+
+/// Milliseconds under a second, else seconds to one decimal place.
+fn format_duration(ms: u64) -> String {
+    if ms < 1000 {
+        format!("{}ms", ms)
+    } else {
+        format!("{:.1}s", ms as f64 / 1000.0)
+    }
+}
+
+#[cfg(test)]
+#[path = "stats_tests.rs"]
+mod tests;
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+use crate::check::{CheckResult, Violation};
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+#[test]
+fn counts_by_severity_file_and_rule() {
+    let output = create_output(vec![
+        CheckResult::failed(
+            "escapes",
+            vec![
+                escape_violation("a.go", 1, "unsafe_pointer"),
+                escape_violation("a.go", 5, "unsafe_pointer"),
+                escape_violation("b.go", 3, "go_linkname"),
+            ],
+        ),
+        CheckResult::passed_with_warnings(
+            "docs",
+            vec![Violation::file_only(
+                "README.md",
+                "missing_section",
+                "Add a section.",
+            )],
+        ),
+    ]);
+
+    let stats = ScanStats::from_output(&output);
+    assert_eq!(stats.errors, 3);
+    assert_eq!(stats.warnings, 1);
+    assert_eq!(stats.files_with_violations, 3);
+    assert_eq!(
+        stats.by_rule,
+        vec![
+            ("unsafe_pointer".to_string(), 2),
+            ("go_linkname".to_string(), 1),
+            ("missing_section".to_string(), 1),
+        ]
+    );
+    assert_eq!(
+        stats.format(1340, 2345),
+        "quench: 4 violations (3 error, 1 warning) across 3 files; 1340 files scanned in 2.3s\n  \
+by rule: unsafe_pointer 2, go_linkname 1, missing_section 1"
+    );
+}
+
+#[test]
+fn violations_without_file_are_not_counted_as_files() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+
+    let stats = ScanStats::from_output(&output);
+    assert_eq!(stats.files_with_violations, 0);
+    assert!(stats.format(1, 5).starts_with(
+        "quench: 1 violation (1 error, 0 warning) across 0 files; 1 file scanned in 5ms"
+    ));
+}
+
+#[test]
+fn no_violations() {
+    let output = create_output(vec![CheckResult::passed("escapes")]);
+    assert_eq!(
+        ScanStats::from_output(&output).format(12, 40),
+        "quench: no violations; 12 files scanned in 40ms"
+    );
+}
+
+#[parameterized(
+    millis = { 999, "999ms" },
+    second = { 1000, "1.0s" },
+    rounded = { 2349, "2.3s" },
+)]
+fn duration_formatting(ms: u64, expected: &str) {
+    assert_eq!(format_duration(ms), expected);
+}
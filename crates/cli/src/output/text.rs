@@ -3,40 +3,53 @@
 
 //! Text output formatter.
 //!
-//! Format per docs/specs/03-output.md#text-format:
+//! Format per docs/specs/03-output.md#text-format, grouped by file by default
+//! (`--group`):
 //! ```text
 //! <check-name>: FAIL
-//!   <file>:<line>: <brief violation description>
-//!     <advice>
+//!   <file>
+//!     <line>[:<column>]: <brief violation description>
+//!       <advice>
 //! ```
 
+use std::collections::{BTreeMap, HashMap};
 use std::io::Write;
+use std::path::Path;
 use termcolor::{ColorChoice, StandardStream, WriteColor};
 
 use super::FormatOptions;
 use crate::check::{CheckOutput, CheckResult, Violation};
+use crate::cli::GroupBy;
 use crate::color::scheme;
 use crate::config::CheckLevel;
 use crate::ratchet::RatchetResult;
 
 /// Text output formatter with color support.
 pub struct TextFormatter {
-    stdout: StandardStream,
+    writer: Box<dyn WriteColor + Send>,
     options: FormatOptions,
     violations_shown: usize,
     truncated: bool,
     last_advice: Option<String>,
+    /// Check whose streamed violations are being written, if any.
+    streaming: Option<String>,
 }
 
 impl TextFormatter {
     /// Create a new text formatter.
     pub fn new(color_choice: ColorChoice, options: FormatOptions) -> Self {
+        Self::with_writer(Box::new(StandardStream::stdout(color_choice)), options)
+    }
+
+    /// Create a text formatter that writes to `writer` instead of stdout.
+    pub fn with_writer(writer: Box<dyn WriteColor + Send>, options: FormatOptions) -> Self {
         Self {
-            stdout: StandardStream::stdout(color_choice),
+            writer,
             options,
             violations_shown: 0,
             truncated: false,
             last_advice: None,
+            streaming: None,
         }
     }
 
@@ -45,6 +58,7 @@ impl TextFormatter {
     pub fn write_check(&mut self, result: &CheckResult) -> std::io::Result<bool> {
         // Reset advice tracking for new check
         self.last_advice = None;
+        self.streaming = None;
 
         // Check if this is a passing result with warnings (violations that don't cause failure)
         let has_warnings = result.passed && !result.violations.is_empty();
@@ -54,17 +68,17 @@ impl TextFormatter {
         }
 
         // Check name: bold
-        self.stdout.set_color(&scheme::check_name())?;
-        write!(self.stdout, "{}", result.name)?;
-        self.stdout.reset()?;
+        self.writer.set_color(&scheme::check_name())?;
+        write!(self.writer, "{}", result.name)?;
+        self.writer.reset()?;
 
         if result.fixed {
             // ": FIXED" in green
-            write!(self.stdout, ": ")?;
-            self.stdout.set_color(&scheme::fixed())?;
-            write!(self.stdout, "FIXED")?;
-            self.stdout.reset()?;
-            writeln!(self.stdout)?;
+            write!(self.writer, ": ")?;
+            self.writer.set_color(&scheme::fixed())?;
+            write!(self.writer, "FIXED")?;
+            self.writer.reset()?;
+            writeln!(self.writer)?;
 
             // Show fix summary
             if let Some(ref summary) = result.fix_summary {
@@ -76,51 +90,118 @@ impl TextFormatter {
 
         if result.skipped {
             // ": SKIP" for skipped checks
-            write!(self.stdout, ": ")?;
-            self.stdout.set_color(&scheme::skip())?;
-            write!(self.stdout, "SKIP")?;
-            self.stdout.reset()?;
-            writeln!(self.stdout)?;
+            write!(self.writer, ": ")?;
+            self.writer.set_color(&scheme::skip())?;
+            write!(self.writer, "SKIP")?;
+            self.writer.reset()?;
+            writeln!(self.writer)?;
 
             // Show skip reason
             if let Some(ref error) = result.error {
-                writeln!(self.stdout, "  {}", error)?;
+                writeln!(self.writer, "  {}", error)?;
             }
 
             return Ok(false);
         }
 
-        if has_warnings {
-            // ": WARN" in yellow for passing checks with violations (warn level)
-            write!(self.stdout, ": ")?;
-            self.stdout.set_color(&scheme::warn())?;
-            write!(self.stdout, "WARN")?;
-            self.stdout.reset()?;
-            writeln!(self.stdout)?;
+        self.write_status(has_warnings)?;
+        self.write_violations(&result.violations, has_warnings)
+    }
+
+    /// Write violations streamed from a scan, before the check's result is
+    /// known.
+    ///
+    /// The first call for a check writes its header, `WARN` if `warn` (the
+    /// check's level) and `FAIL` otherwise; later calls continue under it.
+    /// Returns true if output was truncated.
+    pub fn write_streamed(
+        &mut self,
+        check: &str,
+        violations: &[Violation],
+        warn: bool,
+    ) -> std::io::Result<bool> {
+        if self.streaming.as_deref() != Some(check) {
+            self.last_advice = None;
+            self.writer.set_color(&scheme::check_name())?;
+            write!(self.writer, "{}", check)?;
+            self.writer.reset()?;
+            self.write_status(warn)?;
+            self.streaming = Some(check.to_string());
+        }
+        self.write_violations(violations, warn)
+    }
+
+    /// Write `: WARN` or `: FAIL` after a check name, ending the line.
+    fn write_status(&mut self, warn: bool) -> std::io::Result<()> {
+        write!(self.writer, ": ")?;
+        if warn {
+            // WARN in yellow for passing checks with violations (warn level)
+            self.writer.set_color(&scheme::warn())?;
+            write!(self.writer, "WARN")?;
         } else {
-            // ": FAIL" in red
-            write!(self.stdout, ": ")?;
-            self.stdout.set_color(&scheme::fail())?;
-            write!(self.stdout, "FAIL")?;
-            self.stdout.reset()?;
-            writeln!(self.stdout)?;
+            // FAIL in red
+            self.writer.set_color(&scheme::fail())?;
+            write!(self.writer, "FAIL")?;
         }
+        self.writer.reset()?;
+        writeln!(self.writer)
+    }
 
-        // Violations
-        for violation in &result.violations {
-            if let Some(limit) = self.options.limit
-                && self.violations_shown >= limit
-            {
-                self.truncated = true;
-                return Ok(true); // Truncated
+    /// Write violations under their group headers, up to the limit.
+    ///
+    /// With a per-rule limit, each rule's violations past it are skipped and
+    /// counted in a `+N more <rule>` line after the check's violations.
+    /// `warn` is whether the check only warns. Returns true if output was
+    /// truncated.
+    fn write_violations(&mut self, violations: &[Violation], warn: bool) -> std::io::Result<bool> {
+        let mut per_rule: HashMap<&str, usize> = HashMap::new();
+        let mut hidden: BTreeMap<&str, usize> = BTreeMap::new();
+        for group in group_violations(violations, self.options.group) {
+            let mut header_written = false;
+            for violation in &group.violations {
+                if let Some(limit) = self.options.limit
+                    && self.violations_shown >= limit
+                {
+                    self.truncated = true;
+                    return Ok(true); // Truncated
+                }
+                if let Some(limit) = self.options.limit_per_rule {
+                    let shown = per_rule.entry(rule_name(violation)).or_default();
+                    if *shown >= limit {
+                        *hidden.entry(rule_name(violation)).or_default() += 1;
+                        continue;
+                    }
+                    *shown += 1;
+                }
+                if !header_written && let Some(ref header) = group.header {
+                    self.write_group_header(header)?;
+                }
+                header_written = true;
+                self.write_violation(violation, group.header.as_ref(), warn)?;
+                self.violations_shown += 1;
             }
-            self.write_violation(violation)?;
-            self.violations_shown += 1;
         }
 
+        for (rule, count) in hidden {
+            writeln!(self.writer, "  +{} more {}", count, rule)?;
+        }
         Ok(false)
     }
 
+    /// Write a file or rule header.
+    fn write_group_header(&mut self, header: &GroupHeader) -> std::io::Result<()> {
+        write!(self.writer, "  ")?;
+        match header {
+            GroupHeader::File(file) => {
+                self.writer.set_color(&scheme::path())?;
+                write!(self.writer, "{}", file.display())?;
+                self.writer.reset()?;
+            }
+            GroupHeader::Rule(rule) => write!(self.writer, "{}", rule)?,
+        }
+        writeln!(self.writer)
+    }
+
     fn write_fix_summary(&mut self, summary: &serde_json::Value) -> std::io::Result<()> {
         // Show files_synced for actual fixes
         if let Some(synced) = summary.get("files_synced").and_then(|s| s.as_array()) {
@@ -129,13 +210,30 @@ impl TextFormatter {
                 let source = entry.get("source").and_then(|s| s.as_str()).unwrap_or("?");
                 let sections = entry.get("sections").and_then(|n| n.as_i64()).unwrap_or(0);
                 writeln!(
-                    self.stdout,
+                    self.writer,
                     "  Synced {} from {} ({} sections updated)",
                     file, source, sections
                 )?;
             }
         }
 
+        // Show justification stubs added (or, for dry-run, to be added)
+        if let Some(stubbed) = summary.get("files_stubbed").and_then(|s| s.as_array()) {
+            let dry_run = summary.get("dry_run").and_then(|d| d.as_bool()) == Some(true);
+            for entry in stubbed {
+                let file = entry.get("file").and_then(|f| f.as_str()).unwrap_or("?");
+                let stubs = entry.get("stubs").and_then(|n| n.as_i64()).unwrap_or(0);
+                writeln!(
+                    self.writer,
+                    "  {} {} justification {} to {}",
+                    if dry_run { "Would add" } else { "Added" },
+                    stubs,
+                    if stubs == 1 { "stub" } else { "stubs" },
+                    file
+                )?;
+            }
+        }
+
         // Show previews for dry-run
         if let Some(previews) = summary.get("previews").and_then(|p| p.as_array()) {
             for entry in previews {
@@ -166,7 +264,7 @@ impl TextFormatter {
 
         // Header
         writeln!(
-            self.stdout,
+            self.writer,
             "  Would sync {} from {} ({} sections)",
             file, source, sections
         )?;
@@ -179,19 +277,19 @@ impl TextFormatter {
 
     fn write_unified_diff(&mut self, file: &str, old: &str, new: &str) -> std::io::Result<()> {
         // Unified diff headers with descriptive labels
-        self.stdout.set_color(&scheme::diff_remove())?;
-        writeln!(self.stdout, "  --- {} (original)", file)?;
-        self.stdout.reset()?;
-        self.stdout.set_color(&scheme::diff_add())?;
-        writeln!(self.stdout, "  +++ {} (synced)", file)?;
-        self.stdout.reset()?;
+        self.writer.set_color(&scheme::diff_remove())?;
+        writeln!(self.writer, "  --- {} (original)", file)?;
+        self.writer.reset()?;
+        self.writer.set_color(&scheme::diff_add())?;
+        writeln!(self.writer, "  +++ {} (synced)", file)?;
+        self.writer.reset()?;
 
         let old_lines: Vec<_> = old.lines().collect();
         let new_lines: Vec<_> = new.lines().collect();
 
         // Hunk header showing line counts
         writeln!(
-            self.stdout,
+            self.writer,
             "  @@ -1,{} +1,{} @@",
             old_lines.len(),
             new_lines.len()
@@ -199,59 +297,102 @@ impl TextFormatter {
 
         // Show removed lines (old content)
         for line in &old_lines {
-            self.stdout.set_color(&scheme::diff_remove())?;
-            writeln!(self.stdout, "  -{}", line)?;
-            self.stdout.reset()?;
+            self.writer.set_color(&scheme::diff_remove())?;
+            writeln!(self.writer, "  -{}", line)?;
+            self.writer.reset()?;
         }
 
         // Show added lines (new content)
         for line in &new_lines {
-            self.stdout.set_color(&scheme::diff_add())?;
-            writeln!(self.stdout, "  +{}", line)?;
-            self.stdout.reset()?;
+            self.writer.set_color(&scheme::diff_add())?;
+            writeln!(self.writer, "  +{}", line)?;
+            self.writer.reset()?;
         }
 
         Ok(())
     }
 
-    fn write_violation(&mut self, v: &Violation) -> std::io::Result<()> {
-        write!(self.stdout, "  ")?;
+    fn write_violation(
+        &mut self,
+        v: &Violation,
+        header: Option<&GroupHeader>,
+        warn: bool,
+    ) -> std::io::Result<()> {
+        // Grouped violations are indented under their header
+        let indent = if header.is_some() { "    " } else { "  " };
+        write!(self.writer, "{}", indent)?;
 
-        // File path in cyan
         if let Some(ref file) = v.file {
-            self.stdout.set_color(&scheme::path())?;
-            write!(self.stdout, "{}", file.display())?;
-            self.stdout.reset()?;
+            // File path dimmed, unless the header already shows it
+            let in_file_group = matches!(header, Some(GroupHeader::File(_)));
+            if !in_file_group {
+                self.writer.set_color(&scheme::path())?;
+                write!(self.writer, "{}", file.display())?;
+                self.writer.reset()?;
+            }
 
-            // Line number in yellow
+            // Line and column numbers in yellow
             if let Some(line) = v.line {
-                write!(self.stdout, ":")?;
-                self.stdout.set_color(&scheme::line_number())?;
-                write!(self.stdout, "{}", line)?;
-                self.stdout.reset()?;
+                if !in_file_group {
+                    write!(self.writer, ":")?;
+                }
+                self.writer.set_color(&scheme::line_number())?;
+                write!(self.writer, "{}", line)?;
+                self.writer.reset()?;
+                if let Some(column) = v.column {
+                    write!(self.writer, ":")?;
+                    self.writer.set_color(&scheme::line_number())?;
+                    write!(self.writer, "{}", column)?;
+                    self.writer.reset()?;
+                }
+                write!(self.writer, ": ")?;
+            } else if !in_file_group {
+                write!(self.writer, ": ")?;
             }
-            write!(self.stdout, ": ")?;
         }
 
-        // Violation description (includes type-specific info)
-        writeln!(self.stdout, "{}", self.format_violation_desc(v))?;
+        // Violation description (includes type-specific info), red for
+        // errors and yellow for warnings
+        let severity = if warn || v.warning {
+            scheme::warning()
+        } else {
+            scheme::error()
+        };
+        self.writer.set_color(&severity)?;
+        write!(self.writer, "{}", self.format_violation_desc(v))?;
+        self.writer.reset()?;
+
+        // Occurrences collapsed by `--dedup line`
+        if let Some(count) = v.count {
+            write!(self.writer, " (x{})", count)?;
+        }
+
+        // Downgraded by [severity] in a failing check
+        if v.warning {
+            write!(self.writer, " (")?;
+            self.writer.set_color(&scheme::warn())?;
+            write!(self.writer, "warning")?;
+            self.writer.reset()?;
+            write!(self.writer, ")")?;
+        }
+        writeln!(self.writer)?;
 
         // Only show advice if different from last shown
         let should_show_advice = self.last_advice.as_ref() != Some(&v.advice);
 
         if should_show_advice {
-            // Advice (4-space indent for each line, skip indent on blank lines)
+            // Advice (indented under the violation, skip indent on blank lines)
             for line in v.advice.lines() {
                 if line.is_empty() {
-                    writeln!(self.stdout)?;
+                    writeln!(self.writer)?;
                 } else {
-                    writeln!(self.stdout, "    {}", line)?;
+                    writeln!(self.writer, "{}  {}", indent, line)?;
                 }
             }
 
             // Add extra newline after multi-line advice for readability
             if v.advice.contains('\n') {
-                writeln!(self.stdout)?;
+                writeln!(self.writer)?;
             }
 
             // Update tracking
@@ -400,20 +541,20 @@ impl TextFormatter {
             return Ok(()); // Nothing to report
         }
 
-        self.stdout.set_color(&scheme::check_name())?;
-        write!(self.stdout, "ratchet")?;
-        self.stdout.reset()?;
-        write!(self.stdout, ": ")?;
+        self.writer.set_color(&scheme::check_name())?;
+        write!(self.writer, "ratchet")?;
+        self.writer.reset()?;
+        write!(self.writer, ": ")?;
 
         if has_failures {
             if check_level == CheckLevel::Warn {
-                self.stdout.set_color(&scheme::warn())?;
-                writeln!(self.stdout, "WARN")?;
+                self.writer.set_color(&scheme::warn())?;
+                writeln!(self.writer, "WARN")?;
             } else {
-                self.stdout.set_color(&scheme::fail())?;
-                writeln!(self.stdout, "FAIL")?;
+                self.writer.set_color(&scheme::fail())?;
+                writeln!(self.writer, "FAIL")?;
             }
-            self.stdout.reset()?;
+            self.writer.reset()?;
 
             for comp in &result.comparisons {
                 if !comp.passed {
@@ -424,26 +565,26 @@ impl TextFormatter {
                         "max"
                     };
                     writeln!(
-                        self.stdout,
+                        self.writer,
                         "  {}: {} ({}: {} from baseline)",
                         comp.name,
                         comp.format_value(comp.current),
                         threshold_label,
                         comp.format_value(comp.baseline)
                     )?;
-                    writeln!(self.stdout, "    {}", comp.advice())?;
+                    writeln!(self.writer, "    {}", comp.advice())?;
                 }
             }
         } else {
             // Improvements only
-            self.stdout.set_color(&scheme::pass())?;
-            writeln!(self.stdout, "PASS")?;
-            self.stdout.reset()?;
+            self.writer.set_color(&scheme::pass())?;
+            writeln!(self.writer, "PASS")?;
+            self.writer.reset()?;
 
             for comp in &result.comparisons {
                 if comp.improved {
                     writeln!(
-                        self.stdout,
+                        self.writer,
                         "  {}: {} (baseline: {}) improved",
                         comp.name,
                         comp.format_value(comp.current),
@@ -478,13 +619,13 @@ impl TextFormatter {
             .collect();
 
         if !passed.is_empty() {
-            writeln!(self.stdout, "PASS: {}", passed.join(", "))?;
+            writeln!(self.writer, "PASS: {}", passed.join(", "))?;
         }
         if !failed.is_empty() {
-            writeln!(self.stdout, "FAIL: {}", failed.join(", "))?;
+            writeln!(self.writer, "FAIL: {}", failed.join(", "))?;
         }
         if !skipped.is_empty() {
-            writeln!(self.stdout, "SKIP: {}", skipped.join(", "))?;
+            writeln!(self.writer, "SKIP: {}", skipped.join(", "))?;
         }
         Ok(())
     }
@@ -495,7 +636,7 @@ impl TextFormatter {
             && self.was_truncated()
         {
             writeln!(
-                self.stdout,
+                self.writer,
                 "Stopped after {} violations. Use --no-limit to see all.",
                 limit
             )?;
@@ -519,6 +660,88 @@ impl TextFormatter {
     }
 }
 
+/// Header over a group of violations.
+#[derive(Debug, Clone, PartialEq, Eq)]
+enum GroupHeader {
+    /// Violations in one file, shown without their path.
+    File(std::path::PathBuf),
+    /// Violations of one rule (pattern, or type when there is none).
+    Rule(String),
+}
+
+/// Violations shown together, under an optional header.
+#[derive(Debug)]
+struct ViolationGroup<'a> {
+    header: Option<GroupHeader>,
+    violations: Vec<&'a Violation>,
+}
+
+/// Rule a violation is grouped and limited by: its pattern, or its type
+/// when there is none.
+fn rule_name(v: &Violation) -> &str {
+    v.pattern.as_deref().unwrap_or(&v.violation_type)
+}
+
+/// Arrange a check's violations for display.
+///
+/// `file` puts violations without a file first, then each file in path
+/// order, sorted by line and column. `rule` puts each rule in name order,
+/// sorted by file, line, and column. `none` keeps the check's order. Sorts
+/// are stable, so ties keep the order the check reported them in.
+fn group_violations(violations: &[Violation], group: GroupBy) -> Vec<ViolationGroup<'_>> {
+    let location = |v: &&Violation| (v.line, v.column);
+    match group {
+        GroupBy::None => vec![ViolationGroup {
+            header: None,
+            violations: violations.iter().collect(),
+        }],
+        GroupBy::File => {
+            let mut unfiled = Vec::new();
+            let mut files: BTreeMap<&Path, Vec<&Violation>> = BTreeMap::new();
+            for v in violations {
+                match v.file {
+                    Some(ref file) => files.entry(file.as_path()).or_default().push(v),
+                    None => unfiled.push(v),
+                }
+            }
+            let mut groups = vec![ViolationGroup {
+                header: None,
+                violations: unfiled,
+            }];
+            for (file, mut violations) in files {
+                violations.sort_by_key(location);
+                groups.push(ViolationGroup {
+                    header: Some(GroupHeader::File(file.to_path_buf())),
+                    violations,
+                });
+            }
+            groups
+        }
+        GroupBy::Rule => {
+            let mut rules: BTreeMap<&str, Vec<&Violation>> = BTreeMap::new();
+            for v in violations {
+                rules.entry(rule_name(v)).or_default().push(v);
+            }
+            rules
+                .into_iter()
+                .map(|(rule, mut violations)| {
+                    violations.sort_by(|a, b| {
+                        (a.file.as_deref(), a.line, a.column).cmp(&(
+                            b.file.as_deref(),
+                            b.line,
+                            b.column,
+                        ))
+                    });
+                    ViolationGroup {
+                        header: Some(GroupHeader::Rule(rule.to_string())),
+                        violations,
+                    }
+                })
+                .collect()
+        }
+    }
+}
+
 #[cfg(test)]
 #[path = "text_tests.rs"]
 mod tests;
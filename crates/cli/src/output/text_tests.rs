@@ -3,8 +3,9 @@
 
 use termcolor::ColorChoice;
 
-use super::{FormatOptions, TextFormatter};
+use super::{FormatOptions, GroupHeader, TextFormatter, group_violations};
 use crate::check::{CheckResult, Violation};
+use crate::cli::GroupBy;
 
 #[test]
 fn text_formatter_creates_successfully() {
@@ -426,3 +427,139 @@ fn empty_advice_deduplicated() {
 
     // Both violations shown, but no repeated empty advice blocks
 }
+
+// =============================================================================
+// GROUPING TESTS
+// =============================================================================
+
+fn grouped(violations: &[Violation], group: GroupBy) -> Vec<(Option<GroupHeader>, Vec<String>)> {
+    group_violations(violations, group)
+        .into_iter()
+        .map(|g| {
+            let entries = g
+                .violations
+                .iter()
+                .map(|v| {
+                    format!(
+                        "{}:{}:{}",
+                        v.file
+                            .as_deref()
+                            .map(|f| f.display().to_string())
+                            .unwrap_or_default(),
+                        v.line.unwrap_or(0),
+                        v.pattern.as_deref().unwrap_or(&v.violation_type)
+                    )
+                })
+                .collect();
+            (g.header, entries)
+        })
+        .collect()
+}
+
+fn mixed_violations() -> Vec<Violation> {
+    vec![
+        Violation::file("src/b.rs", 9, "forbidden", "").with_pattern("unwrap"),
+        Violation::file("src/a.rs", 7, "missing_comment", "").with_pattern("unsafe"),
+        Violation::file("src/b.rs", 2, "missing_comment", "").with_pattern("unsafe"),
+        Violation::commit_violation("abc123", "feat: x", "missing_docs", ""),
+        Violation::file("src/a.rs", 3, "forbidden", "").with_pattern("unwrap"),
+    ]
+}
+
+#[test]
+fn groups_by_file_in_path_and_line_order() {
+    assert_eq!(
+        grouped(&mixed_violations(), GroupBy::File),
+        vec![
+            (None, vec![":0:missing_docs".to_string()]),
+            (
+                Some(GroupHeader::File("src/a.rs".into())),
+                vec![
+                    "src/a.rs:3:unwrap".to_string(),
+                    "src/a.rs:7:unsafe".to_string()
+                ]
+            ),
+            (
+                Some(GroupHeader::File("src/b.rs".into())),
+                vec![
+                    "src/b.rs:2:unsafe".to_string(),
+                    "src/b.rs:9:unwrap".to_string()
+                ]
+            ),
+        ]
+    );
+}
+
+#[test]
+fn groups_by_rule_in_name_then_file_order() {
+    assert_eq!(
+        grouped(&mixed_violations(), GroupBy::Rule),
+        vec![
+            (
+                Some(GroupHeader::Rule("missing_docs".to_string())),
+                vec![":0:missing_docs".to_string()]
+            ),
+            (
+                Some(GroupHeader::Rule("unsafe".to_string())),
+                vec![
+                    "src/a.rs:7:unsafe".to_string(),
+                    "src/b.rs:2:unsafe".to_string()
+                ]
+            ),
+            (
+                Some(GroupHeader::Rule("unwrap".to_string())),
+                vec![
+                    "src/a.rs:3:unwrap".to_string(),
+                    "src/b.rs:9:unwrap".to_string()
+                ]
+            ),
+        ]
+    );
+}
+
+#[test]
+fn group_none_keeps_check_order() {
+    let groups = grouped(&mixed_violations(), GroupBy::None);
+    assert_eq!(groups.len(), 1);
+    assert_eq!(groups[0].0, None);
+    assert_eq!(
+        groups[0].1,
+        vec![
+            "src/b.rs:9:unwrap",
+            "src/a.rs:7:unsafe",
+            "src/b.rs:2:unsafe",
+            ":0:missing_docs",
+            "src/a.rs:3:unwrap",
+        ]
+    );
+}
+
+#[test]
+fn grouped_limit_counts_violations_not_headers() {
+    let mut formatter = TextFormatter::new(ColorChoice::Never, FormatOptions::with_limit(1));
+    let violations = vec![
+        Violation::file("src/a.rs", 1, "file_too_large", "Split into modules."),
+        Violation::file("src/b.rs", 1, "file_too_large", "Split into modules."),
+    ];
+    let truncated = formatter
+        .write_check(&CheckResult::failed("cloc", violations))
+        .unwrap();
+    assert!(truncated);
+    assert_eq!(formatter.violations_shown(), 1);
+}
+
+#[test]
+fn limit_per_rule_skips_violations_past_each_rules_limit() {
+    let options = FormatOptions {
+        limit_per_rule: Some(1),
+        ..FormatOptions::no_limit()
+    };
+    let mut formatter = TextFormatter::new(ColorChoice::Never, options);
+    let truncated = formatter
+        .write_check(&CheckResult::failed("escapes", mixed_violations()))
+        .unwrap();
+    // One each of unwrap, unsafe, and missing_docs; the rest are summarized
+    assert!(!truncated);
+    assert!(!formatter.was_truncated());
+    assert_eq!(formatter.violations_shown(), 3);
+}
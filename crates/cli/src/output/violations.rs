@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Flat violation list formatter (`--format json`).
+//!
+//! Emits one object per violation across all checks, for CI dashboards and
+//! other tooling that doesn't want the nested check report. Records are
+//! sorted by file then line so diffs between runs stay meaningful.
+
+use std::io::Write;
+
+use serde::Serialize;
+
+use crate::check::{CheckOutput, Violation};
+
+/// Severity of a violation in the flat list.
+#[derive(Debug, Clone, Copy, PartialEq, Eq, PartialOrd, Ord, Serialize)]
+#[serde(rename_all = "lowercase")]
+pub enum Severity {
+    /// Violation fails the check.
+    Error,
+    /// Violation is reported but the check passes (warn level).
+    Warning,
+}
+
+/// A single violation in the flat list.
+#[derive(Debug, Clone, PartialEq, Eq, PartialOrd, Ord, Serialize)]
+pub struct ViolationRecord {
+    /// File path (null for non-file violations like commit messages).
+    pub file: Option<String>,
+    /// Line number, 1-based.
+    pub line: Option<u32>,
+    /// Column number, 1-based.
+    pub column: Option<u32>,
+    /// Rule identifier: the escape pattern name, or the violation type.
+    pub rule: String,
+    /// Stable machine-readable rule id, lowercase and hyphenated (see
+    /// [`stable_rule_id`]).
+    #[serde(rename = "ruleId")]
+    pub rule_id: String,
+    /// Actionable guidance.
+    pub message: String,
+    /// Whether the violation fails the check.
+    pub severity: Severity,
+    /// Check that produced the violation.
+    pub check: String,
+    /// Occurrences on the line, when collapsed by `--dedup line`.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub count: Option<u32>,
+}
+
+impl ViolationRecord {
+    fn new(check: &str, violation: &Violation, severity: Severity) -> Self {
+        Self {
+            file: violation
+                .file
+                .as_ref()
+                .map(|f| f.to_string_lossy().replace('\\', "/")),
+            line: violation.line,
+            column: violation.column,
+            rule: rule_id(violation),
+            rule_id: stable_rule_id(&rule_id(violation)),
+            message: violation.advice.clone(),
+            severity,
+            check: check.to_string(),
+            count: violation.count,
+        }
+    }
+}
+
+/// Violation types whose `pattern` is the matched source or setting, like
+/// `#[allow(clippy::unwrap_used)]`, rather than a rule name.
+const SOURCE_PATTERN_TYPES: &[&str] = &[
+    "suppress_forbidden",
+    "suppress_missing_comment",
+    "shellcheck_forbidden",
+    "shellcheck_missing_comment",
+    "lint_policy",
+];
+
+/// Rule identifier for a violation: the escape pattern name, or the violation type.
+///
+/// Suppression and lint policy violations use their type, so every
+/// suppressed code shares one id.
+pub fn rule_id(violation: &Violation) -> String {
+    match violation.pattern {
+        Some(ref pattern) if !SOURCE_PATTERN_TYPES.contains(&violation.violation_type.as_str()) => {
+            pattern.clone()
+        }
+        _ => violation.violation_type.clone(),
+    }
+}
+
+/// Normalize a rule id for comparison, so `unsafe-pointer` matches `unsafe_pointer`.
+pub fn normalize_rule(rule: &str) -> String {
+    rule.replace('-', "_").to_ascii_lowercase()
+}
+
+/// Stable machine-readable rule id (`ruleId` in every output format):
+/// lowercase and hyphenated, so `unsafe_pointer` is `unsafe-pointer`. Go
+/// rules drop their `go_` prefix (`go_linkname` is `linkname`), and
+/// surrounding underscores are trimmed (`__import__` is `import`).
+pub fn stable_rule_id(rule: &str) -> String {
+    let rule = normalize_rule(rule);
+    let rule = rule.strip_prefix("go_").unwrap_or(&rule);
+    rule.trim_matches('_').replace('_', "-")
+}
+
+/// Flatten all check results into records sorted by file, line, and column.
+pub fn collect_records(output: &CheckOutput) -> Vec<ViolationRecord> {
+    let mut records: Vec<ViolationRecord> = output
+        .checks
+        .iter()
+        .flat_map(|result| {
+            // Violations on a passing check are warnings (check level = warn),
+            // as are violations downgraded by `[severity]` on a failing one
+            result.violations.iter().map(move |v| {
+                let severity = if result.passed || v.warning {
+                    Severity::Warning
+                } else {
+                    Severity::Error
+                };
+                ViolationRecord::new(&result.name, v, severity)
+            })
+        })
+        .collect();
+    records.sort();
+    records
+}
+
+/// Flat violation list JSON formatter.
+pub struct ViolationsFormatter<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> ViolationsFormatter<W> {
+    /// Create a new violations formatter.
+    pub fn new(writer: W) -> Self {
+        Self { writer }
+    }
+
+    /// Write all violations as a JSON array (`[]` when there are none).
+    pub fn write(&mut self, output: &CheckOutput) -> std::io::Result<()> {
+        let records = collect_records(output);
+        let json = serde_json::to_string_pretty(&records).map_err(std::io::Error::other)?;
+        writeln!(self.writer, "{}", json)
+    }
+}
+
+#[cfg(test)]
+#[path = "violations_tests.rs"]
+mod tests;
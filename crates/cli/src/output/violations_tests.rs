@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::CheckResult;
+use crate::output::json::create_output;
+
+fn write_json(output: &CheckOutput) -> serde_json::Value {
+    let mut buffer = Vec::new();
+    ViolationsFormatter::new(&mut buffer).write(output).unwrap();
+    serde_json::from_slice(&buffer).unwrap()
+}
+
+#[test]
+fn empty_result_is_empty_array() {
+    let output = create_output(vec![
+        CheckResult::passed("cloc"),
+        CheckResult::passed("escapes"),
+    ]);
+    assert_eq!(write_json(&output), serde_json::json!([]));
+}
+
+#[test]
+fn record_has_all_fields() {
+    let violation = Violation::file(
+        "src/lib.go",
+        7,
+        "missing_comment",
+        "Add a // SAFETY: comment.",
+    )
+    .with_pattern("unsafe_pointer")
+    .with_column(9);
+    let output = create_output(vec![CheckResult::failed("escapes", vec![violation])]);
+
+    assert_eq!(
+        write_json(&output),
+        serde_json::json!([{
+            "file": "src/lib.go",
+            "line": 7,
+            "column": 9,
+            "rule": "unsafe_pointer",
+            "ruleId": "unsafe-pointer",
+            "message": "Add a // SAFETY: comment.",
+            "severity": "error",
+            "check": "escapes",
+        }])
+    );
+}
+
+#[test]
+fn missing_location_is_null() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+
+    let json = write_json(&output);
+    assert!(json[0]["file"].is_null());
+    assert!(json[0]["line"].is_null());
+    assert!(json[0]["column"].is_null());
+    assert_eq!(json[0]["rule"], "invalid_format");
+    assert_eq!(json[0]["ruleId"], "invalid-format");
+}
+
+#[test]
+fn passing_check_violations_are_warnings() {
+    let violation = Violation::file_only("README.md", "missing_section", "Add a section.");
+    let output = create_output(vec![CheckResult::passed_with_warnings(
+        "docs",
+        vec![violation],
+    )]);
+    assert_eq!(write_json(&output)[0]["severity"], "warning");
+}
+
+#[test]
+fn downgraded_violation_in_failing_check_is_warning() {
+    let error = Violation::file("a.go", 1, "forbidden", "x");
+    let mut downgraded = Violation::file("a.go", 2, "forbidden", "x");
+    downgraded.warning = true;
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![error, downgraded],
+    )]);
+
+    let json = write_json(&output);
+    assert_eq!(json[0]["severity"], "error");
+    assert_eq!(json[1]["severity"], "warning");
+}
+
+#[test]
+fn stable_rule_ids_are_lowercase_hyphenated() {
+    assert_eq!(stable_rule_id("unsafe_pointer"), "unsafe-pointer");
+    assert_eq!(stable_rule_id("go_linkname"), "linkname");
+    assert_eq!(stable_rule_id("go_linkname_push"), "linkname-push");
+    assert_eq!(stable_rule_id("__import__"), "import");
+    assert_eq!(stable_rule_id("unsafe-pointer"), "unsafe-pointer");
+}
+
+#[test]
+fn normalize_rule_treats_dashes_as_underscores() {
+    assert_eq!(normalize_rule("unsafe-pointer"), "unsafe_pointer");
+    assert_eq!(normalize_rule("Unsafe_Pointer"), "unsafe_pointer");
+}
+
+#[test]
+fn records_sorted_by_file_then_line() {
+    let output = create_output(vec![
+        CheckResult::failed(
+            "escapes",
+            vec![
+                Violation::file("b.go", 3, "forbidden", "x"),
+                Violation::file("a.go", 10, "forbidden", "x"),
+            ],
+        ),
+        CheckResult::failed(
+            "cloc",
+            vec![
+                Violation::file("a.go", 2, "file_too_large", "x"),
+                Violation::file("b.go", 1, "file_too_large", "x"),
+            ],
+        ),
+    ]);
+
+    let locations: Vec<_> = collect_records(&output)
+        .into_iter()
+        .map(|r| (r.file.unwrap(), r.line.unwrap()))
+        .collect();
+    assert_eq!(
+        locations,
+        vec![
+            ("a.go".to_string(), 2),
+            ("a.go".to_string(), 10),
+            ("b.go".to_string(), 1),
+            ("b.go".to_string(), 3),
+        ]
+    );
+}
+
+#[test]
+fn suppression_rule_ids_come_from_violation_type() {
+    let allow = Violation::file(
+        "src/lib.rs",
+        3,
+        "suppress_missing_comment",
+        "Add a comment.",
+    )
+    .with_pattern("#[allow(clippy::unwrap_used)]");
+    let shellcheck = Violation::file("run.sh", 2, "shellcheck_forbidden", "Remove it.")
+        .with_pattern("# shellcheck disable=SC2034");
+    let policy = Violation::file("Cargo.toml", 1, "lint_policy", "Split the PR.")
+        .with_pattern("lint_changes = standalone");
+
+    assert_eq!(rule_id(&allow), "suppress_missing_comment");
+    assert_eq!(stable_rule_id(&rule_id(&allow)), "suppress-missing-comment");
+    assert_eq!(
+        stable_rule_id(&rule_id(&shellcheck)),
+        "shellcheck-forbidden"
+    );
+    assert_eq!(stable_rule_id(&rule_id(&policy)), "lint-policy");
+
+    let escape = Violation::file("main.go", 5, "missing_comment", "Add a // SAFETY: comment.")
+        .with_pattern("unsafe_pointer");
+    assert_eq!(rule_id(&escape), "unsafe_pointer");
+}
@@ -19,7 +19,6 @@ pub enum CompiledPattern {
 
 /// Matcher for single literal strings using SIMD-optimized memchr.
 pub struct LiteralMatcher {
-    pattern: &'static str,
     finder: Finder<'static>,
 }
 
@@ -147,14 +146,11 @@ fn extract_alternation_literals(pattern: &str) -> Option<Vec<String>> {
 impl LiteralMatcher {
     /// Create a new literal matcher.
     ///
-    /// Note: We leak the pattern string to get a 'static lifetime for Finder.
-    /// This is acceptable since patterns are compiled once at startup and live
-    /// for the program duration.
+    /// The finder owns a copy of the needle, so matchers compiled for each
+    /// scan are freed with it rather than leaked.
     pub fn new(pattern: &str) -> Self {
-        let pattern_static: &'static str = Box::leak(pattern.to_string().into_boxed_str());
         Self {
-            pattern: pattern_static,
-            finder: Finder::new(pattern_static),
+            finder: Finder::new(pattern).into_owned(),
         }
     }
 
@@ -163,7 +159,7 @@ impl LiteralMatcher {
             .find_iter(content.as_bytes())
             .map(|pos| PatternMatch {
                 start: pos,
-                end: pos + self.pattern.len(),
+                end: pos + self.finder.needle().len(),
             })
             .collect()
     }
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Scan progress line for interactive terminals.
+//!
+//! Redraws `Scanning 120/4031 internal/store/ptr.go` in place on stderr while
+//! files are scanned, and clears it before results are printed. Enabled only
+//! when stderr is a terminal and the output is the human-readable report.
+
+use std::io::Write;
+use std::path::Path;
+use std::sync::Mutex;
+use std::sync::atomic::{AtomicUsize, Ordering};
+use std::time::{Duration, Instant};
+
+/// Minimum time between redraws, so workers don't contend on stderr.
+const REDRAW_INTERVAL: Duration = Duration::from_millis(50);
+
+/// Widest line drawn; longer paths are shortened from the left so the line
+/// never wraps (a wrapped line can't be cleared with `\r`).
+const MAX_WIDTH: usize = 79;
+
+/// ANSI: return to column 0 and erase the line.
+const CLEAR_LINE: &str = "\r\x1b[K";
+
+/// Progress reporter shared across scan workers.
+pub struct Progress {
+    total: AtomicUsize,
+    done: AtomicUsize,
+    /// Last redraw time; None until the first line is drawn.
+    drawn: Mutex<Option<Instant>>,
+}
+
+impl Progress {
+    pub fn new() -> Self {
+        Self {
+            total: AtomicUsize::new(0),
+            done: AtomicUsize::new(0),
+            drawn: Mutex::new(None),
+        }
+    }
+
+    /// Start counting a scan of `total` files.
+    pub fn start(&self, total: usize) {
+        self.total.store(total, Ordering::Relaxed);
+        self.done.store(0, Ordering::Relaxed);
+    }
+
+    /// Record one scanned file and redraw, at most every [`REDRAW_INTERVAL`].
+    ///
+    /// `path` is shown as given, so pass it relative to the scan root.
+    pub fn tick(&self, path: &Path) {
+        let done = self.done.fetch_add(1, Ordering::Relaxed) + 1;
+        // Another worker is drawing; its line will do
+        let Ok(mut drawn) = self.drawn.try_lock() else {
+            return;
+        };
+        let now = Instant::now();
+        if drawn.is_some_and(|last| now.duration_since(last) < REDRAW_INTERVAL) {
+            return;
+        }
+        *drawn = Some(now);
+
+        let total = self.total.load(Ordering::Relaxed).max(done);
+        let line = render(done, total, &path.to_string_lossy(), MAX_WIDTH);
+        let mut stderr = std::io::stderr().lock();
+        let _ = write!(stderr, "{CLEAR_LINE}{line}");
+        let _ = stderr.flush();
+    }
+
+    /// Erase the progress line, if one was drawn.
+    pub fn finish(&self) {
+        let Ok(mut drawn) = self.drawn.lock() else {
+            return;
+        };
+        if drawn.take().is_some() {
+            let mut stderr = std::io::stderr().lock();
+            let _ = write!(stderr, "{CLEAR_LINE}");
+            let _ = stderr.flush();
+        }
+    }
+}
+
+impl Default for Progress {
+    fn default() -> Self {
+        Self::new()
+    }
+}
+
+/// Format a progress line no wider than `width` characters.
+pub fn render(done: usize, total: usize, path: &str, width: usize) -> String {
+    let counter = format!("Scanning {done}/{total}");
+    let room = width.saturating_sub(counter.chars().count() + 1);
+    let chars = path.chars().count();
+    if path.is_empty() || room < 2 {
+        return counter;
+    }
+    if chars <= room {
+        return format!("{counter} {path}");
+    }
+    let tail: String = path.chars().skip(chars - (room - 1)).collect();
+    format!("{counter} …{tail}")
+}
+
+#[cfg(test)]
+#[path = "progress_tests.rs"]
+mod tests;
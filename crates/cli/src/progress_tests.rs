@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use yare::parameterized;
+
+use super::*;
+
+#[parameterized(
+    fits = { 3, 10, "src/lib.rs", 40, "Scanning 3/10 src/lib.rs" },
+    no_path = { 0, 10, "", 40, "Scanning 0/10" },
+    shortened = { 3, 10, "internal/store/ptr.go", 24, "Scanning 3/10 …re/ptr.go" },
+    no_room = { 3, 10, "src/lib.rs", 14, "Scanning 3/10" },
+)]
+fn renders_progress_line(done: usize, total: usize, path: &str, width: usize, expected: &str) {
+    assert_eq!(render(done, total, path, width), expected);
+}
+
+#[test]
+fn rendered_line_never_exceeds_width() {
+    let path = "a/".repeat(100);
+    for width in [0, 10, 20, 79] {
+        let line = render(1234, 5678, &path, width);
+        assert!(
+            line.chars().count() <= width.max("Scanning 1234/5678".len()),
+            "{line:?} wider than {width}"
+        );
+    }
+}
+
+#[test]
+fn start_resets_count() {
+    let progress = Progress::new();
+    progress.done.store(5, Ordering::Relaxed);
+    progress.start(10);
+    assert_eq!(progress.total.load(Ordering::Relaxed), 10);
+    assert_eq!(progress.done.load(Ordering::Relaxed), 0);
+}
@@ -60,6 +60,7 @@ pub fn create_test_baseline() -> Baseline {
         version: 1,
         updated: chrono::Utc::now(),
         commit: Some("abc1234".to_string()),
+        quench_version: None,
         metrics: BaselineMetrics {
             coverage: Some(CoverageMetrics {
                 total: 85.5,
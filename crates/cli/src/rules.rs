@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Custom rules for embedding tools.
+//!
+//! Teams with bespoke policies (e.g., "no `time.Now()` in package clock")
+//! implement [`Rule`] and register it before calling [`crate::scan`]. The
+//! escapes check runs every registered rule on each source file it scans,
+//! after the built-in patterns, and reports findings under the rule's name:
+//!
+//! ```ignore
+//! struct NoTimeNow;
+//!
+//! impl quench::rules::Rule for NoTimeNow {
+//!     fn name(&self) -> &str {
+//!         "no_time_now"
+//!     }
+//!
+//!     fn check(&self, file: &quench::rules::SourceFile) -> Vec<quench::rules::RuleViolation> {
+//!         if !file.path.starts_with("internal/clock") {
+//!             return Vec::new();
+//!         }
+//!         file.find("time.Now()", "Use the injected Clock instead of time.Now().")
+//!     }
+//! }
+//!
+//! quench::rules::register_rule(NoTimeNow);
+//! let violations = quench::scan(root, &quench::ScanOptions::default())?;
+//! ```
+//!
+//! Findings honor `quench:ignore` directives and `[severity]` overrides
+//! like built-in rules. See docs/specs/checks/escape-hatches.md#custom-rules.
+
+use std::path::Path;
+use std::sync::{Arc, LazyLock, RwLock};
+
+use crate::adapter::go::{GoImport, parse_imports};
+use crate::category::Category;
+
+/// A custom policy checked against each source file.
+pub trait Rule: Send + Sync {
+    /// Rule id reported as the violation's pattern (e.g., `no_time_now`).
+    fn name(&self) -> &str;
+
+    /// One-line description listed by `quench rules`.
+    fn description(&self) -> &str {
+        "Custom rule."
+    }
+
+    /// Category for `--enable-category` and `--disable-category` (None =
+    /// uncategorized).
+    fn category(&self) -> Option<Category> {
+        None
+    }
+
+    /// Check one file, returning a violation per finding.
+    fn check(&self, file: &SourceFile) -> Vec<RuleViolation>;
+}
+
+/// A source file handed to [`Rule::check`].
+pub struct SourceFile<'a> {
+    /// Path relative to the project root.
+    pub path: &'a Path,
+    /// File content.
+    pub content: &'a str,
+    /// Whether the file is test code.
+    pub is_test: bool,
+}
+
+impl SourceFile<'_> {
+    /// Whether the file has one of the given extensions.
+    pub fn has_extension(&self, extensions: &[&str]) -> bool {
+        self.path
+            .extension()
+            .and_then(|e| e.to_str())
+            .is_some_and(|e| extensions.contains(&e))
+    }
+
+    /// Imports of a Go file, with their names and lines (empty for other files).
+    pub fn go_imports(&self) -> Vec<GoImport> {
+        if self.has_extension(&["go"]) {
+            parse_imports(self.content)
+        } else {
+            Vec::new()
+        }
+    }
+
+    /// A violation at each occurrence of `needle`, skipping `//` comment lines.
+    pub fn find(&self, needle: &str, message: &str) -> Vec<RuleViolation> {
+        let mut violations = Vec::new();
+        for (idx, line) in self.content.lines().enumerate() {
+            if line.trim_start().starts_with("//") {
+                continue;
+            }
+            if let Some(offset) = line.find(needle) {
+                violations.push(
+                    RuleViolation::new(idx as u32 + 1, message)
+                        .with_column(line[..offset].chars().count() as u32 + 1),
+                );
+            }
+        }
+        violations
+    }
+}
+
+/// A finding from a custom rule.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct RuleViolation {
+    /// 1-based line number.
+    pub line: u32,
+    /// 1-based column, if known.
+    pub column: Option<u32>,
+    /// Advice shown with the violation.
+    pub message: String,
+}
+
+impl RuleViolation {
+    /// Create a violation at a line.
+    pub fn new(line: u32, message: impl Into<String>) -> Self {
+        Self {
+            line,
+            column: None,
+            message: message.into(),
+        }
+    }
+
+    /// Set the column.
+    pub fn with_column(mut self, column: u32) -> Self {
+        self.column = Some(column);
+        self
+    }
+}
+
+static REGISTRY: LazyLock<RwLock<Vec<Arc<dyn Rule>>>> = LazyLock::new(|| RwLock::new(Vec::new()));
+
+/// Register a custom rule for all subsequent scans in this process.
+///
+/// Rules registered under the same name as an existing rule replace it.
+pub fn register_rule(rule: impl Rule + 'static) {
+    let rule: Arc<dyn Rule> = Arc::new(rule);
+    let mut rules = REGISTRY.write().unwrap_or_else(|e| e.into_inner());
+    rules.retain(|r| r.name() != rule.name());
+    rules.push(rule);
+}
+
+/// Snapshot of the registered rules, in registration order.
+pub fn registered_rules() -> Vec<Arc<dyn Rule>> {
+    REGISTRY.read().unwrap_or_else(|e| e.into_inner()).clone()
+}
+
+#[cfg(test)]
+#[path = "rules_tests.rs"]
+mod tests;
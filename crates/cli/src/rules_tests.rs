@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::scan::{ScanOptions, scan};
+use crate::test_utils::{create_tree, temp_project_with_config};
+
+/// Example policy: "no `time.Now()` in package clock".
+struct NoTimeNow;
+
+impl Rule for NoTimeNow {
+    fn name(&self) -> &str {
+        "no_time_now"
+    }
+
+    fn check(&self, file: &SourceFile) -> Vec<RuleViolation> {
+        if file.is_test || !file.has_extension(&["go"]) || !file.path.starts_with("internal/clock")
+        {
+            return Vec::new();
+        }
+        file.find(
+            "time.Now()",
+            "Use the injected Clock instead of time.Now().",
+        )
+    }
+}
+
+fn source<'a>(path: &'a str, content: &'a str) -> SourceFile<'a> {
+    SourceFile {
+        path: Path::new(path),
+        content,
+        is_test: false,
+    }
+}
+
+#[test]
+fn find_reports_line_and_column() {
+    let file = source(
+        "internal/clock/clock.go",
+        "package clock\n\n// time.Now() is banned here\nfunc now() { _ = time.Now() }\n",
+    );
+    assert_eq!(
+        NoTimeNow.check(&file),
+        vec![
+            RuleViolation::new(4, "Use the injected Clock instead of time.Now().").with_column(18)
+        ]
+    );
+}
+
+#[test]
+fn example_rule_scoped_to_package() {
+    let file = source("cmd/app/main.go", "package main\n\nvar t = time.Now()\n");
+    assert!(NoTimeNow.check(&file).is_empty());
+}
+
+#[test]
+fn go_imports_only_for_go_files() {
+    let content = "import \"time\"\n";
+    assert_eq!(source("a.go", content).go_imports()[0].path, "time");
+    assert!(source("a.py", content).go_imports().is_empty());
+}
+
+#[test]
+fn registered_rule_runs_during_scan() {
+    register_rule(NoTimeNow);
+    assert!(registered_rules().iter().any(|r| r.name() == "no_time_now"));
+
+    let dir = temp_project_with_config("version = 1\n\n[check.agents]\nrequired = []\n");
+    create_tree(
+        dir.path(),
+        &[
+            ("go.mod", "module example.com/p\n\ngo 1.21\n"),
+            (
+                "internal/clock/clock.go",
+                "package clock\n\nimport \"time\"\n\nfunc Now() time.Time {\n\treturn time.Now()\n}\n",
+            ),
+        ],
+    );
+    let options = ScanOptions {
+        enabled_checks: vec!["escapes".to_string()],
+        ..Default::default()
+    };
+
+    let violations = scan(dir.path(), &options).unwrap();
+    assert_eq!(violations.len(), 1);
+    let v = &violations[0];
+    assert_eq!(v.file.as_deref(), Some("internal/clock/clock.go"));
+    assert_eq!(v.line, Some(6));
+    assert_eq!(v.column, Some(9));
+    assert_eq!(v.rule, "no_time_now");
+    assert_eq!(v.check, "escapes");
+}
+
+#[test]
+fn registering_same_name_replaces_rule() {
+    register_rule(NoTimeNow);
+    register_rule(NoTimeNow);
+    let count = registered_rules()
+        .iter()
+        .filter(|r| r.name() == "no_time_now")
+        .count();
+    assert_eq!(count, 1);
+}
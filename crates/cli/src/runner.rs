@@ -15,9 +15,13 @@ use std::time::Instant;
 
 use rayon::prelude::*;
 
-use crate::cache::{CachedViolation, FileCache, FileCacheKey};
-use crate::check::{Check, CheckContext, CheckResult, Violation};
+use crate::cache::{CachedViolation, CheckSet, FileCache, FileCacheKey, hash_content};
+use crate::check::{Check, CheckContext, CheckResult, SourceBuffer, Violation};
 use crate::config::Config;
+use crate::fix_preview::FixPreview;
+use crate::progress::Progress;
+use crate::rules::Rule;
+use crate::stream::ViolationStream;
 use crate::walker::WalkedFile;
 
 /// Cached violations for a file (Arc for O(1) clone).
@@ -33,6 +37,8 @@ pub struct RunnerConfig {
     pub fix: bool,
     /// Show what --fix would change without modifying files.
     pub dry_run: bool,
+    /// Edits --fix --dry-run would make (None = not a dry run).
+    pub fix_preview: Option<Arc<FixPreview>>,
     /// Whether running in CI mode (enables slow checks like commit validation).
     pub ci_mode: bool,
     /// Base branch for commit comparison in CI mode.
@@ -41,6 +47,14 @@ pub struct RunnerConfig {
     pub staged: bool,
     /// Whether verbose diagnostic output is enabled.
     pub verbose: bool,
+    /// Buffer checked in place of its file on disk (`check --stdin`).
+    pub stdin: Option<SourceBuffer>,
+    /// Custom rules for this run (None = the process-wide registry).
+    pub rules: Option<Vec<Arc<dyn Rule>>>,
+    /// Progress line for scanned files (None = no progress output).
+    pub progress: Option<Arc<Progress>>,
+    /// Scanned files' violations, written as they complete (None = no streaming).
+    pub stream: Option<Arc<ViolationStream>>,
 }
 
 impl RunnerConfig {
@@ -61,10 +75,15 @@ impl RunnerConfig {
             changed_files: self.changed_files.as_deref(),
             fix: self.fix,
             dry_run: self.dry_run,
+            fix_preview: self.fix_preview.as_deref(),
             ci_mode: self.ci_mode,
             base_branch: self.base_branch.as_deref(),
             staged: self.staged,
             verbose: self.verbose,
+            stdin: self.stdin.as_ref(),
+            rules: self.rules.as_deref(),
+            progress: self.progress.as_deref(),
+            stream: self.stream.as_deref(),
         }
     }
 }
@@ -113,6 +132,7 @@ impl CheckRunner {
         // Cold runs will reallocate, but that's acceptable as they're infrequent
         // (~4 reallocations worst case, negligible vs check work).
         let file_count = files.len();
+        let check_set = CheckSet::from_names(checks.iter().map(|c| c.name()));
         let mut cached_violations: HashMap<PathBuf, CachedViolationsArc> =
             HashMap::with_capacity(file_count);
         // Expect ~10% cache miss on warm runs. Cold runs will reallocate.
@@ -120,7 +140,7 @@ impl CheckRunner {
 
         for file in files {
             let key = FileCacheKey::from_walked_file(file);
-            if let Some(violations) = cache.lookup(&file.path, &key) {
+            if let Some(violations) = cache.lookup(&file.path, &key, check_set) {
                 // Arc clone is O(1) - just increments refcount
                 cached_violations.insert(file.path.clone(), violations);
             } else {
@@ -204,8 +224,22 @@ impl CheckRunner {
                 if cached_for_check.is_empty() {
                     result
                 } else {
+                    // The merged check passes only if every cached violation is
+                    // a warning; a passing check's own violations already are
+                    let passed = result.passed
+                        && !result.skipped
+                        && cached_for_check.iter().all(|v| v.warning);
+                    let fresh_warnings = result.passed;
                     let mut all_violations = cached_for_check;
-                    all_violations.extend(result.violations);
+                    all_violations.extend(result.violations.into_iter().map(|mut v| {
+                        v.warning |= fresh_warnings;
+                        v
+                    }));
+                    if passed {
+                        for v in &mut all_violations {
+                            v.warning = false;
+                        }
+                    }
 
                     // Sort violations by file path for consistent output
                     all_violations.sort_by(|a, b| {
@@ -215,7 +249,6 @@ impl CheckRunner {
                             .then_with(|| a.line.cmp(&b.line))
                     });
 
-                    let passed = all_violations.is_empty() && !result.skipped;
                     CheckResult {
                         name: result.name,
                         passed,
@@ -255,10 +288,10 @@ impl CheckRunner {
 
                     // Check if this file was in uncached_files (O(1) lookup)
                     if processed_paths.contains(abs_path.as_path()) {
-                        violations_by_file
-                            .entry(abs_path)
-                            .or_default()
-                            .push(CachedViolation::from_violation(violation, &result.name));
+                        let mut cached = CachedViolation::from_violation(violation, &result.name);
+                        // A passing check's violations are warnings
+                        cached.warning |= result.passed;
+                        violations_by_file.entry(abs_path).or_default().push(cached);
                     }
                 }
             }
@@ -266,9 +299,19 @@ impl CheckRunner {
 
         // Insert all processed files into cache (including those with no violations)
         for file in &uncached_files {
+            // Unreadable files aren't cached, so they're retried next run
+            let Ok(content) = std::fs::read(&file.path) else {
+                continue;
+            };
             let key = FileCacheKey::from_walked_file(file);
             let violations = violations_by_file.remove(&file.path).unwrap_or_default();
-            cache.insert(file.path.clone(), key, violations);
+            cache.insert(
+                file.path.clone(),
+                key,
+                hash_content(&content),
+                check_set,
+                violations,
+            );
         }
 
         // Sort results by canonical check order for consistent output
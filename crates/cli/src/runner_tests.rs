@@ -74,10 +74,15 @@ fn runner_executes_all_checks() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     });
     let config = Config::default();
     let files = vec![];
@@ -101,10 +106,15 @@ fn runner_isolates_panicking_check() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     });
     let config = Config::default();
     let files = vec![];
@@ -137,10 +147,15 @@ fn runner_continues_after_check_failure() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     });
     let config = Config::default();
     let files = vec![];
@@ -171,10 +186,15 @@ fn should_terminate_with_limit() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     });
     assert!(!runner.should_terminate(5));
     assert!(runner.should_terminate(10));
@@ -188,10 +208,15 @@ fn should_terminate_without_limit() {
         changed_files: None,
         fix: false,
         dry_run: false,
+        fix_preview: None,
         ci_mode: false,
         base_branch: None,
         staged: false,
         verbose: false,
+        stdin: None,
+        rules: None,
+        progress: None,
+        stream: None,
     });
     assert!(!runner.should_terminate(1000));
 }
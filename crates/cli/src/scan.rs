@@ -0,0 +1,666 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Programmatic scanning API.
+//!
+//! Runs the same configuration, discovery, and checking phases as
+//! `quench check` and returns structured violations, so other tools can
+//! embed quench instead of parsing CLI output. The check command is built
+//! from the phase functions here.
+//!
+//! ```ignore
+//! // Fail only on unsafe.Pointer escapes under internal/ffi
+//! let violations = quench::scan(root, &quench::ScanOptions::default())?;
+//! let blocked = violations.iter().any(|v| {
+//!     v.rule == "unsafe_pointer" && v.file.as_deref().is_some_and(|f| f.starts_with("internal/ffi/"))
+//! });
+//! ```
+//!
+//! A config can be loaded from any file with [`config::load`] or built in
+//! code from [`Config::default`], and passed in [`ScanOptions::config`]:
+//!
+//! ```ignore
+//! let mut config = quench::config::load(Path::new("ci/quench.toml"))?;
+//! config.severity.insert("go_sleep".to_string(), CheckLevel::Off);
+//! let options = quench::ScanOptions { config: Some(config), ..Default::default() };
+//! ```
+//!
+//! Scans share no mutable state: each call loads its own config and builds
+//! its own matchers, module lists, and counters. Services that scan many
+//! repositories concurrently can hold an [`Engine`], which fixes the options
+//! and custom rules once and is safe to call from any number of threads.
+
+use std::collections::HashMap;
+use std::num::NonZeroUsize;
+use std::path::{Component, Path, PathBuf};
+use std::sync::Arc;
+
+use regex::Regex;
+
+use crate::adapter::go::{is_generated_file, matches_build_tags_file};
+use crate::adapter::project::apply_language_defaults;
+use crate::adapter::{ProjectLanguage, language_for_file};
+use crate::category::{self, Category};
+use crate::check::CheckOutput;
+use crate::checks;
+use crate::config::{self, CheckLevel, Config, NestedConfig};
+use crate::discovery;
+use crate::error::{Error, Result};
+use crate::file_size;
+use crate::inline_ignore::InlineIgnores;
+use crate::output::json::create_output;
+use crate::output::violations::{ViolationRecord, collect_records};
+use crate::rules::{self, Rule};
+use crate::runner::{CheckRunner, RunnerConfig};
+use crate::severity;
+use crate::walker::{
+    FileWalker, SkipReason, SkippedFile, WalkStats, WalkedFile, WalkerConfig, listed_files,
+};
+
+/// Options for [`scan`].
+#[derive(Debug, Clone)]
+pub struct ScanOptions {
+    /// Run only these checks (empty = all checks enabled by default).
+    pub enabled_checks: Vec<String>,
+    /// Skip these checks.
+    pub disabled_checks: Vec<String>,
+    /// Maximum violations to collect (None = unlimited).
+    pub limit: Option<usize>,
+    /// Maximum directory depth to traverse.
+    pub max_depth: usize,
+    /// Skip files larger than this many bytes (0 = no limit).
+    pub max_file_size: u64,
+    /// Worker threads for walking and scanning (None = one per CPU).
+    pub jobs: Option<NonZeroUsize>,
+    /// Skip files ignored by `.gitignore`.
+    pub git_ignore: bool,
+    /// Check generated Go files (`// Code generated ... DO NOT EDIT.`).
+    pub include_generated: bool,
+    /// Build tags (e.g., linux, amd64); Go files whose build constraints
+    /// exclude them are skipped (empty = check every file).
+    pub build_tags: Vec<String>,
+    /// Fail on files that can't be parsed instead of reporting them as
+    /// warnings.
+    pub strict_parse: bool,
+    /// Rule categories to turn on, on top of `[categories].enable`.
+    pub enable_categories: Vec<Category>,
+    /// Rule categories to turn off, on top of `[categories].disable`.
+    pub disable_categories: Vec<Category>,
+    /// Project language, overriding detection from marker files.
+    pub language: Option<ProjectLanguage>,
+    /// Check only files of these languages (empty = all files).
+    pub only_languages: Vec<ProjectLanguage>,
+    /// Extra ignore globs; like a configured list, they replace the
+    /// language default ignores unless `keep_default_ignores` is set.
+    pub ignore: Vec<String>,
+    /// Keep the language default ignores alongside configured ones.
+    pub keep_default_ignores: bool,
+    /// Check only these files and directories, relative to the root
+    /// (empty = the whole tree). Go package patterns like `./internal/...`
+    /// are expanded ([`expand_package_patterns`]).
+    pub paths: Vec<PathBuf>,
+    /// Config to scan with instead of the project's quench.toml (None =
+    /// load it from the root). Nested config files aren't read either; set
+    /// [`Config::directories`] for per-directory overrides.
+    pub config: Option<Config>,
+}
+
+impl Default for ScanOptions {
+    fn default() -> Self {
+        Self {
+            enabled_checks: Vec::new(),
+            disabled_checks: Vec::new(),
+            limit: None,
+            max_depth: 100,
+            max_file_size: file_size::MAX_FILE_SIZE,
+            jobs: None,
+            git_ignore: true,
+            include_generated: false,
+            build_tags: Vec::new(),
+            strict_parse: false,
+            enable_categories: Vec::new(),
+            disable_categories: Vec::new(),
+            language: None,
+            only_languages: Vec::new(),
+            ignore: Vec::new(),
+            keep_default_ignores: false,
+            paths: Vec::new(),
+            config: None,
+        }
+    }
+}
+
+/// Scan a project and return its violations, sorted by file then line.
+///
+/// Uses the project's quench.toml (or defaults) like `quench check`, or
+/// [`ScanOptions::config`] when set, and honors `quench:ignore` directives
+/// and `[severity]` overrides. Fast checks only: no cache, no git
+/// comparison, no fixes. Custom rules come from the process-wide registry
+/// ([`rules::register_rule`]).
+pub fn scan(root: &Path, options: &ScanOptions) -> Result<Vec<ViolationRecord>> {
+    scan_with_rules(root, options, None)
+}
+
+/// A reusable scanner: options and custom rules fixed at construction.
+///
+/// An engine is `Send + Sync` and [`Engine::scan`] takes `&self`, so one
+/// engine can scan different roots from several threads at once. Rules are
+/// snapshotted from the registry when the engine is created; later
+/// [`rules::register_rule`] calls don't affect it, and rules added with
+/// [`Engine::with_rule`] apply to this engine only.
+///
+/// ```ignore
+/// let engine = quench::Engine::new(options).with_rule(NoTimeNow);
+/// let results: Vec<_> = std::thread::scope(|s| {
+///     let handles: Vec<_> = repos.iter().map(|r| s.spawn(|| engine.scan(r))).collect();
+///     handles.into_iter().map(|h| h.join()).collect()
+/// });
+/// ```
+#[derive(Clone)]
+pub struct Engine {
+    options: ScanOptions,
+    rules: Vec<Arc<dyn Rule>>,
+}
+
+impl Engine {
+    /// Create an engine with the currently registered custom rules.
+    pub fn new(options: ScanOptions) -> Self {
+        Self {
+            options,
+            rules: rules::registered_rules(),
+        }
+    }
+
+    /// Add a custom rule for this engine's scans.
+    ///
+    /// Replaces a rule of the same name, like [`rules::register_rule`].
+    pub fn with_rule(mut self, rule: impl Rule + 'static) -> Self {
+        let rule: Arc<dyn Rule> = Arc::new(rule);
+        self.rules.retain(|r| r.name() != rule.name());
+        self.rules.push(rule);
+        self
+    }
+
+    /// The options every scan uses.
+    pub fn options(&self) -> &ScanOptions {
+        &self.options
+    }
+
+    /// Scan a project, like [`scan`] with this engine's options and rules.
+    pub fn scan(&self, root: &Path) -> Result<Vec<ViolationRecord>> {
+        scan_with_rules(root, &self.options, Some(self.rules.clone()))
+    }
+}
+
+/// Shared body of [`scan`] and [`Engine::scan`].
+fn scan_with_rules(
+    root: &Path,
+    options: &ScanOptions,
+    custom_rules: Option<Vec<Arc<dyn Rule>>>,
+) -> Result<Vec<ViolationRecord>> {
+    let mut config = match &options.config {
+        Some(config) => config.clone(),
+        None => load_config(root)?.0,
+    };
+    if options.language.is_some() {
+        config.project.language = options.language;
+    }
+    if options.strict_parse {
+        strict_parse(&mut config);
+    }
+    category::apply(
+        &mut config,
+        &options.enable_categories,
+        &options.disable_categories,
+    );
+    config
+        .project
+        .exclude
+        .add_ignores(&options.ignore, options.keep_default_ignores);
+    let mut walker_config = walker_config(root, &mut config, options.max_depth, options.jobs);
+    walker_config.git_ignore = options.git_ignore;
+    walker_config.max_file_size = options.max_file_size;
+    walker_config.scope = expand_package_patterns(root, &options.paths)?
+        .iter()
+        .map(|p| root.join(p))
+        .collect();
+    let (mut files, _) = discover_files(root, walker_config);
+    if !options.include_generated {
+        skip_generated(&mut files);
+    }
+    skip_build_constrained(&mut files, &options.build_tags);
+    only_languages(&mut files, &options.only_languages);
+    if options.config.is_none() {
+        load_directory_configs(root, &files, &mut config)?;
+    }
+    let ignores = InlineIgnores::collect(root, &files);
+
+    let runner = CheckRunner::new(RunnerConfig {
+        // Suppressed and `off` severity violations are filtered after checking
+        limit: if ignores.is_empty() && !severity::filters_violations(&config) {
+            options.limit
+        } else {
+            None
+        },
+        changed_files: None,
+        fix: false,
+        dry_run: false,
+        fix_preview: None,
+        ci_mode: false,
+        base_branch: None,
+        staged: false,
+        verbose: false,
+        stdin: None,
+        rules: custom_rules,
+        progress: None,
+        stream: None,
+    });
+    let mut output = with_jobs(options.jobs, || {
+        run_checks(
+            &runner,
+            root,
+            &config,
+            &files,
+            &options.enabled_checks,
+            &options.disabled_checks,
+        )
+    })?;
+    ignores.apply(&mut output, false);
+    severity::apply(&config, &mut output);
+
+    let mut records = collect_records(&output);
+    if let Some(limit) = options.limit {
+        records.truncate(limit);
+    }
+    Ok(records)
+}
+
+/// Load the config for a project root, or defaults when none is found.
+///
+/// Returns the config and the path it was loaded from.
+pub fn load_config(root: &Path) -> Result<(Config, Option<PathBuf>)> {
+    let config_path = discovery::find_config(root);
+    let config = match &config_path {
+        Some(path) => {
+            tracing::debug!("loading config from {}", path.display());
+            config::load_with_warnings(path)?
+        }
+        None => {
+            tracing::debug!("no config found, using defaults");
+            Config::default()
+        }
+    };
+    Ok((config, config_path))
+}
+
+/// Load the rule overrides of config files in the directories `files` are
+/// in, below `root`, into `config.directories`.
+///
+/// Separate projects' configs, and any overrides inside those projects, are
+/// skipped. Returns how many overrides were loaded.
+pub fn load_directory_configs(
+    root: &Path,
+    files: &[WalkedFile],
+    config: &mut Config,
+) -> Result<usize> {
+    let mut directories = Vec::new();
+    let mut projects: Vec<PathBuf> = Vec::new();
+    // Sorted by path, so a project's config comes before any inside it
+    for path in discovery::find_nested_configs(root, files.iter().map(|f| f.path.as_path())) {
+        let dir = path
+            .parent()
+            .and_then(|dir| dir.strip_prefix(root).ok())
+            .unwrap_or(Path::new(""))
+            .to_path_buf();
+        if projects.iter().any(|project| dir.starts_with(project)) {
+            continue;
+        }
+        match config::load_directory(&path, dir.clone())? {
+            NestedConfig::Directory(directory) => {
+                tracing::debug!("loaded rule overrides from {}", path.display());
+                directories.push(directory);
+            }
+            NestedConfig::Project => {
+                tracing::debug!("skipping separate project config {}", path.display());
+                projects.push(dir);
+            }
+        }
+    }
+    config.directories = directories;
+    Ok(config.directories.len())
+}
+
+/// Raise parse errors to error severity (`--strict-parse`).
+///
+/// Parse errors default to warnings so one broken file doesn't fail the run;
+/// this overrides `[severity]` for them.
+pub fn strict_parse(config: &mut Config) {
+    config
+        .severity
+        .insert(checks::escapes::PARSE_ERROR.to_string(), CheckLevel::Error);
+}
+
+/// Apply detected language defaults to the config and build the walker config.
+pub fn walker_config(
+    root: &Path,
+    config: &mut Config,
+    max_depth: usize,
+    jobs: Option<NonZeroUsize>,
+) -> WalkerConfig {
+    let exclude_patterns = apply_language_defaults(root, config);
+    WalkerConfig {
+        max_depth: Some(max_depth),
+        exclude_patterns,
+        threads: jobs.map_or(0, NonZeroUsize::get),
+        ..Default::default()
+    }
+}
+
+/// Resolve path arguments into the scan root and the paths to check.
+///
+/// Paths are relative to `cwd`. The root is `project_root` when every path
+/// is inside it, so reported paths are the same wherever quench was run from;
+/// otherwise it is the paths' nearest common directory. The returned scope
+/// holds the absolute paths to walk ([`WalkerConfig::scope`]), and is empty
+/// when the whole root is checked.
+pub fn resolve_paths(
+    cwd: &Path,
+    project_root: &Path,
+    paths: &[PathBuf],
+) -> (PathBuf, Vec<PathBuf>) {
+    let project_root = normalize_path(&cwd.join(project_root));
+    let paths: Vec<PathBuf> = paths.iter().map(|p| normalize_path(&cwd.join(p))).collect();
+    let Some(first) = paths.first() else {
+        return (project_root, Vec::new());
+    };
+
+    let root = if paths.iter().all(|p| p.starts_with(&project_root)) {
+        project_root
+    } else {
+        let mut common = first.clone();
+        for path in &paths[1..] {
+            while !path.starts_with(&common) && common.pop() {}
+        }
+        if common.is_file() {
+            common.pop();
+        }
+        common
+    };
+    let scope = if paths.contains(&root) {
+        Vec::new()
+    } else {
+        paths
+    };
+    (root, scope)
+}
+
+/// Resolve `.` and `..` components without touching the filesystem.
+fn normalize_path(path: &Path) -> PathBuf {
+    let mut normalized = PathBuf::new();
+    for component in path.components() {
+        match component {
+            Component::CurDir => {}
+            Component::ParentDir => {
+                normalized.pop();
+            }
+            other => normalized.push(other),
+        }
+    }
+    normalized
+}
+
+/// Expand Go package patterns among path arguments into the Go files of the
+/// packages they match.
+///
+/// A path containing `...` is a pattern, as with `go build`: `...` matches
+/// any string, so `./...` matches every package under `cwd` and
+/// `./internal/...` `internal` and the packages under it. A `...` between
+/// slashes may also match nothing, so `x/.../y` matches `x/y`. A package is
+/// a directory with `.go` files, and stands for those files alone, not its
+/// subdirectories or other files. As with go, `testdata` and `vendor`
+/// directories, names starting with `.` or `_`, and nested modules are
+/// skipped. Other paths are kept as given. A pattern that matches no
+/// packages is an argument error.
+pub fn expand_package_patterns(cwd: &Path, paths: &[PathBuf]) -> Result<Vec<PathBuf>> {
+    // Packages under each pattern base, walked once for all patterns
+    let mut packages: HashMap<PathBuf, Vec<Package>> = HashMap::new();
+    let mut expanded = Vec::new();
+    for path in paths {
+        let Some(pattern) = path.to_str().filter(|p| p.contains("...")) else {
+            expanded.push(path.clone());
+            continue;
+        };
+        let files = package_files(cwd, pattern, &mut packages);
+        if files.is_empty() {
+            return Err(Error::Argument(format!(
+                "pattern {} matched no packages",
+                pattern
+            )));
+        }
+        expanded.extend(files);
+    }
+    Ok(expanded)
+}
+
+/// A Go package: its directory relative to a pattern base, and its Go files.
+struct Package {
+    relative: String,
+    files: Vec<PathBuf>,
+}
+
+/// Go files of the packages a `...` pattern matches, sorted by path.
+///
+/// `packages` caches the packages found under each base directory.
+fn package_files(
+    cwd: &Path,
+    pattern: &str,
+    packages: &mut HashMap<PathBuf, Vec<Package>>,
+) -> Vec<PathBuf> {
+    // Walk from the directories before the first wildcard, matching the rest
+    let parts: Vec<&str> = pattern.split('/').collect();
+    let split = parts
+        .iter()
+        .position(|part| part.contains("..."))
+        .unwrap_or(parts.len());
+    let base = normalize_path(&cwd.join(parts[..split].join("/")));
+    let Ok(matcher) = Regex::new(&package_regex(&parts[split..].join("/"))) else {
+        return Vec::new();
+    };
+
+    let packages = packages.entry(base).or_insert_with_key(|base| {
+        let mut found = Vec::new();
+        collect_packages(base, "", &mut found);
+        found
+    });
+    let mut files: Vec<PathBuf> = packages
+        .iter()
+        .filter(|package| matcher.is_match(&package.relative))
+        .flat_map(|package| package.files.iter().cloned())
+        .collect();
+    files.sort();
+    files
+}
+
+/// Regex for the wildcard part of a package pattern, matched against
+/// package paths relative to the pattern's base.
+fn package_regex(wildcard: &str) -> String {
+    let mut re = regex::escape(wildcard);
+    // A trailing `/...` also matches the directory itself: `x/...` matches `x`
+    if let Some(stem) = re.strip_suffix(r"/\.\.\.") {
+        re = format!("{}(?:/.*)?", stem);
+    }
+    // A `...` element may match no directories: `x/.../y` matches `x/y`
+    if let Some(rest) = re.strip_prefix(r"\.\.\./") {
+        re = format!("(?:.*/)?{}", rest);
+    }
+    let re = re.replace(r"/\.\.\./", "/(?:.*/)?");
+    format!("^{}$", re.replace(r"\.\.\.", ".*"))
+}
+
+/// Add `dir` and the packages below it, with paths relative to the
+/// pattern's base.
+fn collect_packages(dir: &Path, relative: &str, packages: &mut Vec<Package>) {
+    let Ok(entries) = std::fs::read_dir(dir) else {
+        return;
+    };
+    let mut go_files = Vec::new();
+    let mut subdirs = Vec::new();
+    for entry in entries.flatten() {
+        let name = entry.file_name().to_string_lossy().into_owned();
+        if name.starts_with('.') || name.starts_with('_') {
+            continue;
+        }
+        let Ok(file_type) = entry.file_type() else {
+            continue;
+        };
+        if file_type.is_dir() {
+            if name != "testdata" && name != "vendor" {
+                subdirs.push(name);
+            }
+        } else if file_type.is_file() && name.ends_with(".go") {
+            go_files.push(entry.path());
+        }
+    }
+    if !go_files.is_empty() {
+        packages.push(Package {
+            relative: relative.to_string(),
+            files: go_files,
+        });
+    }
+
+    for name in subdirs {
+        let subdir = dir.join(&name);
+        // A nested module holds its own packages
+        if subdir.join("go.mod").is_file() {
+            continue;
+        }
+        let relative = if relative.is_empty() {
+            name
+        } else {
+            format!("{}/{}", relative, name)
+        };
+        collect_packages(&subdir, &relative, packages);
+    }
+}
+
+/// Walk the project and collect all files, sorted by path.
+///
+/// The parallel walker yields files in completion order; sorting gives
+/// checks a stable order so output doesn't vary between runs.
+pub fn discover_files(root: &Path, walker_config: WalkerConfig) -> (Vec<WalkedFile>, WalkStats) {
+    let walker = FileWalker::new(walker_config);
+    let (rx, handle) = walker.walk(root);
+    let mut files: Vec<_> = rx.iter().collect();
+    let stats = handle.join();
+    files.sort_unstable_by(|a, b| a.path.cmp(&b.path));
+    (files, stats)
+}
+
+/// Collect the listed files in `walker_config.scope`, sorted by path.
+///
+/// With `honor_ignores`, the listed files are walked to, so `.gitignore` and
+/// exclude patterns skip them as in a full walk, but no other subtree is
+/// read. Without it every listed file is checked. An empty list yields no
+/// files, rather than the whole root an empty scope walks.
+pub fn discover_listed(
+    root: &Path,
+    walker_config: WalkerConfig,
+    honor_ignores: bool,
+) -> (Vec<WalkedFile>, WalkStats) {
+    if walker_config.scope.is_empty() {
+        return (Vec::new(), WalkStats::default());
+    }
+    if honor_ignores {
+        return discover_files(root, walker_config);
+    }
+    let (mut files, stats) = listed_files(root, &walker_config.scope, walker_config.max_file_size);
+    files.sort_unstable_by(|a, b| a.path.cmp(&b.path));
+    files.dedup_by(|a, b| a.path == b.path);
+    (files, stats)
+}
+
+/// Drop generated Go files from the file list.
+///
+/// Returns the dropped files.
+pub fn skip_generated(files: &mut Vec<WalkedFile>) -> Vec<SkippedFile> {
+    drop_files(files, SkipReason::Generated, |file| {
+        is_generated_file(&file.path)
+    })
+}
+
+/// Drop Go files whose build constraints exclude `tags`, like `go build`.
+///
+/// An empty list keeps every file. Returns the dropped files.
+pub fn skip_build_constrained(files: &mut Vec<WalkedFile>, tags: &[String]) -> Vec<SkippedFile> {
+    if tags.is_empty() {
+        return Vec::new();
+    }
+    drop_files(files, SkipReason::BuildConstraints, |file| {
+        !matches_build_tags_file(&file.path, tags)
+    })
+}
+
+/// Drop the files `skip` selects, returning them with `reason`.
+fn drop_files(
+    files: &mut Vec<WalkedFile>,
+    reason: SkipReason,
+    skip: impl Fn(&WalkedFile) -> bool,
+) -> Vec<SkippedFile> {
+    let mut dropped = Vec::new();
+    files.retain(|file| {
+        if !skip(file) {
+            return true;
+        }
+        dropped.push(SkippedFile {
+            path: file.path.clone(),
+            reason: reason.clone(),
+        });
+        false
+    });
+    dropped
+}
+
+/// Keep only files whose adapter language is one of `languages`.
+///
+/// Files with no adapter (unknown extensions) are dropped too. An empty
+/// list keeps every file. Returns how many were dropped.
+pub fn only_languages(files: &mut Vec<WalkedFile>, languages: &[ProjectLanguage]) -> usize {
+    if languages.is_empty() {
+        return 0;
+    }
+    let before = files.len();
+    files.retain(|file| language_for_file(&file.path).is_some_and(|l| languages.contains(&l)));
+    before - files.len()
+}
+
+/// Run `f` on a pool of `jobs` worker threads.
+///
+/// With None, rayon's global pool (one thread per CPU) is used.
+pub fn with_jobs<R: Send>(jobs: Option<NonZeroUsize>, f: impl FnOnce() -> R + Send) -> Result<R> {
+    let Some(jobs) = jobs else {
+        return Ok(f());
+    };
+    let pool = rayon::ThreadPoolBuilder::new()
+        .num_threads(jobs.get())
+        .build()
+        .map_err(|e| Error::Internal(format!("failed to start worker pool: {}", e)))?;
+    Ok(pool.install(f))
+}
+
+/// Run the selected checks over discovered files.
+pub fn run_checks(
+    runner: &CheckRunner,
+    root: &Path,
+    config: &Config,
+    files: &[WalkedFile],
+    enabled_checks: &[String],
+    disabled_checks: &[String],
+) -> CheckOutput {
+    let checks_list = checks::filter_checks(enabled_checks, disabled_checks);
+    let check_results = runner.run(checks_list, files, config, root);
+    create_output(check_results)
+}
+
+#[cfg(test)]
+#[path = "scan_tests.rs"]
+mod tests;
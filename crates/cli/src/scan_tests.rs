@@ -0,0 +1,688 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::output::violations::Severity;
+use crate::rules::{RuleViolation, SourceFile};
+use crate::test_utils::{create_tree, temp_project_with_config};
+
+const GO_CONFIG: &str = "version = 1\n\n[check.agents]\nrequired = []\n";
+
+fn go_project(main: &str) -> tempfile::TempDir {
+    let dir = temp_project_with_config(GO_CONFIG);
+    create_tree(
+        dir.path(),
+        &[
+            ("go.mod", "module example.com/p\n\ngo 1.21\n"),
+            ("main.go", main),
+        ],
+    );
+    dir
+}
+
+fn escapes_only() -> ScanOptions {
+    ScanOptions {
+        enabled_checks: vec!["escapes".to_string()],
+        ..Default::default()
+    }
+}
+
+#[test]
+fn scan_returns_structured_violations() {
+    let dir = go_project("package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n");
+
+    let violations = scan(dir.path(), &escapes_only()).unwrap();
+
+    assert_eq!(violations.len(), 1);
+    let v = &violations[0];
+    assert_eq!(v.file.as_deref(), Some("main.go"));
+    assert_eq!(v.line, Some(5));
+    assert_eq!(v.column, Some(9));
+    assert_eq!(v.rule, "unsafe_pointer");
+    assert_eq!(v.check, "escapes");
+    assert!(v.message.contains("// SAFETY:"));
+}
+
+#[test]
+fn scan_limit_waits_for_rules_that_drop_violations() {
+    let dir = temp_project_with_config(&format!(
+        "{GO_CONFIG}\n[rules.unsafe_pointer]\nseverity = \"off\"\n"
+    ));
+    create_tree(
+        dir.path(),
+        &[
+            ("go.mod", "module example.com/p\n\ngo 1.21\n"),
+            (
+                "a.go",
+                "package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+            ),
+            (
+                "main.go",
+                "package main\n\n//go:linkname nanotime runtime.nanotime\nfunc nanotime() int64\n",
+            ),
+        ],
+    );
+    let options = ScanOptions {
+        limit: Some(1),
+        ..escapes_only()
+    };
+
+    let violations = scan(dir.path(), &options).unwrap();
+    let rules: Vec<_> = violations.iter().map(|v| v.rule.as_str()).collect();
+    assert_eq!(rules, vec!["go_linkname"]);
+}
+
+#[test]
+fn scan_clean_project_returns_no_violations() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    assert!(scan(dir.path(), &escapes_only()).unwrap().is_empty());
+}
+
+#[test]
+fn scan_reports_parse_errors_and_continues() {
+    let dir = go_project("package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n");
+    create_tree(
+        dir.path(),
+        &[("broken.go", "package main\n\nvar s = \"open\n")],
+    );
+
+    let violations = scan(dir.path(), &escapes_only()).unwrap();
+    let rules: Vec<_> = violations
+        .iter()
+        .map(|v| (v.file.as_deref(), v.rule.as_str(), v.severity))
+        .collect();
+    assert_eq!(
+        rules,
+        vec![
+            (Some("broken.go"), "parse_error", Severity::Warning),
+            (Some("main.go"), "unsafe_pointer", Severity::Error),
+        ]
+    );
+
+    let strict = ScanOptions {
+        strict_parse: true,
+        ..escapes_only()
+    };
+    let violations = scan(dir.path(), &strict).unwrap();
+    assert_eq!(violations[0].rule, "parse_error");
+    assert_eq!(violations[0].severity, Severity::Error);
+}
+
+#[test]
+fn scan_respects_disabled_checks() {
+    let dir = go_project("package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n");
+    let options = ScanOptions {
+        disabled_checks: vec!["escapes".to_string()],
+        ..Default::default()
+    };
+
+    let violations = scan(dir.path(), &options).unwrap();
+    assert!(violations.iter().all(|v| v.check != "escapes"));
+}
+
+#[test]
+fn scan_honors_inline_ignores() {
+    let dir = go_project(
+        "package main\n\nimport \"unsafe\"\n\n// quench:ignore unsafe-pointer reason: audited\nvar p = unsafe.Pointer(nil)\n",
+    );
+    assert!(scan(dir.path(), &escapes_only()).unwrap().is_empty());
+}
+
+#[test]
+fn scan_skips_generated_files_unless_included() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    create_tree(
+        dir.path(),
+        &[(
+            "api.pb.go",
+            "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+        )],
+    );
+    assert!(scan(dir.path(), &escapes_only()).unwrap().is_empty());
+
+    let options = ScanOptions {
+        include_generated: true,
+        ..escapes_only()
+    };
+    let violations = scan(dir.path(), &options).unwrap();
+    assert_eq!(violations.len(), 1);
+    assert_eq!(violations[0].file.as_deref(), Some("api.pb.go"));
+}
+
+#[test]
+fn scan_skips_files_excluded_by_build_tags() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    let unsafe_go = "package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n";
+    let windows = format!("//go:build windows\n\n{}", unsafe_go);
+    create_tree(
+        dir.path(),
+        &[
+            ("winapi.go", windows.as_str()),
+            ("pipe_windows.go", unsafe_go),
+        ],
+    );
+    assert_eq!(scan(dir.path(), &escapes_only()).unwrap().len(), 2);
+
+    let options = ScanOptions {
+        build_tags: vec!["linux".to_string(), "amd64".to_string()],
+        ..escapes_only()
+    };
+    assert!(scan(dir.path(), &options).unwrap().is_empty());
+}
+
+#[test]
+fn scan_ignore_replaces_default_ignores_unless_kept() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    let unsafe_go = "package p\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n";
+    create_tree(
+        dir.path(),
+        &[
+            ("api/service.pb.go", unsafe_go),
+            ("vendor/dep/dep.go", unsafe_go),
+        ],
+    );
+
+    let files = |options: &ScanOptions| -> Vec<String> {
+        let violations = scan(dir.path(), options).unwrap();
+        violations.into_iter().filter_map(|v| v.file).collect()
+    };
+    assert_eq!(files(&escapes_only()), vec!["api/service.pb.go"]);
+
+    let ignore = ScanOptions {
+        ignore: vec!["**/*.pb.go".to_string()],
+        ..escapes_only()
+    };
+    assert_eq!(files(&ignore), vec!["vendor/dep/dep.go"]);
+
+    let keep = ScanOptions {
+        keep_default_ignores: true,
+        ..ignore
+    };
+    assert!(files(&keep).is_empty());
+}
+
+#[test]
+fn scan_paths_check_only_listed_subtrees() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    let unsafe_go = "package p\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n";
+    create_tree(
+        dir.path(),
+        &[
+            ("internal/store/ptr.go", unsafe_go),
+            ("internal/wire/ptr.go", unsafe_go),
+            ("cmd/tool/ptr.go", unsafe_go),
+        ],
+    );
+    let options = ScanOptions {
+        paths: vec![
+            PathBuf::from("internal/store"),
+            PathBuf::from("internal/wire"),
+            PathBuf::from("internal/wire/ptr.go"),
+        ],
+        ..escapes_only()
+    };
+
+    let files: Vec<_> = scan(dir.path(), &options)
+        .unwrap()
+        .into_iter()
+        .filter_map(|v| v.file)
+        .collect();
+    assert_eq!(files, vec!["internal/store/ptr.go", "internal/wire/ptr.go"]);
+}
+
+#[test]
+fn resolve_paths_keeps_cwd_as_root() {
+    let cwd = Path::new("/work/repo");
+
+    assert_eq!(resolve_paths(cwd, cwd, &[]), (cwd.to_path_buf(), vec![]));
+    assert_eq!(
+        resolve_paths(
+            cwd,
+            cwd,
+            &[PathBuf::from("./pkg"), PathBuf::from("cmd/../internal")]
+        ),
+        (
+            cwd.to_path_buf(),
+            vec![
+                PathBuf::from("/work/repo/pkg"),
+                PathBuf::from("/work/repo/internal")
+            ]
+        )
+    );
+    // A path naming the root checks everything
+    assert_eq!(
+        resolve_paths(cwd, cwd, &[PathBuf::from("pkg"), PathBuf::from(".")]),
+        (cwd.to_path_buf(), vec![])
+    );
+}
+
+#[test]
+fn resolve_paths_from_subdirectory_uses_project_root() {
+    let cwd = Path::new("/work/repo/internal/store");
+    let root = Path::new("/work/repo");
+
+    // Without paths the whole project is checked
+    assert_eq!(resolve_paths(cwd, root, &[]), (root.to_path_buf(), vec![]));
+    assert_eq!(
+        resolve_paths(cwd, root, &[PathBuf::from("."), PathBuf::from("../wire")]),
+        (
+            root.to_path_buf(),
+            vec![
+                PathBuf::from("/work/repo/internal/store"),
+                PathBuf::from("/work/repo/internal/wire")
+            ]
+        )
+    );
+    // A relative project root is resolved against cwd
+    assert_eq!(
+        resolve_paths(cwd, Path::new("../.."), &[]),
+        (root.to_path_buf(), vec![])
+    );
+}
+
+#[test]
+fn resolve_paths_outside_cwd_uses_common_ancestor() {
+    let cwd = Path::new("/work/repo");
+
+    assert_eq!(
+        resolve_paths(cwd, cwd, &[PathBuf::from("/work/other")]),
+        (PathBuf::from("/work/other"), vec![])
+    );
+    assert_eq!(
+        resolve_paths(
+            cwd,
+            cwd,
+            &[PathBuf::from("../a/x"), PathBuf::from("../a/y/z")]
+        ),
+        (
+            PathBuf::from("/work/a"),
+            vec![PathBuf::from("/work/a/x"), PathBuf::from("/work/a/y/z")]
+        )
+    );
+}
+
+/// Expanded package files, relative to `root`.
+fn expanded(root: &Path, paths: &[&str]) -> Vec<String> {
+    let paths: Vec<PathBuf> = paths.iter().map(PathBuf::from).collect();
+    expand_package_patterns(root, &paths)
+        .unwrap()
+        .iter()
+        .map(|p| p.strip_prefix(root).unwrap_or(p).display().to_string())
+        .collect()
+}
+
+#[test]
+fn package_patterns_expand_to_package_files() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    create_tree(
+        dir.path(),
+        &[
+            ("internal/core/core.go", "package core\n"),
+            ("internal/core/core_test.go", "package core\n"),
+            ("internal/core/README.md", "# core\n"),
+            ("internal/core/wire/wire.go", "package wire\n"),
+            ("internal/core/testdata/input.go", "package input\n"),
+            ("internal/_old/old.go", "package old\n"),
+            ("vendor/dep/dep.go", "package dep\n"),
+            ("tools/go.mod", "module example.com/tools\n"),
+            ("tools/gen.go", "package tools\n"),
+        ],
+    );
+
+    assert_eq!(
+        expanded(dir.path(), &["./..."]),
+        vec![
+            "internal/core/core.go",
+            "internal/core/core_test.go",
+            "internal/core/wire/wire.go",
+            "main.go",
+        ]
+    );
+    assert_eq!(
+        expanded(dir.path(), &["./internal/core/..."]),
+        vec![
+            "internal/core/core.go",
+            "internal/core/core_test.go",
+            "internal/core/wire/wire.go",
+        ]
+    );
+    assert_eq!(
+        expanded(dir.path(), &["./.../wire"]),
+        vec!["internal/core/wire/wire.go"]
+    );
+    // Paths without a wildcard are kept as given
+    assert_eq!(
+        expanded(dir.path(), &["internal/core", "./internal/..."]),
+        vec![
+            "internal/core",
+            "internal/core/core.go",
+            "internal/core/core_test.go",
+            "internal/core/wire/wire.go",
+        ]
+    );
+}
+
+#[test]
+fn package_pattern_wildcard_element_matches_no_directories() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    create_tree(
+        dir.path(),
+        &[
+            ("internal/core/core.go", "package core\n"),
+            ("internal/x/core/core.go", "package core\n"),
+            ("core/core.go", "package core\n"),
+        ],
+    );
+
+    assert_eq!(
+        expanded(dir.path(), &["./internal/.../core"]),
+        vec!["internal/core/core.go", "internal/x/core/core.go"]
+    );
+    assert_eq!(
+        expanded(dir.path(), &["./.../core"]),
+        vec![
+            "core/core.go",
+            "internal/core/core.go",
+            "internal/x/core/core.go",
+        ]
+    );
+}
+
+#[test]
+fn package_pattern_matching_nothing_is_error() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    let err = expand_package_patterns(dir.path(), &[PathBuf::from("./cmd/...")]).unwrap_err();
+    assert!(
+        err.to_string()
+            .contains("pattern ./cmd/... matched no packages")
+    );
+}
+
+#[test]
+fn scan_invalid_config_is_error() {
+    let dir = temp_project_with_config("version = \"not a number\"\n");
+    assert!(scan(dir.path(), &ScanOptions::default()).is_err());
+}
+
+#[test]
+fn scan_results_identical_across_job_counts() {
+    let dir = go_project("package main\n\nfunc main() {}\n");
+    for i in 0..50 {
+        create_tree(
+            dir.path(),
+            &[(
+                format!("pkg{:02}/unsafe.go", i).as_str(),
+                "package pkg\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+            )],
+        );
+    }
+
+    let single = ScanOptions {
+        jobs: NonZeroUsize::new(1),
+        ..escapes_only()
+    };
+    let many = ScanOptions {
+        jobs: NonZeroUsize::new(8),
+        ..escapes_only()
+    };
+
+    let expected = scan(dir.path(), &single).unwrap();
+    assert_eq!(expected.len(), 50);
+    assert_eq!(scan(dir.path(), &many).unwrap(), expected);
+}
+
+/// Custom rule flagging a call the embedding service bans.
+struct BannedCall(&'static str);
+
+impl Rule for BannedCall {
+    fn name(&self) -> &str {
+        "banned_call"
+    }
+
+    fn check(&self, file: &SourceFile) -> Vec<RuleViolation> {
+        file.find(self.0, "Banned by the review bot.")
+    }
+}
+
+#[test]
+fn engine_rules_apply_only_to_that_engine() {
+    let dir = go_project("package main\n\nfunc main() { os.Exit(1) }\n");
+
+    let engine = Engine::new(escapes_only()).with_rule(BannedCall("os.Exit("));
+    let violations = engine.scan(dir.path()).unwrap();
+    assert_eq!(violations.len(), 1);
+    assert_eq!(violations[0].rule, "banned_call");
+    assert_eq!(violations[0].column, Some(15));
+
+    assert!(
+        Engine::new(escapes_only())
+            .scan(dir.path())
+            .unwrap()
+            .is_empty()
+    );
+    assert!(scan(dir.path(), &escapes_only()).unwrap().is_empty());
+    assert!(
+        rules::registered_rules()
+            .iter()
+            .all(|r| r.name() != "banned_call")
+    );
+}
+
+#[test]
+fn engine_is_send_and_sync() {
+    fn assert_send_sync<T: Send + Sync>() {}
+    assert_send_sync::<Engine>();
+}
+
+#[test]
+fn concurrent_scans_match_sequential_results() {
+    // Trees differ in size and findings so crosstalk between scans shows up
+    let dirs: Vec<_> = (1..=4)
+        .map(|n| {
+            let dir = go_project("package main\n\nfunc main() { os.Exit(1) }\n");
+            for i in 0..n * 5 {
+                create_tree(
+                    dir.path(),
+                    &[(
+                        format!("pkg{:02}/unsafe.go", i).as_str(),
+                        "package pkg\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+                    )],
+                );
+            }
+            dir
+        })
+        .collect();
+    let engine = Engine::new(ScanOptions {
+        jobs: NonZeroUsize::new(2),
+        ..escapes_only()
+    })
+    .with_rule(BannedCall("os.Exit("));
+
+    let expected: Vec<_> = dirs
+        .iter()
+        .map(|d| engine.scan(d.path()).unwrap())
+        .collect();
+    for (n, violations) in expected.iter().enumerate() {
+        assert_eq!(violations.len(), (n + 1) * 5 + 1);
+    }
+
+    std::thread::scope(|s| {
+        let handles: Vec<_> = (0..3)
+            .flat_map(|_| dirs.iter().enumerate())
+            .map(|(n, dir)| {
+                let engine = &engine;
+                s.spawn(move || (n, engine.scan(dir.path()).unwrap()))
+            })
+            .collect();
+        for handle in handles {
+            let (n, violations) = handle.join().unwrap();
+            assert_eq!(violations, expected[n]);
+        }
+    });
+}
+
+#[test]
+fn discover_files_sorted_by_path() {
+    let dir = go_project("package main\n");
+    create_tree(
+        dir.path(),
+        &[
+            ("z.go", "package main\n"),
+            ("a/b.go", "package a\n"),
+            ("m.go", "package main\n"),
+        ],
+    );
+
+    let config = WalkerConfig {
+        force_parallel: true,
+        ..Default::default()
+    };
+    let (files, _) = discover_files(dir.path(), config);
+    let paths: Vec<_> = files.iter().map(|f| f.path.clone()).collect();
+    let mut sorted = paths.clone();
+    sorted.sort();
+    assert_eq!(paths, sorted);
+}
+
+#[test]
+fn discover_listed_honors_ignores_unless_told_not_to() {
+    let dir = go_project("package main\n");
+    create_tree(
+        dir.path(),
+        &[
+            (".gitignore", "gen/\n"),
+            ("gen/out.go", "package gen\n"),
+            ("pkg/a.go", "package pkg\n"),
+            ("pkg/b.go", "package pkg\n"),
+        ],
+    );
+    let config = WalkerConfig {
+        scope: vec![dir.path().join("pkg/a.go"), dir.path().join("gen/out.go")],
+        ..Default::default()
+    };
+    let relative = |files: Vec<WalkedFile>| -> Vec<PathBuf> {
+        files
+            .iter()
+            .map(|f| f.path.strip_prefix(dir.path()).unwrap().to_path_buf())
+            .collect()
+    };
+
+    let (files, _) = discover_listed(dir.path(), config.clone(), true);
+    assert_eq!(relative(files), vec![PathBuf::from("pkg/a.go")]);
+
+    let (files, _) = discover_listed(dir.path(), config, false);
+    assert_eq!(
+        relative(files),
+        vec![PathBuf::from("gen/out.go"), PathBuf::from("pkg/a.go")]
+    );
+
+    let (files, _) = discover_listed(dir.path(), WalkerConfig::default(), true);
+    assert!(files.is_empty());
+}
+
+fn mixed_project() -> tempfile::TempDir {
+    let dir = go_project("package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n");
+    create_tree(
+        dir.path(),
+        &[
+            ("web/app.ts", "const f = new Function(src);\n"),
+            ("tools/gen.py", "breakpoint()\n"),
+            (
+                "notes.xyz",
+                "unsafe.Pointer(nil)\nnew Function(src)\nbreakpoint()\n",
+            ),
+        ],
+    );
+    dir
+}
+
+fn rules_by_file(violations: &[ViolationRecord]) -> Vec<(String, String)> {
+    let mut rules: Vec<_> = violations
+        .iter()
+        .map(|v| (v.file.clone().unwrap_or_default(), v.rule.clone()))
+        .collect();
+    rules.sort();
+    rules
+}
+
+#[test]
+fn scan_dispatches_each_file_to_its_language_adapter() {
+    let dir = mixed_project();
+
+    let violations = scan(dir.path(), &escapes_only()).unwrap();
+    assert_eq!(
+        rules_by_file(&violations),
+        vec![
+            ("main.go".to_string(), "unsafe_pointer".to_string()),
+            ("tools/gen.py".to_string(), "breakpoint".to_string()),
+            ("web/app.ts".to_string(), "new_function".to_string()),
+        ]
+    );
+}
+
+#[test]
+fn scan_only_languages_filters_files() {
+    let dir = mixed_project();
+    let options = ScanOptions {
+        only_languages: vec![ProjectLanguage::JavaScript, ProjectLanguage::Python],
+        ..escapes_only()
+    };
+
+    let violations = scan(dir.path(), &options).unwrap();
+    assert_eq!(
+        rules_by_file(&violations),
+        vec![
+            ("tools/gen.py".to_string(), "breakpoint".to_string()),
+            ("web/app.ts".to_string(), "new_function".to_string()),
+        ]
+    );
+}
+
+#[test]
+fn only_languages_drops_files_without_adapter() {
+    let mut files: Vec<WalkedFile> = ["main.go", "app.ts", "notes.xyz"]
+        .iter()
+        .map(|path| WalkedFile {
+            path: PathBuf::from(path),
+            size: 0,
+            mtime_secs: 0,
+            mtime_nanos: 0,
+            depth: 1,
+            size_class: crate::file_size::FileSizeClass::Small,
+        })
+        .collect();
+
+    assert_eq!(only_languages(&mut files, &[]), 0);
+    assert_eq!(files.len(), 3);
+    assert_eq!(only_languages(&mut files, &[ProjectLanguage::Go]), 2);
+    assert_eq!(files[0].path, PathBuf::from("main.go"));
+}
+
+#[test]
+fn scan_uses_config_built_in_code() {
+    let dir = mixed_project();
+    // The project's own config isn't read
+    std::fs::write(
+        dir.path().join("quench.toml"),
+        "version = 1\n\n[severity]\nbreakpoint = \"off\"\n",
+    )
+    .unwrap();
+
+    let mut config = Config::default();
+    config
+        .severity
+        .insert("unsafe_pointer".to_string(), CheckLevel::Off);
+    let options = ScanOptions {
+        config: Some(config),
+        ..escapes_only()
+    };
+
+    let violations = scan(dir.path(), &options).unwrap();
+    assert_eq!(
+        rules_by_file(&violations),
+        vec![
+            ("tools/gen.py".to_string(), "breakpoint".to_string()),
+            ("web/app.ts".to_string(), "new_function".to_string()),
+        ]
+    );
+}
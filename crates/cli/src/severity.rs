@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Per-rule severity (`[severity]`, `[rules.<rule>]`).
+//!
+//! Overrides the level of individual rules within their check, so a new rule
+//! can be rolled out as a warning before it fails builds:
+//!
+//! ```toml
+//! [severity]
+//! subprocess_shell = "warn"
+//!
+//! [rules.noescape]
+//! severity = "warning"
+//! ```
+//!
+//! Some built-in rules default to a different level than their check (see
+//! [`RULE_DEFAULTS`]); config overrides those too. A check fails when any of
+//! its violations has error severity. Downgraded violations in a failing
+//! check are flagged as warnings, and `off` drops them. Rules without a
+//! default or override keep their check's level. A quench.toml or
+//! .quench.yml in a subdirectory overrides levels for the files under it
+//! (see [`RuleLevels`]). `include` and `exclude` limit a rule to some paths
+//! (see [`RulePaths`]).
+
+use std::collections::HashMap;
+use std::path::{Path, PathBuf};
+
+use globset::GlobSet;
+
+use crate::adapter::glob::build_glob_set;
+use crate::category::rules_in;
+use crate::check::{CheckOutput, Violation};
+use crate::config::{CheckLevel, Config, RuleConfig};
+use crate::output::violations::{normalize_rule, rule_id, stable_rule_id};
+
+/// Built-in rules whose default severity differs from their check's level.
+///
+/// `//go:noescape` only changes escape analysis for an assembly function, so
+/// a missing justification is reported without failing the build.
+/// `context.TODO()` marks a context still to be plumbed through, so it warns
+/// where `context.Background()` fails. A large struct returned by value is
+/// a performance hint, not a bug, as is `fmt.Sprintf` used to concatenate
+/// or a capitalized error string.
+/// A slice returned in map iteration order, a map accessed without its
+/// mutex, and an append that aliases a slice used later are found by
+/// heuristics, so they warn too. A context key of a built-in type only
+/// collides when another package picks the same key, so it warns as well,
+/// and a defer in a loop is often a deliberate hold until the function ends.
+/// A file that doesn't parse is reported and skipped so the rest of the run still
+/// counts; `--strict-parse` makes it an error.
+pub const RULE_DEFAULTS: &[(&str, CheckLevel)] = &[
+    ("go_noescape", CheckLevel::Warn),
+    ("context_todo", CheckLevel::Warn),
+    ("large_struct_return", CheckLevel::Warn),
+    ("sprintf_concat", CheckLevel::Warn),
+    ("map_order", CheckLevel::Warn),
+    ("unlocked_map", CheckLevel::Warn),
+    ("error_string", CheckLevel::Warn),
+    ("aliased_append", CheckLevel::Warn),
+    ("context_key", CheckLevel::Warn),
+    ("defer_loop", CheckLevel::Warn),
+    ("parse_error", CheckLevel::Warn),
+];
+
+/// A rule's built-in level from [`RULE_DEFAULTS`], or error for rules
+/// not listed there.
+pub fn default_level(rule: &str) -> CheckLevel {
+    RULE_DEFAULTS
+        .iter()
+        .find(|(id, _)| *id == rule)
+        .map_or(CheckLevel::Error, |(_, level)| *level)
+}
+
+/// Whether a configured rule key names a rule id.
+///
+/// Go directive rules can be named without their `go_` prefix, so
+/// `noescape` matches `go_noescape`, and any rule by its stable id
+/// (`unsafe-pointer`, `import` for `__import__`).
+pub fn rule_matches(key: &str, rule: &str) -> bool {
+    let key = normalize_rule(key);
+    let rule = normalize_rule(rule);
+    key == rule
+        || rule.strip_prefix("go_") == Some(key.as_str())
+        || key == normalize_rule(&stable_rule_id(&rule))
+}
+
+/// Rules with an `apply_to_tests` setting, keyed like `[rules]`.
+///
+/// Sorted by key, so a rule matched by two keys (`noescape` and
+/// `go_noescape`) resolves the same way on every run.
+pub fn test_scopes(config: &Config) -> Vec<(String, bool)> {
+    let mut scopes: Vec<(String, bool)> = config
+        .rules
+        .iter()
+        .filter_map(|(key, rule)| rule.apply_to_tests.map(|applies| (key.clone(), applies)))
+        .collect();
+    scopes.sort();
+    scopes
+}
+
+/// Whether `rule` checks test code, per [`test_scopes`], or None for the
+/// rule's default.
+pub fn applies_to_tests(scopes: &[(String, bool)], rule: &str) -> Option<bool> {
+    scopes
+        .iter()
+        .find(|(key, _)| rule_matches(key, rule))
+        .map(|(_, applies)| *applies)
+}
+
+/// Whether config can drop violations after checking: rule levels from
+/// `[severity]` or `[rules]`, `[categories].disable`, or nested configs.
+///
+/// A violation limit must wait until they're applied, or dropped violations
+/// fill it and hide ones that count.
+pub fn filters_violations(config: &Config) -> bool {
+    !config.severity.is_empty()
+        || !config.rules.is_empty()
+        || !config.categories.disable.is_empty()
+        || !config.directories.is_empty()
+}
+
+/// Effective per-rule levels: built-in defaults, then `off` for the rules
+/// of `[categories].disable`, then `[severity]`, then
+/// `[rules.<rule>].severity`. Later entries take precedence.
+pub fn rule_levels(config: &Config) -> Vec<(String, CheckLevel)> {
+    RULE_DEFAULTS
+        .iter()
+        .map(|(rule, level)| (rule.to_string(), *level))
+        .chain(
+            config
+                .categories
+                .disable
+                .iter()
+                .flat_map(|category| rules_in(*category))
+                .map(|rule| (rule, CheckLevel::Off)),
+        )
+        .chain(configured_levels(&config.severity, &config.rules))
+        .collect()
+}
+
+/// Levels set by one config file: `[severity]`, then `[rules.<rule>].severity`.
+fn configured_levels(
+    severity: &HashMap<String, CheckLevel>,
+    rules: &HashMap<String, RuleConfig>,
+) -> Vec<(String, CheckLevel)> {
+    let mut severity: Vec<(String, CheckLevel)> = severity
+        .iter()
+        .map(|(rule, level)| (rule.clone(), *level))
+        .collect();
+    let mut rules: Vec<(String, CheckLevel)> = rules
+        .iter()
+        .filter_map(|(rule, settings)| Some((rule.clone(), settings.severity?)))
+        .collect();
+    // Map order is arbitrary; sort so overlapping keys resolve the same way
+    severity.sort_by(|a, b| a.0.cmp(&b.0));
+    rules.sort_by(|a, b| a.0.cmp(&b.0));
+    severity.into_iter().chain(rules).collect()
+}
+
+/// Rule levels for each directory with a nested config.
+///
+/// A nested config's levels follow its nearest configured ancestor's (or the
+/// root config's), so the nearest directory takes precedence.
+pub struct RuleLevels {
+    root: Vec<(String, CheckLevel)>,
+    /// Deepest directories first.
+    dirs: Vec<(PathBuf, Vec<(String, CheckLevel)>)>,
+}
+
+impl RuleLevels {
+    pub fn new(config: &Config) -> Self {
+        let root = rule_levels(config);
+        let mut nested: Vec<_> = config.directories.iter().collect();
+        nested.sort_by_key(|d| d.dir.components().count());
+
+        let mut dirs: Vec<(PathBuf, Vec<(String, CheckLevel)>)> = Vec::new();
+        for directory in nested {
+            // Shallower directories come first, so the last match is nearest
+            let mut levels = dirs
+                .iter()
+                .rev()
+                .find(|(dir, _)| directory.dir.starts_with(dir))
+                .map_or_else(|| root.clone(), |(_, levels)| levels.clone());
+            levels.extend(configured_levels(&directory.severity, &directory.rules));
+            dirs.push((directory.dir.clone(), levels));
+        }
+        dirs.reverse();
+        Self { root, dirs }
+    }
+
+    /// Levels for a violation in `file` (relative to the project root), or
+    /// the root config's for project-wide violations.
+    pub fn for_file(&self, file: Option<&Path>) -> &[(String, CheckLevel)] {
+        file.and_then(|file| self.dirs.iter().find(|(dir, _)| file.starts_with(dir)))
+            .map_or(&self.root, |(_, levels)| levels)
+    }
+}
+
+/// One config file's `include`/`exclude` globs for a rule.
+struct PathScope {
+    key: String,
+    /// Directory the globs are relative to (empty for the root config).
+    dir: PathBuf,
+    /// None = every file.
+    include: Option<GlobSet>,
+    exclude: GlobSet,
+}
+
+impl PathScope {
+    /// Whether the scope covers `path` (relative to its directory).
+    fn covers(&self, path: &Path) -> bool {
+        self.include
+            .as_ref()
+            .is_none_or(|globs| globs.is_match(path))
+            && !self.exclude.is_match(path)
+    }
+}
+
+/// Rule scopes set by one config file, sorted by key.
+fn path_scopes(dir: &Path, rules: &HashMap<String, RuleConfig>) -> Vec<PathScope> {
+    let mut scopes: Vec<PathScope> = rules
+        .iter()
+        .filter(|(_, rule)| !rule.include.is_empty() || !rule.exclude.is_empty())
+        .map(|(key, rule)| PathScope {
+            key: key.clone(),
+            dir: dir.to_path_buf(),
+            include: (!rule.include.is_empty()).then(|| build_glob_set(&rule.include)),
+            exclude: build_glob_set(&rule.exclude),
+        })
+        .collect();
+    scopes.sort_by(|a, b| a.key.cmp(&b.key));
+    scopes
+}
+
+/// Per-rule paths (`[rules.<rule>].include` and `exclude`).
+///
+/// A file is checked by a rule when it matches one of the rule's `include`
+/// globs (or `include` is empty) and none of its `exclude` globs. Globs
+/// match paths relative to the config that sets them, and the nearest
+/// config that scopes a rule takes precedence, as for levels.
+pub struct RulePaths {
+    /// Deepest directories first, the root config last.
+    scopes: Vec<PathScope>,
+}
+
+impl RulePaths {
+    pub fn new(config: &Config) -> Self {
+        let mut nested: Vec<_> = config.directories.iter().collect();
+        nested.sort_by_key(|d| std::cmp::Reverse(d.dir.components().count()));
+
+        let mut scopes = Vec::new();
+        for directory in nested {
+            scopes.extend(path_scopes(&directory.dir, &directory.rules));
+        }
+        scopes.extend(path_scopes(Path::new(""), &config.rules));
+        Self { scopes }
+    }
+
+    /// Whether no rule is limited to some paths.
+    pub fn is_empty(&self) -> bool {
+        self.scopes.is_empty()
+    }
+
+    /// Whether `rule` checks `file` (relative to the project root).
+    pub fn applies(&self, rule: &str, file: &Path) -> bool {
+        self.scopes
+            .iter()
+            .find(|scope| file.starts_with(&scope.dir) && rule_matches(&scope.key, rule))
+            .is_none_or(|scope| scope.covers(file.strip_prefix(&scope.dir).unwrap_or(file)))
+    }
+
+    /// Whether a violation is in a file its rule checks. Violations without
+    /// a file are always kept.
+    pub fn keeps(&self, violation: &Violation) -> bool {
+        self.is_empty()
+            || violation
+                .file
+                .as_deref()
+                .is_none_or(|file| self.applies(&normalize_rule(&rule_id(violation)), file))
+    }
+}
+
+/// Level of one violation under [`rule_levels`]: its rule's configured
+/// level, or `default` when none matches.
+pub fn violation_level(
+    levels: &[(String, CheckLevel)],
+    violation: &Violation,
+    default: CheckLevel,
+) -> CheckLevel {
+    let rule = normalize_rule(&rule_id(violation));
+    levels
+        .iter()
+        .rev()
+        .find(|(key, _)| rule_matches(key, &rule))
+        .map_or(default, |(_, level)| *level)
+}
+
+/// Apply per-rule severity and paths to the output.
+pub fn apply(config: &Config, output: &mut CheckOutput) {
+    let levels = RuleLevels::new(config);
+    let paths = RulePaths::new(config);
+
+    for result in &mut output.checks {
+        if result.skipped || result.violations.is_empty() {
+            continue;
+        }
+        // Violations on a passing check are warnings (check level = warn)
+        let passed = result.passed;
+
+        let mut failed = false;
+        result.violations.retain_mut(|v| {
+            // Violations in files outside a rule's paths are dropped
+            if !paths.keeps(v) {
+                return false;
+            }
+            let default = if passed || v.warning {
+                CheckLevel::Warn
+            } else {
+                CheckLevel::Error
+            };
+            let level = violation_level(levels.for_file(v.file.as_deref()), v, default);
+            failed |= level == CheckLevel::Error;
+            v.warning = level == CheckLevel::Warn;
+            level != CheckLevel::Off
+        });
+
+        result.passed = !failed;
+        if result.passed {
+            // A passing check's violations are already warnings
+            for v in &mut result.violations {
+                v.warning = false;
+            }
+        }
+    }
+    output.passed = output.checks.iter().all(|c| c.passed || c.skipped);
+}
+
+#[cfg(test)]
+#[path = "severity_tests.rs"]
+mod tests;
@@ -0,0 +1,420 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::category::Category;
+use crate::check::CheckResult;
+use crate::config::{DirectoryConfig, RuleConfig};
+use crate::output::json::create_output;
+use crate::test_utils::escape_violation;
+
+fn config(overrides: &[(&str, CheckLevel)]) -> Config {
+    let mut config = Config::default();
+    for (rule, level) in overrides {
+        config.severity.insert(rule.to_string(), *level);
+    }
+    config
+}
+
+#[test]
+fn no_overrides_leaves_output_unchanged() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+
+    apply(&Config::default(), &mut output);
+    assert!(!output.passed);
+    assert!(!output.checks[0].violations[0].warning);
+}
+
+#[test]
+fn downgrading_every_violation_passes_check() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "unsafe_pointer"),
+        ],
+    )]);
+
+    apply(
+        &config(&[("unsafe-pointer", CheckLevel::Warn)]),
+        &mut output,
+    );
+    assert!(output.passed);
+    assert!(output.checks[0].passed);
+    assert_eq!(output.checks[0].violations.len(), 2);
+    assert!(output.checks[0].violations.iter().all(|v| !v.warning));
+}
+
+#[test]
+fn downgraded_violation_in_failing_check_is_warning() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "linkname"),
+        ],
+    )]);
+
+    apply(&config(&[("linkname", CheckLevel::Warn)]), &mut output);
+    assert!(!output.passed);
+    let violations = &output.checks[0].violations;
+    assert!(!violations[0].warning);
+    assert!(violations[1].warning);
+}
+
+#[test]
+fn off_drops_violations() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "linkname"),
+        ],
+    )]);
+
+    apply(&config(&[("linkname", CheckLevel::Off)]), &mut output);
+    assert_eq!(output.checks[0].violations.len(), 1);
+    assert_eq!(
+        output.checks[0].violations[0].pattern.as_deref(),
+        Some("unsafe_pointer")
+    );
+}
+
+#[test]
+fn error_override_fails_warn_level_check() {
+    let mut output = create_output(vec![CheckResult::passed_with_warnings(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "linkname"),
+        ],
+    )]);
+
+    apply(
+        &config(&[("unsafe_pointer", CheckLevel::Error)]),
+        &mut output,
+    );
+    assert!(!output.passed);
+    let violations = &output.checks[0].violations;
+    assert!(!violations[0].warning);
+    assert!(violations[1].warning);
+}
+
+#[test]
+fn skipped_checks_are_untouched() {
+    let mut output = create_output(vec![CheckResult::skipped("build", "no targets")]);
+
+    apply(
+        &config(&[("unsafe_pointer", CheckLevel::Error)]),
+        &mut output,
+    );
+    assert!(output.checks[0].skipped);
+    assert!(output.passed);
+}
+
+#[test]
+fn noescape_defaults_to_warning() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "go_noescape")],
+    )]);
+
+    apply(&Config::default(), &mut output);
+    assert!(output.passed);
+    assert_eq!(output.checks[0].violations.len(), 1);
+}
+
+#[test]
+fn config_overrides_rule_default() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "go_noescape")],
+    )]);
+
+    apply(&config(&[("go_noescape", CheckLevel::Error)]), &mut output);
+    assert!(!output.passed);
+}
+
+#[test]
+fn rules_table_sets_severity_without_go_prefix() {
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "go_linkname"),
+        ],
+    )]);
+
+    let mut config = config(&[("go_linkname", CheckLevel::Error)]);
+    config.rules.insert(
+        "linkname".to_string(),
+        RuleConfig {
+            severity: Some(CheckLevel::Warn),
+            ..RuleConfig::default()
+        },
+    );
+    apply(&config, &mut output);
+    let violations = &output.checks[0].violations;
+    assert!(!violations[0].warning);
+    assert!(violations[1].warning);
+}
+
+#[test]
+fn test_scopes_match_rules_by_any_key_form() {
+    let mut config = Config::default();
+    for (key, applies) in [("unsafe-pointer", false), ("noescape", true)] {
+        config.rules.insert(
+            key.to_string(),
+            RuleConfig {
+                apply_to_tests: Some(applies),
+                ..RuleConfig::default()
+            },
+        );
+    }
+    config.rules.insert(
+        "go_linkname".to_string(),
+        RuleConfig {
+            severity: Some(CheckLevel::Warn),
+            ..RuleConfig::default()
+        },
+    );
+
+    let scopes = test_scopes(&config);
+    assert_eq!(scopes.len(), 2);
+    assert_eq!(applies_to_tests(&scopes, "unsafe_pointer"), Some(false));
+    assert_eq!(applies_to_tests(&scopes, "go_noescape"), Some(true));
+    assert_eq!(applies_to_tests(&scopes, "go_linkname"), None);
+}
+
+#[test]
+fn existing_warnings_keep_warning_level() {
+    let mut warning = escape_violation("main.go", 3, "syscall_import");
+    warning.warning = true;
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer"), warning],
+    )]);
+
+    apply(&Config::default(), &mut output);
+    assert!(!output.passed);
+    assert!(output.checks[0].violations[1].warning);
+}
+
+#[test]
+fn rule_keys_match_with_or_without_go_prefix() {
+    assert!(rule_matches("go_noescape", "go_noescape"));
+    assert!(rule_matches("noescape", "go_noescape"));
+    assert!(rule_matches("unsafe-pointer", "unsafe_pointer"));
+    assert!(rule_matches("import", "__import__"));
+    assert!(!rule_matches("escape", "go_noescape"));
+    assert!(!rule_matches("go_unsafe_pointer", "unsafe_pointer"));
+}
+
+#[test]
+fn violation_level_uses_rule_default_or_fallback() {
+    let levels = rule_levels(&config(&[("linkname", CheckLevel::Off)]));
+
+    let level = |pattern| {
+        violation_level(
+            &levels,
+            &escape_violation("main.go", 2, pattern),
+            CheckLevel::Error,
+        )
+    };
+    assert_eq!(level("go_linkname"), CheckLevel::Off);
+    assert_eq!(level("go_noescape"), CheckLevel::Warn);
+    assert_eq!(level("unsafe_pointer"), CheckLevel::Error);
+}
+
+#[test]
+fn disabled_category_drops_rules_unless_overridden() {
+    let mut config = config(&[("exec_command", CheckLevel::Warn)]);
+    config.categories.disable.push(Category::Security);
+    let levels = rule_levels(&config);
+
+    let level = |pattern| {
+        violation_level(
+            &levels,
+            &escape_violation("main.go", 2, pattern),
+            CheckLevel::Error,
+        )
+    };
+    assert_eq!(level("exec_import"), CheckLevel::Off);
+    assert_eq!(level("exec_command"), CheckLevel::Warn);
+    assert_eq!(level("unsafe_pointer"), CheckLevel::Error);
+}
+
+fn directory(dir: &str, overrides: &[(&str, CheckLevel)]) -> DirectoryConfig {
+    DirectoryConfig {
+        dir: PathBuf::from(dir),
+        version: 1,
+        severity: overrides
+            .iter()
+            .map(|(rule, level)| (rule.to_string(), *level))
+            .collect(),
+        rules: HashMap::new(),
+    }
+}
+
+#[test]
+fn nearest_directory_config_wins() {
+    let mut config = config(&[("unsafe_pointer", CheckLevel::Warn)]);
+    // Listed deepest first to check they're merged by depth
+    config.directories = vec![
+        directory("internal/legacy", &[("unsafe_pointer", CheckLevel::Off)]),
+        directory(
+            "internal",
+            &[
+                ("unsafe_pointer", CheckLevel::Error),
+                ("linkname", CheckLevel::Warn),
+            ],
+        ),
+    ];
+    let levels = RuleLevels::new(&config);
+
+    let level = |file, pattern| {
+        let v = escape_violation(file, 2, pattern);
+        violation_level(levels.for_file(v.file.as_deref()), &v, CheckLevel::Error)
+    };
+    assert_eq!(level("cmd/app/main.go", "unsafe_pointer"), CheckLevel::Warn);
+    assert_eq!(
+        level("internal/store/ptr.go", "unsafe_pointer"),
+        CheckLevel::Error
+    );
+    assert_eq!(
+        level("internal/legacy/ptr.go", "unsafe_pointer"),
+        CheckLevel::Off
+    );
+    // Inherited from internal/
+    assert_eq!(
+        level("internal/legacy/ptr.go", "go_linkname"),
+        CheckLevel::Warn
+    );
+    // internal/ doesn't cover internalize/
+    assert_eq!(
+        level("internalize/ptr.go", "unsafe_pointer"),
+        CheckLevel::Warn
+    );
+    assert_eq!(levels.for_file(None), rule_levels(&config).as_slice());
+}
+
+#[test]
+fn apply_resolves_levels_per_file() {
+    let mut config = Config::default();
+    config.directories = vec![directory("cmd", &[("unsafe_pointer", CheckLevel::Off)])];
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("cmd/app/main.go", 2, "unsafe_pointer"),
+            escape_violation("internal/store/ptr.go", 2, "unsafe_pointer"),
+        ],
+    )]);
+
+    apply(&config, &mut output);
+    assert!(!output.passed);
+    let files: Vec<_> = output.checks[0]
+        .violations
+        .iter()
+        .filter_map(|v| v.file.clone())
+        .collect();
+    assert_eq!(files, vec![PathBuf::from("internal/store/ptr.go")]);
+}
+
+fn scoped(include: &[&str], exclude: &[&str]) -> RuleConfig {
+    RuleConfig {
+        include: include.iter().map(|glob| glob.to_string()).collect(),
+        exclude: exclude.iter().map(|glob| glob.to_string()).collect(),
+        ..RuleConfig::default()
+    }
+}
+
+#[test]
+fn rule_paths_include_then_exclude() {
+    let mut config = Config::default();
+    config.rules.insert(
+        "unsafe-pointer".to_string(),
+        scoped(&["internal/services/**"], &["internal/services/legacy/**"]),
+    );
+    config
+        .rules
+        .insert("noescape".to_string(), scoped(&[], &["vendor/**"]));
+
+    let paths = RulePaths::new(&config);
+    let applies = |rule: &str, file: &str| paths.applies(rule, Path::new(file));
+    assert!(applies(
+        "unsafe_pointer",
+        "internal/services/billing/ptr.go"
+    ));
+    assert!(!applies(
+        "unsafe_pointer",
+        "internal/services/legacy/ptr.go"
+    ));
+    assert!(!applies("unsafe_pointer", "cmd/app/main.go"));
+    assert!(applies("go_noescape", "cmd/app/main.go"));
+    assert!(!applies("go_noescape", "vendor/x/asm.go"));
+    // Rules without paths check every file
+    assert!(applies("go_linkname", "vendor/x/asm.go"));
+}
+
+#[test]
+fn nearest_config_scopes_a_rule() {
+    let mut config = Config::default();
+    config
+        .rules
+        .insert("unsafe_pointer".to_string(), scoped(&["internal/**"], &[]));
+    let mut legacy = directory("internal/legacy", &[]);
+    legacy
+        .rules
+        .insert("unsafe_pointer".to_string(), scoped(&[], &["gen/**"]));
+    config.directories = vec![legacy];
+
+    let paths = RulePaths::new(&config);
+    let applies = |file: &str| paths.applies("unsafe_pointer", Path::new(file));
+    assert!(applies("internal/store/ptr.go"));
+    assert!(!applies("cmd/app/main.go"));
+    // Relative to the nested config's directory
+    assert!(applies("internal/legacy/ptr.go"));
+    assert!(!applies("internal/legacy/gen/ptr.go"));
+}
+
+#[test]
+fn apply_drops_violations_outside_rule_paths() {
+    let mut config = Config::default();
+    config
+        .rules
+        .insert("unsafe_pointer".to_string(), scoped(&["internal/**"], &[]));
+    let mut output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("cmd/app/main.go", 2, "unsafe_pointer")],
+    )]);
+
+    apply(&config, &mut output);
+    assert!(output.passed);
+    assert!(output.checks[0].violations.is_empty());
+}
+
+#[test]
+fn rules_categories_and_nested_configs_filter_violations() {
+    assert!(!filters_violations(&Config::default()));
+    assert!(filters_violations(&config(&[(
+        "unsafe_pointer",
+        CheckLevel::Off
+    )])));
+
+    let mut config = Config::default();
+    config
+        .rules
+        .insert("unsafe_pointer".to_string(), RuleConfig::default());
+    assert!(filters_violations(&config));
+
+    let mut config = Config::default();
+    config.categories.disable = vec![Category::Style];
+    assert!(filters_violations(&config));
+
+    let mut config = Config::default();
+    config.directories = vec![directory("cmd", &[])];
+    assert!(filters_violations(&config));
+}
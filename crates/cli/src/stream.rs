@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! In-order violation streaming for parallel scans.
+//!
+//! Workers finish files in any order; each file's violations are buffered
+//! until every earlier file is done, then handed to the writer. Output is
+//! the same as scanning one file at a time, but starts as soon as the
+//! first files are checked instead of after the whole scan.
+
+use std::collections::BTreeMap;
+use std::sync::Mutex;
+
+use crate::check::Violation;
+
+/// Writes one file's violations; called in file order, never concurrently.
+pub type StreamWriter = Box<dyn FnMut(&[Violation]) + Send>;
+
+/// Violations buffered per file, flushed in file order.
+pub struct ViolationStream {
+    state: Mutex<StreamState>,
+}
+
+struct StreamState {
+    /// Index of the next file to flush.
+    next: usize,
+    /// Finished files waiting on an earlier one.
+    pending: BTreeMap<usize, Vec<Violation>>,
+    write: StreamWriter,
+}
+
+impl ViolationStream {
+    pub fn new(write: StreamWriter) -> Self {
+        Self {
+            state: Mutex::new(StreamState {
+                next: 0,
+                pending: BTreeMap::new(),
+                write,
+            }),
+        }
+    }
+
+    /// Start a scan: file indices count from 0 again.
+    pub fn start(&self) {
+        if let Ok(mut state) = self.state.lock() {
+            state.next = 0;
+            state.pending.clear();
+        }
+    }
+
+    /// Record the violations of file `index` (empty if it had none or was
+    /// skipped), and write every file that is now next in order.
+    ///
+    /// Every index must be completed once, or later files stay buffered.
+    pub fn complete(&self, index: usize, violations: Vec<Violation>) {
+        let Ok(mut state) = self.state.lock() else {
+            return;
+        };
+        state.pending.insert(index, violations);
+        loop {
+            let next = state.next;
+            let Some(violations) = state.pending.remove(&next) else {
+                break;
+            };
+            state.next += 1;
+            if !violations.is_empty() {
+                (state.write)(&violations);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+#[path = "stream_tests.rs"]
+mod tests;
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use std::sync::Arc;
+
+use rayon::prelude::*;
+
+use super::*;
+
+/// A stream that records the files it writes, by path.
+fn recording_stream() -> (ViolationStream, Arc<Mutex<Vec<String>>>) {
+    let written = Arc::new(Mutex::new(Vec::new()));
+    let sink = Arc::clone(&written);
+    let stream = ViolationStream::new(Box::new(move |violations: &[Violation]| {
+        let mut sink = sink.lock().unwrap();
+        for v in violations {
+            sink.push(v.file.as_ref().unwrap().display().to_string());
+        }
+    }));
+    (stream, written)
+}
+
+fn file_violations(index: usize) -> Vec<Violation> {
+    vec![Violation::file(
+        format!("src/file_{index:04}.rs"),
+        1,
+        "forbidden",
+        "advice",
+    )]
+}
+
+#[test]
+fn buffers_files_until_earlier_ones_finish() {
+    let (stream, written) = recording_stream();
+
+    stream.complete(2, file_violations(2));
+    stream.complete(1, file_violations(1));
+    assert!(written.lock().unwrap().is_empty());
+
+    stream.complete(0, file_violations(0));
+    assert_eq!(
+        *written.lock().unwrap(),
+        vec!["src/file_0000.rs", "src/file_0001.rs", "src/file_0002.rs"]
+    );
+}
+
+#[test]
+fn files_without_violations_keep_the_order_moving() {
+    let (stream, written) = recording_stream();
+
+    stream.complete(1, Vec::new());
+    stream.complete(2, file_violations(2));
+    stream.complete(0, Vec::new());
+    assert_eq!(*written.lock().unwrap(), vec!["src/file_0002.rs"]);
+}
+
+#[test]
+fn start_resets_file_order() {
+    let (stream, written) = recording_stream();
+    stream.complete(0, file_violations(0));
+
+    stream.start();
+    stream.complete(0, file_violations(5));
+    assert_eq!(
+        *written.lock().unwrap(),
+        vec!["src/file_0000.rs", "src/file_0005.rs"]
+    );
+}
+
+#[test]
+fn parallel_scan_writes_in_file_order() {
+    let (stream, written) = recording_stream();
+    let files: Vec<usize> = (0..2000).collect();
+
+    // Uneven work per file so workers finish out of order
+    files.par_iter().for_each(|&index| {
+        let spins = (index * 7919) % 5000;
+        let mut acc = 0usize;
+        for i in 0..spins {
+            acc = acc.wrapping_add(std::hint::black_box(i));
+        }
+        std::hint::black_box(acc);
+        let violations = if index % 3 == 0 {
+            Vec::new()
+        } else {
+            file_violations(index)
+        };
+        stream.complete(index, violations);
+    });
+
+    let written = written.lock().unwrap();
+    let expected: Vec<String> = files
+        .iter()
+        .filter(|&&index| index % 3 != 0)
+        .map(|index| format!("src/file_{index:04}.rs"))
+        .collect();
+    assert_eq!(*written, expected);
+}
@@ -106,6 +106,23 @@ pub fn assert_pattern_at_lines(pattern: &str, content: &str, expected_lines: &[u
     );
 }
 
+// Violation test utilities
+
+use crate::check::Violation;
+
+/// Creates a `missing_comment` violation of escape pattern `pattern`, at
+/// column 2, with advice naming the pattern.
+pub fn escape_violation(file: &str, line: u32, pattern: &str) -> Violation {
+    Violation::file(
+        file,
+        line,
+        "missing_comment",
+        format!("Justify {}.", pattern),
+    )
+    .with_pattern(pattern)
+    .with_column(2)
+}
+
 // =============================================================================
 // GIT HELPERS
 // =============================================================================
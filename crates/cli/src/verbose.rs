@@ -4,33 +4,52 @@
 //! Verbose output logger for diagnostic information.
 //!
 //! Writes diagnostic output to stderr. Enabled automatically
-//! in `--ci` mode, or explicitly with `--verbose` or `QUENCH_DEBUG=1`.
+//! in `--ci` mode, or explicitly with `--verbose` (`-v`) or `QUENCH_DEBUG=1`.
+//! `-vv` adds debug detail, like the adapter chosen for each extension.
 
 /// Verbose output logger. Writes to stderr when enabled.
 /// All output is conditional on verbose mode being enabled.
 pub struct VerboseLogger {
-    enabled: bool,
+    level: u8,
 }
 
 impl VerboseLogger {
     pub fn new(enabled: bool) -> Self {
-        Self { enabled }
+        Self::with_level(u8::from(enabled))
+    }
+
+    /// A logger at `level`: 0 = off, 1 = verbose (`-v`), 2 or more = debug
+    /// (`-vv`).
+    pub fn with_level(level: u8) -> Self {
+        Self { level }
     }
 
     pub fn is_enabled(&self) -> bool {
-        self.enabled
+        self.level > 0
+    }
+
+    /// Whether debug detail (`-vv`) is shown.
+    pub fn is_debug(&self) -> bool {
+        self.level > 1
     }
 
     /// Print a verbose line to stderr (indented as content under a section).
     pub fn log(&self, msg: &str) {
-        if self.enabled {
+        if self.is_enabled() {
+            eprintln!("  {}", msg);
+        }
+    }
+
+    /// Print a debug line to stderr, only at `-vv`.
+    pub fn debug(&self, msg: &str) {
+        if self.is_debug() {
             eprintln!("  {}", msg);
         }
     }
 
     /// Print a verbose section header.
     pub fn section(&self, title: &str) {
-        if self.enabled {
+        if self.is_enabled() {
             eprintln!("\n{}:", title);
         }
     }
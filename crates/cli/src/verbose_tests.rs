@@ -44,3 +44,25 @@ fn section_outputs_with_header_when_enabled() {
     // We can't easily capture stderr in unit tests, but we can verify it doesn't panic
     logger.section("Test Section");
 }
+
+#[test]
+fn verbose_level_is_not_debug() {
+    let logger = VerboseLogger::with_level(1);
+    assert!(logger.is_enabled());
+    assert!(!logger.is_debug());
+    // Should not output anything
+    logger.debug("debug message");
+}
+
+#[test]
+fn second_level_is_debug() {
+    let logger = VerboseLogger::with_level(2);
+    assert!(logger.is_enabled());
+    assert!(logger.is_debug());
+}
+
+#[test]
+fn new_enabled_is_first_level() {
+    assert!(!VerboseLogger::new(true).is_debug());
+    assert!(!VerboseLogger::with_level(0).is_enabled());
+}
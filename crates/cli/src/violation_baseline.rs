@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Violation baseline (`--baseline`).
+//!
+//! Records existing violations so adoption on a legacy codebase only fails on
+//! new ones. Entries are keyed by file, rule, and a hash of the offending
+//! line's trimmed text rather than its line number, so unrelated edits that
+//! shift lines don't resurface baselined violations. Fixed violations simply
+//! stop matching.
+//!
+//! Unlike [`crate::baseline::Baseline`], which stores ratcheted metrics,
+//! this file stores individual violations.
+
+use std::collections::HashMap;
+use std::path::{Path, PathBuf};
+
+use serde::{Deserialize, Serialize};
+
+use crate::baseline::BaselineError;
+use crate::build_info;
+use crate::check::{CheckOutput, SourceBuffer, Violation};
+use crate::output::violations::rule_id;
+
+/// Current violation baseline format version.
+pub const VIOLATION_BASELINE_VERSION: u32 = 1;
+
+/// Baseline of known violations.
+#[derive(Debug, Clone, Default, PartialEq, Eq, Serialize, Deserialize)]
+pub struct ViolationBaseline {
+    /// Format version for forward compatibility.
+    pub version: u32,
+
+    /// Version of quench that wrote the baseline.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub quench_version: Option<String>,
+
+    /// Known violations, sorted.
+    pub violations: Vec<BaselineEntry>,
+}
+
+/// A single baselined violation.
+#[derive(Debug, Clone, PartialEq, Eq, Hash, PartialOrd, Ord, Serialize, Deserialize)]
+pub struct BaselineEntry {
+    /// File path relative to the project root.
+    pub file: String,
+    /// Rule identifier: the escape pattern name, or the violation type.
+    pub rule: String,
+    /// Content hash of the violation's line (empty for file-level violations).
+    pub hash: String,
+}
+
+impl ViolationBaseline {
+    /// Build a baseline from every file violation in the output.
+    ///
+    /// Violations without a file (e.g., commit messages) can't be baselined.
+    /// Lines of the file `stdin` stands in for are read from its buffer.
+    pub fn from_output(root: &Path, stdin: Option<&SourceBuffer>, output: &CheckOutput) -> Self {
+        let mut lines = LineReader::new(root, stdin);
+        let mut violations: Vec<BaselineEntry> = output
+            .checks
+            .iter()
+            .flat_map(|result| &result.violations)
+            .filter_map(|v| entry_for(&mut lines, v))
+            .collect();
+        violations.sort();
+
+        Self {
+            version: VIOLATION_BASELINE_VERSION,
+            quench_version: Some(build_info::VERSION.to_string()),
+            violations,
+        }
+    }
+
+    /// Load baseline from file, returning None if not found.
+    pub fn load(path: &Path) -> Result<Option<Self>, BaselineError> {
+        if !path.exists() {
+            return Ok(None);
+        }
+
+        let content =
+            std::fs::read_to_string(path).map_err(|e| BaselineError::Read(e.to_string()))?;
+
+        let baseline: ViolationBaseline =
+            serde_json::from_str(&content).map_err(|e| BaselineError::Parse(e.to_string()))?;
+
+        if baseline.version > VIOLATION_BASELINE_VERSION {
+            return Err(BaselineError::Version {
+                found: baseline.version,
+                supported: VIOLATION_BASELINE_VERSION,
+            });
+        }
+
+        Ok(Some(baseline))
+    }
+
+    /// Save baseline to file, creating parent directories if needed.
+    pub fn save(&self, path: &Path) -> Result<(), BaselineError> {
+        if let Some(parent) = path.parent() {
+            std::fs::create_dir_all(parent).map_err(|e| BaselineError::Write(e.to_string()))?;
+        }
+
+        let content = serde_json::to_string_pretty(self)
+            .map_err(|e| BaselineError::Serialize(e.to_string()))?;
+
+        std::fs::write(path, content + "\n").map_err(|e| BaselineError::Write(e.to_string()))?;
+
+        Ok(())
+    }
+
+    /// Remove baselined violations from the output.
+    ///
+    /// Each entry suppresses one matching violation, so a second copy of a
+    /// baselined line is still reported. A failing check whose violations are
+    /// all baselined passes. Returns the number of suppressed violations.
+    ///
+    /// Lines of the file `stdin` stands in for are read from its buffer, so
+    /// an unsaved edit is matched as it will be saved.
+    pub fn apply(
+        &self,
+        root: &Path,
+        stdin: Option<&SourceBuffer>,
+        output: &mut CheckOutput,
+    ) -> usize {
+        let mut remaining: HashMap<&BaselineEntry, usize> = HashMap::new();
+        for entry in &self.violations {
+            *remaining.entry(entry).or_default() += 1;
+        }
+
+        let mut lines = LineReader::new(root, stdin);
+        let mut suppressed = 0;
+        for result in &mut output.checks {
+            if result.violations.is_empty() {
+                continue;
+            }
+            result.violations.retain(|v| {
+                let Some(entry) = entry_for(&mut lines, v) else {
+                    return true;
+                };
+                match remaining.get_mut(&entry) {
+                    Some(count) if *count > 0 => {
+                        *count -= 1;
+                        suppressed += 1;
+                        false
+                    }
+                    _ => true,
+                }
+            });
+            if result.violations.is_empty() && !result.skipped {
+                result.passed = true;
+            }
+        }
+
+        output.passed = output.checks.iter().all(|c| c.passed || c.skipped);
+        suppressed
+    }
+}
+
+/// Build the baseline entry for a violation.
+fn entry_for(lines: &mut LineReader, violation: &Violation) -> Option<BaselineEntry> {
+    let file = violation.file.as_ref()?;
+    let hash = match violation.line {
+        Some(line) => line_hash(&lines.line(file, line)?),
+        None => String::new(),
+    };
+    Some(BaselineEntry {
+        file: file.to_string_lossy().replace('\\', "/"),
+        rule: rule_id(violation),
+        hash,
+    })
+}
+
+/// Hash a line's trimmed text (64-bit FNV-1a, hex).
+///
+/// FNV is used instead of `DefaultHasher` because baselines are committed
+/// and must hash identically across Rust releases.
+pub fn line_hash(line: &str) -> String {
+    const OFFSET: u64 = 0xcbf2_9ce4_8422_2325;
+    const PRIME: u64 = 0x0100_0000_01b3;
+
+    let hash = line.trim().bytes().fold(OFFSET, |hash, byte| {
+        (hash ^ u64::from(byte)).wrapping_mul(PRIME)
+    });
+    format!("{:016x}", hash)
+}
+
+/// Reads violation lines, loading each file at most once.
+struct LineReader<'a> {
+    root: &'a Path,
+    /// Buffer read in place of its file on disk (`check --stdin`).
+    stdin: Option<&'a SourceBuffer>,
+    files: HashMap<PathBuf, Option<Vec<String>>>,
+}
+
+impl<'a> LineReader<'a> {
+    fn new(root: &'a Path, stdin: Option<&'a SourceBuffer>) -> Self {
+        Self {
+            root,
+            stdin,
+            files: HashMap::new(),
+        }
+    }
+
+    /// Get a 1-based line of a file, or None if the file or line is missing.
+    fn line(&mut self, file: &Path, line: u32) -> Option<String> {
+        let (root, stdin) = (self.root, self.stdin);
+        let lines = self.files.entry(file.to_path_buf()).or_insert_with(|| {
+            let path = root.join(file);
+            match stdin {
+                Some(buffer) if buffer.path == path => Some(buffer.content.clone()),
+                _ => std::fs::read_to_string(path).ok(),
+            }
+            .map(|content| content.lines().map(String::from).collect())
+        });
+        lines
+            .as_ref()?
+            .get((line as usize).checked_sub(1)?)
+            .cloned()
+    }
+}
+
+#[cfg(test)]
+#[path = "violation_baseline_tests.rs"]
+mod tests;
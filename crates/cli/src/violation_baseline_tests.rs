@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use super::*;
+use crate::check::CheckResult;
+use crate::output::json::create_output;
+use crate::test_utils::{create_tree, escape_violation};
+
+fn project(files: &[(&str, &str)]) -> tempfile::TempDir {
+    let dir = tempfile::tempdir().unwrap();
+    create_tree(dir.path(), files);
+    dir
+}
+
+#[test]
+fn line_hash_ignores_indentation() {
+    assert_eq!(
+        line_hash("\tp := unsafe.Pointer(&x)"),
+        line_hash("p := unsafe.Pointer(&x)  ")
+    );
+    assert_ne!(
+        line_hash("p := unsafe.Pointer(&x)"),
+        line_hash("q := unsafe.Pointer(&x)")
+    );
+}
+
+#[test]
+fn line_hash_is_stable() {
+    // FNV-1a reference values; baselines are committed and must not change
+    assert_eq!(line_hash(""), "cbf29ce484222325");
+    assert_eq!(line_hash("a"), "af63dc4c8601ec8c");
+}
+
+#[test]
+fn from_output_records_file_rule_and_hash() {
+    let dir = project(&[("main.go", "package main\n\tp := unsafe.Pointer(&x)\n")]);
+    let output = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &output);
+    assert_eq!(baseline.version, VIOLATION_BASELINE_VERSION);
+    assert_eq!(
+        baseline.violations,
+        vec![BaselineEntry {
+            file: "main.go".to_string(),
+            rule: "unsafe_pointer".to_string(),
+            hash: line_hash("p := unsafe.Pointer(&x)"),
+        }]
+    );
+}
+
+#[test]
+fn from_output_skips_violations_without_file() {
+    let violation = Violation::commit_violation("abc1234", "update", "invalid_format", "Use type:");
+    let output = create_output(vec![CheckResult::failed("git", vec![violation])]);
+
+    let baseline = ViolationBaseline::from_output(Path::new("."), None, &output);
+    assert!(baseline.violations.is_empty());
+}
+
+#[test]
+fn apply_suppresses_baselined_violation_after_line_drift() {
+    let dir = project(&[("main.go", "package main\np := unsafe.Pointer(&x)\n")]);
+    let before = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &before);
+
+    // Two lines inserted above the baselined violation
+    std::fs::write(
+        dir.path().join("main.go"),
+        "package main\n\nimport \"unsafe\"\np := unsafe.Pointer(&x)\n",
+    )
+    .unwrap();
+    let mut after = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 4, "unsafe_pointer")],
+    )]);
+
+    assert_eq!(baseline.apply(dir.path(), None, &mut after), 1);
+    assert!(after.passed);
+    assert!(after.checks[0].passed);
+    assert!(after.checks[0].violations.is_empty());
+}
+
+#[test]
+fn apply_reports_new_violations() {
+    let dir = project(&[(
+        "main.go",
+        "package main\np := unsafe.Pointer(&x)\nq := unsafe.Pointer(&y)\n",
+    )]);
+    let before = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &before);
+
+    let mut after = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "unsafe_pointer"),
+        ],
+    )]);
+
+    assert_eq!(baseline.apply(dir.path(), None, &mut after), 1);
+    assert!(!after.passed);
+    assert_eq!(after.checks[0].violations.len(), 1);
+    assert_eq!(after.checks[0].violations[0].line, Some(3));
+}
+
+#[test]
+fn apply_reports_duplicate_of_baselined_line() {
+    let dir = project(&[(
+        "main.go",
+        "package main\np := unsafe.Pointer(&x)\np := unsafe.Pointer(&x)\n",
+    )]);
+    let before = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &before);
+
+    let mut after = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![
+            escape_violation("main.go", 2, "unsafe_pointer"),
+            escape_violation("main.go", 3, "unsafe_pointer"),
+        ],
+    )]);
+
+    assert_eq!(baseline.apply(dir.path(), None, &mut after), 1);
+    assert_eq!(after.checks[0].violations.len(), 1);
+}
+
+#[test]
+fn apply_ignores_fixed_violations() {
+    let dir = project(&[("main.go", "package main\np := unsafe.Pointer(&x)\n")]);
+    let before = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &before);
+
+    let mut after = create_output(vec![CheckResult::passed("escapes")]);
+
+    assert_eq!(baseline.apply(dir.path(), None, &mut after), 0);
+    assert!(after.passed);
+}
+
+#[test]
+fn apply_reads_stdin_buffer_instead_of_disk() {
+    let dir = project(&[("main.go", "package main\np := unsafe.Pointer(&x)\n")]);
+    let before = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 2, "unsafe_pointer")],
+    )]);
+    let baseline = ViolationBaseline::from_output(dir.path(), None, &before);
+
+    // The buffer moves the line; the file on disk is unchanged
+    let buffer = SourceBuffer {
+        path: dir.path().join("main.go"),
+        content: "package main\n\nimport \"unsafe\"\np := unsafe.Pointer(&x)\n".to_string(),
+    };
+    let mut after = create_output(vec![CheckResult::failed(
+        "escapes",
+        vec![escape_violation("main.go", 4, "unsafe_pointer")],
+    )]);
+
+    assert_eq!(baseline.apply(dir.path(), Some(&buffer), &mut after), 1);
+    assert!(after.passed);
+}
+
+#[test]
+fn save_and_load_roundtrip() {
+    let dir = tempfile::tempdir().unwrap();
+    let path = dir.path().join("nested/.quench-baseline.json");
+    let baseline = ViolationBaseline {
+        version: VIOLATION_BASELINE_VERSION,
+        quench_version: Some("0.1.0".to_string()),
+        violations: vec![BaselineEntry {
+            file: "main.go".to_string(),
+            rule: "unsafe_pointer".to_string(),
+            hash: line_hash("p := unsafe.Pointer(&x)"),
+        }],
+    };
+
+    baseline.save(&path).unwrap();
+    assert_eq!(ViolationBaseline::load(&path).unwrap(), Some(baseline));
+}
+
+#[test]
+fn load_nonexistent_returns_none() {
+    let path = Path::new("/nonexistent/.quench-baseline.json");
+    assert_eq!(ViolationBaseline::load(path).unwrap(), None);
+}
+
+#[test]
+fn load_rejects_newer_version() {
+    let dir = tempfile::tempdir().unwrap();
+    let path = dir.path().join(".quench-baseline.json");
+    std::fs::write(&path, r#"{"version": 99, "violations": []}"#).unwrap();
+
+    assert!(matches!(
+        ViolationBaseline::load(&path),
+        Err(BaselineError::Version { found: 99, .. })
+    ));
+}
@@ -7,12 +7,12 @@
 //! that respects `.gitignore`, custom ignore patterns, and depth limits.
 
 use std::path::{Path, PathBuf};
-use std::sync::Arc;
 use std::sync::atomic::{AtomicUsize, Ordering};
+use std::sync::{Arc, Mutex};
 use std::time::SystemTime;
 
 use crossbeam_channel::{Receiver, bounded};
-use ignore::overrides::OverrideBuilder;
+use ignore::overrides::{Override, OverrideBuilder};
 use ignore::{WalkBuilder, WalkState};
 
 use crate::config::ExcludeConfig;
@@ -72,7 +72,10 @@ pub struct WalkerConfig {
     /// Custom exclude patterns from config (walker-level: prevents I/O on subtrees).
     pub exclude_patterns: Vec<String>,
 
-    /// Whether to respect gitignore files.
+    /// Whether to respect `.gitignore` files (nested, with `!` negation),
+    /// `.git/info/exclude`, and the global gitignore.
+    ///
+    /// `.gitignore` files apply even outside a git repository.
     pub git_ignore: bool,
 
     /// Whether to skip hidden files.
@@ -90,6 +93,16 @@ pub struct WalkerConfig {
 
     /// Force sequential mode regardless of heuristic.
     pub force_sequential: bool,
+
+    /// Only walk these files and directories (empty = the whole root).
+    ///
+    /// Paths are in the same form as the walk root (e.g., `root.join("pkg")`).
+    /// Subtrees outside them are never read, and overlapping paths are
+    /// walked once.
+    pub scope: Vec<PathBuf>,
+
+    /// Skip files larger than this many bytes (0 = no limit; default: 10MB).
+    pub max_file_size: u64,
 }
 
 /// Default threshold for switching from sequential to parallel walking.
@@ -107,10 +120,106 @@ impl Default for WalkerConfig {
             parallel_threshold: DEFAULT_PARALLEL_THRESHOLD,
             force_parallel: false,
             force_sequential: false,
+            scope: Vec::new(),
+            max_file_size: file_size::MAX_FILE_SIZE,
+        }
+    }
+}
+
+/// Files left out of a walk, shared by the walker threads.
+type SkipLog = Arc<Mutex<Vec<SkippedFile>>>;
+
+fn record_skip(log: &SkipLog, path: &Path, reason: SkipReason) {
+    if let Ok(mut skipped) = log.lock() {
+        skipped.push(SkippedFile {
+            path: path.to_path_buf(),
+            reason,
+        });
+    }
+}
+
+fn take_skips(log: &SkipLog) -> Vec<SkippedFile> {
+    let mut skipped = log
+        .lock()
+        .map(|mut skipped| std::mem::take(&mut *skipped))
+        .unwrap_or_default();
+    skipped.sort_by(|a, b| a.path.cmp(&b.path));
+    skipped
+}
+
+/// Whether a file is over the size limit (0 = no limit), warning if so.
+fn skip_for_size(path: &Path, size: u64, max_file_size: u64) -> bool {
+    if max_file_size == 0 || size <= max_file_size {
+        return false;
+    }
+    tracing::warn!(
+        "skipping {} ({} > {} limit)",
+        path.display(),
+        file_size::human_size(size, false),
+        file_size::human_size(max_file_size, false)
+    );
+    true
+}
+
+/// Exclude patterns, matched like `.gitignore` lines relative to the root.
+///
+/// Patterns are also kept one per matcher, to name the one that excluded a
+/// path; only paths the combined matcher excludes are looked up there.
+struct Excludes {
+    all: Override,
+    each: Vec<(String, Override)>,
+}
+
+impl Excludes {
+    /// Matchers for `patterns`, or None when there are none. Invalid
+    /// patterns are ignored.
+    fn new(root: &Path, patterns: &[String]) -> Option<Self> {
+        // In ignore crate's override system:
+        // - Without `!`: INCLUDE matching files (whitelist)
+        // - With `!`: EXCLUDE matching files (blacklist)
+        // To exclude files matching our patterns, we need `!` prefix
+        let build = |patterns: &[String]| {
+            let mut builder = OverrideBuilder::new(root);
+            for pattern in patterns {
+                let _ = builder.add(&format!("!{}", pattern));
+            }
+            builder.build().ok()
+        };
+        if patterns.is_empty() {
+            return None;
+        }
+        let each = patterns
+            .iter()
+            .filter_map(|p| Some((p.clone(), build(std::slice::from_ref(p))?)))
+            .collect();
+        Some(Self {
+            all: build(patterns)?,
+            each,
+        })
+    }
+
+    /// The pattern that excludes `path`, if any.
+    fn matched(&self, path: &Path, is_dir: bool) -> Option<&str> {
+        if !self.all.matched(path, is_dir).is_ignore() {
+            return None;
         }
+        self.each
+            .iter()
+            .find(|(_, matcher)| matcher.matched(path, is_dir).is_ignore())
+            .map(|(pattern, _)| pattern.as_str())
     }
 }
 
+/// Whether a walked entry is inside the scope.
+///
+/// Directories leading to a scope path are entered so the walk can reach it.
+fn in_scope(scope: &[PathBuf], path: &Path, is_dir: bool) -> bool {
+    scope.is_empty()
+        || scope
+            .iter()
+            .any(|s| path.starts_with(s) || (is_dir && s.starts_with(path)))
+}
+
 /// File discovered by the walker.
 #[derive(Debug)]
 pub struct WalkedFile {
@@ -133,6 +242,42 @@ pub struct WalkedFile {
     pub size_class: FileSizeClass,
 }
 
+/// Why a file was left out of a scan.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub enum SkipReason {
+    /// Matched this exclude pattern (`[project] exclude` or `--ignore`).
+    Excluded(String),
+    /// Larger than [`WalkerConfig::max_file_size`], in bytes.
+    TooLarge(u64),
+    /// A generated Go file (`// Code generated ... DO NOT EDIT.`).
+    Generated,
+    /// A Go file whose build constraints exclude the `--build-tags`.
+    BuildConstraints,
+}
+
+impl std::fmt::Display for SkipReason {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        match self {
+            SkipReason::Excluded(pattern) => write!(f, "matches exclude pattern {}", pattern),
+            SkipReason::TooLarge(size) => {
+                write!(f, "too large: {}", file_size::human_size(*size, false))
+            }
+            SkipReason::Generated => write!(f, "generated"),
+            SkipReason::BuildConstraints => write!(f, "excluded by build constraints"),
+        }
+    }
+}
+
+/// A file or directory left out of a scan.
+#[derive(Debug, Clone, PartialEq, Eq)]
+pub struct SkippedFile {
+    /// Path in the same form as the walk root; an excluded directory
+    /// stands for everything under it.
+    pub path: PathBuf,
+    /// Why it was left out.
+    pub reason: SkipReason,
+}
+
 /// Statistics from a walk operation.
 #[derive(Debug, Default)]
 pub struct WalkStats {
@@ -142,7 +287,7 @@ pub struct WalkStats {
     /// Files skipped due to ignore patterns.
     pub files_ignored: usize,
 
-    /// Files skipped due to size limit (>10MB).
+    /// Files skipped due to the size limit ([`WalkerConfig::max_file_size`]).
     pub files_skipped_size: usize,
 
     /// Directories skipped due to depth limit.
@@ -153,6 +298,10 @@ pub struct WalkStats {
 
     /// Errors encountered.
     pub errors: usize,
+
+    /// Files and directories skipped by exclude patterns or the size limit,
+    /// sorted by path.
+    pub skipped: Vec<SkippedFile>,
 }
 
 /// Parallel file walker with gitignore support.
@@ -228,8 +377,9 @@ impl FileWalker {
         builder
             .hidden(self.config.hidden)
             .git_ignore(self.config.git_ignore)
-            .git_exclude(true)
-            .git_global(true)
+            .git_exclude(self.config.git_ignore)
+            .git_global(self.config.git_ignore)
+            .require_git(false)
             .follow_links(true); // Follow symlinks (ignore crate detects loops)
 
         if let Some(depth) = self.config.max_depth {
@@ -240,38 +390,45 @@ impl FileWalker {
             builder.threads(self.config.threads);
         }
 
-        // Add custom exclude patterns using overrides
-        // In ignore crate's override system:
-        // - Without `!`: INCLUDE matching files (whitelist)
-        // - With `!`: EXCLUDE matching files (blacklist)
-        // To exclude files matching our patterns, we need `!` prefix
-        if !self.config.exclude_patterns.is_empty() {
-            let mut override_builder = OverrideBuilder::new(root);
-            for pattern in &self.config.exclude_patterns {
-                let _ = override_builder.add(&format!("!{}", pattern));
-            }
-            if let Ok(overrides) = override_builder.build() {
-                builder.overrides(overrides);
-            }
-        }
-
-        // Filter out common skip directories at the walker level.
-        // This prevents any I/O on these subtrees for both parallel and sequential modes.
-        builder.filter_entry(|entry| {
-            !entry.file_type().map(|t| t.is_dir()).unwrap_or(false)
-                || !entry
+        // Filter out common skip directories, anything outside the scope, and
+        // custom exclude patterns at the walker level. This prevents any I/O on
+        // these subtrees for both parallel and sequential modes. Excluded
+        // entries are logged with the pattern that matched them.
+        let scope = self.config.scope.clone();
+        let excludes = Excludes::new(root, &self.config.exclude_patterns);
+        let skipped: SkipLog = Arc::default();
+        let exclude_log = Arc::clone(&skipped);
+        builder.filter_entry(move |entry| {
+            let is_dir = entry.file_type().map(|t| t.is_dir()).unwrap_or(false);
+            let skipped = is_dir
+                && entry
                     .file_name()
                     .to_str()
                     .map(|name| SKIP_DIRECTORIES.contains(&name))
-                    .unwrap_or(false)
+                    .unwrap_or(false);
+            if skipped || !in_scope(&scope, entry.path(), is_dir) {
+                return false;
+            }
+            match excludes
+                .as_ref()
+                .and_then(|e| e.matched(entry.path(), is_dir))
+            {
+                Some(pattern) => {
+                    let reason = SkipReason::Excluded(pattern.to_string());
+                    record_skip(&exclude_log, entry.path(), reason);
+                    false
+                }
+                None => true,
+            }
         });
 
         let use_parallel = self.should_use_parallel(root);
 
+        let max_file_size = self.config.max_file_size;
         let handle = if use_parallel {
-            Self::walk_parallel(builder, tx)
+            Self::walk_parallel(builder, tx, max_file_size, skipped)
         } else {
-            Self::walk_sequential(builder, tx)
+            Self::walk_sequential(builder, tx, max_file_size, skipped)
         };
 
         (rx, handle)
@@ -281,6 +438,8 @@ impl FileWalker {
     fn walk_parallel(
         builder: WalkBuilder,
         tx: crossbeam_channel::Sender<WalkedFile>,
+        max_file_size: u64,
+        skipped: SkipLog,
     ) -> WalkHandle {
         let walker = builder.build_parallel();
 
@@ -302,6 +461,7 @@ impl FileWalker {
                 let files_skipped_size = Arc::clone(&stats_skipped);
                 let errors = Arc::clone(&stats_errors);
                 let symlink_loops = Arc::clone(&stats_loops);
+                let skipped = Arc::clone(&skipped);
 
                 Box::new(move |entry| match entry {
                     Ok(entry) => {
@@ -319,13 +479,8 @@ impl FileWalker {
                         let meta = entry.metadata();
                         let size = meta.as_ref().map(|m| m.len()).unwrap_or(0);
 
-                        // Skip files exceeding size limit (>10MB)
-                        if size > file_size::MAX_FILE_SIZE {
-                            tracing::warn!(
-                                "skipping {} ({} > 10MB limit)",
-                                entry.path().display(),
-                                file_size::human_size(size, false)
-                            );
+                        if skip_for_size(entry.path(), size, max_file_size) {
+                            record_skip(&skipped, entry.path(), SkipReason::TooLarge(size));
                             files_skipped_size.fetch_add(1, Ordering::Relaxed);
                             return WalkState::Continue;
                         }
@@ -358,6 +513,7 @@ impl FileWalker {
                 files_skipped_size: stats_skipped.load(Ordering::Relaxed),
                 errors: stats_errors.load(Ordering::Relaxed),
                 symlink_loops: stats_loops.load(Ordering::Relaxed),
+                skipped: take_skips(&skipped),
                 ..Default::default()
             }
         });
@@ -370,6 +526,8 @@ impl FileWalker {
     fn walk_sequential(
         builder: WalkBuilder,
         tx: crossbeam_channel::Sender<WalkedFile>,
+        max_file_size: u64,
+        skipped: SkipLog,
     ) -> WalkHandle {
         let walker = builder.build();
 
@@ -391,13 +549,8 @@ impl FileWalker {
                         let meta = entry.metadata();
                         let size = meta.as_ref().map(|m| m.len()).unwrap_or(0);
 
-                        // Skip files exceeding size limit (>10MB)
-                        if size > file_size::MAX_FILE_SIZE {
-                            tracing::warn!(
-                                "skipping {} ({} > 10MB limit)",
-                                entry.path().display(),
-                                file_size::human_size(size, false)
-                            );
+                        if skip_for_size(entry.path(), size, max_file_size) {
+                            record_skip(&skipped, entry.path(), SkipReason::TooLarge(size));
                             files_skipped_size += 1;
                             continue;
                         }
@@ -427,6 +580,7 @@ impl FileWalker {
                 files_skipped_size,
                 errors,
                 symlink_loops,
+                skipped: take_skips(&skipped),
                 ..Default::default()
             }
         });
@@ -443,6 +597,57 @@ impl FileWalker {
     }
 }
 
+/// Collect exactly the listed files, without walking or ignore rules.
+///
+/// Paths are in the same form as the walk root, like [`WalkerConfig::scope`].
+/// Paths that aren't files are skipped, as are files over `max_file_size`
+/// (0 = no limit).
+pub fn listed_files(
+    root: &Path,
+    paths: &[PathBuf],
+    max_file_size: u64,
+) -> (Vec<WalkedFile>, WalkStats) {
+    let mut files = Vec::new();
+    let mut stats = WalkStats::default();
+    for path in paths {
+        let Ok(meta) = std::fs::metadata(path) else {
+            continue;
+        };
+        if !meta.is_file() {
+            continue;
+        }
+
+        let size = meta.len();
+        if skip_for_size(path, size, max_file_size) {
+            stats.skipped.push(SkippedFile {
+                path: path.clone(),
+                reason: SkipReason::TooLarge(size),
+            });
+            stats.files_skipped_size += 1;
+            continue;
+        }
+
+        let (mtime_secs, mtime_nanos) = meta
+            .modified()
+            .ok()
+            .map(|t| {
+                let dur = t.duration_since(SystemTime::UNIX_EPOCH).unwrap_or_default();
+                (dur.as_secs() as i64, dur.subsec_nanos())
+            })
+            .unwrap_or((0, 0));
+        files.push(WalkedFile {
+            depth: path.strip_prefix(root).unwrap_or(path).components().count(),
+            path: path.clone(),
+            size,
+            mtime_secs,
+            mtime_nanos,
+            size_class: FileSizeClass::from_size(size),
+        });
+        stats.files_found += 1;
+    }
+    (files, stats)
+}
+
 /// Handle to a running walk operation.
 pub struct WalkHandle {
     handle: std::thread::JoinHandle<WalkStats>,
@@ -64,6 +64,184 @@ fn respects_gitignore() {
     );
 }
 
+#[test]
+fn respects_gitignore_outside_git_repo() {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            ("main.go", "package main"),
+            ("build/out.go", "package build"),
+            (".gitignore", "build/\n"),
+        ],
+    );
+
+    let walker = FileWalker::new(WalkerConfig::default());
+    let (files, _) = walker.walk_collect(tmp.path());
+
+    let paths: Vec<_> = files.iter().map(|f| f.path.clone()).collect();
+    assert_eq!(paths, vec![tmp.path().join("main.go")]);
+}
+
+#[test]
+fn respects_nested_gitignore_and_negation() {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            ("api.gen.go", "package main"),
+            ("keep.gen.go", "package main"),
+            ("internal/lib.go", "package internal"),
+            ("internal/scratch.go", "package internal"),
+            (".gitignore", "*.gen.go\n!keep.gen.go\n"),
+            ("internal/.gitignore", "scratch.go\n"),
+        ],
+    );
+
+    let walker = FileWalker::new(WalkerConfig::default());
+    let (files, _) = walker.walk_collect(tmp.path());
+
+    let mut paths: Vec<_> = files
+        .iter()
+        .map(|f| f.path.strip_prefix(tmp.path()).unwrap().to_path_buf())
+        .collect();
+    paths.sort();
+    assert_eq!(
+        paths,
+        vec![
+            PathBuf::from("internal/lib.go"),
+            PathBuf::from("keep.gen.go"),
+        ]
+    );
+}
+
+#[test]
+fn git_ignore_disabled_walks_ignored_files() {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            ("main.go", "package main"),
+            ("build/out.go", "package build"),
+            (".gitignore", "build/\n"),
+        ],
+    );
+
+    let walker = FileWalker::new(WalkerConfig {
+        git_ignore: false,
+        ..Default::default()
+    });
+    let (files, _) = walker.walk_collect(tmp.path());
+
+    assert!(
+        files.iter().any(|f| f.path.ends_with("build/out.go")),
+        "expected build/out.go in: {:?}",
+        files.iter().map(|f| &f.path).collect::<Vec<_>>()
+    );
+}
+
+#[parameterized(
+    sequential = { true },
+    parallel = { false },
+)]
+fn records_skipped_entries_with_reasons(sequential: bool) {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            ("src/lib.rs", "fn main() {}"),
+            ("vendor/dep/dep.go", "package dep"),
+            ("src/gen.pb.go", "package gen"),
+            ("src/blob.json", "x".repeat(2048).as_str()),
+        ],
+    );
+
+    let walker = FileWalker::new(WalkerConfig {
+        exclude_patterns: vec!["vendor/".to_string(), "*.pb.go".to_string()],
+        max_file_size: 1024,
+        force_sequential: sequential,
+        force_parallel: !sequential,
+        ..test_config()
+    });
+    let (files, stats) = walker.walk_collect(tmp.path());
+
+    assert_eq!(files.len(), 1);
+    let skipped: Vec<_> = stats
+        .skipped
+        .iter()
+        .map(|s| {
+            let path = s.path.strip_prefix(tmp.path()).unwrap().to_path_buf();
+            (path, s.reason.clone())
+        })
+        .collect();
+    assert_eq!(
+        skipped,
+        vec![
+            (PathBuf::from("src/blob.json"), SkipReason::TooLarge(2048)),
+            (
+                PathBuf::from("src/gen.pb.go"),
+                SkipReason::Excluded("*.pb.go".to_string())
+            ),
+            (
+                PathBuf::from("vendor"),
+                SkipReason::Excluded("vendor/".to_string())
+            ),
+        ]
+    );
+}
+
+#[test]
+fn scope_limits_walk_to_listed_paths() {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            ("pkg/a/a.go", "package a"),
+            ("pkg/b/b.go", "package b"),
+            ("pkg/c/c.go", "package c"),
+            ("cmd/main.go", "package main"),
+        ],
+    );
+
+    let walker = FileWalker::new(WalkerConfig {
+        scope: vec![
+            tmp.path().join("pkg/a"),
+            tmp.path().join("pkg/b/b.go"),
+            tmp.path().join("pkg"),
+        ],
+        ..test_config()
+    });
+    let (files, _) = walker.walk_collect(tmp.path());
+
+    let mut paths: Vec<_> = files
+        .iter()
+        .map(|f| f.path.strip_prefix(tmp.path()).unwrap().to_path_buf())
+        .collect();
+    paths.sort();
+    assert_eq!(
+        paths,
+        vec![
+            PathBuf::from("pkg/a/a.go"),
+            PathBuf::from("pkg/b/b.go"),
+            PathBuf::from("pkg/c/c.go"),
+        ]
+    );
+}
+
+#[parameterized(
+    empty_scope = { &[], "cmd/main.go", false, true },
+    inside = { &["pkg"], "pkg/a/a.go", false, true },
+    exact_file = { &["pkg/a/a.go"], "pkg/a/a.go", false, true },
+    ancestor_dir = { &["pkg/a"], "pkg", true, true },
+    sibling = { &["pkg/a"], "pkg/b", true, false },
+    prefix_name = { &["pkg/a"], "pkg/ab", true, false },
+    file_beside_scope = { &["pkg/a"], "pkg/readme.md", false, false },
+)]
+fn in_scope_cases(scope: &[&str], path: &str, is_dir: bool, expected: bool) {
+    let scope: Vec<PathBuf> = scope.iter().map(PathBuf::from).collect();
+    assert_eq!(in_scope(&scope, Path::new(path), is_dir), expected);
+}
+
 #[test]
 fn respects_depth_limit() {
     let tmp = TempDir::new().unwrap();
@@ -168,6 +346,7 @@ fn handles_empty_directory() {
 fn from_exclude_config() {
     let exclude = ExcludeConfig {
         patterns: vec!["*.log".to_string(), "tmp/".to_string()],
+        ..Default::default()
     };
 
     let walker = FileWalker::from_exclude_config(&exclude);
@@ -395,6 +574,47 @@ fn processes_files_just_under_10mb() {
     assert_eq!(stats.files_skipped_size, 0, "no files should be skipped");
 }
 
+#[parameterized(
+    sequential = { true },
+    parallel = { false },
+)]
+fn skips_files_over_configured_limit(sequential: bool) {
+    let tmp = TempDir::new().unwrap();
+    fs::write(tmp.path().join("small.txt"), "hello").unwrap();
+    fs::write(tmp.path().join("blob.txt"), "x".repeat(2048)).unwrap();
+
+    let walker = FileWalker::new(WalkerConfig {
+        max_file_size: 1024,
+        force_sequential: sequential,
+        force_parallel: !sequential,
+        ..test_config()
+    });
+    let (files, stats) = walker.walk_collect(tmp.path());
+
+    assert_eq!(files.len(), 1);
+    assert!(files[0].path.ends_with("small.txt"));
+    assert_eq!(stats.files_skipped_size, 1);
+}
+
+#[test]
+fn zero_max_file_size_disables_limit() {
+    use std::fs::File;
+
+    let tmp = TempDir::new().unwrap();
+    let large_file = File::create(tmp.path().join("huge.txt")).unwrap();
+    large_file.set_len(15 * 1024 * 1024).unwrap();
+
+    let walker = FileWalker::new(WalkerConfig {
+        max_file_size: 0,
+        ..test_config()
+    });
+    let (files, stats) = walker.walk_collect(tmp.path());
+
+    assert_eq!(files.len(), 1);
+    assert_eq!(files[0].size_class, FileSizeClass::TooLarge);
+    assert_eq!(stats.files_skipped_size, 0);
+}
+
 #[test]
 fn assigns_correct_size_class() {
     use crate::file_size::FileSizeClass;
@@ -428,3 +648,38 @@ fn assigns_correct_size_class() {
         }
     }
 }
+
+#[test]
+fn listed_files_skips_missing_paths_and_directories() {
+    let tmp = TempDir::new().unwrap();
+    create_tree(
+        tmp.path(),
+        &[
+            (".gitignore", "gen/\n"),
+            ("gen/out.go", "package gen"),
+            ("pkg/a.go", "package pkg"),
+        ],
+    );
+
+    let (files, stats) = listed_files(
+        tmp.path(),
+        &[
+            tmp.path().join("gen/out.go"),
+            tmp.path().join("pkg"),
+            tmp.path().join("pkg/deleted.go"),
+            tmp.path().join("pkg/a.go"),
+        ],
+        file_size::MAX_FILE_SIZE,
+    );
+
+    let paths: Vec<_> = files
+        .iter()
+        .map(|f| f.path.strip_prefix(tmp.path()).unwrap().to_path_buf())
+        .collect();
+    assert_eq!(
+        paths,
+        vec![PathBuf::from("gen/out.go"), PathBuf::from("pkg/a.go")]
+    );
+    assert_eq!(files[1].depth, 2);
+    assert_eq!(stats.files_found, 2);
+}
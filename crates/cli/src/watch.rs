@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Watch mode support (`--watch`).
+//!
+//! The check command re-runs when files change. This module holds the parts
+//! that don't depend on the filesystem watcher: batching bursts of change
+//! events, skipping quench's own writes, and diffing violations between
+//! cycles so each cycle prints only what changed.
+
+use std::collections::HashMap;
+use std::fmt;
+use std::hash::Hash;
+use std::path::Path;
+use std::sync::atomic::{AtomicBool, Ordering};
+use std::sync::mpsc::{Receiver, RecvTimeoutError};
+use std::time::Duration;
+
+use crate::output::violations::ViolationRecord;
+
+/// Quiet period that ends a burst of change events (e.g., an editor save).
+pub const DEBOUNCE: Duration = Duration::from_millis(200);
+
+/// How often to check for Ctrl-C while waiting for the first event.
+const POLL: Duration = Duration::from_millis(100);
+
+/// Directories whose changes never trigger a re-run.
+const IGNORED_DIRS: &[&str] = &[".git", ".quench"];
+
+/// Wait for the next batch of events.
+///
+/// Blocks until an event arrives, then collects further events until none
+/// arrive for `window`. Returns None once `stop` is set or the sender is
+/// dropped.
+pub fn next_batch<T>(rx: &Receiver<T>, window: Duration, stop: &AtomicBool) -> Option<Vec<T>> {
+    let first = loop {
+        if stop.load(Ordering::SeqCst) {
+            return None;
+        }
+        match rx.recv_timeout(POLL) {
+            Ok(event) => break event,
+            Err(RecvTimeoutError::Timeout) => continue,
+            Err(RecvTimeoutError::Disconnected) => return None,
+        }
+    };
+
+    let mut batch = vec![first];
+    while let Ok(event) = rx.recv_timeout(window) {
+        batch.push(event);
+    }
+    (!stop.load(Ordering::SeqCst)).then_some(batch)
+}
+
+/// Whether a change to `path` should trigger a re-run.
+///
+/// Changes under `.git/` and `.quench/` (the cache quench itself writes)
+/// are ignored.
+pub fn is_watched(root: &Path, path: &Path) -> bool {
+    let relative = path.strip_prefix(root).unwrap_or(path);
+    !relative
+        .components()
+        .any(|c| IGNORED_DIRS.iter().any(|dir| c.as_os_str() == *dir))
+}
+
+/// Violations that appeared or went away between two cycles.
+#[derive(Debug, Default, PartialEq, Eq)]
+pub struct Delta {
+    /// Violations in the current cycle only.
+    pub new: Vec<ViolationRecord>,
+    /// Violations in the previous cycle only.
+    pub resolved: Vec<ViolationRecord>,
+}
+
+impl Delta {
+    /// Compare two cycles' violations.
+    ///
+    /// Violations match on check, file, rule, and line. A violation that only
+    /// moved (e.g., lines were inserted above it) matches on check, file, and
+    /// rule, so editing a file doesn't report its untouched violations.
+    pub fn between(previous: &[ViolationRecord], current: &[ViolationRecord]) -> Self {
+        let previous: Vec<&ViolationRecord> = previous.iter().collect();
+        let current: Vec<&ViolationRecord> = current.iter().collect();
+
+        let (resolved, new) = unmatched(&previous, &current, |r| {
+            (r.check.clone(), r.file.clone(), r.rule.clone(), r.line)
+        });
+        let (resolved, new) = unmatched(&resolved, &new, |r| {
+            (r.check.clone(), r.file.clone(), r.rule.clone())
+        });
+
+        Self {
+            new: new.into_iter().cloned().collect(),
+            resolved: resolved.into_iter().cloned().collect(),
+        }
+    }
+
+    /// Whether nothing changed.
+    pub fn is_empty(&self) -> bool {
+        self.new.is_empty() && self.resolved.is_empty()
+    }
+}
+
+impl fmt::Display for Delta {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        for record in &self.new {
+            writeln!(f, "  + {}", Location(record))?;
+        }
+        for record in &self.resolved {
+            writeln!(f, "  - {}", Location(record))?;
+        }
+        Ok(())
+    }
+}
+
+/// One-line violation summary: `main.go:12: unsafe_pointer (escapes)`.
+struct Location<'a>(&'a ViolationRecord);
+
+impl fmt::Display for Location<'_> {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        let record = self.0;
+        match (&record.file, record.line) {
+            (Some(file), Some(line)) => write!(f, "{}:{}: ", file, line)?,
+            (Some(file), None) => write!(f, "{}: ", file)?,
+            (None, _) => {}
+        }
+        write!(f, "{} ({})", record.rule, record.check)
+    }
+}
+
+/// Pair up records with equal keys, returning the leftovers on each side.
+fn unmatched<'a, K: Hash + Eq>(
+    previous: &[&'a ViolationRecord],
+    current: &[&'a ViolationRecord],
+    key: impl Fn(&ViolationRecord) -> K,
+) -> (Vec<&'a ViolationRecord>, Vec<&'a ViolationRecord>) {
+    let mut counts: HashMap<K, usize> = HashMap::new();
+    for record in previous {
+        *counts.entry(key(record)).or_default() += 1;
+    }
+
+    let mut new = Vec::new();
+    for &record in current {
+        match counts.get_mut(&key(record)) {
+            Some(count) if *count > 0 => *count -= 1,
+            _ => new.push(record),
+        }
+    }
+
+    // Previous records left unclaimed, keeping their order
+    let mut resolved = Vec::new();
+    for &record in previous.iter().rev() {
+        if let Some(count) = counts.get_mut(&key(record))
+            && *count > 0
+        {
+            *count -= 1;
+            resolved.push(record);
+        }
+    }
+    resolved.reverse();
+
+    (resolved, new)
+}
+
+#[cfg(test)]
+#[path = "watch_tests.rs"]
+mod tests;
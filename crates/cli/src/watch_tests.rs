@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+use std::sync::mpsc;
+use std::thread;
+
+use super::*;
+use crate::output::violations::Severity;
+
+fn record(file: &str, line: u32, rule: &str) -> ViolationRecord {
+    ViolationRecord {
+        file: Some(file.to_string()),
+        line: Some(line),
+        column: None,
+        rule: rule.to_string(),
+        rule_id: crate::output::violations::stable_rule_id(rule),
+        message: "Add a // SAFETY: comment.".to_string(),
+        severity: Severity::Error,
+        check: "escapes".to_string(),
+        count: None,
+    }
+}
+
+#[test]
+fn first_cycle_reports_everything_as_new() {
+    let current = vec![record("main.go", 3, "unsafe_pointer")];
+    let delta = Delta::between(&[], &current);
+    assert_eq!(delta.new, current);
+    assert!(delta.resolved.is_empty());
+}
+
+#[test]
+fn unchanged_cycle_is_empty() {
+    let violations = vec![
+        record("main.go", 3, "unsafe_pointer"),
+        record("main.go", 9, "unsafe_pointer"),
+    ];
+    assert!(Delta::between(&violations, &violations).is_empty());
+}
+
+#[test]
+fn reports_new_and_resolved() {
+    let previous = vec![
+        record("legacy.go", 7, "unsafe_pointer"),
+        record("main.go", 3, "go_linkname"),
+    ];
+    let current = vec![
+        record("main.go", 3, "go_linkname"),
+        record("main.go", 12, "unsafe_pointer"),
+    ];
+
+    let delta = Delta::between(&previous, &current);
+    assert_eq!(delta.new, vec![record("main.go", 12, "unsafe_pointer")]);
+    assert_eq!(
+        delta.resolved,
+        vec![record("legacy.go", 7, "unsafe_pointer")]
+    );
+}
+
+#[test]
+fn moved_violation_is_not_reported() {
+    // Two lines inserted above both violations, one new violation added
+    let previous = vec![
+        record("main.go", 3, "unsafe_pointer"),
+        record("main.go", 9, "unsafe_pointer"),
+    ];
+    let current = vec![
+        record("main.go", 1, "unsafe_pointer"),
+        record("main.go", 5, "unsafe_pointer"),
+        record("main.go", 11, "unsafe_pointer"),
+    ];
+
+    let delta = Delta::between(&previous, &current);
+    assert_eq!(delta.new.len(), 1);
+    assert!(delta.resolved.is_empty());
+}
+
+#[test]
+fn display_lists_changes() {
+    let delta = Delta {
+        new: vec![record("main.go", 12, "unsafe_pointer")],
+        resolved: vec![record("legacy.go", 7, "unsafe_pointer")],
+    };
+    assert_eq!(
+        delta.to_string(),
+        "  + main.go:12: unsafe_pointer (escapes)\n  - legacy.go:7: unsafe_pointer (escapes)\n"
+    );
+}
+
+#[test]
+fn ignores_git_and_cache_directories() {
+    let root = Path::new("/project");
+    assert!(is_watched(root, Path::new("/project/main.go")));
+    assert!(is_watched(root, Path::new("/project/src/gitignore.go")));
+    assert!(!is_watched(root, Path::new("/project/.git/index")));
+    assert!(!is_watched(root, Path::new("/project/.quench/cache.bin")));
+}
+
+#[test]
+fn next_batch_collects_burst() {
+    let (tx, rx) = mpsc::channel();
+    let stop = AtomicBool::new(false);
+    for i in 0..3 {
+        tx.send(i).unwrap();
+    }
+
+    let batch = next_batch(&rx, Duration::from_millis(20), &stop).unwrap();
+    assert_eq!(batch, vec![0, 1, 2]);
+}
+
+#[test]
+fn next_batch_waits_for_quiet_window() {
+    let (tx, rx) = mpsc::channel();
+    let stop = AtomicBool::new(false);
+    let sender = thread::spawn(move || {
+        tx.send(1).unwrap();
+        thread::sleep(Duration::from_millis(10));
+        tx.send(2).unwrap();
+    });
+
+    let batch = next_batch(&rx, Duration::from_millis(200), &stop).unwrap();
+    sender.join().unwrap();
+    assert_eq!(batch, vec![1, 2]);
+}
+
+#[test]
+fn next_batch_returns_none_when_stopped() {
+    let (_tx, rx) = mpsc::channel::<u32>();
+    let stop = AtomicBool::new(true);
+    assert_eq!(next_batch(&rx, DEBOUNCE, &stop), None);
+}
+
+#[test]
+fn next_batch_returns_none_when_disconnected() {
+    let (tx, rx) = mpsc::channel::<u32>();
+    drop(tx);
+    let stop = AtomicBool::new(false);
+    assert_eq!(next_batch(&rx, DEBOUNCE, &stop), None);
+}
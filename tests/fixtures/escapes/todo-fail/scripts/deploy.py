@@ -0,0 +1,4 @@
+# FIXME[OPS-7] retry on timeout
+def deploy():
+    # TODO retry on timeout
+    return True
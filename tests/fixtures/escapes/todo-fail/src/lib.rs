@@ -0,0 +1,5 @@
+// TODO(CORE-12): cache parsed results
+pub fn parse(input: &str) -> usize {
+    // TODO: handle empty input
+    input.len() // FIXME overflow on huge inputs
+}
@@ -0,0 +1,4 @@
+# FIXME[#7] retry on timeout
+def deploy():
+    # TODO(#8) retry on timeout
+    return True
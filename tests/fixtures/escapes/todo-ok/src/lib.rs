@@ -0,0 +1,5 @@
+// TODO(#12): cache parsed results
+pub fn parse(input: &str) -> usize {
+    // TODOs live in the issue tracker, not here
+    input.len() // FIXME[#40] overflow on huge inputs
+}
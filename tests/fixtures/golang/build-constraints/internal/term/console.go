@@ -0,0 +1,13 @@
+//go:build windows
+
+package term
+
+import "unsafe"
+
+type consoleInfo struct {
+	size [2]int16
+}
+
+func infoPointer(info *consoleInfo) uintptr {
+	return uintptr(unsafe.Pointer(info))
+}
@@ -0,0 +1,19 @@
+package term
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct {
+	rows, cols, x, y uint16
+}
+
+// Width returns the terminal width.
+func Width() int {
+	var ws winsize
+	// SAFETY: ws outlives the ioctl call and matches struct winsize.
+	arg := uintptr(unsafe.Pointer(&ws))
+	syscall.Syscall(syscall.SYS_IOCTL, 1, syscall.TIOCGWINSZ, arg)
+	return int(ws.cols)
+}
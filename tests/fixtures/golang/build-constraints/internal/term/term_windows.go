@@ -0,0 +1,11 @@
+package term
+
+import "unsafe"
+
+// Width returns the console width.
+func Width() int {
+	var info consoleInfo
+	p := unsafe.Pointer(&info)
+	_ = p
+	return int(info.size[0])
+}
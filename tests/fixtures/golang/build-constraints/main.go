@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/fixture/internal/term"
+)
+
+func main() {
+	fmt.Println(term.Width())
+}
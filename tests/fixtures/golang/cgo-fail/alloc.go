@@ -0,0 +1,9 @@
+package main
+
+// Missing CGO comment - should fail
+// #include <stdlib.h>
+import "C"
+
+func release() {
+	C.free(nil)
+}
@@ -0,0 +1,14 @@
+package main
+
+/*
+// CGO: inside the preamble this is C code, not a justification
+#include <math.h>
+*/
+import "C"
+
+import "fmt"
+
+func main() {
+	fmt.Println(C.sqrt(2))
+	release()
+}
@@ -0,0 +1,8 @@
+package main
+
+// #include <stdlib.h>
+import "C" // CGO: C.free releases buffers owned by the C library
+
+func release() {
+	C.free(nil)
+}
@@ -0,0 +1,15 @@
+package main
+
+// CGO: libm's sqrt keeps results bit-identical with the C reference implementation
+
+/*
+#include <math.h>
+*/
+import "C"
+
+import "fmt"
+
+func main() {
+	fmt.Println(C.sqrt(2))
+	release()
+}
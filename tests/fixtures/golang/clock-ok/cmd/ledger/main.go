@@ -0,0 +1,14 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"example.com/fixture/internal/ledger"
+)
+
+// main supplies the wall clock; outside the deterministic packages.
+func main() {
+	book := ledger.New(time.Now)
+	fmt.Println(book.Post(100).Format(time.RFC3339))
+}
@@ -0,0 +1,14 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPost(t *testing.T) {
+	at := time.Now()
+	book := New(func() time.Time { return at })
+	if got := book.Post(100); !got.Equal(at) {
+		t.Fatalf("Post() = %v, want %v", got, at)
+	}
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	stdctx "context"
+	"net/http"
+)
+
+// Get loads a user; a fresh root context drops the request's deadline - should fail
+func Get(w http.ResponseWriter, r *http.Request) {
+	user, err := load(stdctx.Background(), r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	_ = audit(stdctx.TODO(), user)
+	w.Write([]byte(user))
+}
+
+func load(ctx stdctx.Context, id string) (string, error) {
+	return id, ctx.Err()
+}
+
+func audit(ctx stdctx.Context, user string) error {
+	return ctx.Err()
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+)
+
+// main owns the root context; outside the request-scoped packages.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Addr: ":8080"}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	log.Fatal(srv.ListenAndServe())
+}
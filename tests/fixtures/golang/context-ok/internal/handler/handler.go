@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+)
+
+// Get loads a user under the request's context.
+func Get(w http.ResponseWriter, r *http.Request) {
+	user, err := load(r.Context(), r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.Write([]byte(user))
+}
+
+func load(ctx context.Context, id string) (string, error) {
+	return id, ctx.Err()
+}
@@ -0,0 +1,12 @@
+package handler
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	if _, err := load(context.Background(), "ada"); err != nil {
+		t.Fatal(err)
+	}
+}
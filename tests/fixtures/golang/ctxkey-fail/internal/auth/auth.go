@@ -0,0 +1,17 @@
+package auth
+
+import (
+	ctxpkg "context"
+)
+
+const requestIDKey = "request-id"
+
+// WithUser returns a context carrying the user.
+func WithUser(ctx ctxpkg.Context, user string) ctxpkg.Context {
+	return ctxpkg.WithValue(ctx, "user", user)
+}
+
+// WithRequestID returns a context carrying the request ID.
+func WithRequestID(ctx ctxpkg.Context, id string) ctxpkg.Context {
+	return ctxpkg.WithValue(ctx, requestIDKey, id)
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+)
+
+type ctxKey int
+
+const (
+	userKey ctxKey = iota
+	requestIDKey
+)
+
+type tenantKey struct{}
+
+// WithUser returns a context carrying the user.
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey, user)
+}
+
+// WithRequestID returns a context carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// WithTenant returns a context carrying the tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// User returns the user the context carries.
+func User(ctx context.Context) string {
+	user, _ := ctx.Value(userKey).(string)
+	return user
+}
@@ -0,0 +1,45 @@
+package files
+
+import "os"
+
+// ReadAll reads each path in turn.
+func ReadAll(paths []string) ([][]byte, error) {
+	var out [][]byte
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		data, err := readFile(f)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+// Touch creates each path in turn.
+func Touch(paths []string) error {
+	for i := 0; i < len(paths); i++ {
+		if paths[i] != "" {
+			f, err := os.Create(paths[i])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+		}
+	}
+	return nil
+}
+
+func readFile(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, info.Size())
+	_, err = f.Read(buf)
+	return buf, err
+}
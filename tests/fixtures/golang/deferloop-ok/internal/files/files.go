@@ -0,0 +1,46 @@
+package files
+
+import (
+	"os"
+	"sync"
+)
+
+// ReadAll reads each path in turn, closing each file before the next.
+func ReadAll(paths []string) ([][]byte, error) {
+	var out [][]byte
+	for _, path := range paths {
+		data, err := func() ([]byte, error) {
+			f, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			return readFile(f)
+		}()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+// LockAll holds every lock until fn returns.
+func LockAll(locks []*sync.Mutex, fn func()) {
+	for _, mu := range locks {
+		mu.Lock()
+		// DEFERLOOP: every lock is held until fn has run
+		defer mu.Unlock()
+	}
+	fn()
+}
+
+func readFile(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, info.Size())
+	_, err = f.Read(buf)
+	return buf, err
+}
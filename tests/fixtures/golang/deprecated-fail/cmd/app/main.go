@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/fixture/internal/store"
+)
+
+func main() {
+	// store.Get is deprecated - should fail
+	fmt.Println(store.Get("name"))
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/fixture/internal/store"
+)
+
+// main has migrated to store.Load; a mention of store.Get in a comment is fine.
+func main() {
+	v, ok := store.Load("name")
+	fmt.Println(v, ok)
+
+	//quench:ignore deprecated_use reason: removed with the v1 API in the next release
+	fmt.Println(store.Get("legacy"))
+}
@@ -0,0 +1,14 @@
+package store
+
+// Get loads a value by key.
+//
+// Deprecated: Use Load, which reports missing keys.
+func Get(key string) string {
+	v, _ := Load(key)
+	return v
+}
+
+// Load loads a value by key.
+func Load(key string) (string, bool) {
+	return key, key != ""
+}
@@ -0,0 +1,14 @@
+package store_test
+
+import (
+	"testing"
+
+	"example.com/fixture/internal/store"
+)
+
+// Tests keep covering the deprecated API until it is removed.
+func TestGet(t *testing.T) {
+	if store.Get("name") != "name" {
+		t.Fatal("Get")
+	}
+}
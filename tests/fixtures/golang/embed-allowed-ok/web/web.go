@@ -0,0 +1,8 @@
+package web
+
+import "embed"
+
+// Assets holds the bundled front-end files.
+//
+//go:embed static/*
+var Assets embed.FS
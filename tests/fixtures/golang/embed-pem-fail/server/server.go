@@ -0,0 +1,15 @@
+package server
+
+import (
+	_ "embed"
+)
+
+// Banner is printed at startup.
+//
+//go:embed banner.txt
+var Banner string
+
+// Cert is the TLS certificate.
+//
+//go:embed certs/server.pem
+var Cert []byte
@@ -0,0 +1,13 @@
+package server
+
+import (
+	"net/http"
+	"os"
+)
+
+// Handle discards errors from Write, Close, and os.Remove - should fail
+func Handle(w http.ResponseWriter, resp *http.Response, tmp string) {
+	w.Write([]byte("ok"))
+	resp.Body.Close()
+	os.Remove(tmp)
+}
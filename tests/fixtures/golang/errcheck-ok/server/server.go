@@ -0,0 +1,19 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"os"
+)
+
+// Handle checks or explicitly ignores every error it is given.
+func Handle(w http.ResponseWriter, resp *http.Response, tmp string) error {
+	defer resp.Body.Close()
+	if _, err := w.Write([]byte("ok")); err != nil {
+		return err
+	}
+	// The temp file is best-effort cleanup; a leftover is harmless.
+	_ = os.Remove(tmp)
+	log.Println("handled")
+	return nil
+}
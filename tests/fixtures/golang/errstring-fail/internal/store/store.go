@@ -0,0 +1,17 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned for missing keys.
+var ErrNotFound = errors.New("Key not found")
+
+// Open connects to the store at addr.
+func Open(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("no address given.")
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned for missing keys.
+var ErrNotFound = errors.New("key not found")
+
+// Open connects to the store at addr.
+func Open(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("no address given")
+	}
+	if addr == "legacy" {
+		return errors.New("Postgres 9 is no longer supported")
+	}
+	return fmt.Errorf("TLS handshake with %s: %w", addr, ErrNotFound)
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"context"
+
+	osexec "os/exec"
+)
+
+func run(ctx context.Context) error {
+	return osexec.CommandContext(ctx, "make", "build").Run()
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+	// EXEC: shells out to git for repository status
+	"os/exec"
+)
+
+func main() {
+	// EXEC: fixed program and arguments, no user input
+	out, err := exec.Command("git", "status").Output()
+	fmt.Println(string(out), err)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"context"
+
+	// EXEC: runs the build tool for the generate step
+	osexec "os/exec"
+)
+
+func run(ctx context.Context) error {
+	return osexec.CommandContext(ctx, "make", "build").Run() // EXEC: fixed make target
+}
+
+// Only Command and CommandContext need a justification.
+func lookup() (string, error) {
+	return osexec.LookPath("make")
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	// EXEC: lists user-selected directories
+	"os/exec"
+)
+
+func list(dir string) ([]byte, error) {
+	// EXEC: dir comes from the request path
+	return exec.Command("sh", "-c", fmt.Sprintf("ls -la %s", dir)).Output()
+}
+
+func main() {
+	out, err := list(".")
+	fmt.Println(string(out), err)
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	stdlog "log"
+	"os"
+)
+
+// MustLoad reads the config file or exits - should fail
+func MustLoad(path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		stdlog.Fatalf("reading %s: %v", path, err)
+	}
+	return data
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"example.com/fixture/internal/config"
+)
+
+func main() {
+	data, err := config.Load("server.toml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(data) == 0 {
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,21 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Load reads the config file, leaving failures to the caller.
+func Load(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// Abort ends a child process started by Reexec.
+func Abort() {
+	// EXIT: the re-executed child has no caller to return an error to
+	os.Exit(3)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	_ "unsafe"
+	u "unsafe"
+)
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+//go:noescape
+func memmove(to, from *byte, n uintptr)
+
+type header struct{ data uintptr }
+
+func main() {
+	x := 1
+	if x > 0 {
+		// SAFETY: x outlives h
+		h := (*header)(u.Pointer(&x))
+		fmt.Println(h)
+	}
+	for i := 0; i < 2; i++ {
+		p := u.Pointer(&x)
+		fmt.Println(i, p)
+	}
+}
@@ -0,0 +1,6 @@
+package main
+
+import "unsafe"
+
+// Ignored by .gitignore (*.gen.go) - would fail if scanned
+var API = unsafe.Pointer(nil)
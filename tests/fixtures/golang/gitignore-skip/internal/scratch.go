@@ -0,0 +1,6 @@
+package internal
+
+import "unsafe"
+
+// Ignored by internal/.gitignore - would fail if scanned
+var Scratch = unsafe.Pointer(nil)
@@ -0,0 +1,7 @@
+package main
+
+import "unsafe"
+
+// Re-included by !keep.gen.go, so it is scanned
+// SAFETY: nil pointer is never dereferenced
+var Keep = unsafe.Pointer(nil)
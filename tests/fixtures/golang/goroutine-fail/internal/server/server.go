@@ -0,0 +1,20 @@
+package server
+
+import "net"
+
+// Serve accepts connections and handles each in its own goroutine.
+func Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			handle(conn)
+		}()
+	}
+}
+
+func handle(conn net.Conn) {
+	conn.Close()
+}
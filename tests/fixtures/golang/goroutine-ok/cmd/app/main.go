@@ -0,0 +1,10 @@
+package main
+
+import "time"
+
+// main is outside [golang.goroutine].packages, so it isn't checked.
+func main() {
+	go func() {
+		time.Sleep(time.Second)
+	}()
+}
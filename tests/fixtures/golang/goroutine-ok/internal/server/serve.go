@@ -0,0 +1,17 @@
+package server
+
+import (
+	"log"
+	"net"
+)
+
+func serve(conn net.Conn) {
+	defer logPanic()
+	handle(conn)
+}
+
+func logPanic() {
+	if r := recover(); r != nil {
+		log.Printf("serve panic: %v", r)
+	}
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"log"
+	"net"
+	"sync"
+)
+
+// Serve accepts connections and handles each in its own goroutine.
+func Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("handler panic: %v", r)
+				}
+			}()
+			handle(conn)
+		}()
+	}
+}
+
+// Run serves a connection on a goroutine that recovers in a helper.
+func Run(conn net.Conn) {
+	go serve(conn)
+}
+
+// Wait closes done once every worker has finished.
+func Wait(wg *sync.WaitGroup, done chan struct{}) {
+	// GOROUTINE: only waits and closes a channel; can't panic
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+}
+
+func handle(conn net.Conn) {
+	conn.Close()
+}
@@ -0,0 +1,16 @@
+package api
+
+import (
+	"io"
+	nethttp "net/http"
+)
+
+// Fetch downloads a document through the default client - should fail
+func Fetch(url string) ([]byte, error) {
+	resp, err := nethttp.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// client bounds every request, so a stalled server can't hang callers.
+var client = &http.Client{Timeout: 10 * time.Second}
+
+// Fetch downloads a document through a client with a timeout.
+func Fetch(url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// Stream follows a long-lived event stream, bounded by ctx instead.
+func Stream(req *http.Request) (*http.Response, error) {
+	// HTTP: the request carries a context; a client timeout would cut the stream
+	return http.DefaultClient.Do(req)
+}
@@ -0,0 +1,5 @@
+package fixture
+
+import "unsafe"
+
+var p = unsafe.Pointer(nil)
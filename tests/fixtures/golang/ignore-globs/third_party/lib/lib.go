@@ -0,0 +1,5 @@
+package lib
+
+import "unsafe"
+
+var p = unsafe.Pointer(nil)
@@ -0,0 +1,11 @@
+package codec
+
+var codecs = map[string]string{}
+
+func init() {
+	codecs["json"] = "application/json"
+}
+
+func init() {
+	codecs["yaml"] = "application/yaml"
+}
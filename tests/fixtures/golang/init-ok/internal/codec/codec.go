@@ -0,0 +1,12 @@
+package codec
+
+var codecs = map[string]string{}
+
+// INIT: importers rely on the built-in codecs being registered
+func init() {
+	codecs["json"] = "application/json"
+}
+
+func init() { // INIT: yaml is registered with json
+	codecs["yaml"] = "application/yaml"
+}
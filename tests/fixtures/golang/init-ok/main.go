@@ -0,0 +1,10 @@
+package main
+
+import _ "example.com/fixture/internal/codec"
+
+// Outside [golang.init].packages, so not checked.
+func init() {
+	println("starting")
+}
+
+func main() {}
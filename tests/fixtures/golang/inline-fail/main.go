@@ -0,0 +1,20 @@
+package main
+
+// Missing INLINE comment - should fail
+//go:inline
+func add(a, b int) int {
+	return a + b
+}
+
+// NOINLINE: Keeps a real call frame for the benchmark
+// Stacked directive without its own INLINE justification - should fail
+//go:noinline
+//go:inline
+func double(x int) int {
+	return x * 2
+}
+
+func main() {
+	_ = add(1, 2)
+	_ = double(3)
+}
@@ -0,0 +1,9 @@
+package main
+
+func main() {
+	x := 1
+	// The conversion this excused was removed, so the directive is stale
+	// quench:ignore unsafe-pointer reason: audited 2024
+	p := &x
+	_ = p
+}
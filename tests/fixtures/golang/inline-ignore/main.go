@@ -0,0 +1,11 @@
+package main
+
+import "unsafe"
+
+func main() {
+	x := 1
+	// quench:ignore unsafe-pointer reason: audited 2024, pointer never escapes main
+	p := unsafe.Pointer(&x)
+	q := unsafe.Pointer(&x) //quench:ignore unsafe-pointer reason: audited 2024
+	_, _ = p, q
+}
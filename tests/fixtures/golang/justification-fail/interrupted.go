@@ -0,0 +1,15 @@
+package main
+
+import "unsafe"
+
+// NOSPLIT: This comment belongs to the function above the directive
+func before() {}
+//go:nosplit
+func leaf() {}
+
+func main() {
+	// SAFETY: This comment justifies the line below, not the one after it
+	x := 1
+	_ = unsafe.Pointer(&x)
+	_, _ = runtimeNano(), read(&x)
+}
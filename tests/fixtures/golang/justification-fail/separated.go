@@ -0,0 +1,18 @@
+package main
+
+// CGO: This comment is set off from the import by a blank line
+
+import "C"
+
+import "unsafe"
+
+// LINKNAME: This comment is set off from the directive by a blank line
+
+//go:linkname runtimeNano runtime.nanotime
+func runtimeNano() int64
+
+func read(p *int) int {
+	// SAFETY: This comment is set off from the conversion by a blank line
+
+	return *(*int)(unsafe.Pointer(p))
+}
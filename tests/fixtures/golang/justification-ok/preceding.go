@@ -0,0 +1,60 @@
+package main
+
+// CGO: libm's sqrt keeps results bit-identical with the C reference implementation
+
+// #include <math.h>
+import "C"
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// LINKNAME: runtime.nanotime is the only monotonic clock that doesn't allocate
+//go:linkname runtimeNano runtime.nanotime
+func runtimeNano() int64
+
+// NOESCAPE: The assembly implementation only reads the buffer and
+// never stores the pointer, so it is safe to keep the argument on the stack
+//go:noescape
+func checksum(data []byte) uint32
+
+// NOSPLIT: Leaf function with a tiny fixed-size frame
+//go:nosplit
+func add(a, b int) int { return a + b }
+
+// UINTPTRESCAPES: p is handed to a raw syscall that reads the memory it points to
+//go:uintptrescapes
+func rawRead(p uintptr, n int) int { return n }
+
+// NOCHECKPTR: base points into an mmap'd region the Go heap never sees
+//go:nocheckptr
+func offset(base, n uintptr) uintptr { return base + n }
+
+func view(p *byte, n int) []byte {
+	// SAFETY: Caller guarantees p points to at least n readable bytes
+	return unsafe.Slice(p, n)
+}
+
+func text(b []byte) string {
+	// SAFETY: b is owned by the caller and never written after this call
+	return unsafe.String(&b[0], len(b))
+}
+
+func header(b []byte) uintptr {
+	// SAFETY: The header is only inspected, never converted back to a slice
+	h := reflect.SliceHeader{Len: len(b), Cap: len(b)}
+	return h.Data
+}
+
+func sqrt(x float64) float64 {
+	return float64(C.sqrt(C.double(x)))
+}
+
+func main() {
+	b := []byte("test")
+	_ = view(&b[0], len(b))
+	_, _, _ = text(b), header(b), sqrt(2)
+	_, _, _ = runtimeNano(), checksum(b), add(1, 2)
+	_, _ = rawRead(0, 0), offset(0, 0)
+}
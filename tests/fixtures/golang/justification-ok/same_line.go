@@ -0,0 +1,27 @@
+package main
+
+// #include <stdlib.h>
+import "C" // CGO: C.free releases buffers owned by the C library
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+func release(p *C.char) {
+	C.free(unsafe.Pointer(p)) // SAFETY: p was allocated by C.malloc and is not used again
+}
+
+func bytesOf(p *byte, n int) []byte {
+	return unsafe.Slice(p, n) // SAFETY: Caller guarantees n readable bytes at p
+}
+
+func stringOf(b []byte) string {
+	return unsafe.String(&b[0], len(b)) // SAFETY: b is never written after this call
+}
+
+func length(b []byte) int {
+	var h reflect.SliceHeader // SAFETY: Only the Len field is read
+	h.Len = len(b)
+	return h.Len
+}
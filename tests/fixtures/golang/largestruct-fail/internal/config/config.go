@@ -0,0 +1,6 @@
+package config
+
+// Load reads the service configuration.
+func Load(path string) (Config, error) {
+	return Config{Path: path}, nil
+}
@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+// Config is the service configuration.
+type Config struct {
+	Path, Addr, User, Token string
+	Timeout                 time.Duration
+}
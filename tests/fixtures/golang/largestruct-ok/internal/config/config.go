@@ -0,0 +1,24 @@
+package config
+
+import "time"
+
+// Config is the service configuration.
+type Config struct {
+	Path, Addr, User, Token string
+	Timeout                 time.Duration
+}
+
+// Point is small enough to return by value.
+type Point struct {
+	X, Y float64
+}
+
+// Load reads the service configuration.
+func Load(path string) (*Config, error) {
+	return &Config{Path: path}, nil
+}
+
+// Origin returns the zero point.
+func Origin() Point {
+	return Point{}
+}
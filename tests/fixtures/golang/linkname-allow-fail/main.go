@@ -0,0 +1,13 @@
+package main
+
+import _ "unsafe"
+
+//go:linkname runtimeNano runtime.nanotime
+func runtimeNano() int64
+
+//go:linkname semacquire sync.runtime_Semacquire
+func semacquire(addr *uint32)
+
+func main() {
+	_ = runtimeNano()
+}
@@ -0,0 +1,14 @@
+package main
+
+import _ "unsafe"
+
+//go:linkname runtimeNano runtime.nanotime
+func runtimeNano() int64
+
+//go:linkname fastrand runtime.fastrand
+func fastrand() uint32
+
+func main() {
+	_ = runtimeNano()
+	_ = fastrand()
+}
@@ -0,0 +1,13 @@
+package main
+
+import _ "unsafe"
+
+// Missing LINKNAME comment on the one-argument form - should fail
+//go:linkname hook
+func hook() int {
+	return 1
+}
+
+func main() {
+	_ = hook()
+}
@@ -0,0 +1,13 @@
+package lib
+
+import _ "unsafe"
+
+// LINKNAME: Exposes helper under the name generated code links against
+//go:linkname helper example.com/fixture/lib.generatedHelper
+func helper() int {
+	return 1
+}
+
+// LINKNAME: Reads the runtime's monotonic clock
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
@@ -0,0 +1,21 @@
+package cache
+
+import "sync"
+
+// Cache holds items shared between request handlers.
+type Cache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+
+// Set stores an item.
+func (c *Cache) Set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+}
+
+// Get reads an item without taking the lock.
+func (c *Cache) Get(key string) string {
+	return c.items[key]
+}
@@ -0,0 +1,39 @@
+package cache
+
+import "sync"
+
+// Cache holds items shared between request handlers.
+type Cache struct {
+	mu    sync.RWMutex
+	items map[string]string
+}
+
+// Set stores an item.
+func (c *Cache) Set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+}
+
+// Get reads an item under the read lock.
+func (c *Cache) Get(key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.items[key]
+}
+
+// Evict removes every item for which keep returns false.
+func (c *Cache) Evict(keep func(string) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.items {
+		if !keep(key) {
+			c.evictLocked(key)
+		}
+	}
+}
+
+// evictLocked removes an item; the caller holds c.mu.
+func (c *Cache) evictLocked(key string) {
+	delete(c.items, key)
+}
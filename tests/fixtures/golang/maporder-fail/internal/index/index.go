@@ -0,0 +1,10 @@
+package index
+
+// Names lists the documents in the index.
+func Names(byName map[string]int) []string {
+	var names []string
+	for name := range byName {
+		names = append(names, name)
+	}
+	return names
+}
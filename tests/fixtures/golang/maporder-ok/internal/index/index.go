@@ -0,0 +1,22 @@
+package index
+
+import "sort"
+
+// Names lists the documents in the index, sorted.
+func Names(byName map[string]int) []string {
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Total sums the ids; the order doesn't matter.
+func Total(byName map[string]int) int {
+	total := 0
+	for _, id := range byName {
+		total += id
+	}
+	return total
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/api/internal/config"
+)
+
+func main() {
+	fmt.Println(config.Name())
+}
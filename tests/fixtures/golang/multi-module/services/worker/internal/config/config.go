@@ -0,0 +1,6 @@
+package config
+
+// Name returns the service name.
+func Name() string {
+	return "worker"
+}
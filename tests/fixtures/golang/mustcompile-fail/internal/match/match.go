@@ -0,0 +1,19 @@
+package match
+
+import (
+	re "regexp"
+)
+
+const wordPattern = `^\w+$`
+
+var word = re.MustCompile(wordPattern)
+
+// Matcher compiles a caller's pattern - should fail
+func Matcher(pattern string) *re.Regexp {
+	return re.MustCompile(pattern)
+}
+
+// Prefix matches names starting with prefix - should fail
+func Prefix(prefix string) *re.Regexp {
+	return re.MustCompilePOSIX("^" + prefix)
+}
@@ -0,0 +1,23 @@
+package match
+
+import "regexp"
+
+var (
+	word  = regexp.MustCompile(`^\w+$`)
+	email = regexp.MustCompile(emailPattern)
+)
+
+// Valid reports whether s is a word or an email address.
+func Valid(s string) bool {
+	return word.MatchString(s) || email.MatchString(s)
+}
+
+// Matcher compiles a caller's pattern, returning its error.
+func Matcher(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(pattern)
+}
+
+// Prefix matches names starting with prefix.
+func Prefix(prefix string) *regexp.Regexp {
+	return regexp.MustCompile("^" + regexp.QuoteMeta(prefix))
+}
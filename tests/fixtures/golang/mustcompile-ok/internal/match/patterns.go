@@ -0,0 +1,3 @@
+package match
+
+const emailPattern = `^[^@\s]+@[^@\s]+$`
@@ -0,0 +1,11 @@
+package main
+
+// Missing NOCHECKPTR comment - should fail
+//go:nocheckptr
+func offset(base, n uintptr) uintptr {
+	return base + n
+}
+
+func main() {
+	_ = offset(0, 8)
+}
@@ -0,0 +1,19 @@
+package main
+
+// NOCHECKPTR: base points into an mmap'd region the Go heap never sees
+//go:nocheckptr
+func offset(base, n uintptr) uintptr {
+	return base + n
+}
+
+// NOCHECKPTR: NOSPLIT: Leaf helper that only does address arithmetic
+//go:nocheckptr
+//go:nosplit
+func align(p uintptr) uintptr {
+	return (p + 7) &^ 7
+}
+
+func main() {
+	_ = offset(0, 8)
+	_ = align(3)
+}
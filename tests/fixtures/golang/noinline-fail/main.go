@@ -0,0 +1,20 @@
+package main
+
+// Missing NOINLINE comment - should fail
+//go:noinline
+func slowAdd(a, b int) int {
+	return a + b
+}
+
+// NOSPLIT: Leaf function with a tiny fixed-size frame
+// Stacked directive without its own NOINLINE justification - should fail
+//go:nosplit
+//go:noinline
+func leaf(x int) int {
+	return x * 2
+}
+
+func main() {
+	_ = slowAdd(1, 2)
+	_ = leaf(3)
+}
@@ -0,0 +1,26 @@
+package main
+
+// NOINLINE: Keeps a real call frame so BenchmarkAdd measures the call overhead
+//go:noinline
+func slowAdd(a, b int) int {
+	return a + b
+}
+
+// NOSPLIT: Leaf function with a tiny fixed-size frame
+// NOINLINE: Must appear in stack traces collected by the profiler
+//go:nosplit
+//go:noinline
+func leaf(x int) int {
+	return x * 2
+}
+
+// NOSPLIT: NOINLINE: Called from signal handlers and tracked by name in runtime.Callers
+//go:nosplit
+//go:noinline
+func marker() {}
+
+func main() {
+	_ = slowAdd(1, 2)
+	_ = leaf(3)
+	marker()
+}
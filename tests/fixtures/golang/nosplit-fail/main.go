@@ -0,0 +1,18 @@
+package main
+
+// Missing NOSPLIT comment - should fail
+//go:nosplit
+func fastAdd(a, b int) int {
+	return a + b
+}
+
+// NOESCAPE: Assembly implementation only reads the buffer
+// Stacked directive without its own NOSPLIT justification - should fail
+//go:noescape
+//go:nosplit
+func checksum(data []byte) uint32
+
+func main() {
+	_ = fastAdd(1, 2)
+	_ = checksum([]byte("test"))
+}
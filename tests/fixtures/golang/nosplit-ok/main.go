@@ -0,0 +1,24 @@
+package main
+
+// NOSPLIT: Leaf function with a tiny fixed-size frame, called from signal handlers
+//go:nosplit
+func fastAdd(a, b int) int {
+	return a + b
+}
+
+// NOESCAPE: Assembly implementation only reads the buffer
+// NOSPLIT: Assembly implementation uses no stack beyond its frame
+//go:noescape
+//go:nosplit
+func checksum(data []byte) uint32
+
+// NOESCAPE: NOSPLIT: Assembly leaf that neither retains data nor grows the stack
+//go:noescape
+//go:nosplit
+func xorBlock(dst, src []byte)
+
+func main() {
+	_ = fastAdd(1, 2)
+	_ = checksum([]byte("test"))
+	xorBlock(make([]byte, 4), []byte("test"))
+}
@@ -0,0 +1,26 @@
+package store
+
+import "fmt"
+
+// Open opens the store.
+func Open(path string) *Store {
+	if path == "" {
+		panic("store: empty path")
+	}
+	return &Store{path: path}
+}
+
+// Store is a key-value store.
+type Store struct {
+	path string
+}
+
+// Get returns a value.
+func (s *Store) Get(key string) string {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(fmt.Sprintf("store: get %q: %v", key, r))
+		}
+	}()
+	return s.path + key
+}
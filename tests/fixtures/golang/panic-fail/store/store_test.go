@@ -0,0 +1,9 @@
+package store
+
+import "testing"
+
+func TestOpen(t *testing.T) {
+	if Open("db") == nil {
+		panic("Open returned nil")
+	}
+}
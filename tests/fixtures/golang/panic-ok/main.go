@@ -0,0 +1,9 @@
+package main
+
+import "example.com/fixture/store"
+
+func main() {
+	if store.Open("db") == nil {
+		panic("no store")
+	}
+}
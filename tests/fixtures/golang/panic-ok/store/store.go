@@ -0,0 +1,41 @@
+package store
+
+import "fmt"
+
+// Open opens the store.
+func Open(path string) *Store {
+	if path == "" {
+		// PANIC: an empty path is a programming error, never user input
+		panic("store: empty path")
+	}
+	return &Store{path: path}
+}
+
+// MustGet returns a value or panics.
+func (s *Store) MustGet(key string) string {
+	v, ok := s.lookup(key)
+	if !ok {
+		panic("store: missing " + key) // PANIC: Must* helpers are only used with static keys
+	}
+	return v
+}
+
+// Store is a key-value store.
+type Store struct {
+	path string
+}
+
+// Get returns a value, annotating panics from lookup with the key.
+func (s *Store) Get(key string) string {
+	defer func() {
+		if r := recover(); r != nil {
+			panic(fmt.Sprintf("store: get %q: %v", key, r))
+		}
+	}()
+	v, _ := s.lookup(key)
+	return v
+}
+
+func (s *Store) lookup(key string) (string, bool) {
+	return s.path + key, true
+}
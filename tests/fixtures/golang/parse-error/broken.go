@@ -0,0 +1,8 @@
+package main
+
+import "unsafe"
+
+func greet() {
+	println("hello)
+	_ = unsafe.Pointer(nil)
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func main() {
+	x := int64(1)
+	p := (*int64)(unsafe.Pointer(&x))
+	fmt.Println(*p)
+}
@@ -0,0 +1,5 @@
+package api
+
+import "unsafe"
+
+var p = unsafe.Pointer(nil)
@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("starting")
+	if err := run(); err != nil {
+		println("run failed:", err.Error())
+	}
+}
+
+func run() error {
+	return nil
+}
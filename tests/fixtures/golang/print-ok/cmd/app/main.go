@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"example.com/fixture/internal/trace"
+)
+
+func main() {
+	// println("debug") is only mentioned here
+	fmt.Println("starting")
+	trace.Run()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "run failed:", err)
+	}
+}
+
+func run() error {
+	print := func(args ...any) { fmt.Print(args...) }
+	print("running\n")
+	return nil
+}
@@ -0,0 +1,10 @@
+package main
+
+import "testing"
+
+func TestRun(t *testing.T) {
+	println("running test")
+	if err := run(); err != nil {
+		t.Fatal(err)
+	}
+}
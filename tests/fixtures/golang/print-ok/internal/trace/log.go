@@ -0,0 +1,8 @@
+package trace
+
+import "log"
+
+// println shadows the builtin, sending trace output to the logger.
+func println(args ...any) {
+	log.Println(args...)
+}
@@ -0,0 +1,6 @@
+package trace
+
+// Run logs its steps through the package's println.
+func Run() {
+	println("step 1")
+}
@@ -0,0 +1,24 @@
+package worker
+
+import "log"
+
+// Run calls fn, meaning to log instead of crashing if it panics.
+func Run(fn func()) {
+	fn()
+	if r := recover(); r != nil {
+		log.Printf("worker: recovered: %v", r)
+	}
+}
+
+// Go runs fn in a goroutine.
+func Go(fn func()) {
+	go func() {
+		defer func() {
+			logPanic := func() {
+				_ = recover()
+			}
+			logPanic()
+		}()
+		fn()
+	}()
+}
@@ -0,0 +1,7 @@
+package main
+
+import "example.com/fixture/worker"
+
+func main() {
+	worker.Run(func() {})
+}
@@ -0,0 +1,27 @@
+package worker
+
+import "log"
+
+// Run calls fn, logging instead of crashing if it panics.
+func Run(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("worker: recovered: %v", r)
+		}
+	}()
+	fn()
+}
+
+// Go runs fn in a goroutine that survives panics.
+func Go(fn func()) {
+	go func() {
+		defer logPanic()
+		fn()
+	}()
+}
+
+func logPanic() {
+	if r := recover(); r != nil {
+		log.Printf("worker: recovered: %v", r)
+	}
+}
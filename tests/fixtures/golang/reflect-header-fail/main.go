@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	. "reflect"
+	"unsafe"
+)
+
+func view(b []byte) string {
+	var s string
+	// SAFETY: s is a local string whose header is rewritten below
+	hdr := (*StringHeader)(unsafe.Pointer(&s))
+	hdr.Data = uintptr(unsafe.Pointer(&b[0]))
+	hdr.Len = len(b)
+	return s
+}
+
+func header(b []byte) uintptr {
+	h := SliceHeader{Len: len(b), Cap: len(b)}
+	return h.Data
+}
+
+func main() {
+	b := []byte("test")
+	fmt.Println(view(b), header(b))
+}
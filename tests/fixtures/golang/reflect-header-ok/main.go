@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	. "reflect"
+	"unsafe"
+)
+
+func view(b []byte) string {
+	var s string
+	// SAFETY: s is a local string whose header is rewritten below
+	hdr := (*StringHeader)(unsafe.Pointer(&s))
+	// SAFETY: b outlives s and is never written after this point
+	hdr.Data = uintptr(unsafe.Pointer(&b[0]))
+	hdr.Len = len(b)
+	return s
+}
+
+func header(b []byte) uintptr {
+	// SAFETY: The header is only inspected, never converted back to a slice
+	h := SliceHeader{Len: len(b), Cap: len(b)}
+	return h.Data
+}
+
+func main() {
+	b := []byte("test")
+	fmt.Println(view(b), header(b))
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// view keeps a header it was handed; it never builds or rewrites one.
+type view struct {
+	hdr *reflect.StringHeader
+}
+
+func describe(h *reflect.SliceHeader) string {
+	if h == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("len=%d cap=%d", h.Len, h.Cap)
+}
+
+func main() {
+	var v view
+	fmt.Println(describe(nil), v.hdr == nil)
+}
@@ -0,0 +1,16 @@
+package billing
+
+import (
+	"io"
+	"net/http"
+)
+
+// Fetch downloads an invoice through the default client - should fail
+func Fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
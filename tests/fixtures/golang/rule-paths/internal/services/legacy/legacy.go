@@ -0,0 +1,16 @@
+package legacy
+
+import (
+	"io"
+	"net/http"
+)
+
+// Fetch downloads a record through the default client - excluded
+func Fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
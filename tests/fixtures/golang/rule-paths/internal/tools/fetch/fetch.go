@@ -0,0 +1,16 @@
+package fetch
+
+import (
+	"io"
+	"net/http"
+)
+
+// Fetch downloads a file through the default client - not included
+func Fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
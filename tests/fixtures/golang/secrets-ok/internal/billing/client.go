@@ -0,0 +1,24 @@
+package billing
+
+import "os"
+
+// Client calls the billing API.
+type Client struct {
+	APIKey   string
+	Password string
+}
+
+// defaultToken is replaced at deploy time.
+const defaultToken = "${BILLING_TOKEN}"
+
+// New reads the key from the environment.
+func New() *Client {
+	apiKey := os.Getenv("BILLING_API_KEY")
+	return &Client{APIKey: apiKey, Password: "changeme"}
+}
+
+// Sandbox returns a client for the public sandbox.
+func Sandbox() *Client {
+	//quench:ignore hardcoded_secret reason: published sandbox key from the provider docs
+	return &Client{APIKey: "pk_test_TYooMQauvdEDq54NiTphI7jx"}
+}
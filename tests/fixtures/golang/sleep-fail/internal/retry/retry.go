@@ -0,0 +1,10 @@
+package retry
+
+import clock "time"
+
+// Do calls fn until it succeeds.
+func Do(fn func() error) {
+	for fn() != nil {
+		clock.Sleep(clock.Second)
+	}
+}
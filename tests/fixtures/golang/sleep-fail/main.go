@@ -0,0 +1,10 @@
+package main
+
+import "time"
+
+func main() {
+	go serve()
+	time.Sleep(100 * time.Millisecond)
+}
+
+func serve() {}
@@ -0,0 +1,13 @@
+package main
+
+import "time"
+
+func main() {
+	for attempt := 1; dial() != nil; attempt++ {
+		// SLEEP: backoff between reconnects; the server drops bursts
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+	<-time.After(time.Second) // waiting on a channel is fine
+}
+
+func dial() error { return nil }
@@ -0,0 +1,6 @@
+package worker
+
+// Start launches the worker.
+func Start(done chan<- struct{}) {
+	go func() { close(done) }()
+}
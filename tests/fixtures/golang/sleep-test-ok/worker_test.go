@@ -0,0 +1,13 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStart(t *testing.T) {
+	done := make(chan struct{})
+	Start(done)
+	time.Sleep(10 * time.Millisecond)
+	<-done
+}
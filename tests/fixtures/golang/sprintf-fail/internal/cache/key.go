@@ -0,0 +1,8 @@
+package cache
+
+import "fmt"
+
+// Key joins a namespace and an id into a cache key.
+func Key(namespace, id string) string {
+	return fmt.Sprintf("%s%s", namespace, id)
+}
@@ -0,0 +1,18 @@
+package cache
+
+import "fmt"
+
+// Key joins a namespace and an id into a cache key.
+func Key(namespace, id string) string {
+	return namespace + id
+}
+
+// Path formats a namespaced path.
+func Path(namespace, id string) string {
+	return fmt.Sprintf("%s/%s", namespace, id)
+}
+
+// Shard names a numbered shard.
+func Shard(name string, n int) string {
+	return fmt.Sprintf("%s%d", name, n)
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/fixture/internal/sys"
+	"example.com/fixture/internal/sys/unixutil"
+)
+
+func main() {
+	fmt.Println(sys.Getpid(), unixutil.Getppid())
+}
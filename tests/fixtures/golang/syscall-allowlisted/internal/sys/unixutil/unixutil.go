@@ -0,0 +1,8 @@
+package unixutil
+
+import sysunix "golang.org/x/sys/unix"
+
+// Getppid returns the parent process ID.
+func Getppid() int {
+	return sysunix.Getppid()
+}
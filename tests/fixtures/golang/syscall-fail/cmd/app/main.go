@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+	. "syscall"
+
+	unix "golang.org/x/sys/unix"
+)
+
+func main() {
+	fmt.Println(Getpid(), unix.Getppid())
+}
@@ -0,0 +1,8 @@
+package sys
+
+import "syscall"
+
+// Getpid returns the process ID.
+func Getpid() int {
+	return syscall.Getpid()
+}
@@ -0,0 +1,8 @@
+package proc
+
+import "syscall"
+
+// Kill sends SIGTERM to a process.
+func Kill(pid int) error {
+	return syscall.Kill(pid, syscall.SIGTERM)
+}
@@ -0,0 +1,12 @@
+package proc
+
+import (
+	"syscall"
+	"testing"
+)
+
+func TestKill(t *testing.T) {
+	if err := Kill(syscall.Getpid() + 1000000); err == nil {
+		t.Skip("no such process expected")
+	}
+}
@@ -0,0 +1,15 @@
+package main
+
+import "unsafe"
+
+// Missing UINTPTRESCAPES comment - should fail
+//go:uintptrescapes
+func rawRead(p uintptr, n int) int {
+	return n
+}
+
+func main() {
+	buf := make([]byte, 8)
+	// SAFETY: buf is live for the duration of the call
+	_ = rawRead(uintptr(unsafe.Pointer(&buf[0])), len(buf))
+}
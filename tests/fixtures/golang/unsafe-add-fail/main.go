@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+func second(xs []int64) int64 {
+	// SAFETY: xs[0] is addressable for as long as xs is live.
+	base := unsafe.Pointer(&xs[0])
+	next := unsafe.Add(base, unsafe.Sizeof(xs[0]))
+	return *(*int64)(next)
+}
+
+func main() {
+	fmt.Println(second([]int64{1, 2}))
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+	u "unsafe"
+)
+
+type header struct{ data uintptr }
+
+func main() {
+	x := 1
+	h := (*header)(u.Pointer(&x))
+	p := u.Pointer(u.Pointer(h))
+	fmt.Println(h, p)
+}
@@ -0,0 +1,9 @@
+package buf
+
+import "unsafe"
+
+// Bytes views a string's bytes without copying.
+func Bytes(s string) []byte {
+	// SAFETY: callers never write to the returned slice.
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
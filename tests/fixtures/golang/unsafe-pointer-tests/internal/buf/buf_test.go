@@ -0,0 +1,14 @@
+package buf
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestBytesSharesMemory(t *testing.T) {
+	s := "hello"
+	b := Bytes(s)
+	if unsafe.Pointer(&b[0]) != unsafe.Pointer(unsafe.StringData(s)) {
+		t.Fatal("expected shared memory")
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	u "unsafe"
+)
+
+func view(p *byte, n int) []byte {
+	s := u.Slice(p, n)
+	_ = u.SliceData(s)
+	return s
+}
+
+func text(b []byte) string {
+	s := u.String(&b[0], len(b))
+	_ = u.StringData(s)
+	return s
+}
+
+func main() {
+	b := []byte("test")
+	fmt.Println(view(&b[0], len(b)), text(b))
+}
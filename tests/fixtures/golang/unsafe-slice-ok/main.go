@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	u "unsafe"
+)
+
+func view(p *byte, n int) []byte {
+	// SAFETY: Caller guarantees p points to at least n readable bytes
+	s := u.Slice(p, n)
+	// SAFETY: s is non-empty, so SliceData returns its backing array
+	_ = u.SliceData(s)
+	return s
+}
+
+func text(b []byte) string {
+	// SAFETY: b is owned by the caller and never written after this call
+	s := u.String(&b[0], len(b))
+	// SAFETY: The returned pointer is only read, never written
+	_ = u.StringData(s)
+	return s
+}
+
+func main() {
+	b := []byte("test")
+	fmt.Println(view(&b[0], len(b)), text(b))
+}
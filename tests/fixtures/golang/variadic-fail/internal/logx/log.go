@@ -0,0 +1,21 @@
+package logx
+
+import (
+	"fmt"
+	"os"
+)
+
+// Infof writes an informational message.
+func Infof(format string, args ...any) {
+	fmt.Printf(format, args)
+}
+
+// Wrapf formats a message as an error.
+func Wrapf(format string, args ...interface{}) error {
+	return fmt.Errorf(format, args)
+}
+
+// Warnf writes a warning to stderr.
+func Warnf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format, args...)
+}
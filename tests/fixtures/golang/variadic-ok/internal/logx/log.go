@@ -0,0 +1,31 @@
+package logx
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Infof writes an informational message.
+func Infof(format string, args ...any) {
+	fmt.Printf(format, args...)
+}
+
+// Warnf writes a warning to stderr.
+func Warnf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
+// Describe formats the arguments with their count.
+func Describe(args ...any) string {
+	return fmt.Sprintf("%d args: %v", len(args), args)
+}
+
+// Join formats each value and joins them.
+func Join(values []any) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, fmt.Sprint(v))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,29 @@
+package pool
+
+import "sync"
+
+// RunAll runs each task in its own goroutine and waits for them.
+func RunAll(tasks []func()) {
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		go func() {
+			wg.Add(1)
+			defer wg.Done()
+			task()
+		}()
+	}
+	wg.Wait()
+}
+
+// RunEach runs each task with a WaitGroup passed to its goroutine.
+func RunEach(tasks []func()) {
+	wg := &sync.WaitGroup{}
+	for _, task := range tasks {
+		go func(group *sync.WaitGroup, run func()) {
+			group.Add(1)
+			defer group.Done()
+			run()
+		}(wg, task)
+	}
+	wg.Wait()
+}
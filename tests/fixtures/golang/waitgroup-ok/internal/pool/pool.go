@@ -0,0 +1,47 @@
+package pool
+
+import "sync"
+
+// RunAll runs each task in its own goroutine and waits for them.
+func RunAll(tasks []func()) {
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task()
+		}()
+	}
+	wg.Wait()
+}
+
+// RunEach runs each task with a WaitGroup passed to its goroutine.
+func RunEach(tasks []func()) {
+	wg := &sync.WaitGroup{}
+	wg.Add(len(tasks))
+	for _, task := range tasks {
+		go func(group *sync.WaitGroup, run func()) {
+			defer group.Done()
+			run()
+		}(wg, task)
+	}
+	wg.Wait()
+}
+
+// Fan runs a goroutine that starts one worker per task, counting each
+// before it starts.
+func Fan(tasks []func()) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, task := range tasks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				task()
+			}()
+		}
+	}()
+	wg.Wait()
+}
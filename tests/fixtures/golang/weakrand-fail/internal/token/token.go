@@ -0,0 +1,11 @@
+package token
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// New returns a session token; math/rand makes it guessable - should fail
+func New() string {
+	return fmt.Sprintf("%016x", rand.Uint64())
+}
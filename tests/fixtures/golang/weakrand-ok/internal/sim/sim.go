@@ -0,0 +1,8 @@
+package sim
+
+import "math/rand"
+
+// Roll simulates a die; outside the security-sensitive packages.
+func Roll() int {
+	return rand.Intn(6) + 1
+}
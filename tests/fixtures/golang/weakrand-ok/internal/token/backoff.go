@@ -0,0 +1,13 @@
+package token
+
+import (
+	"time"
+
+	// WEAKRAND: retry jitter only spreads load; nothing depends on it being unpredictable
+	"math/rand/v2"
+)
+
+// Backoff returns a jittered retry delay.
+func Backoff(attempt int) time.Duration {
+	return time.Duration(attempt)*time.Second + time.Duration(rand.IntN(1000))*time.Millisecond
+}
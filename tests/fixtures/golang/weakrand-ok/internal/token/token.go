@@ -0,0 +1,15 @@
+package token
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// New returns a session token.
+func New() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,7 @@
+package main
+
+import "unsafe"
+
+var p = unsafe.Pointer(nil)
+
+func main() {}
@@ -0,0 +1 @@
+breakpoint()
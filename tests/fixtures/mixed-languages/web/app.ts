@@ -0,0 +1 @@
+export const render = new Function("src", "return src");
@@ -0,0 +1,17 @@
+"""Dangerous calls without justification - should fail."""
+
+import os
+import pickle
+import subprocess as sp
+from builtins import exec as run_code
+
+
+def run(cmd: str, payload: bytes, source: str) -> None:
+    os.system(cmd)
+    sp.run(
+        cmd,
+        shell=True,
+    )
+    obj = pickle.loads(payload)
+    run_code(source)
+    print(eval(source), obj)
@@ -0,0 +1,26 @@
+"""Dangerous calls with justification, and lookalikes - should pass."""
+
+import os
+import pickle
+import subprocess
+
+
+class Expr:
+    def eval(self) -> int:
+        return 0
+
+
+def run(cmd: str, payload: bytes, expr: Expr) -> None:
+    # SAFETY: cmd is a constant from the deployment manifest
+    os.system(cmd)
+    # SAFETY: cmd is built from an allowlist of tool names
+    subprocess.run(
+        cmd,
+        shell=True,
+    )
+    # Argument lists don't go through a shell
+    subprocess.run(["ls", "-l"])
+    # SAFETY: payload comes from our own signed cache
+    obj = pickle.loads(payload)
+    # Method calls and strings are not builtin eval
+    print(expr.eval(), "eval(source)", 'os.system("rm")', obj)
@@ -0,0 +1,6 @@
+/// Read a value through a raw pointer.
+/// Missing SAFETY comment - should fail.
+pub unsafe fn read_raw(ptr: *const u32) -> u32 {
+    // SAFETY: Caller guarantees the pointer is valid.
+    unsafe { *ptr }
+}
@@ -0,0 +1,15 @@
+/// Read a value through a raw pointer.
+///
+/// # Safety
+///
+/// The pointer must be non-null, aligned, and point to an initialized u32.
+pub unsafe fn read_raw(ptr: *const u32) -> u32 {
+    // SAFETY: Caller guarantees the pointer is valid.
+    unsafe { *ptr }
+}
+
+// SAFETY: Only called by the C library with a valid, NUL-terminated buffer.
+pub unsafe extern "C" fn on_message(buf: *const u8) -> u8 {
+    // SAFETY: The C library guarantees buf points to at least one byte.
+    unsafe { *buf }
+}
@@ -0,0 +1,5 @@
+/// Handle to a foreign resource.
+pub struct Handle(*mut u8);
+
+// Missing SAFETY comment - should fail.
+unsafe impl Send for Handle {}
@@ -0,0 +1,6 @@
+/// Handle to a foreign resource.
+pub struct Handle(*mut u8);
+
+// SAFETY: The resource is owned by the handle and never aliased, so moving
+// it to another thread is sound.
+unsafe impl Send for Handle {}
@@ -0,0 +1,5 @@
+/// Types that can be viewed as raw bytes.
+/// Missing SAFETY comment - should fail.
+pub unsafe trait RawBytes {
+    fn as_bytes(&self) -> &[u8];
+}
@@ -0,0 +1,21 @@
+/// Types that can be viewed as raw bytes.
+///
+/// # Safety
+///
+/// Implementors must have no padding and no invalid bit patterns.
+pub unsafe trait RawBytes {
+    fn as_bytes(&self) -> &[u8];
+}
+
+/// Plain data with a fixed layout.
+#[repr(C)]
+pub struct Pixel {
+    pub rgba: [u8; 4],
+}
+
+// SAFETY: Pixel is repr(C) with a single u8 array field, so it has no padding.
+unsafe impl RawBytes for Pixel {
+    fn as_bytes(&self) -> &[u8] {
+        &self.rgba
+    }
+}
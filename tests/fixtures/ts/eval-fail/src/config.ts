@@ -0,0 +1,3 @@
+export function loadConfig(source: string): unknown {
+  return eval(source);
+}
@@ -0,0 +1,4 @@
+export function loadConfig(source: string): unknown {
+  // SAFETY: source is the bundled defaults file, never user input
+  return eval(source);
+}
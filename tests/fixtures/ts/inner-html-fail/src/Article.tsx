@@ -0,0 +1,3 @@
+export function Article({ html }: { html: string }) {
+  return <div className="article" dangerouslySetInnerHTML={{ __html: html }} />;
+}
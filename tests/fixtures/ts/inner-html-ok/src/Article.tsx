@@ -0,0 +1,6 @@
+import { sanitize } from './sanitize';
+
+export function Article({ html }: { html: string }) {
+  // SAFETY: html is passed through sanitize, which strips scripts and handlers
+  return <div className="article" dangerouslySetInnerHTML={{ __html: sanitize(html) }} />;
+}
@@ -0,0 +1,3 @@
+export function sanitize(html: string): string {
+  return html.replace(/<script[^>]*>.*?<\/script>/gi, '');
+}
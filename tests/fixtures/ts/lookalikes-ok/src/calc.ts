@@ -0,0 +1,17 @@
+import { Parser } from './parser';
+
+// Never call eval(input) here; the parser handles expressions.
+const HELP = "Unlike eval(), this only supports arithmetic";
+const WARN = 'new Function(...) is not used';
+const NOTE = `dangerouslySetInnerHTML is not needed ${HELP.length}`;
+const CALL = /eval\(/;
+
+/*
+ * eval(expr) and new Function(expr) are both banned by the CSP.
+ */
+export function calculate(parser: Parser, input: string): number {
+  if (CALL.test(input)) {
+    throw new Error(WARN + NOTE);
+  }
+  return parser.eval(input);
+}
@@ -0,0 +1,5 @@
+export class Parser {
+  eval(input: string): number {
+    return Number(input);
+  }
+}
@@ -0,0 +1,3 @@
+export function compile(body) {
+  return new Function('data', body);
+}
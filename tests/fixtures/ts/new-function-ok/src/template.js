@@ -0,0 +1,4 @@
+export function compile(body) {
+  // SAFETY: body comes from templates checked in under src/templates
+  return new Function('data', body);
+}
@@ -0,0 +1,7 @@
+package generated
+
+import "unsafe"
+
+func Addr(x *int) unsafe.Pointer {
+	return unsafe.Pointer(x)
+}
@@ -0,0 +1,9 @@
+package main
+
+import "unsafe"
+
+func main() {
+	x := 1
+	p := unsafe.Pointer(&x)
+	_ = p
+}
@@ -27,6 +27,9 @@ mod cli_commands;
 #[path = "specs/cli/flags.rs"]
 mod cli_flags;
 
+#[path = "specs/cli/paths.rs"]
+mod cli_paths;
+
 #[path = "specs/cli/toggles.rs"]
 mod cli_toggles;
 
@@ -101,6 +104,21 @@ mod modes_ratchet;
 #[path = "specs/modes/large_files.rs"]
 mod modes_large_files;
 
+#[path = "specs/modes/baseline.rs"]
+mod modes_baseline;
+
+#[path = "specs/modes/inline_ignore.rs"]
+mod modes_inline_ignore;
+
+#[path = "specs/modes/severity.rs"]
+mod modes_severity;
+
+#[path = "specs/modes/diff.rs"]
+mod modes_diff;
+
+#[path = "specs/modes/stdin.rs"]
+mod modes_stdin;
+
 // adapters/
 #[path = "specs/adapters/mod.rs"]
 mod adapters;
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Behavioral specs for per-file adapter dispatch.
+//!
+//! Tests that quench correctly:
+//! - Applies each file's adapter rules by extension, whatever the project language
+//! - Skips adapter rules for files with unknown extensions
+//! - Honors `--only-lang` and `--lang`
+//!
+//! Reference: docs/specs/10-language-adapters.md#adapter-selection
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+fn patterns_by_file(escapes: &CheckJson) -> Vec<(String, String)> {
+    let mut found: Vec<_> = escapes
+        .violations()
+        .iter()
+        .map(|v| {
+            (
+                v["file"].as_str().unwrap_or_default().to_string(),
+                v["pattern"].as_str().unwrap_or_default().to_string(),
+            )
+        })
+        .collect();
+    found.sort();
+    found
+}
+
+/// Spec: docs/specs/10-language-adapters.md#adapter-selection
+///
+/// > each file is then dispatched to an adapter by its extension alone
+#[test]
+fn mixed_tree_dispatches_each_file_to_its_adapter() {
+    let escapes = check("escapes").on("mixed-languages").json().fails();
+    assert_eq!(
+        patterns_by_file(&escapes),
+        vec![
+            ("main.go".to_string(), "unsafe_pointer".to_string()),
+            ("tools/gen.py".to_string(), "breakpoint".to_string()),
+            ("web/app.ts".to_string(), "new_function".to_string()),
+        ]
+    );
+}
+
+/// Spec: docs/specs/10-language-adapters.md#adapter-selection
+///
+/// > Files with any other extension have no adapter
+#[test]
+fn unknown_extension_gets_no_adapter_rules() {
+    let escapes = check("escapes").on("mixed-languages").json().fails();
+    assert!(!escapes.has_violation_for_file("notes.txt"));
+}
+
+/// Spec: docs/specs/10-language-adapters.md#overrides
+///
+/// > `--only-lang <LANG,...>` checks only files dispatched to the listed adapters
+#[test]
+fn only_lang_checks_just_those_files() {
+    let escapes = check("escapes")
+        .on("mixed-languages")
+        .args(&["--only-lang", "ts,py"])
+        .json()
+        .fails();
+    assert_eq!(
+        patterns_by_file(&escapes),
+        vec![
+            ("tools/gen.py".to_string(), "breakpoint".to_string()),
+            ("web/app.ts".to_string(), "new_function".to_string()),
+        ]
+    );
+}
+
+/// Spec: docs/specs/10-language-adapters.md#overrides
+///
+/// > `--only-lang <LANG,...>` checks only files dispatched to the listed adapters
+#[test]
+fn only_lang_go_skips_other_languages() {
+    check("escapes")
+        .on("mixed-languages")
+        .args(&["--only-lang", "go"])
+        .fails()
+        .stdout_has("main.go")
+        .stdout_lacks("app.ts")
+        .stdout_lacks("gen.py");
+}
+
+/// Spec: docs/specs/10-language-adapters.md#overrides
+///
+/// > `--lang <LANG>` does the same for one run.
+#[test]
+fn lang_override_shown_in_verbose_output() {
+    check("escapes")
+        .on("mixed-languages")
+        .args(&["--lang", "python", "-v"])
+        .fails()
+        .stderr_has("Language(s): Python (override)");
+}
+
+/// Spec: docs/specs/10-language-adapters.md#overrides
+///
+/// > Unknown names are a configuration error (exit code 2).
+#[test]
+fn unknown_language_is_config_error() {
+    check("escapes")
+        .on("mixed-languages")
+        .args(&["--only-lang", "cobol"])
+        .exits(2)
+        .stderr_has("unknown language 'cobol'");
+}
+
+/// Spec: docs/specs/10-language-adapters.md#overrides
+///
+/// > language = "go"   # skip marker detection
+#[test]
+fn project_language_config_overrides_detection() {
+    let temp = Project::empty();
+    temp.config("[project]\nlanguage = \"go\"\n");
+    temp.file("Cargo.toml", "[package]\nname = \"x\"\n");
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["-v"])
+        .passes()
+        .stderr_has("Language(s): Go (override)");
+}
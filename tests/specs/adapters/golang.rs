@@ -6,8 +6,10 @@
 //! Tests that quench correctly:
 //! - Detects Go projects via go.mod
 //! - Applies default source/test patterns
-//! - Ignores vendor directory
-//! - Applies Go-specific escape patterns (unsafe.Pointer, go:linkname, go:noescape)
+//! - Ignores the vendor directory of each module, including nested modules
+//! - Applies Go-specific escape patterns (unsafe.Pointer, unsafe.Slice, unsafe.String,
+//!   reflect.SliceHeader, reflect.StringHeader, go:linkname, go:noescape, go:nosplit,
+//!   go:uintptrescapes, go:nocheckptr, go:noinline, go:inline, import "C")
 //!
 //! Reference: docs/specs/langs/golang.md
 
@@ -111,6 +113,53 @@ fn custom_exclude_patterns_respected() {
     );
 }
 
+/// Spec: docs/specs/02-config.md#ignore-patterns
+///
+/// > ignore = ["**/*.pb.go", "testdata/", "third_party/"]
+#[test]
+fn pb_go_file_flagged_without_ignore() {
+    check("escapes")
+        .on("golang/pb-unignored")
+        .fails()
+        .stdout_has("api/service.pb.go");
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > `--ignore <GLOB>` | Skip files matching GLOB (repeatable)
+#[test]
+fn ignore_flag_hides_pb_go_file() {
+    check("escapes")
+        .on("golang/pb-unignored")
+        .args(&["--ignore", "**/*.pb.go"])
+        .passes();
+}
+
+/// Spec: docs/specs/02-config.md#ignore-patterns
+///
+/// > Setting the list replaces them, so the example above checks `vendor/`.
+#[test]
+fn configured_ignore_replaces_vendor_default() {
+    check("escapes")
+        .on("golang/ignore-globs")
+        .fails()
+        .stdout_has("vendor/dep/dep.go")
+        .stdout_lacks("service.pb.go")
+        .stdout_lacks("testdata/")
+        .stdout_lacks("third_party/");
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > `--keep-defaults` | Keep default ignores alongside `--ignore` and configured ones
+#[test]
+fn keep_defaults_merges_vendor_default() {
+    check("escapes")
+        .on("golang/ignore-globs")
+        .args(&["--keep-defaults"])
+        .passes();
+}
+
 // =============================================================================
 // MODULE AND PACKAGE DETECTION SPECS
 // =============================================================================
@@ -147,6 +196,48 @@ fn detects_packages_from_directory_structure() {
     );
 }
 
+/// Spec: docs/specs/langs/golang.md#multi-module-repositories
+///
+/// > Each directory with a `go.mod` is a module root, and each module's
+/// > `vendor/` directory is ignored.
+#[test]
+fn nested_module_vendor_directory_ignored() {
+    check("escapes").on("golang/multi-module").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#multi-module-repositories
+///
+/// > With several modules, packages are named by import path, so
+/// > `internal/config` in two modules stays two packages.
+#[test]
+fn internal_packages_in_different_modules_reported_separately() {
+    let cloc = check("cloc").on("golang/multi-module").json().passes();
+    let by_package = cloc.require("by_package");
+
+    for name in [
+        "example.com/api/internal/config",
+        "example.com/worker/internal/config",
+        "example.com/api",
+        "example.com/worker",
+    ] {
+        let package = by_package
+            .get(name)
+            .unwrap_or_else(|| panic!("missing package {}", name));
+        assert_eq!(
+            package.get("source_files").and_then(|v| v.as_u64()),
+            Some(1),
+            "{} should have exactly its own file",
+            name
+        );
+    }
+    assert!(
+        by_package
+            .as_object()
+            .is_some_and(|packages| packages.keys().all(|k| !k.contains("vendor"))),
+        "vendored packages should not be reported"
+    );
+}
+
 // =============================================================================
 // ESCAPE PATTERN SPECS - unsafe.Pointer
 // =============================================================================
@@ -171,52 +262,1759 @@ fn unsafe_pointer_with_safety_comment_passes() {
     check("escapes").on("golang/unsafe-pointer-ok").passes();
 }
 
+/// Spec: docs/specs/03-output.md#text-format
+///
+/// > Columns point into the source as written: the column of `unsafe`, or of
+/// > the alias for an aliased import, not the start of the line.
+#[test]
+fn unsafe_pointer_reports_exact_column() {
+    let escapes = check("escapes")
+        .on("golang/unsafe-pointer-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(violations[0].get("line").and_then(|l| l.as_u64()), Some(7));
+    assert_eq!(
+        violations[0].get("column").and_then(|c| c.as_u64()),
+        Some(9)
+    );
+}
+
+/// Spec: docs/specs/03-output.md#text-format
+///
+/// > `u.Pointer(&x)` through an aliased `unsafe` import reports the column of `u`.
+#[test]
+fn aliased_unsafe_pointer_columns_point_at_alias() {
+    let escapes = check("escapes")
+        .on("golang/unsafe-pointer-columns")
+        .json()
+        .fails();
+    let locations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| Some((v.get("line")?.as_u64()?, v.get("column")?.as_u64()?)))
+        .collect();
+    assert_eq!(locations, vec![(12, 17), (13, 7), (13, 17)]);
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > Every occurrence is its own violation by default, so two `unsafe.Pointer`
+/// > conversions on one line are reported twice, each at its own column.
+#[test]
+fn repeated_unsafe_pointer_reports_each_occurrence() {
+    let escapes = check("escapes")
+        .on("golang/unsafe-pointer-repeat")
+        .json()
+        .fails();
+    let locations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| Some((v.get("line")?.as_u64()?, v.get("column")?.as_u64()?)))
+        .collect();
+    assert_eq!(locations, vec![(10, 16), (10, 43)]);
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `--dedup line` collapses violations of the same rule on the same line
+/// > into the first one, with a count.
+#[test]
+fn dedup_line_collapses_repeated_unsafe_pointer() {
+    let escapes = check("escapes")
+        .on("golang/unsafe-pointer-repeat")
+        .args(&["--dedup", "line"])
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(
+        violations[0].get("column").and_then(|c| c.as_u64()),
+        Some(16)
+    );
+    assert_eq!(violations[0].get("count").and_then(|c| c.as_u64()), Some(2));
+
+    check("escapes")
+        .on("golang/unsafe-pointer-repeat")
+        .args(&["--dedup", "line"])
+        .fails()
+        .stdout_has("  main.go\n    10:16: missing_comment: unsafe_pointer (x2)");
+}
+
+/// Spec: docs/specs/02-config.md#rules
+///
+/// > `apply_to_tests = false` skips test code for the rule
+#[test]
+fn unsafe_pointer_in_test_file_skipped_when_not_applied_to_tests() {
+    check("escapes").on("golang/unsafe-pointer-tests").passes();
+}
+
+/// Spec: docs/specs/02-config.md#rules
+///
+/// > `apply_to_tests = true` checks an escape pattern in test code with its
+/// > source action
+#[test]
+fn unsafe_pointer_in_test_file_fails_when_applied_to_tests() {
+    let temp = Project::empty();
+    temp.config("[rules.unsafe_pointer]\napply_to_tests = true\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "buf_test.go",
+        "package p\n\nimport \"unsafe\"\n\nfunc view(b []byte) unsafe.Pointer {\n\treturn unsafe.Pointer(&b[0])\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  buf_test.go\n    5:21: missing_comment: unsafe_pointer");
+}
+
+/// Spec: docs/specs/02-config.md#rules
+///
+/// > Rules that check test files on their own, like `go_panic` with
+/// > `library_only = false`, skip them too
+#[test]
+fn analyzer_skips_test_files_when_not_applied_to_tests() {
+    let temp = Project::empty();
+    temp.config(
+        "[golang.panic]\ncheck = \"error\"\nlibrary_only = false\n\n[rules.go_panic]\napply_to_tests = false\n",
+    );
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "p_test.go",
+        "package p\n\nimport \"testing\"\n\nfunc TestP(t *testing.T) {\n\tpanic(\"boom\")\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
 // =============================================================================
 // ESCAPE PATTERN SPECS - go:linkname
 // =============================================================================
 
 /// Spec: docs/specs/langs/golang.md#default-escape-patterns
 ///
-/// > `//go:linkname` requires `// LINKNAME:` comment explaining why.
+/// > `//go:linkname` requires `// LINKNAME:` comment explaining why.
+#[test]
+fn go_linkname_without_linkname_comment_fails() {
+    check("escapes")
+        .on("golang/linkname-fail")
+        .fails()
+        .stdout_has("escapes: FAIL")
+        .stdout_has("// LINKNAME:");
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:linkname` with `// LINKNAME:` comment passes.
+#[test]
+fn go_linkname_with_linkname_comment_passes() {
+    check("escapes").on("golang/linkname-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#linkname-directions
+///
+/// > Both forms need the comment: the one-argument `//go:linkname local`,
+/// > which exports a local symbol, and the two-argument form.
+#[test]
+fn go_linkname_one_argument_form_without_comment_fails() {
+    let escapes = check("escapes")
+        .on("golang/linkname-export-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(
+        violations[0].get("pattern").and_then(|p| p.as_str()),
+        Some("go_linkname")
+    );
+    assert_eq!(violations[0].get("line").and_then(|l| l.as_u64()), Some(6));
+}
+
+/// Spec: docs/specs/langs/golang.md#linkname-directions
+///
+/// > Directives whose target is listed in `allow` need no comment.
+#[test]
+fn go_linkname_allowlisted_targets_pass() {
+    check("escapes").on("golang/linkname-allow-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#linkname-directions
+///
+/// > Any other target still needs one.
+#[test]
+fn go_linkname_unlisted_target_still_needs_comment() {
+    let escapes = check("escapes")
+        .on("golang/linkname-allow-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(
+        violations[0].get("pattern").and_then(|p| p.as_str()),
+        Some("go_linkname")
+    );
+    assert_eq!(violations[0].get("line").and_then(|l| l.as_u64()), Some(8));
+}
+
+/// Spec: docs/specs/langs/golang.md#linkname-directions
+///
+/// > With `foreign_push = "error"`, a push whose target is in another package
+/// > fails. Pulls, and pushes into the file's own package, are not flagged.
+#[test]
+fn go_linkname_foreign_push_fails_when_enabled() {
+    let escapes = check("escapes").on("golang/linkname-push").json().fails();
+    let violations: Vec<_> = escapes
+        .violations_of_type("forbidden")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?.to_string(),
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+            ))
+        })
+        .collect();
+    assert_eq!(
+        violations,
+        vec![(
+            "lib/push.go".to_string(),
+            6,
+            24,
+            "go_linkname_push".to_string()
+        )]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#linkname-directions
+///
+/// > Pushes are allowed by default.
+#[test]
+fn go_linkname_push_with_comment_passes_by_default() {
+    let temp = Project::empty();
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "lib/push.go",
+        "package lib\n\nimport _ \"unsafe\"\n\n// LINKNAME: Replaces the runtime's random source\n//go:linkname fastrand runtime.fastrand\nfunc fastrand() uint32 {\n\treturn 4\n}\n",
+    );
+
+    temp.config("");
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.linkname]\nforeign_push = \"warn\"\n");
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  lib/push.go\n    6:24: forbidden: go_linkname_push");
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - go:noescape
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:noescape` requires `// NOESCAPE:` comment explaining why; a
+/// > missing one is a warning by default.
+#[test]
+fn go_noescape_without_noescape_comment_warns() {
+    check("escapes")
+        .on("golang/noescape-fail")
+        .passes()
+        .stdout_has("// NOESCAPE:");
+
+    check("escapes")
+        .on("golang/noescape-fail")
+        .args(&["--fail-on", "warning"])
+        .exits(1);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:noescape` with `// NOESCAPE:` comment passes.
+#[test]
+fn go_noescape_with_noescape_comment_passes() {
+    check("escapes").on("golang/noescape-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - go:nosplit
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:nosplit` requires `// NOSPLIT:` comment explaining why.
+#[test]
+fn go_nosplit_without_nosplit_comment_fails() {
+    let escapes = check("escapes").on("golang/nosplit-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    // Both the lone directive and the stacked one lacking its own justification
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_nosplit"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![4, 12]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:nosplit` with `// NOSPLIT:` comment passes.
+/// > Stacked directives need their own comments or a combined one
+/// > (`// NOESCAPE: NOSPLIT: reason`).
+#[test]
+fn go_nosplit_with_nosplit_comment_passes() {
+    check("escapes").on("golang/nosplit-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - go:uintptrescapes / go:nocheckptr
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:uintptrescapes` requires `// UINTPTRESCAPES:` comment explaining why.
+#[test]
+fn go_uintptrescapes_without_comment_fails() {
+    let escapes = check("escapes")
+        .on("golang/uintptrescapes-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_uintptrescapes"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![6]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:uintptrescapes` with `// UINTPTRESCAPES:` comment passes.
+#[test]
+fn go_uintptrescapes_with_comment_passes() {
+    check("escapes").on("golang/uintptrescapes-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:nocheckptr` requires `// NOCHECKPTR:` comment explaining why.
+#[test]
+fn go_nocheckptr_without_comment_fails() {
+    let escapes = check("escapes").on("golang/nocheckptr-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_nocheckptr"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![4]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:nocheckptr` with `// NOCHECKPTR:` comment passes, including a
+/// > combined justification for stacked directives.
+#[test]
+fn go_nocheckptr_with_comment_passes() {
+    check("escapes").on("golang/nocheckptr-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - go:noinline / go:inline
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:noinline` requires `// NOINLINE:` comment explaining why. A stacked
+/// > directive's justification doesn't cover it.
+#[test]
+fn go_noinline_without_comment_fails() {
+    let escapes = check("escapes").on("golang/noinline-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_noinline"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![4, 12]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:noinline` with `// NOINLINE:` comment passes, including a
+/// > combined justification for stacked directives.
+#[test]
+fn go_noinline_with_comment_passes() {
+    check("escapes").on("golang/noinline-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:inline` requires `// INLINE:` comment explaining why. A stacked
+/// > `//go:noinline` justification doesn't cover it.
+#[test]
+fn go_inline_without_comment_fails() {
+    let escapes = check("escapes").on("golang/inline-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_inline"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![4, 12]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `//go:inline` with `// INLINE:` comment passes, including a combined
+/// > justification for stacked directives.
+#[test]
+fn go_inline_with_comment_passes() {
+    check("escapes").on("golang/inline-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - cgo
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `import "C"` requires a `// CGO:` comment. The cgo preamble is C code,
+/// > so a `CGO:` marker inside it doesn't count.
+#[test]
+fn cgo_import_without_cgo_comment_fails() {
+    let escapes = check("escapes").on("golang/cgo-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let mut locations: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("cgo_import"))
+        .filter_map(|v| Some((v.get("file")?.as_str()?, v.get("line")?.as_u64()?)))
+        .collect();
+    locations.sort();
+    assert_eq!(locations, vec![("alloc.go", 5), ("main.go", 7)]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `import "C"` with a `// CGO:` comment outside the preamble passes.
+#[test]
+fn cgo_import_with_cgo_comment_passes() {
+    check("escapes").on("golang/cgo-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - os/exec
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `import "os/exec"` and `exec.Command`, `exec.CommandContext` require a
+/// > `// EXEC:` comment, including under an alias.
+#[test]
+fn exec_without_exec_comment_fails() {
+    let escapes = check("escapes").on("golang/exec-fail").json().fails();
+
+    let mut locations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?,
+                v.get("line")?.as_u64()?,
+                v.get("pattern")?.as_str()?,
+            ))
+        })
+        .collect();
+    locations.sort();
+    assert_eq!(
+        locations,
+        vec![
+            ("main.go", 5, "exec_import"),
+            ("main.go", 9, "exec_command"),
+            ("run.go", 6, "exec_import"),
+            ("run.go", 10, "exec_command"),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `os/exec` imports and commands with an `// EXEC:` comment pass.
+#[test]
+fn exec_with_exec_comment_passes() {
+    check("escapes").on("golang/exec-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `exec.Command(... fmt.Sprintf(...))` | forbid
+#[test]
+fn exec_with_formatted_command_string_forbidden() {
+    let escapes = check("escapes")
+        .on("golang/exec-sprintf-fail")
+        .json()
+        .fails();
+    let v = escapes.require_violation("forbidden");
+    assert_eq!(
+        v.get("pattern").and_then(|p| p.as_str()),
+        Some("exec_sprintf")
+    );
+    assert_eq!(v.get("line").and_then(|l| l.as_u64()), Some(11));
+    assert!(!escapes.has_violation("missing_comment"));
+}
+
+// =============================================================================
+// JUSTIFICATION PLACEMENT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/checks/escape-hatches.md#comment-detection
+///
+/// > The justification is accepted as a trailing comment on the same line,
+/// > or in the contiguous comment block directly above the pattern.
+#[test]
+fn justification_on_same_line_or_preceding_block_passes() {
+    check("escapes").on("golang/justification-ok").passes();
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#comment-detection
+///
+/// > The block ends at the first blank line or code line above the pattern.
+#[test]
+fn justification_separated_by_blank_or_code_line_fails() {
+    let escapes = check("escapes")
+        .on("golang/justification-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let mut locations: Vec<_> = violations
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?,
+                v.get("line")?.as_u64()?,
+                v.get("pattern")?.as_str()?,
+            ))
+        })
+        .collect();
+    locations.sort();
+    assert_eq!(
+        locations,
+        vec![
+            ("interrupted.go", 7, "go_nosplit"),
+            ("interrupted.go", 13, "unsafe_pointer"),
+            ("separated.go", 5, "cgo_import"),
+            ("separated.go", 11, "go_linkname"),
+            ("separated.go", 17, "unsafe_pointer"),
+        ]
+    );
+}
+
+// =============================================================================
+// GENERATED FILE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#generated-files
+///
+/// > Files with a `// Code generated ... DO NOT EDIT.` header are skipped.
+#[test]
+fn generated_files_skipped_by_default() {
+    check("escapes").on("golang/generated-skip").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#generated-files
+///
+/// > `--include-generated` checks generated files like any other.
+#[test]
+fn include_generated_checks_generated_files() {
+    let escapes = check("escapes")
+        .on("golang/generated-skip")
+        .args(&["--include-generated"])
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let mut locations: Vec<_> = violations
+        .iter()
+        .filter_map(|v| Some((v.get("file")?.as_str()?, v.get("line")?.as_u64()?)))
+        .collect();
+    locations.sort();
+    assert_eq!(
+        locations,
+        vec![
+            ("api.pb.go", 16),
+            ("mocks/store.go", 15),
+            ("mocks/store.go", 16)
+        ]
+    );
+}
+
+// =============================================================================
+// BUILD CONSTRAINT SPECS
+// =============================================================================
+
+fn escape_files(escapes: &CheckJson) -> Vec<&str> {
+    let mut files: Vec<_> = escapes
+        .violations()
+        .iter()
+        .filter_map(|v| v.get("file")?.as_str())
+        .collect();
+    files.sort();
+    files
+}
+
+/// Spec: docs/specs/langs/golang.md#build-constraints
+///
+/// > By default every file is checked, whatever its build constraints.
+#[test]
+fn build_constrained_files_checked_by_default() {
+    let escapes = check("escapes")
+        .on("golang/build-constraints")
+        .json()
+        .fails();
+    assert_eq!(
+        escape_files(&escapes),
+        vec!["internal/term/console.go", "internal/term/term_windows.go"]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#build-constraints
+///
+/// > Files are then skipped as `go build -tags linux,amd64` would skip them.
+#[test]
+fn build_tags_skip_files_for_other_platforms() {
+    check("escapes")
+        .on("golang/build-constraints")
+        .args(&["--build-tags", "linux,amd64"])
+        .passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#build-constraints
+///
+/// > a `_GOOS`, `_GOARCH`, or `_GOOS_GOARCH` file name suffix for another
+/// > platform
+#[test]
+fn build_tags_keep_files_for_their_platform() {
+    let escapes = check("escapes")
+        .on("golang/build-constraints")
+        .args(&["--build-tags", "windows,amd64"])
+        .json()
+        .fails();
+    assert_eq!(
+        escape_files(&escapes),
+        vec!["internal/term/console.go", "internal/term/term_windows.go"]
+    );
+}
+
+// =============================================================================
+// SYSCALL IMPORT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#syscall-imports
+///
+/// > Imports of `syscall` and `golang.org/x/sys/...` outside allowlisted
+/// > packages are violations, including aliased and dot imports.
+#[test]
+fn syscall_imports_outside_allowlist_fail() {
+    let escapes = check("escapes").on("golang/syscall-fail").json().fails();
+    let violations = escapes.violations_of_type("forbidden");
+
+    let mut locations: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("syscall_import"))
+        .filter_map(|v| Some((v.get("file")?.as_str()?, v.get("line")?.as_u64()?)))
+        .collect();
+    locations.sort();
+    assert_eq!(
+        locations,
+        vec![
+            ("cmd/app/main.go", 5),
+            ("cmd/app/main.go", 7),
+            ("pkg/proc/proc.go", 3)
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#syscall-imports
+///
+/// > The violation names the `[golang.syscall].allow` key to extend.
+#[test]
+fn syscall_import_advice_suggests_allowlist_key() {
+    let escapes = check("escapes").on("golang/syscall-fail").json().fails();
+    let violation = escapes
+        .violations_of_type("forbidden")
+        .into_iter()
+        .find(|v| v.get("file").and_then(|f| f.as_str()) == Some("pkg/proc/proc.go"))
+        .expect("proc.go violation");
+
+    assert_eq!(
+        violation.get("advice").and_then(|a| a.as_str()),
+        Some(
+            "Direct use of \"syscall\" is restricted to wrapper packages. \
+Call the wrapper instead, or add \"pkg/proc\" to [golang.syscall].allow."
+        )
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#syscall-imports
+///
+/// > Packages on the allowlist may import syscall packages; `/...` covers
+/// > subpackages.
+#[test]
+fn syscall_imports_in_allowlisted_packages_pass() {
+    check("escapes").on("golang/syscall-allowlisted").passes();
+}
+
+// =============================================================================
+// PANIC JUSTIFICATION SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#panic-calls
+///
+/// > With `[golang.panic]` enabled, `panic(...)` in library packages needs a
+/// > `// PANIC:` comment; `main` packages and `_test.go` files are skipped.
+#[test]
+fn panic_without_comment_in_library_fails() {
+    let escapes = check("escapes").on("golang/panic-fail").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let locations: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_panic"))
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?,
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+            ))
+        })
+        .collect();
+    assert_eq!(
+        locations,
+        vec![("store/store.go", 8, 3), ("store/store.go", 22, 4)]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#panic-calls
+///
+/// > Justified panics pass, and `allow_repanic` permits re-panics in a
+/// > deferred function that calls `recover()`.
+#[test]
+fn panic_with_comment_or_repanic_passes() {
+    check("escapes").on("golang/panic-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#panic-calls
+///
+/// > The rule is off by default.
+#[test]
+fn panic_rule_is_opt_in() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "store/store.go",
+        "package store\n\nfunc Open() {\n\tpanic(\"unimplemented\")\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// RECOVER PLACEMENT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#recover-calls
+///
+/// > `recover()` outside a deferred function is an error by default,
+/// > including in a function literal nested in a deferred one.
+#[test]
+fn recover_outside_deferred_function_fails() {
+    let escapes = check("escapes").on("golang/recover-fail").json().fails();
+    let locations: Vec<_> = escapes
+        .violations_of_type("forbidden")
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("go_recover"))
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?,
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+            ))
+        })
+        .collect();
+    assert_eq!(
+        locations,
+        vec![("worker/worker.go", 8, 10), ("worker/worker.go", 18, 9)]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#recover-calls
+///
+/// > Calls in `defer func() { ... }()` bodies, and in named functions the
+/// > file defers, pass.
+#[test]
+fn recover_in_deferred_function_passes() {
+    check("escapes").on("golang/recover-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#recover-calls
+///
+/// > Set `check = "off"` to disable the rule.
+#[test]
+fn recover_rule_can_be_disabled() {
+    let temp = Project::empty();
+    temp.config("[golang.recover]\ncheck = \"off\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file("main.go", "package main\n\nfunc main() {\n\trecover()\n}\n");
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// EMBED DIRECTIVE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#embed-directives
+///
+/// > With `sensitive` globs set, only `//go:embed` patterns matching one are
+/// > flagged, and the message names the pattern and the glob.
+#[test]
+fn embed_of_sensitive_file_fails() {
+    let escapes = check("escapes").on("golang/embed-pem-fail").json().fails();
+    let violations = escapes.violations_of_type("forbidden");
+
+    assert_eq!(violations.len(), 1);
+    let v = &violations[0];
+    assert_eq!(v.get("pattern").and_then(|p| p.as_str()), Some("go_embed"));
+    assert_eq!(
+        v.get("file").and_then(|f| f.as_str()),
+        Some("server/server.go")
+    );
+    assert_eq!(v.get("line").and_then(|l| l.as_u64()), Some(14));
+    let advice = v.get("advice").and_then(|a| a.as_str()).unwrap();
+    assert!(advice.contains("certs/server.pem"), "advice: {advice}");
+    assert!(advice.contains("'*.pem'"), "advice: {advice}");
+}
+
+/// Spec: docs/specs/langs/golang.md#embed-directives
+///
+/// > Patterns matching `allow` are never flagged.
+#[test]
+fn embed_on_allowlist_passes() {
+    check("escapes").on("golang/embed-allowed-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#embed-directives
+///
+/// > Without `sensitive` globs, every `//go:embed` pattern is flagged.
+#[test]
+fn embed_without_sensitive_globs_flags_every_pattern() {
+    let temp = Project::empty();
+    temp.config("[golang.embed]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "web/web.go",
+        "package web\n\nimport \"embed\"\n\n//go:embed static/*\nvar Assets embed.FS\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  web/web.go\n    5:12: forbidden: go_embed");
+}
+
+/// Spec: docs/specs/langs/golang.md#embed-directives
+///
+/// > The rule is off by default.
+#[test]
+fn embed_rule_is_opt_in() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "server/server.go",
+        "package server\n\nimport _ \"embed\"\n\n//go:embed certs/server.pem\nvar Cert []byte\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// UNCHECKED ERROR SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#unchecked-errors
+///
+/// > A call to a listed function that stands alone as a statement discards
+/// > its error.
+#[test]
+fn discarded_errors_fail() {
+    let escapes = check("escapes").on("golang/errcheck-fail").json().fails();
+    let violations: Vec<_> = escapes
+        .violations_of_type("forbidden")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+            ))
+        })
+        .collect();
+    assert_eq!(
+        violations,
+        vec![
+            (10, 2, "unchecked_error".to_string()),
+            (11, 2, "unchecked_error".to_string()),
+            (12, 2, "unchecked_error".to_string()),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#unchecked-errors
+///
+/// > Assigning the result, even to `_`, handles it; `defer` and `go`
+/// > statements aren't reported.
+#[test]
+fn handled_errors_pass() {
+    check("escapes").on("golang/errcheck-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#unchecked-errors
+///
+/// > The rule is off by default.
+#[test]
+fn errcheck_rule_is_opt_in() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"os\"\n\nfunc main() {\n\tos.Remove(\"tmp\")\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.errcheck]\ncheck = \"error\"\nfunctions = [\"os.Remove\"]\n");
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  main.go\n    6:2: forbidden: unchecked_error");
+}
+
+// =============================================================================
+// WEAK RANDOM SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#weak-random-sources
+///
+/// > In packages matching `packages`, `math/rand` and `math/rand/v2` imports
+/// > are flagged with advice to use `crypto/rand`.
+#[test]
+fn weak_rand_in_sensitive_package_fails() {
+    let escapes = check("escapes").on("golang/weakrand-fail").json().fails();
+    let violations = escapes.violations_of_type("forbidden");
+
+    assert_eq!(violations.len(), 1);
+    let v = &violations[0];
+    assert_eq!(v.get("pattern").and_then(|p| p.as_str()), Some("weak_rand"));
+    assert_eq!(
+        v.get("file").and_then(|f| f.as_str()),
+        Some("internal/token/token.go")
+    );
+    assert_eq!(v.get("line").and_then(|l| l.as_u64()), Some(5));
+    assert_eq!(v.get("column").and_then(|c| c.as_u64()), Some(2));
+    let advice = v.get("advice").and_then(|a| a.as_str()).unwrap();
+    assert!(advice.contains("crypto/rand"), "advice: {advice}");
+}
+
+/// Spec: docs/specs/langs/golang.md#weak-random-sources
+///
+/// > Packages outside the globs aren't checked, and a `// WEAKRAND:` comment
+/// > allows an import.
+#[test]
+fn weak_rand_outside_scope_or_justified_passes() {
+    check("escapes").on("golang/weakrand-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#weak-random-sources
+///
+/// > Globs also match import paths.
+#[test]
+fn weak_rand_packages_match_import_paths() {
+    let temp = Project::empty();
+    temp.config("[golang.weakrand]\ncheck = \"warn\"\npackages = [\"example.com/p/**/keys\"]\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "internal/keys/keys.go",
+        "package keys\n\nimport \"math/rand/v2\"\n\nvar Seed = rand.Uint64()\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  internal/keys/keys.go\n    3:8: forbidden: weak_rand");
+}
+
+// =============================================================================
+// SLEEP SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#sleep-calls
+///
+/// > Opt in to require a `// SLEEP:` comment on each call. Calls are matched
+/// > under the name `time` is imported as.
+#[test]
+fn sleep_without_comment_fails() {
+    let escapes = check("escapes").on("golang/sleep-fail").json().fails();
+    let mut violations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?.to_string(),
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+            ))
+        })
+        .collect();
+    violations.sort();
+    assert_eq!(
+        violations,
+        vec![
+            (
+                "internal/retry/retry.go".to_string(),
+                8,
+                3,
+                "go_sleep".to_string()
+            ),
+            ("main.go".to_string(), 7, 2, "go_sleep".to_string()),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#sleep-calls
+///
+/// > Violations are `missing_comment` with pattern `go_sleep`.
+#[test]
+fn sleep_with_comment_passes() {
+    check("escapes").on("golang/sleep-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#sleep-calls
+///
+/// > Comments, strings, and `_test.go` files are not checked.
+#[test]
+fn sleep_in_test_file_passes() {
+    check("escapes").on("golang/sleep-test-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#sleep-calls
+///
+/// > Opt in to require a `// SLEEP:` comment on each call
+#[test]
+fn sleep_rule_is_opt_in() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"time\"\n\nfunc main() {\n\ttime.Sleep(time.Second)\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.sleep]\ncheck = \"warn\"\n");
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  main.go\n    6:2: missing_comment: go_sleep");
+}
+
+// =============================================================================
+// INIT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#init-functions
+///
+/// > Violations are `missing_comment` with pattern `go_init`, one per `init()`:
+/// > a file may declare several, and each needs its own comment.
+#[test]
+fn init_without_comment_fails_per_function() {
+    let escapes = check("escapes").on("golang/init-fail").json().fails();
+    let violations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?.to_string(),
+                v.get("line")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+            ))
+        })
+        .collect();
+    // main.go is outside [golang.init].packages
+    assert_eq!(
+        violations,
+        vec![
+            (
+                "internal/codec/codec.go".to_string(),
+                5,
+                "go_init".to_string()
+            ),
+            (
+                "internal/codec/codec.go".to_string(),
+                9,
+                "go_init".to_string()
+            ),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#init-functions
+///
+/// > Opt in to require an `// INIT:` comment on each `init()` function, on the
+/// > same line or in the comment block above
+#[test]
+fn init_with_comment_passes() {
+    check("escapes").on("golang/init-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#init-functions
+///
+/// > `packages = ["internal/**"]     # globs over package dirs and import paths (default: all)`
+#[test]
+fn init_rule_checks_every_package_without_globs() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nfunc init() {\n\tprintln(\"hi\")\n}\n\nfunc main() {}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.init]\ncheck = \"error\"\n");
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  main.go\n    3:1: missing_comment: go_init");
+}
+
+// =============================================================================
+// REQUEST CONTEXT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#request-contexts
+///
+/// > Violations are `forbidden` with pattern `context_background` for
+/// > `context.Background()` and `context_todo` for `context.TODO()`.
+/// > `context_todo` defaults to a warning
+#[test]
+fn root_contexts_in_handler_package_fail() {
+    let escapes = check("escapes").on("golang/context-fail").json().fails();
+    let violations: Vec<_> = escapes
+        .violations_of_type("forbidden")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("file")?.as_str()?.to_string(),
+                v.get("line")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+                v.get("warning").and_then(|w| w.as_bool()) == Some(true),
+            ))
+        })
+        .collect();
+    // cmd/server/main.go is outside [golang.context].packages
+    assert_eq!(
+        violations,
+        vec![
+            (
+                "internal/handler/handler.go".to_string(),
+                10,
+                "context_background".to_string(),
+                false
+            ),
+            (
+                "internal/handler/handler.go".to_string(),
+                15,
+                "context_todo".to_string(),
+                true
+            ),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/golang.md#request-contexts
+///
+/// > Packages outside the globs, such as `main`, and `_test.go` files are not
+/// > checked
+#[test]
+fn propagated_contexts_pass() {
+    check("escapes").on("golang/context-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#request-contexts
+///
+/// > set either with `[severity]`
+#[test]
+fn context_todo_severity_is_configurable() {
+    let temp = Project::empty();
+    temp.config("[golang.context]\ncheck = \"error\"\npackages = [\"api\"]\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "api/api.go",
+        "package api\n\nimport \"context\"\n\nfunc Handle() error {\n\treturn run(context.TODO())\n}\n\nfunc run(ctx context.Context) error {\n\treturn ctx.Err()\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config(
+        "[golang.context]\ncheck = \"error\"\npackages = [\"api\"]\n\n[severity]\ncontext_todo = \"error\"\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  api/api.go\n    6:13: forbidden: context_todo");
+}
+
+// =============================================================================
+// HARDCODED SECRET SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#hardcoded-secrets
+///
+/// > Violations are `forbidden` with pattern `hardcoded_secret`, at the name
+/// > the value is assigned to
+#[test]
+fn hardcoded_secret_fails() {
+    check("escapes")
+        .on("golang/secrets-fail")
+        .fails()
+        .stdout_has("  internal/billing/client.go\n    4:7: forbidden: hardcoded_secret");
+}
+
+/// Spec: docs/specs/langs/golang.md#hardcoded-secrets
+///
+/// > Empty values, placeholders, and templates are skipped, as are
+/// > `_test.go` files
+#[test]
+fn placeholder_and_ignored_secrets_pass() {
+    check("escapes").on("golang/secrets-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#hardcoded-secrets
+///
+/// > `min_entropy = 3.5` # bits per character a value needs to count as a secret
+#[test]
+fn secret_entropy_threshold_is_configurable() {
+    let temp = Project::empty();
+    temp.config("[golang.secrets]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file("db/db.go", "package db\n\nvar dbPassword = \"hunter2\"\n");
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.secrets]\ncheck = \"error\"\nmin_entropy = 2.5\n");
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  db/db.go\n    3:5: forbidden: hardcoded_secret");
+}
+
+// =============================================================================
+// DEPRECATED SYMBOL SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#deprecated-symbols
+///
+/// > Violations are `forbidden` with pattern `deprecated_use`, at the package
+/// > qualifier of the reference
+#[test]
+fn deprecated_use_fails() {
+    check("escapes")
+        .on("golang/deprecated-fail")
+        .fails()
+        .stdout_has("  cmd/app/main.go\n    11:14: forbidden: deprecated_use")
+        .stdout_has("store.Get is deprecated: Use Load, which reports missing keys.");
+}
+
+/// Spec: docs/specs/langs/golang.md#deprecated-symbols
+///
+/// > Uses in comments, strings, and `_test.go` files are not checked
+#[test]
+fn migrated_and_ignored_deprecated_uses_pass() {
+    check("escapes").on("golang/deprecated-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#deprecated-symbols
+///
+/// > Results aren't cached while the rule is on, so a file is reported as
+/// > soon as a symbol it uses is deprecated
+///
+/// Uses quench_cmd() directly, since the CheckBuilder always adds --no-cache.
+#[test]
+fn deprecating_a_symbol_reports_unchanged_callers() {
+    let temp = Project::empty();
+    temp.config("[golang.deprecated]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file("api/api.go", "package api\n\nfunc Old() {}\n");
+    temp.file(
+        "app/app.go",
+        "package app\n\nimport \"example.com/p/api\"\n\nfunc Run() {\n\tapi.Old()\n}\n",
+    );
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success();
+
+    temp.file(
+        "api/api.go",
+        "package api\n\n// Deprecated: Use New.\nfunc Old() {}\n",
+    );
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains(
+            "  app/app.go\n    6:2: forbidden: deprecated_use",
+        ));
+}
+
+// =============================================================================
+// BUILTIN PRINT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#builtin-print
+///
+/// > Violations are `forbidden` with pattern `builtin_print`, at the builtin's name
+#[test]
+fn builtin_println_fails() {
+    check("escapes")
+        .on("golang/print-fail")
+        .fails()
+        .stdout_has("  cmd/app/main.go\n    8:3: forbidden: builtin_print")
+        .stdout_has("The println builtin writes unbuffered to stderr");
+}
+
+/// Spec: docs/specs/langs/golang.md#builtin-print
+///
+/// > A `print` or `println` the package declares, in any of its files, or
+/// > that a function declares as a variable or parameter, shadows the builtin
+#[test]
+fn shadowed_and_test_prints_pass() {
+    check("escapes").on("golang/print-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#builtin-print
+///
+/// > Opt in to flag calls to the `print` and `println` builtins
+#[test]
+fn builtin_print_is_off_by_default() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nfunc main() {\n\tprint(\"x\")\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.print]\ncheck = \"warn\"\n");
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  main.go\n    4:2: forbidden: builtin_print");
+}
+
+// =============================================================================
+// EXPORTED ANY SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#exported-any
+///
+/// > Violations are `missing_comment` with pattern `exported_any`, at the
+/// > function's name.
+#[test]
+fn exported_method_taking_any_fails() {
+    check("escapes")
+        .on("golang/any-fail")
+        .fails()
+        .stdout_has("  pkg/api/client.go\n    7:18: missing_comment: exported_any")
+        .stdout_has("Call takes or returns any")
+        .stdout_lacks("11:");
+}
+
+/// Spec: docs/specs/langs/golang.md#exported-any
+///
+/// > Type parameter lists are skipped, so constraints like `[T any]` aren't
+/// > matched.
+#[test]
+fn justified_generic_and_unexported_any_pass() {
+    check("escapes").on("golang/any-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#exported-any
+///
+/// > optionally only in some packages
+#[test]
+fn exported_any_checks_every_package_without_globs() {
+    let temp = Project::empty();
+    temp.config("[golang.any]\ncheck = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "internal/codec/codec.go",
+        "package codec\n\nfunc Encode(v interface{}) []byte {\n\treturn nil\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  internal/codec/codec.go\n    3:6: missing_comment: exported_any");
+}
+
+// =============================================================================
+// GOROUTINE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#goroutine-recovery
+///
+/// > Violations are `missing_comment` with pattern `go_goroutine`, at the `go`
+/// > keyword.
+#[test]
+fn goroutine_without_recover_fails() {
+    check("escapes")
+        .on("golang/goroutine-fail")
+        .fails()
+        .stdout_has("  internal/server/server.go\n    12:3: missing_comment: go_goroutine")
+        .stdout_has("crashes the whole process");
+}
+
+/// Spec: docs/specs/langs/golang.md#goroutine-recovery
+///
+/// > A launched named function or method (`go serve(c)`, `go s.loop()`) is
+/// > read from its declaration in the same package
+#[test]
+fn recovering_and_justified_goroutines_pass() {
+    check("escapes").on("golang/goroutine-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#goroutine-recovery
+///
+/// > functions from other packages can't be read and need the comment.
+#[test]
+fn goroutine_of_another_package_function_fails() {
+    let temp = Project::empty();
+    temp.config("[golang.goroutine]\ncheck = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "internal/jobs/jobs.go",
+        "package jobs\n\nimport \"example.com/p/internal/worker\"\n\nfunc Start() {\n\tgo worker.Run()\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  internal/jobs/jobs.go\n    6:2: missing_comment: go_goroutine")
+        .stdout_has("worker.Run doesn't defer a recover()");
+}
+
+// =============================================================================
+// LARGE STRUCT RETURN SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#large-struct-returns
+///
+/// > Violations are `forbidden` with pattern `large_struct_return`, at the
+/// > function's name
+#[test]
+fn large_struct_returned_by_value_fails() {
+    check("escapes")
+        .on("golang/largestruct-fail")
+        .fails()
+        .stdout_has("  internal/config/config.go\n    4:6: forbidden: large_struct_return")
+        .stdout_has("Load returns Config by value, copying 72 bytes on every call (max: 64)");
+}
+
+/// Spec: docs/specs/langs/golang.md#large-struct-returns
+///
+/// > func Open() (*Config, error)             // ok: pointer
+#[test]
+fn large_struct_by_pointer_and_small_struct_pass() {
+    check("escapes").on("golang/largestruct-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#large-struct-returns
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn large_struct_return_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.largestruct]\ncheck = \"error\"\nmax_bytes = 16\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "geo/geo.go",
+        "package geo\n\ntype Rect struct {\n\tMin, Max [2]float64\n}\n\nfunc Unit() Rect {\n\treturn Rect{}\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  geo/geo.go\n    7:6: forbidden: large_struct_return");
+}
+
+// =============================================================================
+// SPRINTF CONCATENATION SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#sprintf-concatenation
+///
+/// > Violations are `forbidden` with pattern `sprintf_concat`, at `fmt`
+#[test]
+fn sprintf_of_only_s_verbs_fails() {
+    check("escapes")
+        .on("golang/sprintf-fail")
+        .fails()
+        .stdout_has("  internal/cache/key.go\n    7:9: forbidden: sprintf_concat")
+        .stdout_has("only joins its 2 arguments");
+}
+
+/// Spec: docs/specs/langs/golang.md#sprintf-concatenation
+///
+/// > key := fmt.Sprintf("%s/%s", ns, id)       // ok: the format adds text
+#[test]
+fn sprintf_with_formatting_passes() {
+    check("escapes").on("golang/sprintf-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#sprintf-concatenation
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn sprintf_concat_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.sprintf]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "name.go",
+        "package p\n\nimport \"fmt\"\n\nvar name = fmt.Sprintf(\"%s%s%s\", a, b, c)\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  name.go\n    5:12: forbidden: sprintf_concat");
+}
+
+// =============================================================================
+// WALL CLOCK SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#wall-clock
+///
+/// > Violations are `missing_comment` with pattern `time_now`, at the `time`
+/// > qualifier
+#[test]
+fn time_now_in_deterministic_package_fails() {
+    // cmd/ledger/main.go is outside [golang.clock].packages
+    check("escapes")
+        .on("golang/clock-fail")
+        .fails()
+        .stdout_has("  internal/ledger/ledger.go\n    20:9: missing_comment: time_now")
+        .stdout_has("Package internal/ledger is deterministic")
+        .stdout_lacks("cmd/ledger/main.go");
+}
+
+/// Spec: docs/specs/langs/golang.md#wall-clock
+///
+/// > a `// CLOCK:` comment on the same line or in the comment block above
+/// > accepts a call
+#[test]
+fn injected_or_justified_clock_passes() {
+    check("escapes").on("golang/clock-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#wall-clock
+///
+/// > nothing is checked while `packages` is empty
+#[test]
+fn time_now_without_packages_passes() {
+    let temp = Project::empty();
+    temp.config("[golang.clock]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "stamp.go",
+        "package p\n\nimport \"time\"\n\nvar started = time.Now()\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// DEFAULT HTTP CLIENT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-http-client
+///
+/// > Violations are `missing_comment` with pattern `default_http_client`, at
+/// > the `http` qualifier
+#[test]
+fn default_http_client_fails() {
+    check("escapes")
+        .on("golang/http-fail")
+        .fails()
+        .stdout_has("  internal/api/client.go\n    10:15: missing_comment: default_http_client")
+        .stdout_has("http.Get uses http.DefaultClient");
+}
+
+/// Spec: docs/specs/langs/golang.md#default-http-client
+///
+/// > an `// HTTP:` comment on the same line or in the comment block above
+/// > accepts a use
+#[test]
+fn client_with_timeout_passes() {
+    check("escapes").on("golang/http-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - unsafe.Slice / unsafe.String
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `unsafe.Slice`, `unsafe.SliceData`, `unsafe.String`, and `unsafe.StringData`
+/// > require `// SAFETY:` comment, including through an aliased import.
+#[test]
+fn unsafe_slice_and_string_without_safety_comment_fails() {
+    let escapes = check("escapes")
+        .on("golang/unsafe-slice-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let lines_for = |pattern: &str| -> Vec<u64> {
+        violations
+            .iter()
+            .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some(pattern))
+            .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+            .collect()
+    };
+    assert_eq!(lines_for("unsafe_slice"), vec![9, 10]);
+    assert_eq!(lines_for("unsafe_string"), vec![15, 16]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `unsafe.Slice` and `unsafe.String` with `// SAFETY:` comment pass.
+#[test]
+fn unsafe_slice_and_string_with_safety_comment_passes() {
+    check("escapes").on("golang/unsafe-slice-ok").passes();
+}
+
+// =============================================================================
+// ESCAPE PATTERN SPECS - unsafe.Add / introspection
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `unsafe.Add` requires `// SAFETY:` comment; `unsafe.Sizeof` is allowed
+/// > by default.
+#[test]
+fn unsafe_add_without_safety_comment_fails() {
+    let escapes = check("escapes").on("golang/unsafe-add-fail").json().fails();
+    let violations: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| {
+            Some((
+                v.get("line")?.as_u64()?,
+                v.get("column")?.as_u64()?,
+                v.get("pattern")?.as_str()?.to_string(),
+            ))
+        })
+        .collect();
+    assert_eq!(violations, vec![(11, 10, "unsafe_add".to_string())]);
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > `unsafe.Add` with `// SAFETY:` comment passes.
 #[test]
-fn go_linkname_without_linkname_comment_fails() {
-    check("escapes")
-        .on("golang/linkname-fail")
-        .fails()
-        .stdout_has("escapes: FAIL")
-        .stdout_has("// LINKNAME:");
+fn unsafe_add_with_safety_comment_passes() {
+    check("escapes").on("golang/unsafe-add-ok").passes();
 }
 
-/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+/// Spec: docs/specs/langs/golang.md#unsafe-introspection
 ///
-/// > `//go:linkname` with `// LINKNAME:` comment passes.
+/// > With `introspection = "error"`, `unsafe.Sizeof`, `unsafe.Alignof`, and
+/// > `unsafe.Offsetof` calls require a `// SAFETY:` comment too.
 #[test]
-fn go_linkname_with_linkname_comment_passes() {
-    check("escapes").on("golang/linkname-ok").passes();
+fn unsafe_introspection_requires_comment_when_enabled() {
+    let temp = Project::empty();
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "layout/layout.go",
+        "package layout\n\nimport u \"unsafe\"\n\ntype header struct {\n\tlen  int32\n\tdata [8]byte\n}\n\nvar dataOffset = u.Offsetof(header{}.data)\n",
+    );
+
+    temp.config("");
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.config("[golang.unsafe]\nintrospection = \"error\"\n");
+    let escapes = check("escapes").pwd(temp.path()).json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(
+        violations[0].get("pattern").and_then(|p| p.as_str()),
+        Some("unsafe_introspection")
+    );
+    assert_eq!(violations[0].get("line").and_then(|l| l.as_u64()), Some(10));
+    assert_eq!(
+        violations[0].get("column").and_then(|c| c.as_u64()),
+        Some(18)
+    );
 }
 
 // =============================================================================
-// ESCAPE PATTERN SPECS - go:noescape
+// ESCAPE PATTERN SPECS - reflect.SliceHeader / reflect.StringHeader
 // =============================================================================
 
 /// Spec: docs/specs/langs/golang.md#default-escape-patterns
 ///
-/// > `//go:noescape` requires `// NOESCAPE:` comment explaining why.
+/// > `reflect.SliceHeader` and `reflect.StringHeader` require `// SAFETY:` comment,
+/// > including through a dot-import. Assigning a header's `.Data` field needs its own.
 #[test]
-fn go_noescape_without_noescape_comment_fails() {
-    check("escapes")
-        .on("golang/noescape-fail")
-        .fails()
-        .stdout_has("escapes: FAIL")
-        .stdout_has("// NOESCAPE:");
+fn reflect_header_without_safety_comment_fails() {
+    let escapes = check("escapes")
+        .on("golang/reflect-header-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    // `.Data` assignment on a tracked header, and a dot-imported SliceHeader literal
+    let lines: Vec<_> = violations
+        .iter()
+        .filter(|v| v.get("pattern").and_then(|p| p.as_str()) == Some("reflect_header"))
+        .filter_map(|v| v.get("line").and_then(|l| l.as_u64()))
+        .collect();
+    assert_eq!(lines, vec![13, 19]);
 }
 
 /// Spec: docs/specs/langs/golang.md#default-escape-patterns
 ///
-/// > `//go:noescape` with `// NOESCAPE:` comment passes.
+/// > `reflect.SliceHeader` and `reflect.StringHeader` with `// SAFETY:` comment pass.
 #[test]
-fn go_noescape_with_noescape_comment_passes() {
-    check("escapes").on("golang/noescape-ok").passes();
+fn reflect_header_with_safety_comment_passes() {
+    check("escapes").on("golang/reflect-header-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#default-escape-patterns
+///
+/// > Only header literals, pointer conversions and `.Data` assignments are matched,
+/// > so naming the type in a signature or field needs no comment.
+#[test]
+fn reflect_header_type_mention_passes() {
+    check("escapes")
+        .on("golang/reflect-header-type-ok")
+        .passes();
 }
 
 // =============================================================================
@@ -448,6 +2246,65 @@ lint_config = [".golangci.yml"]
         .passes();
 }
 
+// =============================================================================
+// PARSE ERROR SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#parse-errors
+///
+/// > A Go file that doesn't parse is reported as a `parse_error` at its first
+/// > error, and the rest of the project is still checked.
+#[test]
+fn parse_error_reported_and_other_files_still_checked() {
+    let escapes = check("escapes").on("golang/parse-error").json().fails();
+
+    let parse_errors = escapes.violations_of_type("parse_error");
+    assert_eq!(parse_errors.len(), 1);
+    let error = &parse_errors[0];
+    assert_eq!(
+        error.get("file").and_then(|f| f.as_str()),
+        Some("broken.go")
+    );
+    assert_eq!(error.get("line").and_then(|l| l.as_u64()), Some(6));
+    assert_eq!(error.get("column").and_then(|c| c.as_u64()), Some(10));
+    assert!(
+        error
+            .get("advice")
+            .and_then(|a| a.as_str())
+            .is_some_and(|a| a.contains("unterminated string literal"))
+    );
+
+    // The valid file is scanned; the broken one isn't matched
+    let files: Vec<_> = escapes
+        .violations_of_type("missing_comment")
+        .iter()
+        .filter_map(|v| v.get("file")?.as_str().map(String::from))
+        .collect();
+    assert_eq!(files, vec!["main.go"]);
+}
+
+/// Spec: docs/specs/langs/golang.md#parse-errors
+///
+/// > Parse errors are warnings, so they don't fail the check on their own.
+/// > `--strict-parse` makes them errors.
+#[test]
+fn parse_error_is_warning_unless_strict_parse() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file("main.go", "package main\n\nfunc main() {\n");
+
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  main.go\n    3:13: parse_error");
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--strict-parse"])
+        .fails()
+        .stdout_has("  main.go\n    3:13: parse_error");
+}
+
 // =============================================================================
 // EXACT OUTPUT FORMAT SPECS
 // =============================================================================
@@ -478,9 +2335,413 @@ fn exact_unsafe_pointer_fail_text() {
         .fails()
         .stdout_eq(
             r###"escapes: FAIL
-  main.go:7: missing_comment: unsafe_pointer
-    Add a // SAFETY: comment explaining pointer validity.
+  main.go
+    7:9: missing_comment: unsafe_pointer
+      Add a // SAFETY: comment explaining pointer validity.
 FAIL: escapes
 "###,
         );
 }
+
+// =============================================================================
+// MAP ITERATION ORDER SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#map-iteration-order
+///
+/// > Violations are `forbidden` with pattern `map_order`, at the `for`
+#[test]
+fn map_range_appended_slice_returned_unsorted_fails() {
+    check("escapes")
+        .on("golang/maporder-fail")
+        .fails()
+        .stdout_has("  internal/index/index.go\n    6:2: forbidden: map_order")
+        .stdout_has("names is returned in a different order on every run");
+}
+
+/// Spec: docs/specs/langs/golang.md#map-iteration-order
+///
+/// > Calling any `sort` function or `slices.Sort*` on the slice after the
+/// > loop clears it.
+#[test]
+fn map_range_sorted_before_return_passes() {
+    check("escapes").on("golang/maporder-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#map-iteration-order
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn map_order_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.maporder]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "keys.go",
+        "package p\n\nfunc Keys(m map[string]bool) (keys []string) {\n\tfor k := range m {\n\t\tkeys = append(keys, k)\n\t}\n\treturn keys\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  keys.go\n    4:2: forbidden: map_order");
+}
+
+// =============================================================================
+// UNLOCKED MAP ACCESS SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#unlocked-map-access
+///
+/// > Violations are `forbidden` with pattern `unlocked_map`, at the receiver
+#[test]
+fn map_field_accessed_without_lock_fails() {
+    check("escapes")
+        .on("golang/maplock-fail")
+        .fails()
+        .stdout_has("  internal/cache/cache.go\n    20:9: forbidden: unlocked_map")
+        .stdout_has("Cache.items is accessed with the mutex held elsewhere, but not here");
+}
+
+/// Spec: docs/specs/langs/golang.md#unlocked-map-access
+///
+/// > methods whose names end in `Locked` (`evictLocked`) are assumed to run
+/// > with the mutex held and are skipped
+#[test]
+fn map_field_accessed_under_lock_passes() {
+    check("escapes").on("golang/maplock-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#unlocked-map-access
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn unlocked_map_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.maplock]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "set.go",
+        "package p\n\nimport \"sync\"\n\ntype Set struct {\n\tsync.Mutex\n\tm map[string]bool\n}\n\nfunc (s *Set) Add(k string) {\n\ts.Lock()\n\ts.m[k] = true\n\ts.Unlock()\n}\n\nfunc (s *Set) Has(k string) bool {\n\treturn s.m[k]\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  set.go\n    17:9: forbidden: unlocked_map");
+}
+
+// =============================================================================
+// ERROR STRING SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#error-strings
+///
+/// > Violations are `forbidden` with pattern `error_string`, at the package
+/// > qualifier
+#[test]
+fn capitalized_or_punctuated_error_strings_fail() {
+    check("escapes")
+        .on("golang/errstring-fail")
+        .fails()
+        .stdout_has("  internal/store/store.go\n    9:19: forbidden: error_string")
+        .stdout_has("Start it with a lowercase letter")
+        .stdout_has("    14:10: forbidden: error_string")
+        .stdout_has("Drop the trailing punctuation.");
+}
+
+/// Spec: docs/specs/langs/golang.md#error-strings
+///
+/// > common acronyms (`API`, `EOF`, `HTTP`, `ID`, `JSON`, `SQL`, `TLS`,
+/// > `URL`, `UUID`, and a few more) and the words in `allow` are accepted
+#[test]
+fn lowercase_and_allowed_error_strings_pass() {
+    check("escapes").on("golang/errstring-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#error-strings
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn error_string_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.errstring]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "errs.go",
+        "package p\n\nimport \"errors\"\n\nvar ErrClosed = errors.New(\"Closed\")\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  errs.go\n    5:17: forbidden: error_string");
+}
+
+// =============================================================================
+// UNSPREAD ARGUMENT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#unspread-arguments
+///
+/// > the violations are `forbidden` with pattern `unspread_args`, on by
+/// > default
+#[test]
+fn unspread_variadic_slices_fail() {
+    check("escapes")
+        .on("golang/variadic-fail")
+        .fails()
+        .stdout_has("  internal/logx/log.go\n    10:21: forbidden: unspread_args")
+        .stdout_has("Pass args... to spread it into the arguments.")
+        .stdout_has("    15:28: forbidden: unspread_args")
+        .stdout_lacks("    20:");
+}
+
+/// Spec: docs/specs/langs/golang.md#unspread-arguments
+///
+/// > The violation is at the slice, when it's the whole argument and the
+/// > only one after the format (or writer)
+#[test]
+fn spread_variadic_slices_pass() {
+    check("escapes").on("golang/variadic-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#unspread-arguments
+///
+/// > check = "error"                # error | warn | off (default: error)
+#[test]
+fn unspread_args_can_be_turned_off() {
+    let temp = Project::empty();
+    temp.config("[golang.variadic]\ncheck = \"off\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "log.go",
+        "package p\n\nimport \"fmt\"\n\nfunc logf(format string, args ...any) {\n\tfmt.Printf(format, args)\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// ALIASING APPEND SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#aliasing-append
+///
+/// > Violations are `forbidden` with pattern `aliased_append`, at the source
+/// > slice
+#[test]
+fn append_aliasing_a_later_used_slice_fails() {
+    check("escapes")
+        .on("golang/appendalias-fail")
+        .fails()
+        .stdout_has("  internal/batch/batch.go\n    5:16: forbidden: aliased_append")
+        .stdout_has("out reuses the backing array of keys, which is used again on line 6");
+}
+
+/// Spec: docs/specs/langs/golang.md#aliasing-append
+///
+/// > The result is assigned to another name or field (`b := ...`, `r.buf =
+/// > ...`), not back to `s`
+#[test]
+fn append_copying_or_refilling_in_place_passes() {
+    check("escapes").on("golang/appendalias-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#aliasing-append
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn aliased_append_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.config("[golang.appendalias]\ncheck = \"error\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "buf.go",
+        "package p\n\nfunc f(a []int) ([]int, int) {\n\tb := append(a[:0:4], 1)\n\treturn b, a[0]\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  buf.go\n    4:14: forbidden: aliased_append");
+}
+
+// =============================================================================
+// WAITGROUP ADD SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#waitgroup-add
+///
+/// > the violations are `forbidden` with pattern `waitgroup_add`, on by
+/// > default
+#[test]
+fn waitgroup_add_inside_goroutine_fails() {
+    check("escapes")
+        .on("golang/waitgroup-fail")
+        .fails()
+        .stdout_has("  internal/pool/pool.go\n    10:4: forbidden: waitgroup_add")
+        .stdout_has("Call wg.Add before the go statement.")
+        .stdout_has("    23:4: forbidden: waitgroup_add");
+}
+
+/// Spec: docs/specs/langs/golang.md#waitgroup-add
+///
+/// > A goroutine whose WaitGroup was already added to before its `go`
+/// > statement is already counted
+#[test]
+fn waitgroup_add_before_go_passes() {
+    check("escapes").on("golang/waitgroup-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#waitgroup-add
+///
+/// > check = "error"                # error | warn | off (default: error)
+#[test]
+fn waitgroup_add_can_be_a_warning() {
+    let temp = Project::empty();
+    temp.config("[golang.waitgroup]\ncheck = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "run.go",
+        "package p\n\nimport \"sync\"\n\nfunc run() {\n\tvar wg sync.WaitGroup\n\tgo func() {\n\t\twg.Add(1)\n\t\twg.Done()\n\t}()\n\twg.Wait()\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("8:3: forbidden: waitgroup_add");
+}
+
+// =============================================================================
+// CONTEXT KEY SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#context-keys
+///
+/// > Violations are `forbidden` with pattern `context_key`, at the key
+#[test]
+fn builtin_context_keys_fail() {
+    check("escapes")
+        .on("golang/ctxkey-fail")
+        .fails()
+        .stdout_has("  internal/auth/auth.go\n    11:31: forbidden: context_key")
+        .stdout_has("The context key \"user\" has built-in type string")
+        .stdout_has("    16:31: forbidden: context_key");
+}
+
+/// Spec: docs/specs/langs/golang.md#context-keys
+///
+/// > A key of any other type, including `type ctxKey string`, passes.
+#[test]
+fn custom_context_key_types_pass() {
+    check("escapes").on("golang/ctxkey-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#context-keys
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn context_key_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "user.go",
+        "package p\n\nimport \"context\"\n\nfunc WithUser(ctx context.Context) context.Context {\n\treturn context.WithValue(ctx, \"user\", 1)\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  user.go\n    6:31: forbidden: context_key");
+}
+
+// =============================================================================
+// DEFER LOOP SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#defer-in-loops
+///
+/// > Violations are `missing_comment` with pattern `defer_loop`, at `defer`
+#[test]
+fn defer_in_loop_fails() {
+    check("escapes")
+        .on("golang/deferloop-fail")
+        .fails()
+        .stdout_has("  internal/files/files.go\n    13:3: missing_comment: defer_loop")
+        .stdout_has("This defer runs when the function returns")
+        .stdout_has("    31:4: missing_comment: defer_loop");
+}
+
+/// Spec: docs/specs/langs/golang.md#defer-in-loops
+///
+/// > one inside a function literal, including a `go func()`, passes
+#[test]
+fn defer_in_closure_in_loop_passes() {
+    check("escapes").on("golang/deferloop-ok").passes();
+}
+
+/// Spec: docs/specs/langs/golang.md#defer-in-loops
+///
+/// > are warnings by default, even at `check = "error"`
+#[test]
+fn defer_loop_is_a_warning_by_default() {
+    let temp = Project::empty();
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "unlock.go",
+        "package p\n\nfunc unlockAll(locks []Locker) {\n\tfor _, l := range locks {\n\t\tdefer l.Unlock()\n\t}\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .passes()
+        .stdout_has("  unlock.go\n    5:3: missing_comment: defer_loop");
+}
+
+// =============================================================================
+// MUSTCOMPILE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#mustcompile-patterns
+///
+/// > Violations are `forbidden` with pattern `must_compile`, at the pattern
+/// > argument of `MustCompile` or `MustCompilePOSIX`.
+#[test]
+fn mustcompile_on_variable_pattern_fails() {
+    check("escapes")
+        .on("golang/mustcompile-fail")
+        .fails()
+        .stdout_has("  internal/match/match.go\n    13:24: forbidden: must_compile")
+        .stdout_has("regexp.MustCompile panics if pattern isn't a valid pattern")
+        .stdout_has("    18:29: forbidden: must_compile")
+        .stdout_has("Use regexp.CompilePOSIX and handle the error")
+        .stdout_lacks("    9:");
+}
+
+/// Spec: docs/specs/langs/golang.md#mustcompile-patterns
+///
+/// > A pattern passes when it's built only from string literals, consts
+/// > declared in its package, `+`, and `regexp.QuoteMeta(...)`
+#[test]
+fn mustcompile_on_literal_pattern_passes() {
+    check("escapes").on("golang/mustcompile-ok").passes();
+}
+
+// =============================================================================
+// LIBRARY EXIT SPECS
+// =============================================================================
+
+/// Spec: docs/specs/langs/golang.md#library-exits
+///
+/// > Violations are `missing_comment` with pattern `go_exit`, at the package
+/// > qualifier
+#[test]
+fn log_fatal_in_library_package_fails() {
+    check("escapes")
+        .on("golang/exit-fail")
+        .fails()
+        .stdout_has("  internal/config/config.go\n    12:3: missing_comment: go_exit")
+        .stdout_has("log.Fatalf in a library exits or panics");
+}
+
+/// Spec: docs/specs/langs/golang.md#library-exits
+///
+/// > Files in `package main`, comments, strings, and `_test.go` files are
+/// > not checked.
+#[test]
+fn exits_in_main_or_with_exit_comment_pass() {
+    check("escapes").on("golang/exit-ok").passes();
+}
@@ -247,6 +247,109 @@ fn ts_ignore_allowed_in_test_code() {
     check("escapes").on("javascript/ts-ignore-test-ok").passes();
 }
 
+// =============================================================================
+// ESCAPE PATTERN SPECS - eval, new Function, dangerouslySetInnerHTML
+// =============================================================================
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `eval(...)` requires a `// SAFETY:` comment.
+#[test]
+fn eval_without_safety_comment_fails() {
+    check("escapes")
+        .on("ts/eval-fail")
+        .fails()
+        .stdout_has("  src/config.ts\n    2:10: missing_comment: eval")
+        .stdout_has("// SAFETY:");
+}
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `eval(...)` with a `// SAFETY:` comment passes.
+#[test]
+fn eval_with_safety_comment_passes() {
+    check("escapes").on("ts/eval-ok").passes();
+}
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `new Function(...)` requires a `// SAFETY:` comment.
+#[test]
+fn new_function_without_safety_comment_fails() {
+    check("escapes")
+        .on("ts/new-function-fail")
+        .fails()
+        .stdout_has("  src/template.js\n    2:10: missing_comment: new_function");
+}
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `new Function(...)` with a `// SAFETY:` comment passes.
+#[test]
+fn new_function_with_safety_comment_passes() {
+    check("escapes").on("ts/new-function-ok").passes();
+}
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `dangerouslySetInnerHTML` requires a `// SAFETY:` comment.
+#[test]
+fn dangerously_set_inner_html_without_safety_comment_fails() {
+    check("escapes")
+        .on("ts/inner-html-fail")
+        .fails()
+        .stdout_has("  src/Article.tsx\n    2:35: missing_comment: dangerously_set_inner_html");
+}
+
+/// Spec: docs/specs/langs/javascript.md#default-escape-patterns
+///
+/// > `dangerouslySetInnerHTML` with a `// SAFETY:` comment passes.
+#[test]
+fn dangerously_set_inner_html_with_safety_comment_passes() {
+    check("escapes").on("ts/inner-html-ok").passes();
+}
+
+/// Spec: docs/specs/langs/javascript.md#strings-comments-and-lookalikes
+///
+/// > Occurrences in string, template and regex literals, comments, and
+/// > methods named `eval` are not flagged.
+#[test]
+fn eval_in_strings_comments_and_methods_passes() {
+    check("escapes").on("ts/lookalikes-ok").passes();
+}
+
+/// Spec: docs/specs/langs/javascript.md#strings-comments-and-lookalikes
+///
+/// > Template literal `${...}` expressions are code.
+#[test]
+fn eval_in_template_expression_fails() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("package.json", r#"{ "name": "app" }"#);
+    temp.file("src/run.js", "export const out = `${eval(input)}`;\n");
+
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  src/run.js\n    1:23: missing_comment: eval");
+}
+
+/// Spec: docs/specs/langs/javascript.md#escapes-in-test-code
+///
+/// > Escape patterns are allowed in test code.
+#[test]
+fn eval_allowed_in_test_code() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("package.json", r#"{ "name": "app" }"#);
+    temp.file(
+        "src/config.test.ts",
+        "it('evaluates', () => {\n  expect(eval('1 + 1')).toBe(2);\n});\n",
+    );
+
+    check("escapes").pwd(temp.path()).passes();
+}
+
 // =============================================================================
 // SUPPRESS DIRECTIVE SPECS - ESLint
 // =============================================================================
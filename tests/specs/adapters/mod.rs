@@ -7,6 +7,7 @@
 //!
 //! Reference: docs/specs/10-language-adapters.md
 
+pub mod dispatch;
 pub mod golang;
 pub mod javascript;
 pub mod python;
@@ -374,7 +374,7 @@ fn python_adapter_detects_flat_layout_package() {
 
 /// Spec: docs/specs/langs/python.md#default-escape-patterns
 ///
-/// > eval( | comment | # EVAL:
+/// > eval( | comment | # SAFETY: or # EVAL:
 #[test]
 fn python_adapter_eval_without_comment_fails() {
     check("escapes")
@@ -386,7 +386,7 @@ fn python_adapter_eval_without_comment_fails() {
 
 /// Spec: docs/specs/langs/python.md#default-escape-patterns
 ///
-/// > eval( | comment | # EVAL:
+/// > eval( | comment | # SAFETY: or # EVAL:
 #[test]
 fn python_adapter_eval_with_comment_passes() {
     check("escapes").on("python/escape-ok").passes();
@@ -394,7 +394,7 @@ fn python_adapter_eval_with_comment_passes() {
 
 /// Spec: docs/specs/langs/python.md#default-escape-patterns
 ///
-/// > exec( | comment | # EXEC:
+/// > exec( | comment | # SAFETY: or # EXEC:
 #[test]
 fn python_adapter_exec_without_comment_fails() {
     let temp = Project::empty();
@@ -428,6 +428,67 @@ fn python_adapter_dynamic_import_without_comment_fails() {
         .stdout_has("# DYNAMIC:");
 }
 
+/// Spec: docs/specs/langs/python.md#default-escape-patterns
+///
+/// > `os.system`, `subprocess` with `shell=True`, `pickle.loads`, `exec`, and
+/// > `eval` require a `# SAFETY:` comment, including through import aliases.
+#[test]
+fn python_adapter_shell_and_pickle_calls_without_comment_fail() {
+    let escapes = check("escapes")
+        .on("python/shell-calls-fail")
+        .json()
+        .fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    let mut found: Vec<(&str, u64)> = violations
+        .iter()
+        .map(|v| {
+            (
+                v.get("pattern").and_then(|p| p.as_str()).unwrap(),
+                v.get("line").and_then(|l| l.as_u64()).unwrap(),
+            )
+        })
+        .collect();
+    found.sort_by_key(|(_, line)| *line);
+    assert_eq!(
+        found,
+        vec![
+            ("os_system", 10),
+            ("subprocess_shell", 11),
+            ("pickle_loads", 15),
+            ("exec", 16),
+            ("eval", 17),
+        ]
+    );
+}
+
+/// Spec: docs/specs/langs/python.md#default-escape-patterns
+///
+/// > Only real calls are matched: string literals and methods that share a
+/// > name (`expr.eval()`) are skipped, as is `subprocess` without `shell=True`.
+#[test]
+fn python_adapter_shell_and_pickle_calls_with_comment_pass() {
+    check("escapes").on("python/shell-calls-ok").passes();
+}
+
+/// Spec: docs/specs/langs/python.md#default-escape-patterns
+///
+/// > eval( | comment | # SAFETY: or # EVAL:
+#[test]
+fn python_adapter_eval_with_safety_comment_passes() {
+    let temp = Project::empty();
+    temp.file(
+        "pyproject.toml",
+        "[project]\nname = \"test\"\nversion = \"0.1.0\"\n",
+    );
+    temp.file(
+        "src/calc.py",
+        "# SAFETY: expression is validated by the parser\nresult = eval(expr)\n",
+    );
+
+    check("escapes").pwd(temp.path()).passes();
+}
+
 /// Spec: docs/specs/langs/python.md#default-escape-patterns
 ///
 /// > breakpoint() | forbid
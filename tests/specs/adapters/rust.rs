@@ -14,6 +14,7 @@
 #![allow(clippy::unwrap_used, clippy::expect_used)]
 
 use crate::prelude::*;
+use yare::parameterized;
 
 // =============================================================================
 // AUTO-DETECTION SPECS
@@ -232,6 +233,45 @@ fn rust_adapter_unsafe_with_safety_comment_passes() {
     check("escapes").on("rust/unsafe-ok").passes();
 }
 
+/// Spec: docs/specs/langs/rust.md#default-escape-patterns
+///
+/// > unsafe fn | comment | // SAFETY: or # Safety doc section
+#[parameterized(
+    unsafe_fn = { "rust/unsafe-fn-fail", "unsafe_fn", 3 },
+    unsafe_impl = { "rust/unsafe-impl-fail", "unsafe_impl", 5 },
+    unsafe_trait = { "rust/unsafe-trait-fail", "unsafe_trait", 3 },
+)]
+fn rust_adapter_unsafe_declaration_without_safety_comment_fails(
+    fixture: &str,
+    pattern: &str,
+    line: u64,
+) {
+    let escapes = check("escapes").on(fixture).json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    assert_eq!(violations.len(), 1, "{:?}", violations);
+    assert_eq!(
+        violations[0].get("pattern").and_then(|p| p.as_str()),
+        Some(pattern)
+    );
+    assert_eq!(
+        violations[0].get("line").and_then(|l| l.as_u64()),
+        Some(line)
+    );
+}
+
+/// Spec: docs/specs/langs/rust.md#default-escape-patterns
+///
+/// > unsafe impl | comment | // SAFETY:
+#[parameterized(
+    unsafe_fn = { "rust/unsafe-fn-ok" },
+    unsafe_impl = { "rust/unsafe-impl-ok" },
+    unsafe_trait = { "rust/unsafe-trait-ok" },
+)]
+fn rust_adapter_unsafe_declaration_with_safety_comment_passes(fixture: &str) {
+    check("escapes").on(fixture).passes();
+}
+
 // Note: .unwrap() and .expect() are not checked by quench.
 // Use Clippy's unwrap_used and expect_used lints for that.
 // Quench ensures escapes and suppressions are commented.
@@ -295,8 +295,9 @@ fn cursor_missing_in_claude_text_format() {
         .exits(1)
         .stdout_eq(
             "agents: FAIL
-  .cursor/rules/general.mdc: cursor_missing_in_claude: CLAUDE.md
-    Section \"Testing\" exists in .cursor/rules/general.mdc (alwaysApply) but not in CLAUDE.md. Use --fix to add missing sections.
+  .cursor/rules/general.mdc
+    cursor_missing_in_claude: CLAUDE.md
+      Section \"Testing\" exists in .cursor/rules/general.mdc (alwaysApply) but not in CLAUDE.md. Use --fix to add missing sections.
 FAIL: agents
 ",
         );
@@ -312,8 +313,9 @@ fn cursor_no_agent_file_text_format() {
         .exits(1)
         .stdout_eq(
             "agents: FAIL
-  .cursor/rules/api.mdc: cursor_no_agent_file: src/api/CLAUDE.md
-    Rule scoped to src/api/ but no CLAUDE.md found there. Use --fix to create src/api/CLAUDE.md from rule content.
+  .cursor/rules/api.mdc
+    cursor_no_agent_file: src/api/CLAUDE.md
+      Rule scoped to src/api/ but no CLAUDE.md found there. Use --fix to create src/api/CLAUDE.md from rule content.
 FAIL: agents
 ",
         );
@@ -329,8 +331,9 @@ fn cursor_parse_error_text_format() {
         .exits(1)
         .stdout_eq(
             "agents: FAIL
-  .cursor/rules/bad.mdc: cursor_parse_error
-    Malformed .mdc frontmatter: unterminated frontmatter (missing closing ---)
+  .cursor/rules/bad.mdc
+    cursor_parse_error
+      Malformed .mdc frontmatter: unterminated frontmatter (missing closing ---)
 FAIL: agents
 ",
         );
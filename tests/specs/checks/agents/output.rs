@@ -246,8 +246,9 @@ sync_from = "CLAUDE.md"
 fn exact_missing_file_text() {
     check("agents").on("agents/missing-file").fails().stdout_eq(
         r###"agents: FAIL
-  CLAUDE.md: missing required file
-    Required agent file 'CLAUDE.md' not found at project root
+  CLAUDE.md
+    missing required file
+      Required agent file 'CLAUDE.md' not found at project root
 FAIL: agents
 "###,
     );
@@ -260,16 +261,18 @@ FAIL: agents
 fn exact_out_of_sync_text() {
     check("agents").on("agents/out-of-sync").fails().stdout_eq(
         r###"agents: FAIL
-  .cursorrules: out of sync with CLAUDE.md
-    Code Style differs. Use --fix to sync from CLAUDE.md, or reconcile manually.
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
-  .cursorrules: missing required section
-    In the root .cursorrules, add a "## Directory Structure" section: Overview of project layout and key directories
-  .cursorrules: missing required section
-    In the root .cursorrules, add a "## Landing the Plane" section: Checklist for AI agents before completing work
+  .cursorrules
+    out of sync with CLAUDE.md
+      Code Style differs. Use --fix to sync from CLAUDE.md, or reconcile manually.
+    missing required section
+      In the root .cursorrules, add a "## Directory Structure" section: Overview of project layout and key directories
+    missing required section
+      In the root .cursorrules, add a "## Landing the Plane" section: Checklist for AI agents before completing work
+  CLAUDE.md
+    missing required section
+      In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
+    missing required section
+      In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
 FAIL: agents
 "###,
     );
@@ -282,12 +285,13 @@ FAIL: agents
 fn exact_forbidden_table_text() {
     check("agents").on("agents/with-table").fails().stdout_eq(
         r###"agents: FAIL
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
-  CLAUDE.md:7: forbidden table
-    In the root CLAUDE.md, tables are forbidden. Use a list or mermaid diagram instead.
+  CLAUDE.md
+    missing required section
+      In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
+    missing required section
+      In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
+    7: forbidden table
+      In the root CLAUDE.md, tables are forbidden. Use a list or mermaid diagram instead.
 FAIL: agents
 "###,
     );
@@ -303,8 +307,9 @@ fn exact_missing_section_text() {
         .fails()
         .stdout_eq(
             r###"agents: FAIL
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before finishing work
+  CLAUDE.md
+    missing required section
+      In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before finishing work
 FAIL: agents
 "###,
         );
@@ -320,12 +325,13 @@ fn exact_oversized_lines_text() {
         .fails()
         .stdout_eq(
             r###"agents: FAIL
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
-  CLAUDE.md: missing required section
-    In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
-  CLAUDE.md: file too large (tokens: 59 vs 50)
-    In the root CLAUDE.md, file has 59 lines (max: 50). Split into smaller files or reduce content.
+  CLAUDE.md
+    missing required section
+      In the root CLAUDE.md, add a "## Directory Structure" section: Overview of project layout and key directories
+    missing required section
+      In the root CLAUDE.md, add a "## Landing the Plane" section: Checklist for AI agents before completing work
+    file too large (tokens: 59 vs 50)
+      In the root CLAUDE.md, file has 59 lines (max: 50). Split into smaller files or reduce content.
 FAIL: agents
 "###,
         );
@@ -52,13 +52,14 @@ src/
     );
     check("docs").pwd(temp.path()).fails().stdout_eq(
         "docs: FAIL
-  CLAUDE.md:5: broken_toc: src/missing.rs
-    File does not exist (0 of 1 paths valid, 1 failed).
-    This check ensures directory trees in documentation stay up-to-date.
-    Update the table of contents or directory tree to match actual files.
-    If this is illustrative, use a language tag like ```{lang}, ```diagram, ```example, or ```ignore.
-
-    Tried: relative to markdown file, relative to project root, stripping parent directory prefix
+  CLAUDE.md
+    5: broken_toc: src/missing.rs
+      File does not exist (0 of 1 paths valid, 1 failed).
+      This check ensures directory trees in documentation stay up-to-date.
+      Update the table of contents or directory tree to match actual files.
+      If this is illustrative, use a language tag like ```{lang}, ```diagram, ```example, or ```ignore.
+
+      Tried: relative to markdown file, relative to project root, stripping parent directory prefix
 
 FAIL: docs
 ",
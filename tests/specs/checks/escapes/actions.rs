@@ -126,6 +126,73 @@ fn escapes_comment_action_fails_when_no_comment_found() {
     );
 }
 
+/// Spec: docs/specs/checks/escape-hatches.md#comment-markers
+///
+/// > A pattern's `comment` can list alternatives separated by `|`; any one of them justifies the match.
+#[test]
+fn escapes_comment_action_accepts_any_alternative_marker() {
+    let temp = Project::empty();
+    temp.config(
+        r#"[[check.escapes.patterns]]
+name = "unsafe"
+pattern = "unsafe\\s*\\{"
+action = "comment"
+comment = "// SAFETY:|// JUSTIFY:"
+"#,
+    );
+    temp.file(
+        "src/lib.rs",
+        r#"
+// SAFETY: Pointer guaranteed valid by caller
+unsafe { *ptr }
+
+// JUSTIFY: Length checked above
+unsafe { *end }
+"#,
+    );
+
+    check("escapes").pwd(temp.path()).passes();
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#comment-markers
+///
+/// > Markers for built-in patterns are changed by name in `[check.escapes.comments]`
+/// > without redefining the pattern
+#[test]
+fn escapes_comments_table_overrides_default_marker() {
+    let temp = Project::empty();
+    temp.config(
+        r#"[check.escapes.comments]
+unsafe_pointer = "// SAFETY:|// JUSTIFY:"
+"#,
+    );
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        r#"package main
+
+import "unsafe"
+
+func main() {
+	x := 1
+	// JUSTIFY: x outlives p
+	p := unsafe.Pointer(&x)
+	q := unsafe.Pointer(&x)
+	_, _ = p, q
+}
+"#,
+    );
+
+    let escapes = check("escapes").pwd(temp.path()).json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+    assert_eq!(violations.len(), 1);
+    assert_eq!(violations[0].get("line").and_then(|l| l.as_u64()), Some(9));
+    assert_eq!(
+        violations[0].get("advice").and_then(|a| a.as_str()),
+        Some("Add a // SAFETY: or // JUSTIFY: comment explaining pointer validity.")
+    );
+}
+
 // =============================================================================
 // FORBID ACTION SPECS
 // =============================================================================
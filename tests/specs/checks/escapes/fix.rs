@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Justification stub specs for `--fix`.
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+/// Copy the golang/fix-stubs fixture (minus the golden file) into a temp project.
+fn fix_stubs_project() -> Project {
+    let temp = Project::empty();
+    let source = fixture("golang/fix-stubs");
+    for name in ["go.mod", "quench.toml", "main.go"] {
+        temp.file(
+            name,
+            &std::fs::read_to_string(source.join(name)).expect("fixture file"),
+        );
+    }
+    temp
+}
+
+fn golden() -> String {
+    std::fs::read_to_string(fixture("golang/fix-stubs/main.go.golden")).expect("golden file")
+}
+
+fn main_go(temp: &Project) -> String {
+    std::fs::read_to_string(temp.path().join("main.go")).unwrap()
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#justification-stubs
+///
+/// > `--fix` inserts `<marker> TODO explain` on the line above each
+/// > unjustified match, at the match line's indentation.
+#[test]
+fn fix_inserts_justification_stubs() {
+    let temp = fix_stubs_project();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--fix"])
+        .passes()
+        .stdout_has("escapes: FIXED")
+        .stdout_has("Added 3 justification stubs to main.go");
+
+    assert_eq!(main_go(&temp), golden());
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#justification-stubs
+///
+/// > Re-running `--fix` never stacks stubs.
+#[test]
+fn fix_is_idempotent() {
+    let temp = fix_stubs_project();
+    check("escapes").pwd(temp.path()).args(&["--fix"]).passes();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--fix"])
+        .passes()
+        .stdout_lacks("FIXED");
+    assert_eq!(main_go(&temp), golden());
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#justification-stubs
+///
+/// > `--fix --dry-run` reports the stubs without writing them.
+#[test]
+fn fix_dry_run_leaves_files_unchanged() {
+    let temp = fix_stubs_project();
+    let before = main_go(&temp);
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--fix", "--dry-run"])
+        .exits(1)
+        .stdout_has("Would add 3 justification stubs to main.go");
+
+    assert_eq!(main_go(&temp), before);
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > The report is followed by a unified diff of every edit `--fix` would
+/// > make, in file path order.
+#[test]
+fn fix_dry_run_prints_unified_diff() {
+    let temp = fix_stubs_project();
+    let golden_diff =
+        std::fs::read_to_string(fixture("golang/fix-stubs/main.go.diff")).expect("golden diff");
+
+    let stdout = check("escapes")
+        .pwd(temp.path())
+        .args(&["--fix", "--dry-run"])
+        .exits(1)
+        .stdout();
+
+    assert!(
+        stdout.ends_with(&golden_diff),
+        "expected the diff at the end of stdout:\n{}",
+        stdout
+    );
+}
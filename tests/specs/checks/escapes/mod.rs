@@ -9,14 +9,17 @@
 //! - Separates source and test code
 //! - Generates correct violation types
 //! - Outputs metrics in JSON format
+//! - Requires issue references on TODO/FIXME comments (opt-in)
 //!
 //! Reference: docs/specs/checks/escape-hatches.md
 
 mod actions;
 mod edge_cases;
+mod fix;
 mod output;
 mod suppress_other;
 mod suppress_rust;
+mod todo;
 
 /// Helper: project with exclude pattern for generated files.
 fn exclude_project() -> crate::prelude::Project {
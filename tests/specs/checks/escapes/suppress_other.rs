@@ -30,10 +30,11 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  script.sh:2: shellcheck_missing_comment: # shellcheck disable=SC2086
-    Quote the variable expansion to prevent word splitting.
-    Use "$var" instead of $var unless word splitting is intentionally needed.
-    Only if the lint is a false positive, add a comment above the directive.
+  script.sh
+    2: shellcheck_missing_comment: # shellcheck disable=SC2086
+      Quote the variable expansion to prevent word splitting.
+      Use "$var" instead of $var unless word splitting is intentionally needed.
+      Only if the lint is a false positive, add a comment above the directive.
 
 FAIL: escapes
 "#,
@@ -62,11 +63,12 @@ comment = "# EXTERNAL:"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r##"escapes: FAIL
-  script.sh:2: shellcheck_missing_comment: # shellcheck disable=SC2154
-    Define this variable before use or document its external source.
-    If set by the shell environment, add a comment explaining where it comes from.
-    Only if fixing is not feasible, add:
-      # EXTERNAL: ...
+  script.sh
+    2: shellcheck_missing_comment: # shellcheck disable=SC2154
+      Define this variable before use or document its external source.
+      If set by the shell environment, add a comment explaining where it comes from.
+      Only if fixing is not feasible, add:
+        # EXTERNAL: ...
 
 FAIL: escapes
 "##,
@@ -92,10 +94,11 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  script.sh:2: shellcheck_missing_comment: # shellcheck disable=SC2034
-    Remove this unused variable.
-    If the variable is used externally, export it or add a comment explaining its purpose.
-    Only if the lint is a false positive, add a comment above the directive.
+  script.sh
+    2: shellcheck_missing_comment: # shellcheck disable=SC2034
+      Remove this unused variable.
+      If the variable is used externally, export it or add a comment explaining its purpose.
+      Only if the lint is a false positive, add a comment above the directive.
 
 FAIL: escapes
 "#,
@@ -121,10 +124,11 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  script.sh:2: shellcheck_missing_comment: # shellcheck disable=SC9999
-    Fix the ShellCheck warning instead of suppressing it.
-    ShellCheck warnings usually indicate real issues or portability problems.
-    Only if the lint is a false positive, add a comment above the directive.
+  script.sh
+    2: shellcheck_missing_comment: # shellcheck disable=SC9999
+      Fix the ShellCheck warning instead of suppressing it.
+      ShellCheck warnings usually indicate real issues or portability problems.
+      Only if the lint is a false positive, add a comment above the directive.
 
 FAIL: escapes
 "#,
@@ -155,10 +159,11 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  main.go:2: suppress_missing_comment: //nolint:errcheck
-    Handle this error properly.
-    Add error handling or explicitly check and handle the error case.
-    Only if the lint is a false positive, add a comment above the directive or inline (//nolint:code // reason).
+  main.go
+    2: suppress_missing_comment: //nolint:errcheck
+      Handle this error properly.
+      Add error handling or explicitly check and handle the error case.
+      Only if the lint is a false positive, add a comment above the directive or inline (//nolint:code // reason).
 
 FAIL: escapes
 "#,
@@ -185,11 +190,12 @@ comment = "// FALSE_POSITIVE:"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  main.go:2: suppress_missing_comment: //nolint:gosec
-    Address the security issue identified by gosec.
-    Review the security finding and apply the recommended fix.
-    Only if fixing is not feasible, add:
-      // FALSE_POSITIVE: ...
+  main.go
+    2: suppress_missing_comment: //nolint:gosec
+      Address the security issue identified by gosec.
+      Review the security finding and apply the recommended fix.
+      Only if fixing is not feasible, add:
+        // FALSE_POSITIVE: ...
 
 FAIL: escapes
 "#,
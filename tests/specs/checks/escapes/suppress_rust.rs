@@ -239,14 +239,15 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  src/lib.rs:1: suppress_missing_comment: #[allow(dead_code)]
-    Remove this dead code.
-    Dead code should be deleted to keep the codebase clean and maintainable.
-    Only if fixing is not feasible, add one of:
-      // KEEP UNTIL: ...
-      // NOTE(compat): ...
-      // NOTE(compatibility): ...
-      // NOTE(lifetime): ...
+  src/lib.rs
+    1: suppress_missing_comment: #[allow(dead_code)]
+      Remove this dead code.
+      Dead code should be deleted to keep the codebase clean and maintainable.
+      Only if fixing is not feasible, add one of:
+        // KEEP UNTIL: ...
+        // NOTE(compat): ...
+        // NOTE(compatibility): ...
+        // NOTE(lifetime): ...
 
 FAIL: escapes
 "#,
@@ -276,11 +277,12 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  src/lib.rs:1: suppress_missing_comment: #[allow(clippy::too_many_arguments)]
-    Refactor this function to use fewer arguments.
-    Consider grouping related parameters into a struct or using the builder pattern.
-    Only if fixing is not feasible, add:
-      // TODO(refactor): ...
+  src/lib.rs
+    1: suppress_missing_comment: #[allow(clippy::too_many_arguments)]
+      Refactor this function to use fewer arguments.
+      Consider grouping related parameters into a struct or using the builder pattern.
+      Only if fixing is not feasible, add:
+        // TODO(refactor): ...
 
 FAIL: escapes
 "#,
@@ -310,12 +312,13 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  src/lib.rs:1: suppress_missing_comment: #[allow(clippy::cast_possible_truncation)]
-    Verify this cast is safe and won't truncate data.
-    Add explicit bounds checking or use safe conversion methods (e.g., try_into).
-    Only if fixing is not feasible, add one of:
-      // CORRECTNESS: ...
-      // SAFETY: ...
+  src/lib.rs
+    1: suppress_missing_comment: #[allow(clippy::cast_possible_truncation)]
+      Verify this cast is safe and won't truncate data.
+      Add explicit bounds checking or use safe conversion methods (e.g., try_into).
+      Only if fixing is not feasible, add one of:
+        // CORRECTNESS: ...
+        // SAFETY: ...
 
 FAIL: escapes
 "#,
@@ -346,10 +349,11 @@ check = "comment"
 
     check("escapes").pwd(temp.path()).fails().stdout_eq(
         r#"escapes: FAIL
-  src/lib.rs:1: suppress_missing_comment: #[allow(unused_variables)]
-    Fix the underlying issue instead of suppressing the lint.
-    Suppressions should only be used when the lint is a false positive.
-    Only if the lint is a false positive, add a comment above the attribute.
+  src/lib.rs
+    1: suppress_missing_comment: #[allow(unused_variables)]
+      Fix the underlying issue instead of suppressing the lint.
+      Suppressions should only be used when the lint is a false positive.
+      Only if the lint is a false positive, add a comment above the attribute.
 
 FAIL: escapes
 "#,
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Behavioral specs for TODO/FIXME issue references.
+//!
+//! Reference: docs/specs/checks/escape-hatches.md#issue-references
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+/// Spec: docs/specs/checks/escape-hatches.md#issue-references
+///
+/// > Opt-in rule `unreferenced_todo` flags tags in comments that aren't
+/// > directly followed by a reference in parentheses or brackets
+#[test]
+fn todo_without_reference_fails_in_every_language() {
+    let escapes = check("escapes").on("escapes/todo-fail").json().fails();
+    let violations = escapes.violations_of_type("forbidden");
+
+    let locations: Vec<(&str, u64, u64)> = violations
+        .iter()
+        .map(|v| {
+            assert_eq!(
+                v.get("pattern").and_then(|p| p.as_str()),
+                Some("unreferenced_todo")
+            );
+            (
+                v.get("file").and_then(|f| f.as_str()).unwrap(),
+                v.get("line").and_then(|l| l.as_u64()).unwrap(),
+                v.get("column").and_then(|c| c.as_u64()).unwrap(),
+            )
+        })
+        .collect();
+    assert_eq!(
+        locations,
+        vec![
+            ("scripts/deploy.py", 3, 7),
+            ("src/lib.rs", 3, 8),
+            ("src/lib.rs", 4, 20),
+        ]
+    );
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#issue-references
+///
+/// > For GitHub issues, set `reference = "#[0-9]+"` to accept `TODO(#123)`
+#[test]
+fn todo_with_configured_reference_passes() {
+    check("escapes").on("escapes/todo-ok").passes();
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#issue-references
+///
+/// > For GitHub issues, set `reference = "#[0-9]+"` to accept `TODO(#123)`
+#[test]
+fn todo_reference_must_match_configured_format() {
+    let temp = Project::empty();
+    temp.config(
+        r##"[check.escapes.todo]
+check = "error"
+reference = "#[0-9]+"
+"##,
+    );
+    temp.file("src/lib.rs", "// TODO(JIRA-123): fix\npub fn f() {}\n");
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  src/lib.rs\n    1:4: forbidden: unreferenced_todo");
+}
+
+/// Spec: docs/specs/checks/escape-hatches.md#issue-references
+///
+/// > Opt-in rule `unreferenced_todo`
+#[test]
+fn todo_rule_is_off_by_default() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("src/lib.rs", "// TODO: fix\npub fn f() {}\n");
+    check("escapes").pwd(temp.path()).passes();
+}
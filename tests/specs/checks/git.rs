@@ -492,13 +492,14 @@ fn git_missing_docs_violation_references_file() {
 fn exact_missing_docs_text() {
     check("git").on("git/missing-docs").fails().stdout_eq(
         r###"git: FAIL
-  CLAUDE.md: feature commits without documentation
-    Add a Commits section describing the format, e.g.:
+  CLAUDE.md
+    feature commits without documentation
+      Add a Commits section describing the format, e.g.:
 
-    ## Commits
+      ## Commits
 
-    Use conventional commit format: `type(scope): description`
-    Types: feat, fix, chore, docs, test, refactor
+      Use conventional commit format: `type(scope): description`
+      Types: feat, fix, chore, docs, test, refactor
 
 FAIL: git
 "###,
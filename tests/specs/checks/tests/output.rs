@@ -95,8 +95,9 @@ check = "error"
         .fails()
         .stdout_eq(
             "tests: FAIL
-  src/feature.rs: missing_tests
-    Add tests in tests/feature.rs or a sibling feature_tests.rs file
+  src/feature.rs
+    missing_tests
+      Add tests in tests/feature.rs or a sibling feature_tests.rs file
 FAIL: tests
 ",
         );
@@ -123,8 +124,8 @@ check = "error"
         .args(&["--base", "main"])
         .fails()
         .stdout_has("tests: FAIL")
-        .stdout_has("src/parser.rs: missing_tests")
-        .stdout_has("src/lexer.rs: missing_tests");
+        .stdout_has("  src/parser.rs\n    missing_tests")
+        .stdout_has("  src/lexer.rs\n    missing_tests");
 }
 
 /// Spec: JSON output includes change_type and lines_changed
@@ -61,6 +61,26 @@ fn version_exits_successfully() {
     quench_cmd().arg("--version").assert().success();
 }
 
+/// Spec: docs/specs/01-cli.md#global-flags
+///
+/// > `quench --version` prints one line, so CI can pin and log the exact build
+#[test]
+fn version_prints_version_commit_and_compiler() {
+    let output = quench_cmd().arg("--version").output().unwrap();
+    assert!(output.status.success());
+    let stdout = String::from_utf8(output.stdout).unwrap();
+
+    let version = stdout.trim().strip_prefix("quench ").unwrap();
+    assert!(
+        version.starts_with(env!("CARGO_PKG_VERSION")),
+        "stdout: {}",
+        stdout
+    );
+    assert!(version.contains("(commit "), "stdout: {}", stdout);
+    assert!(version.contains(", rustc "), "stdout: {}", stdout);
+    assert_eq!(stdout.lines().count(), 1, "stdout: {}", stdout);
+}
+
 /// Spec: docs/specs/01-cli.md#commands
 ///
 /// > quench check runs quality checks
@@ -108,3 +128,68 @@ fn unknown_command_fails() {
         .code(2)
         .stderr(predicates::str::is_match(r"(?i)(unrecognized|unknown)").unwrap());
 }
+
+// =============================================================================
+// RULES COMMAND SPECS
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#quench-rules
+///
+/// > Generated from the rule registry, so every built-in rule is listed.
+#[test]
+fn rules_json_lists_every_registered_rule() {
+    use quench::adapter::{ProjectLanguage, default_escapes};
+
+    let output = quench_cmd()
+        .args(["rules", "--format", "json"])
+        .output()
+        .unwrap();
+    assert!(output.status.success());
+    let rules: Vec<serde_json::Value> = serde_json::from_slice(&output.stdout).unwrap();
+    let listed: Vec<(&str, &str)> = rules
+        .iter()
+        .filter_map(|r| Some((r.get("language")?.as_str()?, r.get("id")?.as_str()?)))
+        .collect();
+
+    for language in ProjectLanguage::ADAPTERS {
+        let name = language.to_string().to_ascii_lowercase();
+        for pattern in default_escapes(language) {
+            assert!(
+                listed.contains(&(name.as_str(), pattern.name)),
+                "{} rule {} missing from `quench rules`",
+                name,
+                pattern.name
+            );
+        }
+    }
+    assert!(listed.contains(&("go", "go_recover")));
+}
+
+/// Spec: docs/specs/01-cli.md#quench-rules
+///
+/// > Each rule's id, default severity, required marker, and a one-line description.
+#[test]
+fn rules_text_shows_severity_and_marker() {
+    let output = quench_cmd().arg("rules").output().unwrap();
+    assert!(output.status.success());
+    let stdout = String::from_utf8(output.stdout).unwrap();
+
+    assert!(stdout.contains("go:\n"), "stdout: {}", stdout);
+    let line = stdout
+        .lines()
+        .find(|l| l.trim_start().starts_with("unsafe_pointer "))
+        .unwrap();
+    assert!(line.contains(" error "), "line: {}", line);
+    assert!(line.contains("// SAFETY:"), "line: {}", line);
+    assert!(
+        line.contains("explaining pointer validity"),
+        "line: {}",
+        line
+    );
+
+    let noescape = stdout
+        .lines()
+        .find(|l| l.trim_start().starts_with("go_noescape "))
+        .unwrap();
+    assert!(noescape.contains(" warning "), "line: {}", noescape);
+}
@@ -7,7 +7,7 @@
 //! - Requires --fix flag
 //! - Shows files that would be modified
 //! - Shows diff of proposed changes
-//! - Exits 0 even when fixes needed
+//! - Exits 1 when fixes are needed
 //! - Does not modify any files
 //!
 //! Reference: docs/specs/01-cli.md#output-flags
@@ -63,7 +63,7 @@ sync_from = "CLAUDE.md"
     cli()
         .pwd(temp.path())
         .args(&["--fix", "--dry-run"])
-        .passes()
+        .exits(1)
         .stdout_has(".cursorrules");
 }
 
@@ -88,7 +88,7 @@ sections.required = []
     cli()
         .pwd(temp.path())
         .args(&["--fix", "--dry-run"])
-        .passes()
+        .exits(1)
         .stdout_has("Content B") // Old content (being removed)
         .stdout_has("Landing the Plane"); // New content (being added)
 }
@@ -99,9 +99,9 @@ sections.required = []
 
 /// Spec: docs/specs/01-cli.md#output-flags
 ///
-/// > --dry-run exits 0 even when fixes are needed.
+/// > --dry-run exits 1 when --fix would change anything.
 #[test]
-fn dry_run_exits_0_when_fixes_needed() {
+fn dry_run_exits_1_when_fixes_needed() {
     let temp = Project::empty();
     temp.config(
         r#"[check.agents]
@@ -113,11 +113,11 @@ sync_from = "CLAUDE.md"
     temp.file("CLAUDE.md", SOURCE);
     temp.file(".cursorrules", TARGET);
 
-    // Files are out of sync, fixes are needed, but --dry-run exits 0
+    // Files are out of sync, so fixes are pending
     cli()
         .pwd(temp.path())
         .args(&["--fix", "--dry-run"])
-        .passes(); // passes() expects exit code 0
+        .exits(1);
 }
 
 // =============================================================================
@@ -144,7 +144,7 @@ sync_from = "CLAUDE.md"
     cli()
         .pwd(temp.path())
         .args(&["--fix", "--dry-run"])
-        .passes();
+        .exits(1);
 
     // Verify .cursorrules was NOT modified
     let content = std::fs::read_to_string(temp.path().join(".cursorrules")).unwrap();
@@ -201,7 +201,7 @@ sections.required = []
         .pwd(temp.path())
         .args(&["--fix", "--dry-run"])
         .json()
-        .passes();
+        .fails();
 
     // Find the agents check result
     let agents = result
@@ -7,6 +7,9 @@
 //! - Global flags (-h, -V, -C)
 //! - Check command flags (-o, --output)
 //! - Unknown flags (exit code 2)
+//! - Custom check failure status (--exit-code)
+//! - Scan progress line (--no-progress)
+//! - Exit-code-only runs (--quiet)
 //!
 //! Reference: docs/specs/01-cli.md#global-flags
 
@@ -131,3 +134,252 @@ fn check_unknown_long_flag_fails() {
         .code(2)
         .stderr(predicates::str::is_match(r"(?i)(unexpected|unknown|unrecognized)").unwrap());
 }
+
+// =============================================================================
+// EXIT CODE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > `--exit-code N` sets the status used when checks fail.
+#[test]
+fn exit_code_sets_check_failure_status() {
+    check("escapes").on("golang/unsafe-add-fail").exits(1);
+    check("escapes")
+        .on("golang/unsafe-add-fail")
+        .args(&["--exit-code", "10"])
+        .exits(10);
+    check("escapes")
+        .on("golang/unsafe-add-ok")
+        .args(&["--exit-code", "10"])
+        .passes();
+}
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > Configuration and argument errors exit 2 whatever `--exit-code` is, so
+/// > a pipeline can tell violations apart from quench failing.
+#[test]
+fn exit_code_does_not_change_error_status() {
+    check("escapes")
+        .on("golang/unsafe-add-fail")
+        .args(&["--exit-code", "10", "nope"])
+        .exits(2)
+        .stderr_has("path not found");
+}
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > 0, 2, and 3 are reserved and can't be used as `--exit-code`.
+#[test]
+fn exit_code_rejects_reserved_status() {
+    quench_cmd()
+        .args(["check", "--exit-code", "2"])
+        .assert()
+        .code(2)
+        .stderr(predicates::str::contains("reserved"));
+}
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > the run exits 0 while at most N violations would fail it (errors, plus
+/// > warnings under `--fail-on warning`), and fails once there are more.
+#[test]
+fn max_violations_fails_only_above_threshold() {
+    let temp = Project::empty();
+    temp.config(
+        r#"[[check.escapes.patterns]]
+name = "unwrap"
+pattern = "\\.unwrap\\(\\)"
+action = "forbid"
+
+[[check.escapes.patterns]]
+name = "dbg"
+pattern = "dbg!"
+action = "forbid"
+
+[rules.dbg]
+severity = "warning"
+"#,
+    );
+    temp.file(
+        "src/a.rs",
+        "fn a() { x.unwrap(); }\nfn b() { y.unwrap(); }\n",
+    );
+    temp.file("src/b.rs", "fn c() { dbg!(1); }\n");
+
+    // Two errors; the warning doesn't count
+    check("escapes").pwd(temp.path()).fails();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--max-violations", "2"])
+        .passes();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--max-violations", "1", "--exit-code", "10"])
+        .exits(10);
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--max-violations", "2", "--fail-on", "warning"])
+        .fails();
+}
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > `--no-fail` exits 0 whatever the checks find
+#[test]
+fn no_fail_exits_zero_with_violations() {
+    let temp = Project::empty();
+    temp.config(
+        r#"[[check.escapes.patterns]]
+name = "unwrap"
+pattern = "\\.unwrap\\(\\)"
+action = "forbid"
+"#,
+    );
+    temp.file("src/a.rs", "fn a() { x.unwrap(); }\n");
+
+    check("escapes").pwd(temp.path()).fails();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--no-fail", "--fail-on", "warning", "--exit-code", "10"])
+        .passes()
+        .stdout_has("escapes: FAIL")
+        .stdout_has("forbidden: unwrap");
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--no-fail"])
+        .json()
+        .passes();
+    assert_eq!(escapes.violations().len(), 1);
+}
+
+/// Spec: docs/specs/01-cli.md#exit-codes
+///
+/// > `quench check --help` lists the exit codes.
+#[test]
+fn check_help_lists_exit_codes() {
+    quench_cmd()
+        .args(["check", "--help"])
+        .assert()
+        .success()
+        .stdout(predicates::str::contains("Exit codes:"))
+        .stdout(predicates::str::contains("1  One or more checks failed"));
+}
+
+// =============================================================================
+// PROGRESS SPECS
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > It only appears when stderr is a terminal
+#[test]
+fn progress_not_shown_when_stderr_is_not_a_terminal() {
+    check("escapes")
+        .on("golang/unsafe-add-ok")
+        .passes()
+        .stderr_eq("");
+    check("escapes")
+        .on("golang/unsafe-add-fail")
+        .fails()
+        .stderr_lacks("Scanning");
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `--no-progress` | Don't show the scan progress line
+#[test]
+fn no_progress_flag_is_accepted() {
+    check("escapes")
+        .on("golang/unsafe-add-ok")
+        .args(&["--no-progress"])
+        .passes()
+        .stderr_eq("");
+}
+
+// =============================================================================
+// QUIET SPECS
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `--quiet` prints nothing on stdout or stderr, and still exits 1 when
+/// > checks fail
+#[test]
+fn quiet_prints_nothing_and_sets_exit_code() {
+    check("escapes")
+        .on("golang/unsafe-add-fail")
+        .args(&["--quiet"])
+        .fails()
+        .stdout_eq("")
+        .stderr_eq("");
+    check("escapes")
+        .on("golang/unsafe-add-ok")
+        .args(&["-q"])
+        .passes()
+        .stdout_eq("")
+        .stderr_eq("");
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `--quiet` prints nothing on stdout or stderr
+#[test]
+fn quiet_hides_warnings() {
+    // An unused quench:ignore directive and a missing --baseline file
+    cli()
+        .on("golang/inline-ignore-stale")
+        .args(&["--quiet", "--baseline", "missing-baseline.json"])
+        .passes()
+        .stdout_eq("")
+        .stderr_eq("");
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `-o` and `--format` are ignored
+#[test]
+fn quiet_ignores_output_format() {
+    check("escapes")
+        .on("golang/unsafe-add-fail")
+        .args(&["--quiet", "-o", "json", "--format", "sarif"])
+        .fails()
+        .stdout_eq("");
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > Failing follows `--fail-on`
+#[test]
+fn quiet_fails_on_warnings_with_fail_on_warning() {
+    let temp = Project::empty();
+    temp.config("[check.escapes]\ncheck = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tvar x int\n\t_ = unsafe.Pointer(&x)\n}\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--quiet"])
+        .passes()
+        .stdout_eq("");
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--quiet", "--fail-on", "warning"])
+        .fails()
+        .stdout_eq("")
+        .stderr_eq("");
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > It can't be combined with `--verbose`, `--timing`, `--stats`, or `--watch`.
+#[test]
+fn quiet_conflicts_with_verbose() {
+    check("escapes")
+        .on("golang/unsafe-add-ok")
+        .args(&["--quiet", "--verbose"])
+        .exits(2);
+}
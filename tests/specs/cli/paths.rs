@@ -0,0 +1,384 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Behavioral specs for path arguments to `quench check`.
+//!
+//! Tests that quench correctly:
+//! - Checks only the listed paths, merging their results
+//! - Checks files under overlapping paths once
+//! - Reports paths relative to the project root, wherever it runs from
+//! - Detects the project root, or takes it from `--root`
+//! - Checks the paths listed by `--files-from`
+//! - Expands Go package patterns like `./...` into the packages' files
+//!
+//! Reference: docs/specs/01-cli.md#file-arguments
+//! Reference: docs/specs/01-cli.md#go-package-patterns
+//! Reference: docs/specs/01-cli.md#project-root
+//! Reference: docs/specs/01-cli.md#file-lists
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use std::io::Write;
+use std::process::Stdio;
+
+use crate::prelude::*;
+
+const UNSAFE_GO: &str = "package p\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n";
+
+/// Go project with the same escape in three packages under `internal/`.
+fn go_tree() -> Project {
+    let temp = Project::empty();
+    temp.config("[check.agents]\nrequired = []\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file("internal/store/ptr.go", UNSAFE_GO);
+    temp.file("internal/wire/ptr.go", UNSAFE_GO);
+    temp.file("internal/cache/ptr.go", UNSAFE_GO);
+    temp
+}
+
+fn violation_files(escapes: &CheckJson) -> Vec<String> {
+    let mut files: Vec<String> = escapes
+        .violations()
+        .iter()
+        .filter_map(|v| v.get("file")?.as_str().map(str::to_string))
+        .collect();
+    files.sort();
+    files
+}
+
+/// Spec: docs/specs/01-cli.md#file-arguments
+///
+/// > Several paths are checked in one run and their results merged.
+#[test]
+fn two_paths_with_common_ancestor_are_merged() {
+    let temp = go_tree();
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["internal/store", "./internal/wire"])
+        .json()
+        .fails();
+    assert_eq!(
+        violation_files(&escapes),
+        vec!["internal/store/ptr.go", "internal/wire/ptr.go"]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#file-arguments
+///
+/// > A file under overlapping paths (`quench check internal internal/store`) is checked once.
+#[test]
+fn overlapping_paths_check_each_file_once() {
+    let temp = go_tree();
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["internal", "internal/store", "internal/store/ptr.go"])
+        .json()
+        .fails();
+    assert_eq!(
+        violation_files(&escapes),
+        vec![
+            "internal/cache/ptr.go",
+            "internal/store/ptr.go",
+            "internal/wire/ptr.go",
+        ]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#file-arguments
+///
+/// > Reported paths are relative to the project root, whichever argument
+/// > matched them
+#[test]
+fn text_output_paths_relative_to_project_root() {
+    let temp = go_tree();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["internal/store", "internal/cache"])
+        .fails()
+        .stdout_has("  internal/store/ptr.go\n    5:9: missing_comment: unsafe_pointer")
+        .stdout_has("  internal/cache/ptr.go\n    5:9: missing_comment: unsafe_pointer")
+        .stdout_lacks("internal/wire");
+}
+
+/// Spec: docs/specs/01-cli.md#file-arguments
+///
+/// > A path that doesn't exist is a configuration error (exit code 2).
+#[test]
+fn missing_path_is_config_error() {
+    let temp = go_tree();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["internal/store", "internal/nope"])
+        .exits(2)
+        .stderr_has("path not found");
+}
+
+/// Spec: docs/specs/01-cli.md#file-arguments
+///
+/// > Reported paths are relative to the project root, whichever argument
+/// > matched them and wherever quench was run from
+#[test]
+fn paths_from_nested_directory_match_project_root() {
+    let temp = go_tree();
+
+    let from_root = check("escapes")
+        .pwd(temp.path())
+        .args(&["internal/store"])
+        .json()
+        .fails();
+    let nested = check("escapes")
+        .pwd(temp.path().join("internal/store"))
+        .args(&["."])
+        .json()
+        .fails();
+    assert_eq!(violation_files(&nested), vec!["internal/store/ptr.go"]);
+    assert_eq!(violation_files(&nested), violation_files(&from_root));
+
+    check("escapes")
+        .pwd(temp.path().join("internal/store"))
+        .args(&["../wire/ptr.go"])
+        .fails()
+        .stdout_has("  internal/wire/ptr.go\n    5:9: missing_comment: unsafe_pointer");
+}
+
+/// Spec: docs/specs/01-cli.md#project-root
+///
+/// > Without path arguments the whole project is checked, even from a
+/// > subdirectory.
+#[test]
+fn nested_directory_without_paths_checks_whole_project() {
+    let temp = go_tree();
+
+    let escapes = check("escapes")
+        .pwd(temp.path().join("internal/wire"))
+        .json()
+        .fails();
+    assert_eq!(
+        violation_files(&escapes),
+        vec![
+            "internal/cache/ptr.go",
+            "internal/store/ptr.go",
+            "internal/wire/ptr.go",
+        ]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#project-root
+///
+/// > `--root <DIR>` sets it explicitly.
+#[test]
+fn root_flag_overrides_detection() {
+    let temp = go_tree();
+    temp.file("services/api/go.mod", "module example.com/api\n\ngo 1.21\n");
+    temp.file("services/api/store/ptr.go", UNSAFE_GO);
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--root", "services/api"])
+        .fails()
+        .stdout_has("  store/ptr.go\n    5:9: missing_comment: unsafe_pointer")
+        .stdout_lacks("services/")
+        .stdout_lacks("internal/");
+}
+
+/// Spec: docs/specs/01-cli.md#project-root
+///
+/// > A path or `--root` directory that doesn't exist is a configuration error
+/// > (exit code 2).
+#[test]
+fn missing_root_is_config_error() {
+    let temp = go_tree();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--root", "nope"])
+        .exits(2)
+        .stderr_has("root not found");
+}
+
+// =============================================================================
+// FILE LISTS
+// =============================================================================
+
+/// [`go_tree`] with `internal/cache/` ignored by `.gitignore`, and a list of
+/// one file in each package plus a deleted one.
+fn listed_tree() -> Project {
+    let temp = go_tree();
+    temp.file(".gitignore", "internal/cache/\n");
+    temp.file(
+        "changed.txt",
+        "internal/store/ptr.go\ninternal/cache/ptr.go\n\ninternal/gone.go\n",
+    );
+    temp
+}
+
+/// Spec: docs/specs/01-cli.md#file-lists
+///
+/// > Only the listed files are checked, and `.gitignore` and ignore patterns
+/// > still skip listed files they match.
+#[test]
+fn files_from_checks_listed_files_except_ignored() {
+    let temp = listed_tree();
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--files-from", "changed.txt"])
+        .json()
+        .fails();
+    assert_eq!(violation_files(&escapes), vec!["internal/store/ptr.go"]);
+}
+
+/// Spec: docs/specs/01-cli.md#file-lists
+///
+/// > `--no-ignore-files-from` checks every listed file, ignored or not.
+#[test]
+fn no_ignore_files_from_checks_ignored_listed_files() {
+    let temp = listed_tree();
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--files-from", "changed.txt", "--no-ignore-files-from"])
+        .json()
+        .fails();
+    assert_eq!(
+        violation_files(&escapes),
+        vec!["internal/cache/ptr.go", "internal/store/ptr.go"]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#file-lists
+///
+/// > `--files-from -` reads the list from stdin.
+#[test]
+fn files_from_stdin() {
+    let temp = listed_tree();
+
+    let mut child = quench_cmd()
+        .args(["check", "--no-cache", "--escapes", "--files-from", "-"])
+        .current_dir(temp.path())
+        .stdin(Stdio::piped())
+        .stdout(Stdio::piped())
+        .spawn()
+        .expect("command should run");
+    child
+        .stdin
+        .take()
+        .unwrap()
+        .write_all(b"internal/wire/ptr.go\n")
+        .unwrap();
+    let output = child.wait_with_output().expect("command should finish");
+
+    assert_eq!(output.status.code(), Some(1));
+    let stdout = String::from_utf8_lossy(&output.stdout);
+    assert!(stdout.contains("internal/wire/ptr.go"), "{stdout}");
+    assert!(!stdout.contains("internal/store/ptr.go"), "{stdout}");
+}
+
+/// Spec: docs/specs/01-cli.md#file-lists
+///
+/// > An empty list checks nothing
+#[test]
+fn empty_file_list_checks_nothing() {
+    let temp = listed_tree();
+    temp.file("changed.txt", "");
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--files-from", "changed.txt"])
+        .passes();
+}
+
+/// Spec: docs/specs/01-cli.md#file-lists
+///
+/// > A list that can't be read is a configuration error (exit code 2).
+#[test]
+fn missing_file_list_is_config_error() {
+    let temp = go_tree();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--files-from", "nope.txt"])
+        .exits(2)
+        .stderr_has("could not read nope.txt");
+}
+
+// =============================================================================
+// GO PACKAGE PATTERNS
+// =============================================================================
+
+fn go_file_counts(cloc: &CheckJson) -> (Option<u64>, Option<u64>) {
+    let metrics = cloc.require("metrics");
+    (
+        metrics.get("source_files").and_then(|v| v.as_u64()),
+        metrics.get("test_files").and_then(|v| v.as_u64()),
+    )
+}
+
+/// Spec: docs/specs/01-cli.md#go-package-patterns
+///
+/// > `./...` matches every package under the current directory
+#[test]
+fn package_pattern_checks_every_package_in_module() {
+    let cloc = check("cloc")
+        .on("go-multi")
+        .args(&["./..."])
+        .json()
+        .passes();
+    // cmd/cli, cmd/server, internal/core, pkg/api, and pkg/storage
+    assert_eq!(go_file_counts(&cloc), (Some(5), Some(3)));
+
+    let cloc = check("cloc")
+        .on("go-multi")
+        .args(&["./pkg/..."])
+        .json()
+        .passes();
+    assert_eq!(go_file_counts(&cloc), (Some(2), Some(2)));
+}
+
+/// Spec: docs/specs/01-cli.md#go-package-patterns
+///
+/// > A package is a directory with `.go` files, and stands for its own `.go`
+/// > files only, so `testdata` directories, nested modules, and files other
+/// > than Go source aren't checked.
+#[test]
+fn package_pattern_skips_testdata_and_nested_modules() {
+    let temp = go_tree();
+    temp.file("internal/store/testdata/ptr.go", UNSAFE_GO);
+    temp.file(
+        "internal/tools/go.mod",
+        "module example.com/tools\n\ngo 1.21\n",
+    );
+    temp.file("internal/tools/ptr.go", UNSAFE_GO);
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["./internal/..."])
+        .json()
+        .fails();
+    assert_eq!(
+        violation_files(&escapes),
+        vec![
+            "internal/cache/ptr.go",
+            "internal/store/ptr.go",
+            "internal/wire/ptr.go",
+        ]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#go-package-patterns
+///
+/// > A pattern that matches no packages is a configuration error (exit code 2).
+#[test]
+fn package_pattern_matching_nothing_is_config_error() {
+    let temp = go_tree();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["./cmd/..."])
+        .exits(2)
+        .stderr_has("pattern ./cmd/... matched no packages");
+}
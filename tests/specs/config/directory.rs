@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Behavioral specs for per-directory rule overrides.
+//!
+//! Reference: docs/specs/02-config.md#directory-overrides
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+/// Spec: docs/specs/02-config.md#directory-overrides
+///
+/// > Each file's violations take their levels from the nearest config above
+/// > them: overrides merge over the root config and every nested config in
+/// > between, and the nearest directory wins
+#[test]
+fn nested_configs_override_rules_for_their_subtree() {
+    let escapes = check("escapes").on("go-layered").json().fails();
+    let mut levels: Vec<(String, bool)> = escapes
+        .violations()
+        .iter()
+        .filter_map(|v| {
+            let warning = v.get("warning").and_then(|w| w.as_bool());
+            Some((v.get("file")?.as_str()?.to_string(), warning == Some(true)))
+        })
+        .collect();
+    levels.sort();
+
+    // cmd/ keeps the root's warn, internal/ raises it to error, and
+    // internal/legacy/ turns it off
+    assert_eq!(
+        levels,
+        vec![
+            ("cmd/tool/main.go".to_string(), true),
+            ("internal/store/store.go".to_string(), false),
+        ]
+    );
+}
+
+/// Spec: docs/specs/02-config.md#directory-overrides
+///
+/// > A `.quench.yml` (or `quench.toml`) in a subdirectory overrides rule
+/// > severity for the files under it
+#[test]
+fn adding_nested_config_overrides_root_severity() {
+    let temp = Project::empty();
+    temp.config("[severity]\nunsafe_pointer = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "cmd/tool/main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\t_ = unsafe.Pointer(&x)\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+
+    temp.file(
+        "cmd/.quench.yml",
+        "version: 1\nseverity:\n  unsafe_pointer: error\n",
+    );
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stdout_has("  cmd/tool/main.go\n    7:6: missing_comment: unsafe_pointer");
+}
+
+/// Spec: docs/specs/02-config.md#directory-overrides
+///
+/// > A nested config that sets anything else belongs to a separate project
+/// > (a monorepo member, a test fixture): it and everything below it are
+/// > skipped, and its files keep the root config's levels
+#[test]
+fn nested_project_config_is_not_an_override() {
+    let temp = Project::empty();
+    temp.config("[severity]\nunsafe_pointer = \"warn\"\n");
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "services/api/main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\t_ = unsafe.Pointer(&x)\n}\n",
+    );
+    temp.file(
+        "services/api/.quench.yml",
+        "version: 1\nseverity:\n  unsafe_pointer: error\ncheck:\n  escapes:\n    check: error\n",
+    );
+    temp.file(
+        "services/api/internal/.quench.yml",
+        "version: 1\nseverity:\n  unsafe_pointer: error\n",
+    );
+    temp.file(
+        "services/api/internal/lib.go",
+        "package internal\n\nimport \"unsafe\"\n\nfunc Addr(x *int) {\n\t_ = unsafe.Pointer(x)\n}\n",
+    );
+    check("escapes").pwd(temp.path()).passes();
+}
+
+/// Spec: docs/specs/02-config.md#directory-overrides
+///
+/// > Overrides are validated like the root config
+#[test]
+fn invalid_nested_override_is_config_error() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("internal/lib.go", "package internal\n");
+    temp.file(
+        "internal/.quench.yml",
+        "version: 1\nseverity:\n  unsafe_pointer: loud\n",
+    );
+    cli().pwd(temp.path()).exits(2).stderr_has("loud");
+}
@@ -5,6 +5,8 @@
 //!
 //! Tests that quench correctly handles:
 //! - Config file validation
+//! - YAML config files
+//! - Per-directory rule overrides
 //! - Environment variables
 //! - Git configuration
 //!
@@ -20,3 +22,9 @@ mod env;
 
 #[path = "git.rs"]
 mod git;
+
+#[path = "yaml.rs"]
+mod yaml;
+
+#[path = "directory.rs"]
+mod directory;
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Behavioral specs for `.quench.yml` config files.
+//!
+//! Reference: docs/specs/02-config.md#yaml-config
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+// =============================================================================
+// DISCOVERY SPECS
+// =============================================================================
+
+/// Spec: docs/specs/02-config.md#yaml-config
+///
+/// > `.quench.yml` is used when no quench.toml is found first
+#[test]
+fn yaml_config_enables_check_and_ignores_globs() {
+    let escapes = check("escapes").on("yaml-config").json().fails();
+    let violations = escapes.violations_of_type("missing_comment");
+
+    // generated/ is ignored by project.ignore
+    assert_eq!(violations.len(), 1);
+    assert!(escapes.has_violation_for_file("main.go"));
+    assert!(!escapes.has_violation_for_file("bindings.go"));
+}
+
+/// Spec: docs/specs/02-config.md#yaml-config
+///
+/// > Checks are disabled with `check: "off"`
+#[test]
+fn yaml_config_disables_check() {
+    // main.go exceeds max_lines, but cloc is off
+    check("cloc").on("yaml-config").passes();
+}
+
+/// Spec: docs/specs/02-config.md#yaml-config
+///
+/// > Severities are set with `check: warn`
+#[test]
+fn yaml_config_sets_severity() {
+    let temp = Project::empty();
+    temp.file(
+        ".quench.yml",
+        "version: 1\ncheck:\n  escapes:\n    check: warn\n",
+    );
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+    );
+
+    let escapes = check("escapes").pwd(temp.path()).json().passes();
+    assert!(escapes.has_violation("missing_comment"));
+}
+
+/// Spec: docs/specs/02-config.md#discovery
+///
+/// > CLI flags (highest priority)
+#[test]
+fn cli_flags_override_yaml_config() {
+    // escapes is enabled at error level in .quench.yml and would fail
+    cli()
+        .on("yaml-config")
+        .args(&["--no-escapes", "--no-git"])
+        .passes();
+}
+
+/// Spec: docs/specs/02-config.md#discovery
+///
+/// > quench.toml is preferred when both files exist in the same directory
+#[test]
+fn toml_config_preferred_over_yaml() {
+    let temp = Project::empty();
+    temp.config("[check.escapes]\ncheck = \"off\"\n");
+    temp.file(
+        ".quench.yml",
+        "version: 1\ncheck:\n  escapes:\n    check: error\n",
+    );
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nvar p = unsafe.Pointer(nil)\n",
+    );
+
+    check("escapes").pwd(temp.path()).passes();
+}
+
+// =============================================================================
+// ERROR SPECS
+// =============================================================================
+
+/// Spec: docs/specs/02-config.md#validation
+///
+/// > Malformed YAML reports the line and column with the offending source line
+#[test]
+fn malformed_yaml_reports_line_context() {
+    let temp = Project::empty();
+    temp.file(
+        ".quench.yml",
+        "version: 1\ncheck:\n  escapes:\n    check: loud\n",
+    );
+
+    cli()
+        .pwd(temp.path())
+        .exits(2)
+        .stderr_has("YAML parse error at line 4, column 12")
+        .stderr_has("4 |     check: loud");
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Violation baseline behavioral specifications.
+//!
+//! Reference: docs/specs/01-cli.md#violation-baseline
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+const GO_MOD: &str = "module example.com/fixture\n\ngo 1.21\n";
+const BASELINE: &str = ".quench-baseline.json";
+
+fn legacy_project() -> Project {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", GO_MOD);
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\tp := unsafe.Pointer(&x)\n\t_ = p\n}\n",
+    );
+    temp
+}
+
+/// Spec: docs/specs/01-cli.md#violation-baseline
+///
+/// > `--write-baseline` | Record all current violations to the `--baseline` file
+#[test]
+fn write_baseline_records_current_violations() {
+    let temp = legacy_project();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE, "--write-baseline"])
+        .passes();
+
+    let baseline: serde_json::Value =
+        serde_json::from_str(&std::fs::read_to_string(temp.path().join(BASELINE)).unwrap())
+            .unwrap();
+    assert_eq!(baseline["quench_version"], env!("CARGO_PKG_VERSION"));
+    let violations = baseline["violations"].as_array().unwrap();
+    assert_eq!(violations.len(), 1);
+    assert_eq!(violations[0]["file"], "main.go");
+    assert_eq!(violations[0]["rule"], "unsafe_pointer");
+    assert!(violations[0].get("line").is_none());
+}
+
+/// Spec: docs/specs/01-cli.md#violation-baseline
+///
+/// > Line numbers are not stored, so edits that move a baselined line don't resurface it.
+#[test]
+fn baselined_violation_suppressed_after_line_drift() {
+    let temp = legacy_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE, "--write-baseline"])
+        .passes();
+
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\n// Entry point.\n//\n// Does little.\nfunc main() {\n\tx := 1\n\tp := unsafe.Pointer(&x)\n\t_ = p\n}\n",
+    );
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE])
+        .passes();
+}
+
+/// Spec: docs/specs/01-cli.md#violation-baseline
+///
+/// > A violation baseline records them so only new violations are reported
+#[test]
+fn new_violation_fails_with_baseline() {
+    let temp = legacy_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE, "--write-baseline"])
+        .passes();
+
+    temp.file(
+        "extra.go",
+        "package main\n\nimport \"unsafe\"\n\nvar q = unsafe.Pointer(nil)\n",
+    );
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE])
+        .json()
+        .fails();
+    assert!(escapes.has_violation_for_file("extra.go"));
+    assert!(!escapes.has_violation_for_file("main.go"));
+}
+
+/// Spec: docs/specs/01-cli.md#violation-baseline
+///
+/// > A fixed violation no longer matches anything and is ignored.
+#[test]
+fn fixed_baselined_violation_is_ignored() {
+    let temp = legacy_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE, "--write-baseline"])
+        .passes();
+
+    temp.file("main.go", "package main\n\nfunc main() {}\n");
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE])
+        .passes()
+        .stderr_eq("");
+}
+
+/// Spec: docs/specs/01-cli.md#violation-baseline
+///
+/// > A missing baseline file is a warning, and all violations are reported.
+#[test]
+fn missing_baseline_reports_all_violations() {
+    let temp = legacy_project();
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", BASELINE])
+        .fails()
+        .stderr_has("baseline .quench-baseline.json not found");
+}
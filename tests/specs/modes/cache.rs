@@ -95,7 +95,7 @@ fn verbose_shows_cache_stats() {
 
 /// Spec: docs/specs/performance.md#file-caching
 ///
-/// > Modifying a file causes cache miss for that file
+/// > Changing a file's content causes cache miss for that file
 /// > Format: "Cache: N hits, M misses"
 #[test]
 fn modified_file_causes_cache_miss() {
@@ -122,11 +122,11 @@ fn modified_file_causes_cache_miss() {
         .success()
         .stderr(predicates::str::is_match(r"Cache: \d+ hits?, 0 misses?").unwrap());
 
-    // Touch file (change mtime)
+    // Change file content
     thread::sleep(Duration::from_millis(10));
-    fs::write(&test_file, "fn main() {}\n").unwrap();
+    fs::write(&test_file, "fn main() { let _x = 1; }\n").unwrap();
 
-    // Third run: should have at least one miss for the touched file
+    // Third run: should have at least one miss for the modified file
     quench_cmd()
         .args(["check"])
         .env("QUENCH_DEBUG", "1")
@@ -136,6 +136,125 @@ fn modified_file_causes_cache_miss() {
         .stderr(predicates::str::is_match(r"Cache: \d+ hits?, [1-9]\d* misses?").unwrap());
 }
 
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > Entries are keyed on content hash, so rewriting a file with the same
+/// > content (new mtime) still hits the cache
+#[test]
+fn touched_file_with_same_content_hits_cache() {
+    let temp = default_project();
+    let test_file = temp.path().join("test.rs");
+    fs::write(&test_file, "fn main() {}\n").unwrap();
+
+    quench_cmd()
+        .args(["check"])
+        .current_dir(temp.path())
+        .assert()
+        .success();
+
+    // Touch file (change mtime, same content)
+    thread::sleep(Duration::from_millis(10));
+    fs::write(&test_file, "fn main() {}\n").unwrap();
+
+    quench_cmd()
+        .args(["check"])
+        .env("QUENCH_DEBUG", "1")
+        .current_dir(temp.path())
+        .assert()
+        .success()
+        .stderr(predicates::str::is_match(r"Cache: \d+ hits?, 0 misses?").unwrap());
+}
+
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > Entries record which checks ran, so enabling a check re-checks files
+/// > cached by a run without it
+#[test]
+fn enabling_check_invalidates_entries() {
+    let temp = default_project();
+    fs::write(temp.path().join("test.rs"), "fn main() {}\n").unwrap();
+
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success();
+
+    // Subset of the cached checks: served from cache
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .env("QUENCH_DEBUG", "1")
+        .current_dir(temp.path())
+        .assert()
+        .success()
+        .stderr(predicates::str::is_match(r"Cache: \d+ hits?, 0 misses?").unwrap());
+
+    // More checks than were cached: re-checked
+    quench_cmd()
+        .args(["check"])
+        .env("QUENCH_DEBUG", "1")
+        .current_dir(temp.path())
+        .assert()
+        .success()
+        .stderr(predicates::str::is_match(r"Cache: 0 hits?, \d+ misses?").unwrap());
+}
+
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > A corrupted cache file is discarded and files are re-scanned
+#[test]
+fn corrupted_cache_falls_back_to_rescan() {
+    let temp = default_project();
+    temp.config(
+        r#"
+[[check.escapes.patterns]]
+name = "unsafe"
+pattern = "unsafe\\s*\\{"
+action = "comment"
+comment = "// SAFETY:"
+"#,
+    );
+    fs::write(
+        temp.path().join("lib.rs"),
+        "pub fn f(x: *const u8) -> u8 { unsafe { *x } }\n",
+    )
+    .unwrap();
+
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains("missing_comment"));
+
+    // Flip bytes throughout the cache file
+    let cache_path = temp.path().join(".quench/cache.bin");
+    let mut bytes = fs::read(&cache_path).unwrap();
+    for byte in bytes.iter_mut().step_by(7) {
+        *byte ^= 0x5a;
+    }
+    fs::write(&cache_path, &bytes).unwrap();
+
+    // Same result, with every file re-checked
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .env("QUENCH_DEBUG", "1")
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains("missing_comment"))
+        .stderr(predicates::str::is_match(r"Cache: 0 hits?, \d+ misses?").unwrap());
+
+    // Truncated cache file
+    fs::write(&cache_path, b"q").unwrap();
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains("missing_comment"));
+}
+
 /// Spec: docs/specs/performance.md#file-caching
 ///
 /// > Config changes invalidate entire cache
@@ -184,6 +303,125 @@ max_lines = 500
         .stderr(predicates::str::is_match(r"Cache: 0 hits?, \d+ misses?").unwrap());
 }
 
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > Config changed → invalidate all, including any rule setting
+#[test]
+fn enabling_rule_invalidates_cache() {
+    let temp = default_project();
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"syscall\"\n\nfunc main() { _ = syscall.Getpid() }\n",
+    );
+
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success();
+
+    // Turn on a rule the cached entries didn't run
+    temp.config("[golang.syscall]\ncheck = \"error\"\n");
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains("syscall_import"));
+}
+
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > Config changed → invalidate all, including any rule setting
+#[test]
+fn toggling_apply_to_tests_invalidates_cache() {
+    let temp = default_project();
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "buf_test.go",
+        "package p\n\nimport \"unsafe\"\n\nfunc view(b []byte) unsafe.Pointer {\n\treturn unsafe.Pointer(&b[0])\n}\n",
+    );
+
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success();
+
+    // Cached entries skipped the test file's matches
+    temp.config("[rules.unsafe_pointer]\napply_to_tests = true\n");
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .code(1)
+        .stdout(predicates::str::contains("missing_comment: unsafe_pointer"));
+}
+
+/// Spec: docs/specs/performance.md#file-caching
+///
+/// > Cached violations keep their severity, so a warn-level rule still
+/// > passes on a warm cache
+#[test]
+fn cached_warnings_still_pass() {
+    let temp = default_project();
+    temp.config("[golang.panic]\ncheck = \"warn\"\n");
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "store/store.go",
+        "package store\n\nfunc Must(err error) {\n\tif err != nil {\n\t\tpanic(err)\n\t}\n}\n",
+    );
+
+    for _ in 0..2 {
+        quench_cmd()
+            .args(["check", "--escapes"])
+            .current_dir(temp.path())
+            .assert()
+            .success()
+            .stdout(predicates::str::contains("go_panic"));
+    }
+}
+
+/// Spec: docs/specs/langs/golang.md#large-struct-returns
+///
+/// > Results aren't cached while the rule is on, so growing a struct in one
+/// > file flags the unchanged files that return it
+#[test]
+fn large_struct_return_rechecks_unchanged_files() {
+    let temp = default_project();
+    temp.config("[golang.largestruct]\ncheck = \"error\"\nmax_bytes = 16\n");
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "geo/types.go",
+        "package geo\n\ntype Rect struct {\n\tMin [2]float64\n}\n",
+    );
+    temp.file(
+        "geo/api.go",
+        "package geo\n\nfunc Unit() Rect {\n\treturn Rect{}\n}\n",
+    );
+
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success()
+        .stdout(predicates::str::contains("large_struct_return").not());
+
+    temp.file(
+        "geo/types.go",
+        "package geo\n\ntype Rect struct {\n\tMin, Max [2]float64\n}\n",
+    );
+    quench_cmd()
+        .args(["check", "--escapes"])
+        .current_dir(temp.path())
+        .assert()
+        .success()
+        .stdout(predicates::str::contains(
+            "  geo/api.go\n    3:6: forbidden: large_struct_return",
+        ));
+}
+
 /// Spec: docs/specs/performance.md#file-caching
 ///
 /// > Docs violations with target paths (broken_link, broken_toc) are invalidated
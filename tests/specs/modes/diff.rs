@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Changed-line scope (`--diff`) behavioral specifications.
+//!
+//! Reference: docs/specs/01-cli.md#scope-flags
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+const GO_MOD: &str = "module example.com/fixture\n\ngo 1.21\n";
+
+/// Unjustified unsafe.Pointer on line 7.
+const LEGACY: &str = "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\tp := unsafe.Pointer(&x)\n\t_ = p\n}\n";
+
+/// Appended after LEGACY; its unsafe.Pointer lands on line 13.
+const ADDED: &str = "\nfunc added() {\n\ty := 2\n\tq := unsafe.Pointer(&y)\n\t_ = q\n}\n";
+
+/// Git project with a legacy violation committed on main.
+fn legacy_repo() -> Project {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", GO_MOD);
+    temp.file("main.go", LEGACY);
+    git_init(&temp);
+    git_initial_commit(&temp);
+    git_branch(&temp, "feature");
+    temp
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > `--diff <REF>` reports only violations on lines added or modified since REF.
+#[test]
+fn diff_reports_only_violations_on_changed_lines() {
+    let temp = legacy_repo();
+    temp.file("main.go", &format!("{}{}", LEGACY, ADDED));
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--diff", "main"])
+        .json()
+        .fails();
+    assert_eq!(escapes.violations().len(), 1);
+    assert_eq!(escapes.violations()[0]["line"], 13);
+
+    // Without --diff the legacy violation is reported too
+    let escapes = check("escapes").pwd(temp.path()).json().fails();
+    assert_eq!(escapes.violations().len(), 2);
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > Legacy violations on unchanged lines don't fail `--diff`.
+#[test]
+fn diff_passes_when_only_legacy_violations() {
+    let temp = legacy_repo();
+    temp.file("README.md", "# Project\n");
+    git_add_all(&temp);
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--diff", "main"])
+        .passes();
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > A renamed file only reports violations on lines edited after the move.
+#[test]
+fn diff_renamed_file_without_edits_passes() {
+    let temp = legacy_repo();
+    std::process::Command::new("git")
+        .args(["mv", "main.go", "moved.go"])
+        .current_dir(temp.path())
+        .output()
+        .expect("git mv should succeed");
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--diff", "main"])
+        .passes();
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > New files, tracked or not, count as changed in full.
+#[test]
+fn diff_reports_violations_in_new_files() {
+    let temp = legacy_repo();
+    temp.file("extra.go", LEGACY);
+
+    let escapes = check("escapes")
+        .pwd(temp.path())
+        .args(&["--diff", "main"])
+        .json()
+        .fails();
+    assert_eq!(escapes.violations().len(), 1);
+    assert!(escapes.has_violation_for_file("extra.go"));
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > Outside a git repository, `--diff` warns and reports all violations.
+#[test]
+fn diff_outside_git_repo_reports_all_violations() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", GO_MOD);
+    temp.file("main.go", LEGACY);
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--diff", "main"])
+        .fails()
+        .stderr_has("--diff needs a git repository");
+}
@@ -108,6 +108,38 @@ fn file_walking_respects_nested_gitignore() {
         .stdout_lacks("vendor/");
 }
 
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > Files ignored by `.gitignore` (nested files and `!` negations included)
+/// > are not scanned.
+#[test]
+fn file_walking_skips_gitignored_violations() {
+    let escapes = check("escapes").on("golang/gitignore-skip").json().passes();
+
+    // keep.gen.go is re-included by `!keep.gen.go` and scanned
+    let source = escapes.require("metrics").get("source").unwrap();
+    assert_eq!(
+        source.get("unsafe_pointer").and_then(|v| v.as_u64()),
+        Some(1)
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > `--no-gitignore` scans files ignored by `.gitignore`.
+#[test]
+fn no_gitignore_flag_scans_ignored_files() {
+    let escapes = check("escapes")
+        .on("golang/gitignore-skip")
+        .args(&["--no-gitignore"])
+        .json()
+        .fails();
+
+    assert!(escapes.has_violation_for_file("build/out.go"));
+    assert!(escapes.has_violation_for_file("api.gen.go"));
+    assert!(escapes.has_violation_for_file("internal/scratch.go"));
+}
+
 // =============================================================================
 // Custom Ignore Patterns
 // =============================================================================
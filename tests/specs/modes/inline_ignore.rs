@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Inline suppression behavioral specifications.
+//!
+//! Reference: docs/specs/01-cli.md#inline-ignores
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use crate::prelude::*;
+
+/// Spec: docs/specs/01-cli.md#inline-ignores
+///
+/// > `// quench:ignore <rule> reason: <why>` suppresses violations of `<rule>`
+/// > on the same line, or on the next line when the comment stands alone.
+#[test]
+fn inline_ignore_suppresses_violation() {
+    let escapes = check("escapes").on("golang/inline-ignore").json().passes();
+    assert!(escapes.violations().is_empty());
+
+    // Suppressed violations still count toward metrics
+    let source = escapes.require("metrics").get("source").unwrap();
+    assert_eq!(
+        source.get("unsafe_pointer").and_then(|v| v.as_u64()),
+        Some(2)
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#inline-ignores
+///
+/// > A directive that suppresses nothing is reported as an unused suppression.
+#[test]
+fn stale_inline_ignore_warns_unused() {
+    cli()
+        .on("golang/inline-ignore-stale")
+        .passes()
+        .stderr_has("main.go:6: unused quench:ignore unsafe-pointer");
+}
+
+/// Spec: docs/specs/01-cli.md#inline-ignores
+///
+/// > Unused suppressions are only reported when every check runs.
+#[test]
+fn stale_inline_ignore_not_reported_for_check_subset() {
+    check("escapes")
+        .on("golang/inline-ignore-stale")
+        .passes()
+        .stderr_lacks("unused");
+}
+
+/// Spec: docs/specs/01-cli.md#inline-ignores
+///
+/// > The reason is required. A directive without one is reported and
+/// > suppresses nothing.
+#[test]
+fn inline_ignore_without_reason_does_not_suppress() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\t// quench:ignore unsafe-pointer\n\tp := unsafe.Pointer(&x)\n\t_ = p\n}\n",
+    );
+
+    check("escapes")
+        .pwd(temp.path())
+        .fails()
+        .stderr_has("main.go:7: quench:ignore needs a reason");
+}
+
+/// Spec: docs/specs/01-cli.md#inline-ignores
+///
+/// > Rules are matched with `-` and `_` treated alike.
+#[test]
+fn inline_ignore_matches_rule_by_pattern_name() {
+    let temp = Project::empty();
+    temp.config("");
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\tp := unsafe.Pointer(&x) // quench:ignore unsafe_pointer reason: audited\n\t_ = p\n}\n",
+    );
+
+    check("escapes").pwd(temp.path()).passes();
+}
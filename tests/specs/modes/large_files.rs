@@ -36,7 +36,7 @@ fn large_file_skipped_with_warning() {
         .passes()
         .stderr_has("skipping")
         .stderr_has("huge.rs")
-        .stderr_has("10MB limit");
+        .stderr_has("10.0MB limit");
 }
 
 /// Large file is not counted in check violations.
@@ -146,3 +146,52 @@ fn multiple_large_files_skipped() {
         .stderr_has("huge2.rs")
         .stderr_has("huge3.rs");
 }
+
+// =============================================================================
+// MAX FILE SIZE SPECS
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > Files over 10MB are skipped without being read ... `--max-file-size 1MB`
+/// > lowers the limit
+#[test]
+fn max_file_size_skips_files_over_limit() {
+    let project = default_project();
+    project.file("src/lib.rs", "fn main() {}");
+
+    // 2MB is under the default limit, so cloc reports it as oversized
+    let blob = File::create(project.path().join("src/blob.rs")).unwrap();
+    blob.set_len(2 * 1024 * 1024).unwrap();
+    let cloc = check("cloc").pwd(project.path()).json().fails();
+    assert!(cloc.has_violation_for_file("blob.rs"));
+
+    check("cloc")
+        .pwd(project.path())
+        .args(&["--max-file-size", "1MB", "--verbose"])
+        .passes()
+        .stderr_has("Max file size: 1.0MB")
+        .stderr_has("1 too large");
+}
+
+/// Spec: docs/specs/01-cli.md#scope-flags
+///
+/// > A size of 0 scans every file, however large.
+#[test]
+fn max_file_size_zero_scans_every_file() {
+    let project = default_project();
+    project.file("src/lib.rs", "fn main() {}");
+
+    let large_file = File::create(project.path().join("src/huge.rs")).unwrap();
+    large_file.set_len(15 * 1024 * 1024).unwrap();
+
+    let cloc = check("cloc")
+        .pwd(project.path())
+        .args(&["--max-file-size", "0"])
+        .json()
+        .fails();
+    assert!(
+        cloc.has_violation_for_file("huge.rs"),
+        "huge.rs should be scanned with no size limit"
+    );
+}
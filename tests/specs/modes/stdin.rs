@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 Alfred Jean LLC
+
+//! Stdin mode (`check --stdin --filename`) behavioral specifications.
+//!
+//! Reference: docs/specs/01-cli.md#stdin-mode
+
+#![allow(clippy::unwrap_used, clippy::expect_used)]
+
+use std::io::Write;
+use std::process::{Output, Stdio};
+
+use crate::prelude::*;
+
+const UNJUSTIFIED: &str = "package store\n\nimport \"unsafe\"\n\nfunc Ptr(x *int) unsafe.Pointer {\n\treturn unsafe.Pointer(x)\n}\n";
+
+/// Run `quench check --stdin` in `dir` with `content` on stdin.
+fn check_stdin(dir: &std::path::Path, args: &[&str], content: &str) -> Output {
+    let mut child = quench_cmd()
+        .args(["check", "--no-cache", "--stdin"])
+        .args(args)
+        .current_dir(dir)
+        .stdin(Stdio::piped())
+        .stdout(Stdio::piped())
+        .stderr(Stdio::piped())
+        .spawn()
+        .expect("command should run");
+    child
+        .stdin
+        .take()
+        .unwrap()
+        .write_all(content.as_bytes())
+        .unwrap();
+    child.wait_with_output().expect("command should finish")
+}
+
+fn locations(output: &Output) -> Vec<(String, u64)> {
+    let json: serde_json::Value = serde_json::from_slice(&output.stdout).expect("valid JSON");
+    json.as_array()
+        .unwrap()
+        .iter()
+        .map(|v| {
+            (
+                v["file"].as_str().unwrap().to_string(),
+                v["line"].as_u64().unwrap(),
+            )
+        })
+        .collect()
+}
+
+fn go_project() -> Project {
+    let temp = Project::empty();
+    temp.config(MINIMAL_CONFIG);
+    temp.file("go.mod", "module example.com/fixture\n\ngo 1.21\n");
+    temp
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > Reads source from stdin and reports violations under `--filename`;
+/// > the file need not exist on disk.
+#[test]
+fn stdin_buffer_reports_violations_under_filename() {
+    let temp = go_project();
+    let output = check_stdin(
+        temp.path(),
+        &["--filename", "internal/store/ptr.go", "--format", "json"],
+        UNJUSTIFIED,
+    );
+
+    assert_eq!(output.status.code(), Some(1));
+    assert_eq!(
+        locations(&output),
+        vec![
+            ("internal/store/ptr.go".to_string(), 5),
+            ("internal/store/ptr.go".to_string(), 6),
+        ]
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > The buffer is checked in place of the file on disk.
+#[test]
+fn stdin_buffer_replaces_file_on_disk() {
+    let temp = go_project();
+    temp.file("ptr.go", UNJUSTIFIED);
+    let justified = "package store\n\nimport \"unsafe\"\n\n// SAFETY: x is pinned by the caller\nfunc Ptr(x *int) unsafe.Pointer {\n\t// SAFETY: x is pinned by the caller\n\treturn unsafe.Pointer(x)\n}\n";
+
+    let output = check_stdin(temp.path(), &["--filename", "ptr.go"], justified);
+    assert_eq!(
+        output.status.code(),
+        Some(0),
+        "{}",
+        String::from_utf8_lossy(&output.stdout)
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > `--baseline` matches violations against the buffer's lines rather than
+/// > the file on disk
+#[test]
+fn stdin_baseline_hashes_buffer_lines() {
+    let temp = go_project();
+    temp.file("ptr.go", UNJUSTIFIED);
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--baseline", ".quench-baseline.json", "--write-baseline"])
+        .passes();
+
+    // The unsaved buffer moves the baselined lines down
+    let edited = UNJUSTIFIED.replace(
+        "\nfunc Ptr",
+        "\n// Ptr returns x.\n//\n// It does little.\nfunc Ptr",
+    );
+    let output = check_stdin(
+        temp.path(),
+        &[
+            "--filename",
+            "ptr.go",
+            "--baseline",
+            ".quench-baseline.json",
+        ],
+        &edited,
+    );
+    assert_eq!(
+        output.status.code(),
+        Some(0),
+        "{}",
+        String::from_utf8_lossy(&output.stdout)
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > `--filename` picks the language adapter, so Go patterns apply even
+/// > without a go.mod.
+#[test]
+fn stdin_filename_picks_language_without_project_markers() {
+    let temp = Project::empty();
+    temp.config(MINIMAL_CONFIG);
+
+    let output = check_stdin(temp.path(), &["--filename", "ptr.go"], UNJUSTIFIED);
+    assert_eq!(output.status.code(), Some(1));
+    let stdout = String::from_utf8_lossy(&output.stdout);
+    assert!(
+        stdout.contains("  ptr.go\n    6:9: missing_comment: unsafe_pointer"),
+        "{}",
+        stdout
+    );
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > Without a surrounding module, `[golang.syscall].allow` still matches
+/// > package directories.
+#[test]
+fn stdin_syscall_allowlist_degrades_to_directories() {
+    let temp = Project::empty();
+    temp.config(&format!(
+        "{}\n[golang.syscall]\ncheck = \"error\"\nallow = [\"internal/sys/...\"]\n",
+        MINIMAL_CONFIG
+    ));
+    let source = "package sys\n\nimport \"syscall\"\n\nvar _ = syscall.Getpid\n";
+
+    let allowed = check_stdin(temp.path(), &["--filename", "internal/sys/pid.go"], source);
+    assert_eq!(allowed.status.code(), Some(0));
+
+    let denied = check_stdin(temp.path(), &["--filename", "cmd/app/pid.go"], source);
+    assert_eq!(denied.status.code(), Some(1));
+    assert!(String::from_utf8_lossy(&denied.stdout).contains("  cmd/app/pid.go\n    3:"));
+}
+
+/// Spec: docs/specs/01-cli.md#stdin-mode
+///
+/// > `--stdin` requires `--filename`.
+#[test]
+fn stdin_requires_filename() {
+    let temp = go_project();
+    let output = check_stdin(temp.path(), &[], UNJUSTIFIED);
+    assert_eq!(output.status.code(), Some(2));
+}
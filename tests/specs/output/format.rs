@@ -149,6 +149,21 @@ fn json_output_timestamp_iso8601() {
     );
 }
 
+/// Spec: docs/specs/03-output.md#json-format
+///
+/// > `quench_version` and `quench_commit` identify the build that produced
+/// > the report
+#[test]
+fn json_output_records_quench_version() {
+    let temp = default_project();
+    let result = cli().pwd(temp.path()).args(&["--no-git"]).json().passes();
+    let json = result.value();
+
+    assert_eq!(json["quench_version"], env!("CARGO_PKG_VERSION"));
+    let commit = json["quench_commit"].as_str().unwrap();
+    assert!(!commit.is_empty());
+}
+
 /// Spec: docs/specs/output.schema.json
 ///
 /// > Check objects have required fields: name, passed
@@ -284,6 +299,57 @@ fn color_env_forces_color() {
         .stdout_has("\x1b[");
 }
 
+/// Spec: docs/specs/03-output.md#colorization
+///
+/// > `--color never` disables color, even when `COLOR` is set
+#[test]
+fn color_never_flag_emits_no_escape_codes() {
+    cli()
+        .on("output-test")
+        .args(&["--color", "never"])
+        .env("COLOR", "1")
+        .exits(1)
+        .stdout_lacks("\x1b[");
+}
+
+/// Spec: docs/specs/03-output.md#colorization
+///
+/// > `--color always` colors the check report even when piped or when
+/// > `NO_COLOR` is set
+#[test]
+fn color_always_flag_colors_piped_output() {
+    cli()
+        .on("output-test")
+        .args(&["--color", "always"])
+        .env("NO_COLOR", "1")
+        .exits(1)
+        .stdout_has("\x1b[");
+}
+
+/// Spec: docs/specs/03-output.md#color-scheme
+///
+/// > **Error violation**: Red
+#[test]
+fn error_violations_are_red() {
+    cli()
+        .on("output-test")
+        .args(&["--color", "always"])
+        .exits(1)
+        .stdout_has("\x1b[31m");
+}
+
+/// Spec: docs/specs/03-output.md#colorization
+///
+/// > Only the text check report is colored; other formats never are
+#[test]
+fn color_always_flag_leaves_json_uncolored() {
+    cli()
+        .on("output-test")
+        .args(&["--color", "always", "-o", "json"])
+        .exits(1)
+        .stdout_lacks("\x1b[");
+}
+
 // =============================================================================
 // Violation Limits
 // =============================================================================
@@ -376,12 +442,13 @@ fn check_output_format_exact() {
     // Multi-line advice has trailing newline for readability
     let expected = "\
 cloc: FAIL
-  src/oversized.rs: file_too_large (lines: 15 vs 10)
-    First, look for repetitive patterns that could be extracted into helper functions, or refactor to be more unit testable and concise.
+  src/oversized.rs
+    file_too_large (lines: 15 vs 10)
+      First, look for repetitive patterns that could be extracted into helper functions, or refactor to be more unit testable and concise.
 
-    Then split into sibling modules or submodules in a folder by semantic concern (target 2\u{2013}3 lines each).
+      Then split into sibling modules or submodules in a folder by semantic concern (target 2\u{2013}3 lines each).
 
-    Avoid removing individual lines to satisfy the linter; prefer extracting testable code blocks.
+      Avoid removing individual lines to satisfy the linter; prefer extracting testable code blocks.
 
 PASS: escapes, agents, docs, tests, git, license
 FAIL: cloc
@@ -394,6 +461,136 @@ FAIL: cloc
     );
 }
 
+// =============================================================================
+// Grouping
+// =============================================================================
+
+/// Spec: docs/specs/03-output.md#text-format-default
+///
+/// > Violations are grouped under a header per file, so each path is shown
+/// > once. Files are listed in path order and violations by line and column
+/// > within each file.
+#[test]
+fn text_output_groups_violations_by_file() {
+    check("escapes").on("escapes/todo-fail").fails().stdout_eq(
+        "escapes: FAIL
+  scripts/deploy.py
+    3:7: forbidden: unreferenced_todo
+      TODO has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+  src/lib.rs
+    3:8: forbidden: unreferenced_todo
+    4:20: forbidden: unreferenced_todo
+      FIXME has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+FAIL: escapes
+",
+    );
+}
+
+/// Spec: docs/specs/03-output.md#text-format-default
+///
+/// > `rule` | A header per rule (escape pattern name, or violation type), in
+/// > name order; violations show `file:line:column`, sorted by file and line
+#[test]
+fn text_output_groups_violations_by_rule() {
+    check("escapes")
+        .on("escapes/todo-fail")
+        .args(&["--group", "rule"])
+        .fails()
+        .stdout_eq(
+            "escapes: FAIL
+  unreferenced_todo
+    scripts/deploy.py:3:7: forbidden: unreferenced_todo
+      TODO has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+    src/lib.rs:3:8: forbidden: unreferenced_todo
+    src/lib.rs:4:20: forbidden: unreferenced_todo
+      FIXME has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+FAIL: escapes
+",
+        );
+}
+
+/// Spec: docs/specs/03-output.md#text-format-default
+///
+/// > `none` | One flat list in the order checks report, each violation with
+/// > `file:line:column`
+#[test]
+fn text_output_ungrouped_lists_each_location() {
+    check("escapes")
+        .on("escapes/todo-fail")
+        .args(&["--group", "none"])
+        .fails()
+        .stdout_has("\n  scripts/deploy.py:3:7: forbidden: unreferenced_todo\n")
+        .stdout_has("\n  src/lib.rs:3:8: forbidden: unreferenced_todo\n")
+        .stdout_has("\n  src/lib.rs:4:20: forbidden: unreferenced_todo\n");
+}
+
+// =============================================================================
+// Streaming
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > Files are scanned in parallel but written in file path order, so the
+/// > report reads the same as without the flag
+#[test]
+fn stream_writes_the_same_report() {
+    check("escapes")
+        .on("escapes/todo-fail")
+        .args(&["--stream"])
+        .fails()
+        .stdout_eq(
+            "escapes: FAIL
+  scripts/deploy.py
+    3:7: forbidden: unreferenced_todo
+      TODO has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+  src/lib.rs
+    3:8: forbidden: unreferenced_todo
+    4:20: forbidden: unreferenced_todo
+      FIXME has no issue reference. Add one in parentheses or brackets right after it, matching `[A-Z][A-Z0-9]+-[0-9]+`, or resolve it.
+FAIL: escapes
+",
+        );
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > Files are scanned in parallel but written in file path order
+#[test]
+fn stream_keeps_file_order_across_parallel_scans() {
+    let temp = Project::empty();
+    temp.config("[check.escapes.todo]\ncheck = \"error\"\n");
+    for i in 0..200 {
+        let padding = "// filler\n".repeat(i % 17);
+        temp.file(
+            format!("src/mod_{i:03}.rs"),
+            &format!("{padding}// TODO tidy up\n"),
+        );
+    }
+
+    let buffered = check("escapes")
+        .pwd(temp.path())
+        .args(&["--no-limit"])
+        .fails()
+        .stdout();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--no-limit", "--stream", "--jobs", "8"])
+        .fails()
+        .stdout_eq(&buffered);
+}
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > It applies to the check report (file or flat grouping) and `--format github`
+#[test]
+fn stream_writes_github_annotations() {
+    cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "github", "--stream"])
+        .exits(1)
+        .stdout_eq("::error file=main.go,line=4,col=1,title=nosplit::Add a // NOSPLIT: comment explaining why the stack check can be skipped.\n");
+}
+
 // =============================================================================
 // Advice Deduplication
 // =============================================================================
@@ -405,15 +602,18 @@ FAIL: cloc
 fn text_output_deduplicates_consecutive_identical_advice() {
     cli().on("dedup-advice").exits(1).stdout_eq(
         "cloc: FAIL
-  src/file_c.rs: file_too_large (lines: 7 vs 5)
-    First, look for repetitive patterns that could be extracted into helper functions, or refactor to be more unit testable and concise.
+  src/file_a.rs
+    file_too_large (lines: 7 vs 5)
+      First, look for repetitive patterns that could be extracted into helper functions, or refactor to be more unit testable and concise.
 
-    Then split into sibling modules or submodules in a folder by semantic concern (target 1\u{2013}1 lines each).
+      Then split into sibling modules or submodules in a folder by semantic concern (target 1\u{2013}1 lines each).
 
-    Avoid removing individual lines to satisfy the linter; prefer extracting testable code blocks.
+      Avoid removing individual lines to satisfy the linter; prefer extracting testable code blocks.
 
-  src/file_b.rs: file_too_large (lines: 7 vs 5)
-  src/file_a.rs: file_too_large (lines: 7 vs 5)
+  src/file_b.rs
+    file_too_large (lines: 7 vs 5)
+  src/file_c.rs
+    file_too_large (lines: 7 vs 5)
 PASS: escapes, agents, docs, tests, git, license
 FAIL: cloc
 ",
@@ -465,3 +665,677 @@ fn json_output_never_deduplicates_advice() {
         );
     }
 }
+
+// =============================================================================
+// Violation List Format
+// =============================================================================
+
+/// Spec: docs/specs/03-output.md#violation-list-format-json
+///
+/// > `--format json` emits a flat array with one object per violation
+/// > Sorted by file, then line, then column
+/// > Exit codes are the same as for the default format
+#[test]
+fn violation_list_sorted_by_file() {
+    let result = cli()
+        .on("dedup-advice")
+        .args(&["--format", "json"])
+        .exits(1);
+    let records: serde_json::Value = serde_json::from_str(&result.stdout()).unwrap();
+    let records = records.as_array().expect("should be a JSON array");
+
+    let files: Vec<_> = records
+        .iter()
+        .map(|r| r.get("file").and_then(|f| f.as_str()).unwrap())
+        .collect();
+    assert_eq!(
+        files,
+        vec!["src/file_a.rs", "src/file_b.rs", "src/file_c.rs"]
+    );
+
+    for record in records {
+        assert_eq!(
+            record.get("rule").and_then(|r| r.as_str()),
+            Some("file_too_large")
+        );
+        assert_eq!(
+            record.get("severity").and_then(|s| s.as_str()),
+            Some("error")
+        );
+        assert!(record.get("message").and_then(|m| m.as_str()).is_some());
+    }
+}
+
+/// Spec: docs/specs/03-output.md#violation-list-format-json
+///
+/// > No violations produces `[]`
+#[test]
+fn violation_list_empty_is_empty_array() {
+    let temp = default_project();
+    cli()
+        .pwd(temp.path())
+        .args(&["--format", "json"])
+        .passes()
+        .stdout_eq("[]\n");
+}
+
+/// Spec: docs/specs/03-output.md#rule-ids
+///
+/// > Every format carries a stable rule id: `ruleId` in `--format json` and
+/// > SARIF, `source` in checkstyle, the suite name in JUnit, and `title` in
+/// > GitHub annotations
+#[test]
+fn rule_id_is_in_every_format() {
+    let format = |name: &str| {
+        cli()
+            .on("golang/nosplit-fail")
+            .args(&["--format", name])
+            .exits(1)
+            .stdout()
+    };
+
+    let json: serde_json::Value = serde_json::from_str(&format("json")).unwrap();
+    assert_eq!(json[0]["rule"], "go_nosplit");
+    assert_eq!(json[0]["ruleId"], "nosplit");
+
+    let sarif: serde_json::Value = serde_json::from_str(&format("sarif")).unwrap();
+    assert_eq!(sarif["runs"][0]["results"][0]["ruleId"], "nosplit");
+
+    assert!(format("checkstyle").contains("source=\"quench.escapes.nosplit\""));
+    assert!(format("junit").contains("<testsuite name=\"quench.escapes.nosplit\""));
+    assert!(format("github").contains(",title=nosplit::"));
+}
+
+// =============================================================================
+// SARIF Format
+// =============================================================================
+
+fn sarif_validator() -> jsonschema::Validator {
+    let schema_path = std::path::PathBuf::from(env!("CARGO_MANIFEST_DIR"))
+        .parent()
+        .unwrap()
+        .parent()
+        .unwrap()
+        .join("docs/specs/sarif.schema.json");
+    let schema_str = std::fs::read_to_string(&schema_path).unwrap();
+    let schema: serde_json::Value = serde_json::from_str(&schema_str).unwrap();
+    jsonschema::validator_for(&schema).expect("schema should be valid")
+}
+
+/// Spec: docs/specs/03-output.md#sarif-format-sarif
+///
+/// > `--format sarif` emits a SARIF 2.1.0 log
+/// > The emitted properties are documented in sarif.schema.json
+#[test]
+fn sarif_output_validates_against_schema() {
+    let result = cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "sarif"])
+        .exits(1);
+    let sarif: serde_json::Value = serde_json::from_str(&result.stdout()).unwrap();
+
+    let validator = sarif_validator();
+    let errors: Vec<_> = validator
+        .iter_errors(&sarif)
+        .map(|e| e.to_string())
+        .collect();
+    assert!(errors.is_empty(), "SARIF should validate: {:?}", errors);
+
+    let run = &sarif["runs"][0];
+    let rule = run["tool"]["driver"]["rules"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .find(|r| r["id"] == "nosplit")
+        .expect("should describe go_nosplit rule");
+    assert!(rule["shortDescription"]["text"].as_str().is_some());
+
+    let result = run["results"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .find(|r| r["ruleId"] == "nosplit")
+        .expect("should report go_nosplit");
+    let location = &result["locations"][0]["physicalLocation"];
+    assert_eq!(location["artifactLocation"]["uri"], "main.go");
+    assert_eq!(location["region"]["startLine"], 4);
+    assert_eq!(location["region"]["startColumn"], 1);
+}
+
+/// Spec: docs/specs/03-output.md#sarif-format-sarif
+///
+/// > One run, with `tool.driver.rules[]` listing each rule that has violations
+#[test]
+fn sarif_output_without_violations_validates() {
+    let temp = default_project();
+    let result = cli().pwd(temp.path()).args(&["--format", "sarif"]).passes();
+    let sarif: serde_json::Value = serde_json::from_str(&result.stdout()).unwrap();
+
+    assert!(sarif_validator().is_valid(&sarif));
+    assert_eq!(sarif["runs"][0]["results"], serde_json::json!([]));
+}
+
+/// Spec: docs/specs/03-output.md#sarif-format-sarif
+///
+/// > The log is deterministic: results are sorted by `uri`, `startLine`,
+/// > `startColumn`, then `ruleId`, and `tool.driver.rules[]` by `id`
+#[test]
+fn sarif_output_is_identical_across_scans() {
+    let scan = || {
+        cli()
+            .on("violations")
+            .args(&["--format", "sarif", "--no-limit"])
+            .exits(1)
+            .stdout()
+    };
+    let first = scan();
+    assert_eq!(first, scan());
+
+    let sarif: serde_json::Value = serde_json::from_str(&first).unwrap();
+    let ids: Vec<&str> = sarif["runs"][0]["tool"]["driver"]["rules"]
+        .as_array()
+        .unwrap()
+        .iter()
+        .map(|r| r["id"].as_str().unwrap())
+        .collect();
+    let mut sorted = ids.clone();
+    sorted.sort();
+    assert_eq!(ids, sorted);
+}
+
+// =============================================================================
+// Checkstyle Format
+// =============================================================================
+
+/// Spec: docs/specs/03-output.md#checkstyle-format-checkstyle
+///
+/// > `--format checkstyle` emits the checkstyle XML report
+/// > `source` is `quench.<check>.<rule>`
+#[test]
+fn checkstyle_output_reports_violations_per_file() {
+    let result = cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "checkstyle"])
+        .exits(1);
+    let xml = result.stdout();
+
+    assert!(
+        xml.starts_with(
+            "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<checkstyle version=\"4.3\">\n"
+        )
+    );
+    assert!(xml.ends_with("</checkstyle>\n"));
+    assert_eq!(xml.matches("<file name=\"main.go\">").count(), 1);
+    assert!(
+        xml.contains("<error line=\"4\" column=\"1\" severity=\"error\" message=\"Add a // NOSPLIT: comment explaining why the stack check can be skipped.\" source=\"quench.escapes.nosplit\"/>"),
+        "missing go_nosplit error:\n{}",
+        xml
+    );
+}
+
+/// Spec: docs/specs/03-output.md#checkstyle-format-checkstyle
+///
+/// > One `<file>` per path, in sorted order
+#[test]
+fn checkstyle_output_without_violations_is_empty_report() {
+    let temp = default_project();
+    let result = cli()
+        .pwd(temp.path())
+        .args(&["--format", "checkstyle"])
+        .passes();
+    assert_eq!(
+        result.stdout(),
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<checkstyle version=\"4.3\">\n</checkstyle>\n"
+    );
+}
+
+// =============================================================================
+// JUnit Format
+// =============================================================================
+
+/// Element names in document order, checking every tag is closed by a
+/// matching end tag. Returns (depth, name) per start tag.
+fn xml_elements(xml: &str) -> Vec<(usize, String)> {
+    let mut elements = Vec::new();
+    let mut open: Vec<String> = Vec::new();
+    for tag in xml.split('<').skip(1) {
+        let tag = &tag[..tag.find('>').expect("unclosed tag")];
+        if tag.starts_with('?') {
+            continue;
+        }
+        if let Some(name) = tag.strip_prefix('/') {
+            assert_eq!(open.pop().as_deref(), Some(name), "mismatched </{}>", name);
+            continue;
+        }
+        let name = tag.split_whitespace().next().unwrap().trim_end_matches('/');
+        elements.push((open.len(), name.to_string()));
+        if !tag.ends_with('/') {
+            open.push(name.to_string());
+        }
+    }
+    assert!(open.is_empty(), "unclosed elements: {:?}", open);
+    elements
+}
+
+/// Spec: docs/specs/03-output.md#junit-format-junit
+///
+/// > `--format junit` emits a JUnit XML report: each rule is a
+/// > `<testsuite>` and each violation a failing `<testcase>`
+#[test]
+fn junit_output_reports_violations_as_failing_test_cases() {
+    let result = cli()
+        .on("golang/unsafe-add-fail")
+        .args(&["--format", "junit"])
+        .exits(1);
+    let xml = result.stdout();
+
+    assert!(xml.starts_with("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<testsuites "));
+    assert_eq!(
+        xml_elements(&xml),
+        vec![
+            (0, "testsuites".to_string()),
+            (1, "testsuite".to_string()),
+            (2, "testcase".to_string()),
+            (3, "failure".to_string()),
+        ]
+    );
+    assert!(
+        xml.contains(
+            "<testsuite name=\"quench.escapes.unsafe-add\" tests=\"1\" failures=\"1\" timestamp=\""
+        ),
+        "missing unsafe_add suite:\n{}",
+        xml
+    );
+    assert!(
+        xml.contains("<testcase name=\"main.go:11\" classname=\"quench.escapes.unsafe-add\" file=\"main.go\" line=\"11\">"),
+        "missing test case:\n{}",
+        xml
+    );
+    assert!(
+        xml.contains("type=\"error\">main.go:11: Add a // SAFETY: comment"),
+        "missing failure body:\n{}",
+        xml
+    );
+}
+
+/// Spec: docs/specs/03-output.md#junit-format-junit
+///
+/// > Files without violations don't appear
+#[test]
+fn junit_output_without_violations_has_no_suites() {
+    let temp = default_project();
+    let result = cli().pwd(temp.path()).args(&["--format", "junit"]).passes();
+    assert_eq!(
+        xml_elements(&result.stdout()),
+        vec![(0, "testsuites".to_string())]
+    );
+    assert!(
+        result
+            .stdout()
+            .contains("tests=\"0\" failures=\"0\" timestamp=\"")
+    );
+}
+
+// =============================================================================
+// GitHub Actions Format
+// =============================================================================
+
+/// Spec: docs/specs/03-output.md#github-actions-format-github
+///
+/// > `--format github` emits one workflow command per violation
+#[test]
+fn github_output_emits_error_annotations() {
+    cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "github"])
+        .exits(1)
+        .stdout_eq("::error file=main.go,line=4,col=1,title=nosplit::Add a // NOSPLIT: comment explaining why the stack check can be skipped.\n");
+}
+
+/// Spec: docs/specs/03-output.md#github-actions-format-github
+///
+/// > Violations are sorted like `--format json`; nothing is printed when there are none
+#[test]
+fn github_output_without_violations_is_empty() {
+    let temp = default_project();
+    cli()
+        .pwd(temp.path())
+        .args(&["--format", "github"])
+        .passes()
+        .stdout_eq("");
+}
+
+/// Spec: docs/specs/03-output.md#github-actions-format-github
+///
+/// > `--format auto` selects `github` when `GITHUB_ACTIONS=true`
+#[test]
+fn auto_format_selects_github_in_actions() {
+    cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "auto"])
+        .env("GITHUB_ACTIONS", "true")
+        .exits(1)
+        .stdout_has("::error file=main.go,line=4,col=1,title=nosplit::");
+}
+
+/// Spec: docs/specs/03-output.md#github-actions-format-github
+///
+/// > and the normal check report otherwise
+#[test]
+fn auto_format_uses_check_report_outside_actions() {
+    cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "auto"])
+        .env("GITHUB_ACTIONS", "false")
+        .exits(1)
+        .stdout_has("escapes: FAIL")
+        .stdout_lacks("::error");
+}
+
+// =============================================================================
+// Summary Statistics
+// =============================================================================
+
+fn stats_project() -> Project {
+    let temp = Project::empty();
+    temp.config(
+        r#"[[check.escapes.patterns]]
+name = "unwrap"
+pattern = "\\.unwrap\\(\\)"
+action = "forbid"
+
+[[check.escapes.patterns]]
+name = "dbg"
+pattern = "dbg!"
+action = "forbid"
+
+[rules.dbg]
+severity = "warning"
+"#,
+    );
+    temp.file(
+        "src/a.rs",
+        "fn a() { x.unwrap(); }\nfn b() { y.unwrap(); }\n",
+    );
+    temp.file("src/b.rs", "fn c() { dbg!(1); }\n");
+    temp
+}
+
+/// Spec: docs/specs/03-output.md#summary-statistics---stats
+///
+/// > `quench: 3 violations (2 error, 1 warning) across 2 files; 40 files scanned in 1.2s`
+#[test]
+fn stats_footer_counts_violations_by_severity_and_rule() {
+    let temp = stats_project();
+    let result = check("escapes").pwd(temp.path()).args(&["--stats"]).fails();
+    let stdout = result.stdout();
+    let footer: Vec<&str> = stdout
+        .lines()
+        .skip_while(|line| !line.starts_with("quench: "))
+        .collect();
+
+    assert_eq!(footer.len(), 2, "missing stats footer:\n{}", stdout);
+    assert!(
+        footer[0].starts_with("quench: 3 violations (2 error, 1 warning) across 2 files; "),
+        "unexpected footer: {}",
+        footer[0]
+    );
+    assert!(footer[0].contains(" files scanned in "));
+    assert_eq!(footer[1], "  by rule: unwrap 2, dbg 1");
+}
+
+/// Spec: docs/specs/03-output.md#summary-statistics---stats
+///
+/// > Counts are not capped by the violation limit
+#[test]
+fn stats_footer_counts_past_violation_limit() {
+    let temp = stats_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--stats", "--limit", "1"])
+        .fails()
+        .stdout_has("quench: 3 violations (2 error, 1 warning)");
+}
+
+/// Spec: docs/specs/03-output.md#per-rule-limit---limit-per-rule
+///
+/// > `--limit-per-rule N` shows at most N violations of each rule per check
+#[test]
+fn limit_per_rule_notes_remaining_violations() {
+    let temp = stats_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--limit-per-rule", "1"])
+        .fails()
+        .stdout_has("  src/a.rs\n    1:11: forbidden: unwrap")
+        .stdout_lacks("    2:11: forbidden: unwrap")
+        .stdout_has("  src/b.rs\n    1:10: forbidden: dbg")
+        .stdout_has("  +1 more unwrap\n");
+}
+
+/// Spec: docs/specs/03-output.md#per-rule-limit---limit-per-rule
+///
+/// > `--stats`, `--max-violations`, and the exit status count every violation
+#[test]
+fn limit_per_rule_still_counts_every_violation() {
+    let temp = stats_project();
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--stats", "--limit-per-rule", "1"])
+        .fails()
+        .stdout_has("quench: 3 violations (2 error, 1 warning)");
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--limit-per-rule", "1", "--max-violations", "1"])
+        .fails();
+}
+
+/// Spec: docs/specs/03-output.md#summary-statistics---stats
+///
+/// > Only written with text output
+#[test]
+fn stats_footer_suppressed_in_machine_formats() {
+    let temp = stats_project();
+    // A footer after the JSON report would fail to parse
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["--stats"])
+        .json()
+        .fails();
+    cli()
+        .pwd(temp.path())
+        .args(&["--stats", "--format", "sarif"])
+        .exits(1)
+        .stdout_lacks("quench: ");
+}
+
+// =============================================================================
+// Path Style
+// =============================================================================
+
+/// Spec: docs/specs/01-cli.md#output-flags
+///
+/// > `--paths <STYLE>` reports file paths `relative` to the scan root
+/// > (default) or `absolute`
+#[test]
+fn paths_relative_by_default_and_absolute_on_request() {
+    let root = fixture("golang/nosplit-fail").canonicalize().unwrap();
+    let absolute = root.join("main.go").display().to_string();
+
+    for (args, expected) in [
+        (&[][..], "main.go"),
+        (&["--paths", "relative"][..], "main.go"),
+        (&["--paths", "absolute"][..], absolute.as_str()),
+    ] {
+        let escapes = check("escapes")
+            .on("golang/nosplit-fail")
+            .args(args)
+            .json()
+            .fails();
+        let file = escapes.require_violation("missing_comment")["file"].clone();
+        assert_eq!(file, expected, "args: {:?}", args);
+
+        let text = check("escapes")
+            .on("golang/nosplit-fail")
+            .args(args)
+            .fails();
+        assert!(
+            text.stdout().contains(&format!(
+                "  {}\n    4:1: missing_comment: go_nosplit",
+                expected
+            )),
+            "args: {:?}\n{}",
+            args,
+            text.stdout()
+        );
+
+        let github = cli()
+            .on("golang/nosplit-fail")
+            .args(args)
+            .args(&["--format", "github"])
+            .exits(1);
+        assert!(
+            github.stdout().contains(&format!(
+                "::error file={},line=4,col=1,title=nosplit::",
+                expected.replace(':', "%3A")
+            )),
+            "args: {:?}\n{}",
+            args,
+            github.stdout()
+        );
+    }
+}
+
+/// Spec: docs/specs/03-output.md#sarif-format-sarif
+///
+/// > with `--paths absolute`, `uri` is an absolute `file://` URI and
+/// > `uriBaseId` is omitted
+#[test]
+fn sarif_uri_follows_path_style() {
+    let root = fixture("golang/nosplit-fail").canonicalize().unwrap();
+    let sarif_location = |args: &[&str]| {
+        let result = cli()
+            .on("golang/nosplit-fail")
+            .args(&["--format", "sarif"])
+            .args(args)
+            .exits(1);
+        let sarif: serde_json::Value = serde_json::from_str(&result.stdout()).unwrap();
+        assert!(sarif_validator().is_valid(&sarif));
+        sarif["runs"][0]["results"][0]["locations"][0]["physicalLocation"]["artifactLocation"]
+            .clone()
+    };
+
+    assert_eq!(
+        sarif_location(&[]),
+        serde_json::json!({ "uri": "main.go", "uriBaseId": "%SRCROOT%" })
+    );
+    assert_eq!(
+        sarif_location(&["--paths", "absolute"]),
+        serde_json::json!({ "uri": format!("file://{}/main.go", root.display()) })
+    );
+}
+
+// =============================================================================
+// Human Format
+// =============================================================================
+
+/// Spec: docs/specs/03-output.md#human-format-format-human
+///
+/// > `--format human` emits one line per violation
+#[test]
+fn human_output_emits_one_line_per_violation() {
+    cli()
+        .on("golang/nosplit-fail")
+        .args(&["--format", "human"])
+        .exits(1)
+        .stdout_has("main.go:4:1: error: Add a // NOSPLIT: comment")
+        .stdout_has("(nosplit)\n");
+}
+
+/// Spec: docs/specs/03-output.md#human-format-format-human
+///
+/// > Nothing is printed when there are no violations
+#[test]
+fn human_output_without_violations_is_empty() {
+    let temp = default_project();
+    let result = cli().pwd(temp.path()).args(&["--format", "human"]).passes();
+    assert_eq!(result.stdout(), "");
+}
+
+// =============================================================================
+// Output File
+// =============================================================================
+
+fn unsafe_pointer_project() -> Project {
+    let temp = default_project();
+    temp.file("go.mod", "module example.com/p\n\ngo 1.21\n");
+    temp.file(
+        "main.go",
+        "package main\n\nimport \"unsafe\"\n\nfunc main() {\n\tx := 1\n\t_ = unsafe.Pointer(&x)\n}\n",
+    );
+    temp
+}
+
+/// Spec: docs/specs/03-output.md#output-file
+///
+/// > `--output FILE` writes the selected format's output to FILE instead of
+/// > stdout, creating missing parent directories
+#[test]
+fn output_file_matches_stdout_output() {
+    let temp = unsafe_pointer_project();
+    let stdout = cli()
+        .pwd(temp.path())
+        .args(&["--format", "sarif"])
+        .exits(1)
+        .stdout();
+
+    let result = cli()
+        .pwd(temp.path())
+        .args(&["--format", "sarif", "--output", "reports/quench.sarif"])
+        .exits(1);
+    assert_eq!(result.stdout(), "");
+
+    let written = std::fs::read_to_string(temp.path().join("reports/quench.sarif")).unwrap();
+    assert_eq!(written, stdout);
+}
+
+/// Spec: docs/specs/03-output.md#output-file
+///
+/// > Without `--format`, the file's extension picks the format: `.sarif` for
+/// > SARIF, `.json` for the JSON report, and the text report otherwise
+#[test]
+fn output_file_extension_selects_format() {
+    let temp = unsafe_pointer_project();
+    cli()
+        .pwd(temp.path())
+        .args(&["--output", "quench.sarif"])
+        .exits(1);
+    let sarif: serde_json::Value =
+        serde_json::from_str(&std::fs::read_to_string(temp.path().join("quench.sarif")).unwrap())
+            .unwrap();
+    assert!(sarif_validator().is_valid(&sarif));
+
+    cli()
+        .pwd(temp.path())
+        .args(&["--output", "quench.txt"])
+        .exits(1);
+    let text = std::fs::read_to_string(temp.path().join("quench.txt")).unwrap();
+    assert!(text.contains("escapes: FAIL"), "text report: {}", text);
+    assert!(
+        !text.contains('\x1b'),
+        "file should not be colored: {}",
+        text
+    );
+}
+
+/// Spec: docs/specs/03-output.md#output-file
+///
+/// > `--format` can't be combined with an `--output` format name
+#[test]
+fn format_with_output_format_name_is_a_config_error() {
+    cli()
+        .on("violations")
+        .args(&["--format", "sarif", "-o", "json"])
+        .exits(2)
+        .stderr_has("--format cannot be combined with an --output format");
+}
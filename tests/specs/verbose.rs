@@ -131,6 +131,49 @@ fn file_count_is_logged() {
         .stderr_has("  Scanned");
 }
 
+/// Spec: docs/specs/01-cli.md#development-flags
+///
+/// > each file left out with the reason (an exclude pattern, a generated
+/// > header, `--max-file-size`, or `--build-tags`)
+#[test]
+fn skipped_files_are_logged_with_reason() {
+    let temp = default_project();
+    temp.config("[project]\nexclude = [\"fixtures/**\"]\n");
+    temp.file("src/lib.rs", "fn main() {}");
+    temp.file("fixtures/sample.rs", "fn sample() {}");
+    temp.file(
+        "src/gen.go",
+        "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage src\n",
+    );
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["-v"])
+        .passes()
+        .stderr_has("  Skipped: fixtures/sample.rs (matches exclude pattern fixtures/**)")
+        .stderr_has("  Skipped: src/gen.go (generated)")
+        .stdout_lacks("Skipped:")
+        .stderr_lacks("\nAdapters:");
+}
+
+/// Spec: docs/specs/01-cli.md#development-flags
+///
+/// > `-vv` adds debug detail: the adapter each file extension is dispatched
+/// > to
+#[test]
+fn double_verbose_logs_adapter_per_extension() {
+    let temp = default_project();
+    temp.file("src/lib.rs", "fn main() {}");
+    temp.file("src/other.rs", "fn test() {}");
+
+    check("escapes")
+        .pwd(temp.path())
+        .args(&["-vv"])
+        .passes()
+        .stderr_has("\nAdapters:\n")
+        .stderr_has("  .rs: Rust (2 files)");
+}
+
 /// Spec: plans/verbose-in-ci-mode.md - Phase 3
 ///
 /// > Suite execution is logged (before/after)
@@ -204,7 +247,8 @@ Configuration:
 
 Discovery:
   Max depth limit: 100
-  Scanned 3 files (0 errors, 0 symlink loops, 0 skipped >10MB)
+  Max file size: 10.0MB
+  Scanned 3 files (0 errors, 0 symlink loops, 0 too large)
 
 Ratchet:
   Mode: file
@@ -258,7 +302,8 @@ Configuration:
 
 Discovery:
   Max depth limit: 100
-  Scanned 3 files (0 errors, 0 symlink loops, 0 skipped >10MB)
+  Max file size: 10.0MB
+  Scanned 3 files (0 errors, 0 symlink loops, 0 too large)
 
 Ratchet:
   Mode: file